@@ -30,6 +30,86 @@ type Config struct {
 	BridgeConfig BridgeConfig
 	// BatchCallLimit is the limit of batch calls
 	BatchCallLimit int
+	// ContractMetrics are gauges of values returned by view methods of contracts
+	ContractMetrics []ContractMetric `yaml:"contract_metrics"`
+	// Fleet is the config for the relayer and agent fleet health checks
+	Fleet FleetConfig `yaml:"fleet"`
+}
+
+// FleetConfig contains the config for the relayer and agent fleet health checks.
+// The last successful tx of a member is found from historical nonces, so the omnirpc endpoints of the member chains
+// have to serve archive data. Without it fleet_last_successful_tx_age_seconds is not reported, the other checks are.
+type FleetConfig struct {
+	// RFQAPIURL is the url of the RFQ API, quote freshness is not checked if it is empty
+	RFQAPIURL string `yaml:"rfq_api_url"`
+	// TxLookback is the max number of reverted txes to look past for the last successful tx, requires an archive rpc
+	TxLookback int `yaml:"tx_lookback" default:"10"`
+	// Members are the relayers and agents of the fleet
+	Members []FleetMember `yaml:"members"`
+}
+
+// FleetMember contains the config for the health checks of a relayer or agent.
+type FleetMember struct {
+	// Name of the member, e.g. rfq-relayer
+	Name string `yaml:"name"`
+	// Address is the address the member submits txes from
+	Address string `yaml:"address"`
+	// ChainIDs are the chains the member submits txes on
+	ChainIDs []int `yaml:"chain_ids"`
+	// MinBalance is the native gas balance, in ether, below which the member is flagged
+	MinBalance float64 `yaml:"min_balance"`
+	// MinBalances override the min balance per chain id
+	MinBalances map[int]float64 `yaml:"min_balances"`
+	// RFQ is whether the member is an rfq relayer, whose quotes are checked for freshness
+	RFQ bool `yaml:"rfq"`
+}
+
+// GetMinBalance returns the min balance of the member on the given chain.
+func (f FleetMember) GetMinBalance(chainID int) float64 {
+	if minBalance, ok := f.MinBalances[chainID]; ok {
+		return minBalance
+	}
+	return f.MinBalance
+}
+
+// ContractMetric contains the config for a gauge of the value returned by a view method of contracts.
+// The method is either given by its signature and returns, e.g. "balanceOf(address)" and "uint256",
+// or by its name and the json abi of the contract.
+type ContractMetric struct {
+	// Name is the name of the gauge
+	Name string `yaml:"name"`
+	// Description is the description of the gauge
+	Description string `yaml:"description"`
+	// Signature is the signature of the method
+	Signature string `yaml:"signature"`
+	// Returns are the comma separated return types of the method
+	Returns string `yaml:"returns"`
+	// Method is the name of the method in the abi
+	Method string `yaml:"method"`
+	// ABI is the json abi containing the method
+	ABI string `yaml:"abi"`
+	// Args are the arguments of the call, parsed according to the types of the method inputs
+	Args []string `yaml:"args"`
+	// Output is the index of the exported return value, which has to be a number or a bool
+	Output int `yaml:"output"`
+	// Decimals is the number of decimals of the returned value
+	Decimals uint8 `yaml:"decimals"`
+	// Labels are the labels of every observation of the gauge
+	Labels map[string]string `yaml:"labels"`
+	// Contracts are the contracts the method is called on
+	Contracts []MetricContract `yaml:"contracts"`
+}
+
+// MetricContract contains the config for a contract of a contract metric.
+type MetricContract struct {
+	// ChainID is the chain id
+	ChainID int `yaml:"chain_id"`
+	// Address is the address of the contract
+	Address string `yaml:"address"`
+	// Args override the arguments of the metric for this contract
+	Args []string `yaml:"args"`
+	// Labels are the labels of the observations of this contract, they override the labels of the metric
+	Labels map[string]string `yaml:"labels"`
 }
 
 // BridgeConfig contains the config for the bridge.
@@ -151,5 +231,10 @@ func DecodeConfig(filePath string) (_ Config, err error) {
 	if err != nil {
 		return Config{}, fmt.Errorf("could not unmarshall config %s: %w", ellipsis.Shorten(string(input), 30), err)
 	}
+
+	// calls are batched in chunks of the limit, a limit below 1 would never make progress.
+	if cfg.BatchCallLimit <= 0 {
+		return Config{}, fmt.Errorf("batch call limit must be positive, got %d", cfg.BatchCallLimit)
+	}
 	return *cfg, nil
 }
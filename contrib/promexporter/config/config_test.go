@@ -14,3 +14,62 @@ func TestDefaultConfig(t *testing.T) {
 	_, err := config.DecodeConfig(tmpFile.Name())
 	assert.Nil(t, err)
 }
+
+func TestBatchCallLimitConfig(t *testing.T) {
+	tmpFile := filet.TmpFile(t, "", "batchcalllimit: 0\n")
+
+	_, err := config.DecodeConfig(tmpFile.Name())
+	assert.NotNil(t, err)
+}
+
+func TestContractMetricsConfig(t *testing.T) {
+	tmpFile := filet.TmpFile(t, "", `contract_metrics:
+  - name: token_balance
+    signature: balanceOf(address)
+    returns: uint256
+    decimals: 18
+    labels:
+      token: usdc
+    contracts:
+      - chain_id: 1
+        address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+        args: ["0x2796317b0fF8538F253012862c06787Adfb8cEb6"]
+`)
+
+	cfg, err := config.DecodeConfig(tmpFile.Name())
+	assert.Nil(t, err)
+	assert.Len(t, cfg.ContractMetrics, 1)
+
+	metric := cfg.ContractMetrics[0]
+	assert.Equal(t, "balanceOf(address)", metric.Signature)
+	assert.Equal(t, uint8(18), metric.Decimals)
+	assert.Equal(t, map[string]string{"token": "usdc"}, metric.Labels)
+	assert.Equal(t, 1, metric.Contracts[0].ChainID)
+	assert.Equal(t, []string{"0x2796317b0fF8538F253012862c06787Adfb8cEb6"}, metric.Contracts[0].Args)
+}
+
+func TestFleetConfig(t *testing.T) {
+	tmpFile := filet.TmpFile(t, "", `fleet:
+  rfq_api_url: http://rfq-api
+  members:
+    - name: rfq-relayer
+      address: "0xdc927bd56cf9dfc2e3779c7e3d6d28da1c219969"
+      chain_ids: [1, 10]
+      min_balance: 0.5
+      min_balances:
+        1: 2
+      rfq: true
+`)
+
+	cfg, err := config.DecodeConfig(tmpFile.Name())
+	assert.Nil(t, err)
+	assert.Equal(t, "http://rfq-api", cfg.Fleet.RFQAPIURL)
+	assert.Equal(t, 10, cfg.Fleet.TxLookback)
+	assert.Len(t, cfg.Fleet.Members, 1)
+
+	member := cfg.Fleet.Members[0]
+	assert.True(t, member.RFQ)
+	assert.Equal(t, []int{1, 10}, member.ChainIDs)
+	assert.Equal(t, float64(2), member.GetMinBalance(1))
+	assert.Equal(t, 0.5, member.GetMinBalance(10))
+}
@@ -194,7 +194,7 @@ type tokenData struct {
 				)
 			}
 
-			err = e.batchCalls(ctx, client, calls)
+			_, err = e.batchCalls(ctx, client, calls)
 			if err != nil {
 				return fmt.Errorf("could not get token balances: %w", err)
 			}
@@ -260,7 +260,7 @@ func (e *exporter) getAllTokens(parentCtx context.Context) (allTokens Tokens, er
 	}
 
 	// TODO: once go 1.21 is introduced do min(cfg.BatchCallLimit, 2)
-	err = e.batchCalls(ctx, bridgeConfigClient, calls)
+	_, err = e.batchCalls(ctx, bridgeConfigClient, calls)
 	if err != nil {
 		return nil, fmt.Errorf("could not get token balances: %w", err)
 	}
@@ -285,28 +285,31 @@ func (e *exporter) getAllTokens(parentCtx context.Context) (allTokens Tokens, er
 	return allTokens, nil
 }
 
-func (e *exporter) batchCalls(ctx context.Context, evmClient ethergoClient.EVM, calls []w3types.Caller) (err error) {
+// batchCalls batches the calls in chunks of the batch call limit. It returns the error of each call, nil for the calls
+// that succeeded, along with the first call error so callers that need every call can fail as a whole.
+func (e *exporter) batchCalls(ctx context.Context, evmClient ethergoClient.EVM, calls []w3types.Caller) (callErrs []error, err error) {
 	tasks := core.ChunkSlice(calls, e.cfg.BatchCallLimit)
+	callErrs = make([]error, len(calls))
 
-	g, ctx := errgroup.WithContext(ctx)
+	var g errgroup.Group
+	start := 0
 	for _, task := range tasks {
-		task := task // capture func literal
+		task, start := task, start // capture func literal
 		g.Go(func() error {
-			err = evmClient.BatchWithContext(ctx, task...)
-			if err != nil {
-				return fmt.Errorf("could not batch calls: %w", err)
-			}
-
+			copy(callErrs[start:start+len(task)], splitCallErrors(evmClient.BatchWithContext(ctx, task...), len(task)))
 			return nil
 		})
+		start += len(task)
 	}
+	_ = g.Wait()
 
-	err = g.Wait()
-	if err != nil {
-		return fmt.Errorf("could not get token balances: %w", err)
+	for _, callErr := range callErrs {
+		if callErr != nil {
+			return callErrs, fmt.Errorf("could not batch calls: %w", callErr)
+		}
 	}
 
-	return nil
+	return callErrs, nil
 }
 
 // Tokens is a list of token configs.
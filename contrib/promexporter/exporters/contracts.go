@@ -0,0 +1,370 @@
+package exporters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lmittmann/w3"
+	"github.com/lmittmann/w3/module/eth"
+	"github.com/lmittmann/w3/w3types"
+	"github.com/synapsecns/sanguine/contrib/promexporter/config"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// contractMethod is a view method of a contract metric.
+type contractMethod struct {
+	selector []byte
+	inputs   abi.Arguments
+	outputs  abi.Arguments
+}
+
+// contractCall is a call of a contract metric on a contract.
+type contractCall struct {
+	metric     *contractMetric
+	contract   common.Address
+	input      []byte
+	attributes attribute.Set
+}
+
+// contractMetric is a gauge of the value returned by a view method of contracts.
+type contractMetric struct {
+	cfg    config.ContractMetric
+	method contractMethod
+	gauge  metric.Float64ObservableGauge
+}
+
+// newContractMethod parses the method of a contract metric from its signature or abi.
+func newContractMethod(cfg config.ContractMetric) (contractMethod, error) {
+	if cfg.ABI == "" {
+		fn, err := w3.NewFunc(cfg.Signature, cfg.Returns)
+		if err != nil {
+			return contractMethod{}, fmt.Errorf("could not parse signature %s: %w", cfg.Signature, err)
+		}
+		return contractMethod{selector: fn.Selector[:], inputs: fn.Args, outputs: fn.Returns}, nil
+	}
+
+	parsedABI, err := abi.JSON(strings.NewReader(cfg.ABI))
+	if err != nil {
+		return contractMethod{}, fmt.Errorf("could not parse abi: %w", err)
+	}
+
+	method, ok := parsedABI.Methods[cfg.Method]
+	if !ok {
+		return contractMethod{}, fmt.Errorf("method %s not found in abi", cfg.Method)
+	}
+	return contractMethod{selector: method.ID, inputs: method.Inputs, outputs: method.Outputs}, nil
+}
+
+// encode encodes a call of the method with the given string arguments.
+func (c contractMethod) encode(args []string) ([]byte, error) {
+	if len(args) != len(c.inputs) {
+		return nil, fmt.Errorf("expected %d args, got %d", len(c.inputs), len(args))
+	}
+
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		value, err := parseArg(c.inputs[i].Type, arg)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse arg %d: %w", i, err)
+		}
+		values[i] = value
+	}
+
+	packed, err := c.inputs.Pack(values...)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack args: %w", err)
+	}
+	return append(append([]byte{}, c.selector...), packed...), nil
+}
+
+// decode decodes the output at the given index of the returned data as a float, with the given decimals.
+func (c contractMethod) decode(data []byte, output int, decimals uint8) (float64, error) {
+	values, err := c.outputs.Unpack(data)
+	if err != nil {
+		return 0, fmt.Errorf("could not unpack outputs: %w", err)
+	}
+	if output >= len(values) {
+		return 0, fmt.Errorf("output %d out of range, method has %d outputs", output, len(values))
+	}
+
+	value, err := toBig(values[output])
+	if err != nil {
+		return 0, err
+	}
+	return core.BigToDecimals(value, decimals), nil
+}
+
+// parseArg parses a string argument according to its abi type.
+func parseArg(argType abi.Type, arg string) (interface{}, error) {
+	//nolint: exhaustive
+	switch argType.T {
+	case abi.AddressTy:
+		if !common.IsHexAddress(arg) {
+			return nil, fmt.Errorf("invalid address %s", arg)
+		}
+		return common.HexToAddress(arg), nil
+	case abi.BoolTy:
+		//nolint: wrapcheck
+		return strconv.ParseBool(arg)
+	case abi.StringTy:
+		return arg, nil
+	case abi.BytesTy:
+		//nolint: wrapcheck
+		return hexutil.Decode(arg)
+	case abi.FixedBytesTy:
+		decoded, err := hexutil.Decode(arg)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode bytes: %w", err)
+		}
+		if len(decoded) > argType.Size {
+			return nil, fmt.Errorf("expected at most %d bytes, got %d", argType.Size, len(decoded))
+		}
+		// fixed size byte arrays are left aligned.
+		value := make([]byte, argType.Size)
+		copy(value, decoded)
+		return abi.ReadFixedBytes(argType, append(value, make([]byte, 32-argType.Size)...))
+	case abi.IntTy, abi.UintTy:
+		value, ok := new(big.Int).SetString(arg, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %s", arg)
+		}
+		return toIntType(argType, value)
+	default:
+		return nil, fmt.Errorf("unsupported arg type %s", argType.String())
+	}
+}
+
+// toIntType converts an integer to the go type abi packing expects for the integer type.
+func toIntType(argType abi.Type, value *big.Int) (interface{}, error) {
+	if argType.Size > 64 {
+		return value, nil
+	}
+
+	if argType.T == abi.UintTy {
+		if !value.IsUint64() {
+			return nil, fmt.Errorf("%s out of range of %s", value, argType.String())
+		}
+		switch argType.Size {
+		case 8:
+			return uint8(value.Uint64()), nil
+		case 16:
+			return uint16(value.Uint64()), nil
+		case 32:
+			return uint32(value.Uint64()), nil
+		case 64:
+			return value.Uint64(), nil
+		}
+	} else {
+		if !value.IsInt64() {
+			return nil, fmt.Errorf("%s out of range of %s", value, argType.String())
+		}
+		switch argType.Size {
+		case 8:
+			return int8(value.Int64()), nil
+		case 16:
+			return int16(value.Int64()), nil
+		case 32:
+			return int32(value.Int64()), nil
+		case 64:
+			return value.Int64(), nil
+		}
+	}
+	// non standard sizes are packed from big ints.
+	return value, nil
+}
+
+// toBig converts a decoded number or bool to a big int.
+func toBig(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case bool:
+		if v {
+			return big.NewInt(1), nil
+		}
+		return big.NewInt(0), nil
+	case uint8:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint16:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	case int8:
+		return big.NewInt(int64(v)), nil
+	case int16:
+		return big.NewInt(int64(v)), nil
+	case int32:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	default:
+		return nil, fmt.Errorf("unsupported output type %T", value)
+	}
+}
+
+// newContractCall creates the call of a contract metric on a contract.
+func (m *contractMetric) newContractCall(contract config.MetricContract) (contractCall, error) {
+	if !common.IsHexAddress(contract.Address) {
+		return contractCall{}, fmt.Errorf("invalid address %s", contract.Address)
+	}
+
+	args := m.cfg.Args
+	if contract.Args != nil {
+		args = contract.Args
+	}
+
+	input, err := m.method.encode(args)
+	if err != nil {
+		return contractCall{}, fmt.Errorf("could not encode call to %s: %w", contract.Address, err)
+	}
+
+	// contract labels override the labels of the metric.
+	labels := make(map[string]string)
+	for key, value := range m.cfg.Labels {
+		labels[key] = value
+	}
+	for key, value := range contract.Labels {
+		labels[key] = value
+	}
+
+	keyValues := []attribute.KeyValue{
+		attribute.Int(metrics.ChainID, contract.ChainID),
+		attribute.String(metrics.ContractAddress, common.HexToAddress(contract.Address).String()),
+	}
+	for key, value := range labels {
+		keyValues = append(keyValues, attribute.String(key, value))
+	}
+
+	return contractCall{
+		metric:     m,
+		contract:   common.HexToAddress(contract.Address),
+		input:      input,
+		attributes: attribute.NewSet(keyValues...),
+	}, nil
+}
+
+// contractStats registers the gauges of the contract metrics, the calls of each chain are batched in one callback.
+// A failed call only drops its own observation.
+func (e *exporter) contractStats(metricConfigs []config.ContractMetric) error {
+	meter := e.metrics.Meter(meterName)
+
+	callsByChain := make(map[int][]contractCall)
+	names := make(map[string]bool)
+	for _, metricConfig := range metricConfigs {
+		if names[metricConfig.Name] {
+			return fmt.Errorf("duplicate contract metric %s", metricConfig.Name)
+		}
+		names[metricConfig.Name] = true
+
+		method, err := newContractMethod(metricConfig)
+		if err != nil {
+			return fmt.Errorf("could not parse method of %s: %w", metricConfig.Name, err)
+		}
+
+		gauge, err := meter.Float64ObservableGauge(metricConfig.Name, metric.WithDescription(metricConfig.Description))
+		if err != nil {
+			return fmt.Errorf("could not create gauge: %w", err)
+		}
+
+		contractMetric := &contractMetric{cfg: metricConfig, method: method, gauge: gauge}
+		for _, contract := range metricConfig.Contracts {
+			call, err := contractMetric.newContractCall(contract)
+			if err != nil {
+				return fmt.Errorf("could not create call of %s: %w", metricConfig.Name, err)
+			}
+			callsByChain[contract.ChainID] = append(callsByChain[contract.ChainID], call)
+		}
+	}
+
+	for chainID, calls := range callsByChain {
+		chainID := chainID
+		calls := calls // capture func literals
+
+		gauges := make(map[metric.Float64ObservableGauge]bool)
+		for _, call := range calls {
+			gauges[call.metric.gauge] = true
+		}
+		var instruments []metric.Observable
+		for gauge := range gauges {
+			instruments = append(instruments, gauge)
+		}
+
+		if _, err := meter.RegisterCallback(func(parentCtx context.Context, o metric.Observer) (err error) {
+			ctx, span := e.metrics.Tracer().Start(parentCtx, "contract_stats", trace.WithAttributes(
+				attribute.Int(metrics.ChainID, chainID),
+			))
+
+			defer func() {
+				metrics.EndSpanWithErr(span, err)
+			}()
+
+			client, err := e.omnirpcClient.GetConfirmationsClient(ctx, chainID, 1)
+			if err != nil {
+				return fmt.Errorf("could not get confirmations client: %w", err)
+			}
+
+			outputs := make([][]byte, len(calls))
+			batch := make([]w3types.Caller, len(calls))
+			for i, call := range calls {
+				call := call
+				batch[i] = eth.Call(&w3types.Message{To: &call.contract, Input: call.input}, nil, nil).Returns(&outputs[i])
+			}
+
+			callErrs, _ := e.batchCalls(ctx, client, batch)
+
+			var errs []error
+			for i, call := range calls {
+				if callErrs[i] != nil {
+					errs = append(errs, fmt.Errorf("could not call %s of %s: %w", call.metric.cfg.Name, call.contract, callErrs[i]))
+					continue
+				}
+				value, err := call.metric.method.decode(outputs[i], call.metric.cfg.Output, call.metric.cfg.Decimals)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("could not decode %s of %s: %w", call.metric.cfg.Name, call.contract, err))
+					continue
+				}
+				o.ObserveFloat64(call.metric.gauge, value, metric.WithAttributeSet(call.attributes))
+			}
+
+			//nolint: wrapcheck
+			return errors.Join(errs...)
+		}, instruments...); err != nil {
+			return fmt.Errorf("registering callback on instruments: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// splitCallErrors returns the error of each of the size calls of a batch. The calls of a batch that failed as a whole
+// all get its error.
+func splitCallErrors(err error, size int) []error {
+	callErrs := make([]error, size)
+	if err == nil {
+		return callErrs
+	}
+
+	var batchErrs w3.CallErrors
+	if errors.As(err, &batchErrs) && len(batchErrs) == size {
+		copy(callErrs, batchErrs)
+		return callErrs
+	}
+
+	for i := range callErrs {
+		callErrs[i] = err
+	}
+	return callErrs
+}
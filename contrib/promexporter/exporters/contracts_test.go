@@ -0,0 +1,76 @@
+package exporters_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lmittmann/w3"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/contrib/promexporter/config"
+	"github.com/synapsecns/sanguine/contrib/promexporter/exporters"
+)
+
+const erc20ABI = `[{"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
+func TestEncodeContractCall(t *testing.T) {
+	owner := "0x2796317b0fF8538F253012862c06787Adfb8cEb6"
+	expected := hexutil.MustDecode("0x70a08231" + "000000000000000000000000" + owner[2:])
+
+	// the signature and the abi of the same method encode the same call.
+	input, err := exporters.EncodeContractCall(config.ContractMetric{Signature: "balanceOf(address)", Returns: "uint256"}, []string{owner})
+	Nil(t, err)
+	Equal(t, expected, input)
+
+	input, err = exporters.EncodeContractCall(config.ContractMetric{Method: "balanceOf", ABI: erc20ABI}, []string{owner})
+	Nil(t, err)
+	Equal(t, expected, input)
+
+	_, err = exporters.EncodeContractCall(config.ContractMetric{Signature: "balanceOf(address)", Returns: "uint256"}, []string{"not an address"})
+	NotNil(t, err)
+
+	_, err = exporters.EncodeContractCall(config.ContractMetric{Signature: "balanceOf(address)", Returns: "uint256"}, nil)
+	NotNil(t, err)
+
+	input, err = exporters.EncodeContractCall(config.ContractMetric{Signature: "getToken(uint8,bytes32)", Returns: "address"}, []string{"2", "0x01"})
+	Nil(t, err)
+	Len(t, input, 68)
+	Equal(t, byte(2), input[35])
+	Equal(t, byte(1), input[36])
+}
+
+func TestDecodeContractOutput(t *testing.T) {
+	balance := new(big.Int).Mul(big.NewInt(15), big.NewInt(1e17))
+	value, err := exporters.DecodeContractOutput(config.ContractMetric{Signature: "balanceOf(address)", Returns: "uint256", Decimals: 18}, common.LeftPadBytes(balance.Bytes(), 32))
+	Nil(t, err)
+	InDelta(t, 1.5, value, 1e-9)
+
+	// the second output is a bool.
+	data := append(common.LeftPadBytes(common.HexToAddress("0x01").Bytes(), 32), common.LeftPadBytes([]byte{1}, 32)...)
+	value, err = exporters.DecodeContractOutput(config.ContractMetric{Signature: "paused()", Returns: "address,bool", Output: 1}, data)
+	Nil(t, err)
+	Equal(t, float64(1), value)
+
+	// addresses are not numbers.
+	_, err = exporters.DecodeContractOutput(config.ContractMetric{Signature: "paused()", Returns: "address,bool"}, data)
+	NotNil(t, err)
+
+	_, err = exporters.DecodeContractOutput(config.ContractMetric{Signature: "paused()", Returns: "address,bool", Output: 2}, data)
+	NotNil(t, err)
+}
+
+func TestSplitCallErrors(t *testing.T) {
+	Equal(t, []error{nil, nil}, exporters.SplitCallErrors(nil, 2))
+
+	// only the reverting call of the batch fails.
+	reverted := errors.New("execution reverted")
+	callErrs := exporters.SplitCallErrors(fmt.Errorf("could not batch: %w", w3.CallErrors{nil, reverted, nil}), 3)
+	Equal(t, []error{nil, reverted, nil}, callErrs)
+
+	// every call fails with the batch.
+	failed := errors.New("connection refused")
+	Equal(t, []error{failed, failed}, exporters.SplitCallErrors(failed, 2))
+}
@@ -0,0 +1,63 @@
+package exporters
+
+import (
+	"context"
+	"time"
+
+	"github.com/synapsecns/sanguine/contrib/promexporter/config"
+)
+
+// EncodeContractCall exports the encoding of a contract metric call for testing.
+func EncodeContractCall(cfg config.ContractMetric, args []string) ([]byte, error) {
+	method, err := newContractMethod(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return method.encode(args)
+}
+
+// DecodeContractOutput exports the decoding of a contract metric output for testing.
+func DecodeContractOutput(cfg config.ContractMetric, data []byte) (float64, error) {
+	method, err := newContractMethod(cfg)
+	if err != nil {
+		return 0, err
+	}
+	return method.decode(data, cfg.Output, cfg.Decimals)
+}
+
+// FindNonceBlock exports the search for the block of a nonce for testing.
+func FindNonceBlock(ctx context.Context, nonceAt func(ctx context.Context, block uint64) (uint64, error), latest, nonce uint64) (uint64, error) {
+	return findNonceBlock(ctx, nonceAt, latest, nonce)
+}
+
+// RFQQuote is a quote of the rfq api for testing.
+type RFQQuote struct {
+	OriginChainID int
+	DestChainID   int
+	UpdatedAt     time.Time
+}
+
+// RFQRouteStats are the freshness stats of a route for testing.
+type RFQRouteStats struct {
+	MaxAge time.Duration
+	Count  int64
+}
+
+// RouteStats exports the grouping of quotes by route for testing, keyed by [origin, dest].
+func RouteStats(quotes []RFQQuote, now time.Time) map[[2]int]RFQRouteStats {
+	rfqQuotes := make([]rfqQuote, len(quotes))
+	for i, quote := range quotes {
+		rfqQuotes[i] = rfqQuote(quote)
+	}
+
+	stats := make(map[[2]int]RFQRouteStats)
+	for route, routeStats := range routeStats(rfqQuotes, now) {
+		stats[[2]int{route.originChainID, route.destChainID}] = RFQRouteStats{MaxAge: routeStats.maxAge, Count: routeStats.count}
+	}
+	return stats
+}
+
+// SplitCallErrors exports the split of the error of a batch of calls for testing.
+func SplitCallErrors(err error, size int) []error {
+	return splitCallErrors(err, size)
+}
@@ -109,6 +109,18 @@ func StartExporterServer(ctx context.Context, handler metrics.Handler, cfg confi
 		}
 	}
 
+	// register config defined contract metrics
+	err = exp.contractStats(cfg.ContractMetrics)
+	if err != nil {
+		return fmt.Errorf("could setup metric: %w", err)
+	}
+
+	// register relayer and agent fleet metrics
+	err = exp.fleetStats(cfg.Fleet)
+	if err != nil {
+		return fmt.Errorf("could setup metric: %w", err)
+	}
+
 	for chainID := range cfg.BridgeChecks {
 		for _, token := range cfg.VpriceCheckTokens {
 			chainID := chainID
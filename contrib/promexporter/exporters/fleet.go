@@ -0,0 +1,505 @@
+package exporters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lmittmann/w3/module/eth"
+	"github.com/lmittmann/w3/w3types"
+	"github.com/synapsecns/sanguine/contrib/promexporter/config"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/core/metrics"
+	ethergoClient "github.com/synapsecns/sanguine/ethergo/client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	fleetGasBalance       = "fleet_gas_balance"
+	fleetMinGasBalance    = "fleet_min_gas_balance"
+	fleetGasBalanceLow    = "fleet_gas_balance_low"
+	fleetNonceGap         = "fleet_nonce_gap"
+	fleetLastTxAge        = "fleet_last_successful_tx_age_seconds"
+	fleetRFQQuoteAge      = "fleet_rfq_quote_age_seconds"
+	fleetRFQQuoteCount    = "fleet_rfq_quotes"
+	originChainIDLabel    = "origin_chain_id"
+	destinationChainLabel = "dest_chain_id"
+)
+
+// etherDecimals is the number of decimals of the native gas token.
+const etherDecimals = 18
+
+// rawCall is a batchable rpc call decoding its result into a custom type,
+// used where the eth module does not support the call or decodes more than is needed.
+type rawCall struct {
+	method string
+	args   []interface{}
+	result interface{}
+}
+
+// CreateRequest creates the batch element of the call.
+func (r rawCall) CreateRequest() (rpc.BatchElem, error) {
+	return rpc.BatchElem{Method: r.method, Args: r.args, Result: r.result}, nil
+}
+
+// HandleResponse returns the error of the call, the result is decoded by the rpc client.
+func (r rawCall) HandleResponse(elem rpc.BatchElem) error {
+	//nolint: wrapcheck
+	return elem.Error
+}
+
+// pendingNonce returns the nonce of the address including pending txes.
+func pendingNonce(address common.Address, nonce *hexutil.Uint64) w3types.Caller {
+	return rawCall{method: "eth_getTransactionCount", args: []interface{}{address, "pending"}, result: nonce}
+}
+
+// rpcTx is the part of a tx of a block needed to find the txes of an address.
+// the sender is taken from the rpc response so txes of chain specific types don't have to be decoded.
+type rpcTx struct {
+	Hash  common.Hash    `json:"hash"`
+	From  common.Address `json:"from"`
+	Nonce hexutil.Uint64 `json:"nonce"`
+}
+
+// rpcBlock is the part of a block needed to find the txes of an address.
+type rpcBlock struct {
+	Timestamp    hexutil.Uint64 `json:"timestamp"`
+	Transactions []rpcTx        `json:"transactions"`
+}
+
+// rpcReceipt is the part of a receipt needed to know whether a tx succeeded.
+type rpcReceipt struct {
+	Status hexutil.Uint64 `json:"status"`
+}
+
+// findNonceBlock returns the first block at or before latest at which the nonce of the address is at least nonce,
+// which is the block that includes the tx with nonce nonce-1.
+func findNonceBlock(ctx context.Context, nonceAt func(ctx context.Context, block uint64) (uint64, error), latest, nonce uint64) (uint64, error) {
+	low, high := uint64(0), latest
+	for low < high {
+		mid := low + (high-low)/2
+		midNonce, err := nonceAt(ctx, mid)
+		if err != nil {
+			return 0, fmt.Errorf("could not get nonce at block %d: %w", mid, err)
+		}
+
+		if midNonce >= nonce {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	return low, nil
+}
+
+// lastTx is the last successful tx of a fleet member on a chain.
+type lastTx struct {
+	// nonce is the latest nonce the last successful tx was searched up to.
+	nonce uint64
+	// block is the block of the last successful tx.
+	block uint64
+	// timestamp is the time of the block of the last successful tx.
+	timestamp time.Time
+	// found is whether a successful tx was found.
+	found bool
+}
+
+type lastTxKey struct {
+	chainID int
+	address common.Address
+}
+
+// lastTxTracker caches the last successful txes of the fleet, so they are only searched for when the nonce changes.
+type lastTxTracker struct {
+	lookback int
+	mux      sync.Mutex
+	txes     map[lastTxKey]lastTx
+}
+
+func newLastTxTracker(lookback int) *lastTxTracker {
+	return &lastTxTracker{
+		lookback: lookback,
+		txes:     make(map[lastTxKey]lastTx),
+	}
+}
+
+func (l *lastTxTracker) get(key lastTxKey) (lastTx, bool) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	tx, ok := l.txes[key]
+	return tx, ok
+}
+
+func (l *lastTxTracker) put(key lastTxKey, tx lastTx) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.txes[key] = tx
+}
+
+// lastSuccessfulTx returns the last successful tx of the address up to the given nonce.
+// Txes are searched for from the newest, looking past at most lookback reverted txes.
+// Searching requires historical nonces, so the rpc has to serve archive data.
+func (l *lastTxTracker) lastSuccessfulTx(ctx context.Context, client ethergoClient.EVM, key lastTxKey, nonce, latestBlock uint64) (lastTx, error) {
+	cached, ok := l.get(key)
+	if ok && cached.nonce == nonce {
+		return cached, nil
+	}
+
+	// txes before the cached nonce have already been searched.
+	var lowest uint64
+	if nonce > uint64(l.lookback) {
+		lowest = nonce - uint64(l.lookback)
+	}
+	if ok && cached.nonce > lowest {
+		lowest = cached.nonce
+	}
+
+	nonceAt := func(ctx context.Context, block uint64) (blockNonce uint64, err error) {
+		err = client.BatchWithContext(ctx, eth.Nonce(key.address, new(big.Int).SetUint64(block)).Returns(&blockNonce))
+		//nolint: wrapcheck
+		return blockNonce, err
+	}
+
+	upper := latestBlock
+	for txNonce := nonce; txNonce > lowest; txNonce-- {
+		block, err := findNonceBlock(ctx, nonceAt, upper, txNonce)
+		if err != nil {
+			return lastTx{}, fmt.Errorf("could not find block of nonce %d: %w", txNonce-1, err)
+		}
+		upper = block
+
+		success, timestamp, err := txStatus(ctx, client, key.address, block, txNonce-1)
+		if err != nil {
+			return lastTx{}, fmt.Errorf("could not get status of nonce %d: %w", txNonce-1, err)
+		}
+
+		if success {
+			tx := lastTx{nonce: nonce, block: block, timestamp: timestamp, found: true}
+			l.put(key, tx)
+			return tx, nil
+		}
+	}
+
+	// every searched tx reverted, the last successful tx is still the cached one if there is one.
+	cached.nonce = nonce
+	l.put(key, cached)
+	return cached, nil
+}
+
+// txStatus returns whether the tx of the address with the given nonce in the block succeeded, and the time of the block.
+func txStatus(ctx context.Context, client ethergoClient.EVM, address common.Address, block, nonce uint64) (success bool, timestamp time.Time, err error) {
+	var fullBlock rpcBlock
+	err = client.BatchWithContext(ctx, rawCall{
+		method: "eth_getBlockByNumber",
+		args:   []interface{}{hexutil.EncodeUint64(block), true},
+		result: &fullBlock,
+	})
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("could not get block %d: %w", block, err)
+	}
+
+	for _, tx := range fullBlock.Transactions {
+		if tx.From != address || uint64(tx.Nonce) != nonce {
+			continue
+		}
+
+		var receipt rpcReceipt
+		err = client.BatchWithContext(ctx, rawCall{
+			method: "eth_getTransactionReceipt",
+			args:   []interface{}{tx.Hash},
+			result: &receipt,
+		})
+		if err != nil {
+			return false, time.Time{}, fmt.Errorf("could not get receipt of %s: %w", tx.Hash, err)
+		}
+
+		return receipt.Status == hexutil.Uint64(1), time.Unix(int64(fullBlock.Timestamp), 0), nil
+	}
+
+	return false, time.Time{}, fmt.Errorf("tx with nonce %d not found in block %d", nonce, block)
+}
+
+// fleetGauges are the gauges of the fleet health checks.
+type fleetGauges struct {
+	balance    metric.Float64ObservableGauge
+	minBalance metric.Float64ObservableGauge
+	balanceLow metric.Int64ObservableGauge
+	nonceGap   metric.Int64ObservableGauge
+	lastTxAge  metric.Float64ObservableGauge
+}
+
+// fleetStats registers the health checks of the relayers and agents of the fleet, the members of each chain are batched in one callback.
+func (e *exporter) fleetStats(fleet config.FleetConfig) (err error) {
+	meter := e.metrics.Meter(meterName)
+
+	var gauges fleetGauges
+	if gauges.balance, err = meter.Float64ObservableGauge(fleetGasBalance, metric.WithDescription("native gas balance in ether")); err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+	if gauges.minBalance, err = meter.Float64ObservableGauge(fleetMinGasBalance, metric.WithDescription("configured min native gas balance in ether")); err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+	if gauges.balanceLow, err = meter.Int64ObservableGauge(fleetGasBalanceLow, metric.WithDescription("1 if the gas balance is below the min balance")); err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+	if gauges.nonceGap, err = meter.Int64ObservableGauge(fleetNonceGap, metric.WithDescription("pending nonce minus latest nonce")); err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+	if gauges.lastTxAge, err = meter.Float64ObservableGauge(fleetLastTxAge, metric.WithDescription("seconds since the block of the last successful tx")); err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+
+	membersByChain := make(map[int][]config.FleetMember)
+	var rfqRelayers []config.FleetMember
+	for _, member := range fleet.Members {
+		if !common.IsHexAddress(member.Address) {
+			return fmt.Errorf("invalid address %s of %s", member.Address, member.Name)
+		}
+		for _, chainID := range member.ChainIDs {
+			membersByChain[chainID] = append(membersByChain[chainID], member)
+		}
+		if member.RFQ {
+			rfqRelayers = append(rfqRelayers, member)
+		}
+	}
+
+	tracker := newLastTxTracker(fleet.TxLookback)
+	for chainID, members := range membersByChain {
+		err = e.fleetChainStats(meter, gauges, tracker, chainID, members)
+		if err != nil {
+			return err
+		}
+	}
+
+	if fleet.RFQAPIURL != "" && len(rfqRelayers) > 0 {
+		err = e.rfqQuoteStats(meter, fleet.RFQAPIURL, rfqRelayers)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nolint: cyclop
+func (e *exporter) fleetChainStats(meter metric.Meter, gauges fleetGauges, tracker *lastTxTracker, chainID int, members []config.FleetMember) error {
+	if _, err := meter.RegisterCallback(func(parentCtx context.Context, o metric.Observer) (err error) {
+		ctx, span := e.metrics.Tracer().Start(parentCtx, "fleet_stats", trace.WithAttributes(
+			attribute.Int(metrics.ChainID, chainID),
+		))
+
+		defer func() {
+			metrics.EndSpanWithErr(span, err)
+		}()
+
+		client, err := e.omnirpcClient.GetConfirmationsClient(ctx, chainID, 1)
+		if err != nil {
+			return fmt.Errorf("could not get confirmations client: %w", err)
+		}
+
+		var latestBlock big.Int
+		balances := make([]big.Int, len(members))
+		nonces := make([]uint64, len(members))
+		pendingNonces := make([]hexutil.Uint64, len(members))
+
+		calls := []w3types.Caller{eth.BlockNumber().Returns(&latestBlock)}
+		for i, member := range members {
+			address := common.HexToAddress(member.Address)
+			calls = append(calls,
+				eth.Balance(address, nil).Returns(&balances[i]),
+				eth.Nonce(address, nil).Returns(&nonces[i]),
+				pendingNonce(address, &pendingNonces[i]),
+			)
+		}
+
+		_, err = e.batchCalls(ctx, client, calls)
+		if err != nil {
+			return fmt.Errorf("could not get fleet stats: %w", err)
+		}
+
+		lastTxes := make([]lastTx, len(members))
+		lastTxErrs := make([]error, len(members))
+		g, gctx := errgroup.WithContext(ctx)
+		for i, member := range members {
+			// members without txes have no last tx.
+			if nonces[i] == 0 {
+				continue
+			}
+
+			i := i
+			key := lastTxKey{chainID: chainID, address: common.HexToAddress(member.Address)} // capture func literal
+
+			g.Go(func() error {
+				lastTxes[i], lastTxErrs[i] = tracker.lastSuccessfulTx(gctx, client, key, nonces[i], latestBlock.Uint64())
+				return nil
+			})
+		}
+		_ = g.Wait()
+
+		for i, member := range members {
+			attributes := attribute.NewSet(
+				attribute.Int(metrics.ChainID, chainID),
+				attribute.String(metrics.EOAAddress, common.HexToAddress(member.Address).String()),
+				attribute.String("name", member.Name),
+			)
+
+			balance := core.BigToDecimals(&balances[i], etherDecimals)
+			minBalance := member.GetMinBalance(chainID)
+			var balanceLow int64
+			if balance < minBalance {
+				balanceLow = 1
+			}
+
+			var nonceGap int64
+			if uint64(pendingNonces[i]) > nonces[i] {
+				nonceGap = int64(uint64(pendingNonces[i]) - nonces[i])
+			}
+
+			o.ObserveFloat64(gauges.balance, balance, metric.WithAttributeSet(attributes))
+			o.ObserveFloat64(gauges.minBalance, minBalance, metric.WithAttributeSet(attributes))
+			o.ObserveInt64(gauges.balanceLow, balanceLow, metric.WithAttributeSet(attributes))
+			o.ObserveInt64(gauges.nonceGap, nonceGap, metric.WithAttributeSet(attributes))
+
+			if lastTxErrs[i] != nil {
+				logger.Warnf("could not get last successful tx of %s on %d, the rpc has to serve archive data: %v", member.Name, chainID, lastTxErrs[i])
+				continue
+			}
+			if lastTxes[i].found {
+				o.ObserveFloat64(gauges.lastTxAge, time.Since(lastTxes[i].timestamp).Seconds(), metric.WithAttributeSet(attributes))
+			}
+		}
+
+		return nil
+	}, gauges.balance, gauges.minBalance, gauges.balanceLow, gauges.nonceGap, gauges.lastTxAge); err != nil {
+		return fmt.Errorf("registering callback on instruments: %w", err)
+	}
+
+	return nil
+}
+
+// rfqQuote is the part of a quote of the rfq api needed to check its freshness.
+type rfqQuote struct {
+	OriginChainID int       `json:"origin_chain_id"`
+	DestChainID   int       `json:"dest_chain_id"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type rfqRoute struct {
+	originChainID int
+	destChainID   int
+}
+
+// rfqRouteStats are the freshness stats of the quotes of a relayer on a route.
+type rfqRouteStats struct {
+	// maxAge is the age of the stalest quote.
+	maxAge time.Duration
+	count  int64
+}
+
+// RFQRouteStats groups quotes by route, returning the age of the stalest quote and the number of quotes of each route.
+func routeStats(quotes []rfqQuote, now time.Time) map[rfqRoute]rfqRouteStats {
+	stats := make(map[rfqRoute]rfqRouteStats)
+	for _, quote := range quotes {
+		route := rfqRoute{originChainID: quote.OriginChainID, destChainID: quote.DestChainID}
+		routeStats := stats[route]
+		routeStats.count++
+		if age := now.Sub(quote.UpdatedAt); age > routeStats.maxAge {
+			routeStats.maxAge = age
+		}
+		stats[route] = routeStats
+	}
+	return stats
+}
+
+// getRFQQuotes gets the quotes of a relayer from the rfq api.
+func (e *exporter) getRFQQuotes(ctx context.Context, apiURL string, relayer common.Address) ([]rfqQuote, error) {
+	quotesURL := fmt.Sprintf("%s/quotes?relayerAddr=%s", strings.TrimSuffix(apiURL, "/"), url.QueryEscape(relayer.String()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, quotesURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not get quotes: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected status code 200, got %d", resp.StatusCode)
+	}
+
+	var quotes []rfqQuote
+	err = json.NewDecoder(resp.Body).Decode(&quotes)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode quotes: %w", err)
+	}
+	return quotes, nil
+}
+
+// rfqQuoteStats registers the freshness checks of the quotes of the rfq relayers of the fleet.
+func (e *exporter) rfqQuoteStats(meter metric.Meter, apiURL string, relayers []config.FleetMember) error {
+	quoteAge, err := meter.Float64ObservableGauge(fleetRFQQuoteAge, metric.WithDescription("seconds since the stalest quote of the route was updated"))
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+
+	quoteCount, err := meter.Int64ObservableGauge(fleetRFQQuoteCount, metric.WithDescription("number of quotes of the route"))
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+
+	if _, err := meter.RegisterCallback(func(parentCtx context.Context, o metric.Observer) (err error) {
+		ctx, span := e.metrics.Tracer().Start(parentCtx, "rfq_quote_stats")
+
+		defer func() {
+			metrics.EndSpanWithErr(span, err)
+		}()
+
+		var errs []error
+		for _, relayer := range relayers {
+			address := common.HexToAddress(relayer.Address)
+			quotes, err := e.getRFQQuotes(ctx, apiURL, address)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("could not get quotes of %s: %w", relayer.Name, err))
+				continue
+			}
+
+			for route, stats := range routeStats(quotes, time.Now()) {
+				attributes := attribute.NewSet(
+					attribute.Int(originChainIDLabel, route.originChainID),
+					attribute.Int(destinationChainLabel, route.destChainID),
+					attribute.String(metrics.EOAAddress, address.String()),
+					attribute.String("name", relayer.Name),
+				)
+
+				o.ObserveFloat64(quoteAge, stats.maxAge.Seconds(), metric.WithAttributeSet(attributes))
+				o.ObserveInt64(quoteCount, stats.count, metric.WithAttributeSet(attributes))
+			}
+		}
+
+		//nolint: wrapcheck
+		return errors.Join(errs...)
+	}, quoteAge, quoteCount); err != nil {
+		return fmt.Errorf("registering callback on instruments: %w", err)
+	}
+
+	return nil
+}
+
+var _ w3types.Caller = rawCall{}
@@ -0,0 +1,57 @@
+package exporters_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/contrib/promexporter/exporters"
+)
+
+func TestFindNonceBlock(t *testing.T) {
+	// txes with nonces 0, 1 and 2 are included in blocks 10, 10 and 25.
+	nonceAt := func(_ context.Context, block uint64) (uint64, error) {
+		switch {
+		case block >= 25:
+			return 3, nil
+		case block >= 10:
+			return 2, nil
+		default:
+			return 0, nil
+		}
+	}
+
+	ctx := context.Background()
+	block, err := exporters.FindNonceBlock(ctx, nonceAt, 100, 3)
+	Nil(t, err)
+	Equal(t, uint64(25), block)
+
+	block, err = exporters.FindNonceBlock(ctx, nonceAt, 100, 2)
+	Nil(t, err)
+	Equal(t, uint64(10), block)
+
+	block, err = exporters.FindNonceBlock(ctx, nonceAt, 100, 1)
+	Nil(t, err)
+	Equal(t, uint64(10), block)
+
+	_, err = exporters.FindNonceBlock(ctx, func(context.Context, uint64) (uint64, error) {
+		return 0, errors.New("missing trie node")
+	}, 100, 1)
+	NotNil(t, err)
+}
+
+func TestRouteStats(t *testing.T) {
+	now := time.Now()
+	stats := exporters.RouteStats([]exporters.RFQQuote{
+		{OriginChainID: 1, DestChainID: 10, UpdatedAt: now.Add(-time.Minute)},
+		{OriginChainID: 1, DestChainID: 10, UpdatedAt: now.Add(-time.Second)},
+		{OriginChainID: 10, DestChainID: 1, UpdatedAt: now.Add(-time.Hour)},
+	}, now)
+
+	Equal(t, map[[2]int]exporters.RFQRouteStats{
+		{1, 10}: {MaxAge: time.Minute, Count: 2},
+		{10, 1}: {MaxAge: time.Hour, Count: 1},
+	}, stats)
+}
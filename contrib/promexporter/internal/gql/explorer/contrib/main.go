@@ -39,7 +39,7 @@ func main() {
 	if err != nil {
 		panic(fmt.Errorf("error creating null handler, %w", err))
 	}
-	gqlServer.EnableGraphql(router, nil, nil, nil, nil, nil, nil, nil, serverConfig.Config{}, nullHandler)
+	gqlServer.EnableGraphql(router, nil, nil, nil, nil, nil, nil, nil, serverConfig.Config{}, nil, nullHandler)
 
 	tmpPort, err := freeport.GetFreePort()
 	if err != nil {
@@ -14,6 +14,7 @@
 	"github.com/dubonzi/otelresty"
 	"github.com/go-resty/resty/v2"
 	"github.com/google/uuid"
+	"github.com/synapsecns/sanguine/contrib/screener-api/trmlabs"
 	"github.com/synapsecns/sanguine/core/ginhelper"
 	"github.com/synapsecns/sanguine/core/metrics"
 )
@@ -21,24 +22,53 @@
 // ScreenerClient is an interface for the Screener API.
 type ScreenerClient interface {
 	ScreenAddress(ctx context.Context, ruleset, address string) (blocked bool, err error)
+	// ScreenAddresses screens several addresses at once, returning whether each address is blocked in the same order.
+	ScreenAddresses(ctx context.Context, ruleset string, addresses []string) (blocked []bool, err error)
 	BlacklistAddress(ctx context.Context, appsecret string, appid string, body BlackListBody) (string, error)
+	// GetAuditLogs gets the screening decisions matching the query, newest first.
+	GetAuditLogs(ctx context.Context, appsecret string, appid string, query AuditLogQuery) ([]AuditLogEntry, error)
 }
 
+// CallerHeader is the header identifying the caller in the audit log.
+const CallerHeader = "Caller"
+
+// MaxBatchSize is the maximum number of addresses screened in one batch.
+const MaxBatchSize = 100
+
 type clientImpl struct {
 	rClient *resty.Client
+	caller  string
+}
+
+// Option is an option for the client.
+type Option func(*clientImpl)
+
+// WithCaller sets the caller recorded in the audit log of the screener, e.g. the name of the service.
+func WithCaller(caller string) Option {
+	return func(c *clientImpl) {
+		c.caller = caller
+	}
 }
 
 // NewClient creates a new client for the Screener API.
-func NewClient(metricHandler metrics.Handler, screenerURL string) (ScreenerClient, error) {
-	client := resty.New().
+func NewClient(metricHandler metrics.Handler, screenerURL string, opts ...Option) (ScreenerClient, error) {
+	impl := &clientImpl{}
+	for _, opt := range opts {
+		opt(impl)
+	}
+
+	impl.rClient = resty.New().
 		SetBaseURL(screenerURL).
 		OnBeforeRequest(func(_ *resty.Client, request *resty.Request) error {
 			request.Header.Add(ginhelper.RequestIDHeader, uuid.New().String())
+			if impl.caller != "" {
+				request.Header.Set(CallerHeader, impl.caller)
+			}
 			return nil
 		})
 
-	otelresty.TraceClient(client, otelresty.WithTracerProvider(metricHandler.GetTracerProvider()))
-	return &clientImpl{client}, nil
+	otelresty.TraceClient(impl.rClient, otelresty.WithTracerProvider(metricHandler.GetTracerProvider()))
+	return impl, nil
 }
 
 type blockedResponse struct {
@@ -63,6 +93,49 @@ func (c clientImpl) ScreenAddress(ctx context.Context, ruleset, address string)
 	return blockedRes.Blocked, nil
 }
 
+// BatchRequest is the json payload of a batch screening request.
+type BatchRequest struct {
+	Addresses []string `json:"addresses"`
+}
+
+// AddressRisk is whether an address is blocked.
+type AddressRisk struct {
+	Address string `json:"address"`
+	Blocked bool   `json:"risk"`
+}
+
+// BatchResponse is the json response of a batch screening request, in the order of the request.
+type BatchResponse struct {
+	Risks []AddressRisk `json:"risks"`
+}
+
+// ScreenAddresses checks if addresses are blocked by the screener.
+func (c clientImpl) ScreenAddresses(ctx context.Context, ruleset string, addresses []string) ([]bool, error) {
+	var batchRes BatchResponse
+	resp, err := c.rClient.R().
+		SetContext(ctx).
+		SetBody(BatchRequest{Addresses: addresses}).
+		SetResult(&batchRes).
+		Post(fmt.Sprintf("/%s/addresses", ruleset))
+	if err != nil {
+		return nil, fmt.Errorf("error from server: %s: %w", resp.Status(), err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("error from server: %s", resp.Status())
+	}
+
+	if len(batchRes.Risks) != len(addresses) {
+		return nil, fmt.Errorf("expected %d results, got %d", len(addresses), len(batchRes.Risks))
+	}
+
+	blocked := make([]bool, len(batchRes.Risks))
+	for i, risk := range batchRes.Risks {
+		blocked[i] = risk.Blocked
+	}
+	return blocked, nil
+}
+
 // BlackListBody is the json payload that represents a blacklisted address.
 type BlackListBody struct {
 	Type    string `json:"type"`
@@ -82,21 +155,90 @@ type blacklistResponse struct {
 func (c clientImpl) BlacklistAddress(ctx context.Context, appsecret string, appid string, body BlackListBody) (string, error) {
 	var blacklistRes blacklistResponse
 
-	nonce := strings.ReplaceAll(uuid.New().String(), "-", "")[:32]
-	timestamp := fmt.Sprintf("%d", time.Now().Unix())
-	queryString := ""
-
 	bodyBz, err := json.Marshal(body)
 	if err != nil {
 		return "", fmt.Errorf("error marshaling body: %w", err)
 	}
 
+	resp, err := c.signedPost(ctx, appsecret, appid, "/api/data/sync/", bodyBz, &blacklistRes)
+	if err != nil {
+		return resp.Status(), fmt.Errorf("error from server: %s: %w", resp.String(), err)
+	}
+
+	if resp.IsError() {
+		return resp.Status(), fmt.Errorf("error from server: %s", resp.String())
+	}
+
+	return blacklistRes.Status, nil
+}
+
+// AuditLogQuery is the json payload of an audit log query, empty fields are ignored.
+type AuditLogQuery struct {
+	Address string `json:"address"`
+	Ruleset string `json:"ruleset"`
+	Caller  string `json:"caller"`
+	// From is the time of the earliest entry (inclusive)
+	From time.Time `json:"from"`
+	// To is the time of the latest entry (exclusive)
+	To time.Time `json:"to"`
+	// Limit is the maximum number of entries returned, defaults to (and is capped at) MaxAuditLogLimit
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// MaxAuditLogLimit is the maximum number of audit log entries returned by a query.
+const MaxAuditLogLimit = 1000
+
+// AuditLogEntry is a screening decision of the audit log.
+type AuditLogEntry struct {
+	CreatedAt  time.Time                      `json:"createdAt"`
+	Address    string                         `json:"address"`
+	Ruleset    string                         `json:"ruleset"`
+	Caller     string                         `json:"caller"`
+	Risk       bool                           `json:"risk"`
+	Provider   string                         `json:"provider"`
+	CacheHit   bool                           `json:"cacheHit"`
+	Indicators []trmlabs.AddressRiskIndicator `json:"indicators"`
+}
+
+type auditLogResponse struct {
+	Logs  []AuditLogEntry `json:"logs"`
+	Error string          `json:"error"`
+}
+
+func (c clientImpl) GetAuditLogs(ctx context.Context, appsecret string, appid string, query AuditLogQuery) ([]AuditLogEntry, error) {
+	var auditLogRes auditLogResponse
+
+	bodyBz, err := json.Marshal(query)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling query: %w", err)
+	}
+
+	resp, err := c.signedPost(ctx, appsecret, appid, "/api/audit/", bodyBz, &auditLogRes)
+	if err != nil {
+		return nil, fmt.Errorf("error from server: %s: %w", resp.String(), err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("error from server: %s", resp.String())
+	}
+
+	return auditLogRes.Logs, nil
+}
+
+// signedPost posts the json body to a protected path, signing the request with the app secret.
+func (c clientImpl) signedPost(ctx context.Context, appsecret string, appid string, path string, bodyBz []byte, result interface{}) (*resty.Response, error) {
+	nonce := strings.ReplaceAll(uuid.New().String(), "-", "")[:32]
+	timestamp := fmt.Sprintf("%d", time.Now().Unix())
+	queryString := ""
+
 	message := fmt.Sprintf("%s%s%s%s%s%s%s",
-		appid, timestamp, nonce, "POST", "/api/data/sync/", queryString, string(bodyBz))
+		appid, timestamp, nonce, "POST", path, queryString, string(bodyBz))
 
 	signature := GenerateSignature(appsecret, message)
 
-	resp, err := c.rClient.R().
+	//nolint: wrapcheck
+	return c.rClient.R().
 		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("AppID", appid).
@@ -104,19 +246,9 @@ func (c clientImpl) BlacklistAddress(ctx context.Context, appsecret string, appi
 		SetHeader("Nonce", nonce).
 		SetHeader("QueryString", queryString).
 		SetHeader("Signature", signature).
-		SetBody(body).
-		SetResult(&blacklistRes).
-		Post("/api/data/sync/")
-
-	if err != nil {
-		return resp.Status(), fmt.Errorf("error from server: %s: %w", resp.String(), err)
-	}
-
-	if resp.IsError() {
-		return resp.Status(), fmt.Errorf("error from server: %s", resp.String())
-	}
-
-	return blacklistRes.Status, nil
+		SetBody(bodyBz).
+		SetResult(result).
+		Post(path)
 }
 
 // GenerateSignature generates a signature for the request.
@@ -142,8 +274,16 @@ func (n noOpClient) ScreenAddress(_ context.Context, _, _ string) (bool, error)
 	return false, nil
 }
 
+func (n noOpClient) ScreenAddresses(_ context.Context, _ string, addresses []string) ([]bool, error) {
+	return make([]bool, len(addresses)), nil
+}
+
 func (n noOpClient) BlacklistAddress(_ context.Context, _ string, _ string, _ BlackListBody) (string, error) {
 	return "", nil
 }
 
+func (n noOpClient) GetAuditLogs(_ context.Context, _ string, _ string, _ AuditLogQuery) ([]AuditLogEntry, error) {
+	return nil, nil
+}
+
 var _ ScreenerClient = noOpClient{}
@@ -27,6 +27,49 @@ type Config struct {
 	// TODO: This HAS to be re-structured somehow
 	// Whitelist is a list of addresses to whitelist
 	Whitelist []string `yaml:"whitelist"`
+	// Providers are the providers addresses are screened with, defaults to trm.
+	// the indicators of all providers are merged and each ruleset decides which ones are blocked
+	Providers []string `yaml:"providers"`
+	// SanctionsOracle is the configuration of the sanctions-oracle provider
+	SanctionsOracle SanctionsOracleConfig `yaml:"sanctions-oracle"`
+	// OFAC is the configuration of the ofac provider
+	OFAC OFACConfig `yaml:"ofac"`
+	// AuditRetention is how long screening decisions are kept in the audit log (in seconds)
+	// 0 keeps them forever
+	AuditRetention int `yaml:"audit-retention"`
+}
+
+const (
+	// TRMProvider screens addresses with the TRM Labs API.
+	TRMProvider = "trm"
+	// SanctionsOracleProvider screens addresses with an on-chain Chainalysis style sanctions oracle.
+	SanctionsOracleProvider = "sanctions-oracle"
+	// OFACProvider screens addresses against a local copy of the OFAC SDN list.
+	OFACProvider = "ofac"
+)
+
+// SanctionsOracleConfig is the config for the sanctions-oracle provider.
+type SanctionsOracleConfig struct {
+	// RPCURL is the rpc url of the chain of the oracle
+	RPCURL string `yaml:"rpc-url"`
+	// Address is the address of the oracle, defaults to the chainalysis oracle
+	Address string `yaml:"address"`
+}
+
+// OFACConfig is the config for the ofac provider.
+type OFACConfig struct {
+	// List is the file path or url of the SDN list (sdn.csv)
+	List string `yaml:"list"`
+	// RefreshInterval is how often the list is reimported (in seconds), 0 imports it once
+	RefreshInterval int `yaml:"refresh-interval"`
+}
+
+// GetProviders gets the providers addresses are screened with.
+func (c Config) GetProviders() []string {
+	if len(c.Providers) == 0 {
+		return []string{TRMProvider}
+	}
+	return c.Providers
 }
 
 // VolumeThreshold defines thresholds for different risk categories and types.
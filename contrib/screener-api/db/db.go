@@ -29,12 +29,14 @@ type BlacklistedAddressDB interface {
 
 // RuleWriterDB is the interface for writing rules to the database.
 type RuleWriterDB interface {
-	PutAddressIndicators(ctx context.Context, address string, riskIndicator []trmlabs.AddressRiskIndicator) error
+	// PutAddressIndicators caches the indicators of an address along with the providers they were screened by.
+	PutAddressIndicators(ctx context.Context, address, provider string, riskIndicator []trmlabs.AddressRiskIndicator) error
 }
 
 // RuleReaderDB is the interface for reading rules from the database.
 type RuleReaderDB interface {
-	GetAddressIndicators(ctx context.Context, address string, since time.Time) ([]trmlabs.AddressRiskIndicator, error)
+	// GetAddressIndicators gets the cached indicators of an address and the providers they were screened by.
+	GetAddressIndicators(ctx context.Context, address string, since time.Time) (_ []trmlabs.AddressRiskIndicator, provider string, _ error)
 }
 
 // RuleDB is the interface for reading and writing rules to the database.
@@ -43,10 +45,42 @@ type RuleDB interface {
 	RuleReaderDB
 }
 
+// AuditLogWriterDB is the interface for writing the audit log to the database.
+// The audit log is append only, entries are only removed once they are past retention.
+type AuditLogWriterDB interface {
+	PutAuditLogs(ctx context.Context, logs ...AuditLog) error
+	DeleteAuditLogsBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// AuditLogReaderDB is the interface for reading the audit log from the database.
+type AuditLogReaderDB interface {
+	GetAuditLogs(ctx context.Context, filter AuditLogFilter) ([]AuditLog, error)
+}
+
+// AuditLogDB is the interface for reading and writing the audit log to the database.
+type AuditLogDB interface {
+	AuditLogWriterDB
+	AuditLogReaderDB
+}
+
 // DB is the general database interface for the screener-api.
 type DB interface {
 	BlacklistedAddressDB
 	RuleDB
+	AuditLogDB
+}
+
+// AuditLogFilter filters the audit log, empty fields are ignored.
+type AuditLogFilter struct {
+	Address string
+	Ruleset string
+	Caller  string
+	// From is the time of the earliest entry (inclusive)
+	From time.Time
+	// To is the time of the latest entry (exclusive)
+	To     time.Time
+	Limit  int
+	Offset int
 }
 
 // ErrNoAddressNotCached is returned when an address is not cached.
@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"strings"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
@@ -14,18 +15,18 @@ func (d *DBSuite) TestEmpty() {
 		testAddress := gofakeit.BitcoinAddress()
 
 		// 5 mins ago
-		_, err := testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now().Add(time.Minute*-5))
+		_, _, err := testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now().Add(time.Minute*-5))
 		d.Require().Error(err, db.ErrNoAddressNotCached)
 
-		err = testDB.PutAddressIndicators(d.GetTestContext(), testAddress, []trmlabs.AddressRiskIndicator{})
+		err = testDB.PutAddressIndicators(d.GetTestContext(), testAddress, "trm", []trmlabs.AddressRiskIndicator{})
 		d.Require().NoError(err)
 
 		// 5 mins ago
-		_, err = testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now().Add(time.Minute*-5))
+		_, _, err = testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now().Add(time.Minute*-5))
 		d.Require().NoError(err)
 
 		// also make sure expiry works correctly, this should error
-		_, err = testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now())
+		_, _, err = testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now())
 		d.Require().Error(err, db.ErrNoAddressNotCached)
 	})
 }
@@ -35,22 +36,22 @@ func (d *DBSuite) TestAddressUpdate() {
 		testAddress := gofakeit.BitcoinAddress()
 
 		// 5 mins ago
-		_, err := testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now().Add(time.Minute*-5))
+		_, _, err := testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now().Add(time.Minute*-5))
 		d.Require().Error(err, db.ErrNoAddressNotCached)
 
-		err = testDB.PutAddressIndicators(d.GetTestContext(), testAddress, []trmlabs.AddressRiskIndicator{})
+		err = testDB.PutAddressIndicators(d.GetTestContext(), testAddress, "trm", []trmlabs.AddressRiskIndicator{})
 		d.Require().NoError(err)
 
 		// 5 mins ago
-		_, err = testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now().Add(time.Minute*-5))
+		_, _, err = testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now().Add(time.Minute*-5))
 		d.Require().NoError(err)
 
 		// also make sure expiry works correctly, this should error
-		_, err = testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now())
+		_, _, err = testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now())
 		d.Require().Error(err, db.ErrNoAddressNotCached)
 
 		// update the address
-		err = testDB.PutAddressIndicators(d.GetTestContext(), testAddress, []trmlabs.AddressRiskIndicator{
+		err = testDB.PutAddressIndicators(d.GetTestContext(), testAddress, "trm,ofac", []trmlabs.AddressRiskIndicator{
 			{
 				Category: "test",
 			},
@@ -58,11 +59,12 @@ func (d *DBSuite) TestAddressUpdate() {
 		d.Require().NoError(err)
 
 		// 5 mins ago
-		_, err = testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now().Add(time.Minute*-5))
+		_, provider, err := testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now().Add(time.Minute*-5))
 		d.Require().NoError(err)
+		d.Require().Equal("trm,ofac", provider)
 
 		// also make sure expiry works correctly, this should error
-		_, err = testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now())
+		_, _, err = testDB.GetAddressIndicators(d.GetTestContext(), testAddress, time.Now())
 		d.Require().Error(err, db.ErrNoAddressNotCached)
 	})
 }
@@ -113,3 +115,44 @@ func (d *DBSuite) TestBlacklist() {
 		d.Require().NoError(err)
 	})
 }
+
+func (d *DBSuite) TestAuditLog() {
+	d.RunOnAllDBs(func(testDB db.DB) {
+		testAddress := strings.ToLower(gofakeit.BitcoinAddress())
+		now := time.Now()
+
+		err := testDB.PutAuditLogs(d.GetTestContext(),
+			db.AuditLog{CreatedAt: now.Add(-time.Hour * 48), Address: testAddress, Ruleset: "rfq", Caller: "rfq-relayer", Risk: true, Provider: "trm"},
+			db.AuditLog{CreatedAt: now.Add(-time.Hour), Address: testAddress, Ruleset: "cctp", Caller: "cctp-relayer", Provider: "trm", CacheHit: true,
+				Indicators: []trmlabs.AddressRiskIndicator{{Category: "test"}}},
+			db.AuditLog{CreatedAt: now, Address: gofakeit.BitcoinAddress(), Ruleset: "rfq", Caller: "rfq-relayer", Provider: "whitelist"},
+		)
+		d.Require().NoError(err)
+
+		// newest first
+		logs, err := testDB.GetAuditLogs(d.GetTestContext(), db.AuditLogFilter{Address: testAddress})
+		d.Require().NoError(err)
+		d.Require().Len(logs, 2)
+		d.Require().Equal("cctp", logs[0].Ruleset)
+		d.Require().True(logs[0].CacheHit)
+		d.Require().Equal("test", logs[0].Indicators.ToTRMLabs()[0].Category)
+		d.Require().Equal("rfq", logs[1].Ruleset)
+
+		logs, err = testDB.GetAuditLogs(d.GetTestContext(), db.AuditLogFilter{Address: testAddress, Caller: "rfq-relayer"})
+		d.Require().NoError(err)
+		d.Require().Len(logs, 1)
+
+		logs, err = testDB.GetAuditLogs(d.GetTestContext(), db.AuditLogFilter{Address: testAddress, From: now.Add(-time.Hour * 2), Limit: 10})
+		d.Require().NoError(err)
+		d.Require().Len(logs, 1)
+
+		// retention
+		deleted, err := testDB.DeleteAuditLogsBefore(d.GetTestContext(), now.Add(-time.Hour*24))
+		d.Require().NoError(err)
+		d.Require().Equal(int64(1), deleted)
+
+		logs, err = testDB.GetAuditLogs(d.GetTestContext(), db.AuditLogFilter{Address: testAddress})
+		d.Require().NoError(err)
+		d.Require().Len(logs, 1)
+	})
+}
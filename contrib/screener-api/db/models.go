@@ -37,6 +37,28 @@ type AddressIndicators struct {
 	Address string `gorm:"column:address;primary_key"`
 	// RiskIndicators is the list of categories for the address
 	Indicators addressRiskIndicators `gorm:"column:indicators"`
+	// Provider is the providers the indicators were screened by, empty for indicators cached before it was recorded
+	Provider string `gorm:"column:provider"`
+}
+
+// AuditLog is a screening decision.
+type AuditLog struct {
+	ID        uint64    `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt time.Time `gorm:"column:created_at;index"`
+	// Address is the screened address
+	Address string `gorm:"column:address;index"`
+	// Ruleset is the ruleset the address was screened against
+	Ruleset string `gorm:"column:ruleset"`
+	// Caller is who requested the screening
+	Caller string `gorm:"column:caller"`
+	// Risk is whether the address was blocked
+	Risk bool `gorm:"column:risk"`
+	// Provider is what the decision was made from, the blacklist, the whitelist or the screening providers
+	Provider string `gorm:"column:provider"`
+	// CacheHit is whether the indicators came from the cache
+	CacheHit bool `gorm:"column:cache_hit"`
+	// Indicators are the risk indicators of the address
+	Indicators addressRiskIndicators `gorm:"column:indicators"`
 }
 
 // addressRiskIndicator is a risk indicator for an address
@@ -95,13 +117,14 @@ func (a addressRiskIndicators) ToTRMLabs() (res []trmlabs.AddressRiskIndicator)
 }
 
 // MakeRecord creates a new address indicators record.
-func MakeRecord(address string, records []trmlabs.AddressRiskIndicator) *AddressIndicators {
+func MakeRecord(address, provider string, records []trmlabs.AddressRiskIndicator) *AddressIndicators {
 	indicators := make(addressRiskIndicators, len(records))
 	copy(indicators, records)
 
 	return &AddressIndicators{
 		Address:    strings.ToLower(address),
 		Indicators: indicators,
+		Provider:   provider,
 	}
 }
 
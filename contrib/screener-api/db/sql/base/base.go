@@ -31,6 +31,7 @@ func NewStore(db *gorm.DB, metrics metrics.Handler) *Store {
 func GetAllModels() (allModels []interface{}) {
 	allModels = append(allModels, &db.AddressIndicators{})
 	allModels = append(allModels, &db.BlacklistedAddress{})
+	allModels = append(allModels, &db.AuditLog{})
 
 	return allModels
 }
@@ -90,38 +91,93 @@ func (s *Store) DeleteBlacklistedAddress(ctx context.Context, id string) error {
 	return nil
 }
 
-// GetAddressIndicators gets the address indicators for the given address.
-func (s *Store) GetAddressIndicators(ctx context.Context, address string, since time.Time) ([]trmlabs.AddressRiskIndicator, error) {
+// GetAddressIndicators gets the address indicators for the given address and the providers they were screened by.
+func (s *Store) GetAddressIndicators(ctx context.Context, address string, since time.Time) ([]trmlabs.AddressRiskIndicator, string, error) {
 	var addressIndicators db.AddressIndicators
 	result := s.db.WithContext(ctx).Where(&db.AddressIndicators{
 		Address: strings.ToLower(address),
 	}).First(&addressIndicators)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return nil, db.ErrNoAddressNotCached
+			return nil, "", db.ErrNoAddressNotCached
 		}
-		return nil, result.Error
+		return nil, "", result.Error
 	}
 
 	// if the address indicators are not found, return nil
 	if addressIndicators.UpdatedAt.Before(since) {
-		return nil, db.ErrNoAddressNotCached
+		return nil, "", db.ErrNoAddressNotCached
 	}
 
-	return addressIndicators.Indicators.ToTRMLabs(), nil
+	return addressIndicators.Indicators.ToTRMLabs(), addressIndicators.Provider, nil
 }
 
-// PutAddressIndicators puts the address indicators for the given address.
-func (s *Store) PutAddressIndicators(ctx context.Context, address string, riskIndicator []trmlabs.AddressRiskIndicator) error {
+// PutAddressIndicators puts the address indicators for the given address and the providers they were screened by.
+func (s *Store) PutAddressIndicators(ctx context.Context, address, provider string, riskIndicator []trmlabs.AddressRiskIndicator) error {
 	dbTx := s.db.WithContext(ctx).Model(&db.AddressIndicators{}).
 		Clauses(clause.OnConflict{
 			Columns: []clause.Column{
 				{Name: addressName},
 			},
-			DoUpdates: clause.AssignmentColumns([]string{addressName, indicatorName}),
-		}).Create(db.MakeRecord(address, riskIndicator))
+			DoUpdates: clause.AssignmentColumns([]string{addressName, indicatorName, providerName}),
+		}).Create(db.MakeRecord(address, provider, riskIndicator))
 	if dbTx.Error != nil {
 		return fmt.Errorf("failed to store address indicators: %w", dbTx.Error)
 	}
 	return nil
 }
+
+// PutAuditLogs appends entries to the audit log.
+func (s *Store) PutAuditLogs(ctx context.Context, logs ...db.AuditLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	dbTx := s.db.WithContext(ctx).Model(&db.AuditLog{}).Create(&logs)
+	if dbTx.Error != nil {
+		return fmt.Errorf("failed to store audit logs: %w", dbTx.Error)
+	}
+	return nil
+}
+
+// GetAuditLogs gets the audit log entries matching the filter, newest first.
+func (s *Store) GetAuditLogs(ctx context.Context, filter db.AuditLogFilter) ([]db.AuditLog, error) {
+	query := s.db.WithContext(ctx).Model(&db.AuditLog{})
+	if filter.Address != "" {
+		query = query.Where(fmt.Sprintf("%s = ?", addressName), strings.ToLower(filter.Address))
+	}
+	if filter.Ruleset != "" {
+		query = query.Where(fmt.Sprintf("%s = ?", rulesetName), strings.ToLower(filter.Ruleset))
+	}
+	if filter.Caller != "" {
+		query = query.Where(fmt.Sprintf("%s = ?", callerName), filter.Caller)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where(fmt.Sprintf("%s >= ?", createdAtName), filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where(fmt.Sprintf("%s < ?", createdAtName), filter.To)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var logs []db.AuditLog
+	dbTx := query.Order(fmt.Sprintf("%s desc, %s desc", createdAtName, idName)).Find(&logs)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get audit logs: %w", dbTx.Error)
+	}
+	return logs, nil
+}
+
+// DeleteAuditLogsBefore deletes the audit log entries created before the given time.
+func (s *Store) DeleteAuditLogsBefore(ctx context.Context, before time.Time) (int64, error) {
+	dbTx := s.db.WithContext(ctx).Where(fmt.Sprintf("%s < ?", createdAtName), before).Delete(&db.AuditLog{})
+	if dbTx.Error != nil {
+		return 0, fmt.Errorf("failed to delete audit logs: %w", dbTx.Error)
+	}
+	return dbTx.RowsAffected, nil
+}
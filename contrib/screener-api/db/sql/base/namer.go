@@ -9,6 +9,7 @@ func init() {
 
 	addressName = namer.GetConsistentName("Address")
 	indicatorName = namer.GetConsistentName("Indicators")
+	providerName = namer.GetConsistentName("Provider")
 
 	typeName = namer.GetConsistentName("Type")
 	idName = namer.GetConsistentName("ID")
@@ -16,11 +17,16 @@ func init() {
 	networkName = namer.GetConsistentName("Network")
 	tagName = namer.GetConsistentName("Tag")
 	remarkName = namer.GetConsistentName("Remark")
+
+	rulesetName = namer.GetConsistentName("Ruleset")
+	callerName = namer.GetConsistentName("Caller")
+	createdAtName = namer.GetConsistentName("CreatedAt")
 }
 
 var (
 	addressName   string
 	indicatorName string
+	providerName  string
 
 	typeName    string
 	idName      string
@@ -28,4 +34,8 @@ func init() {
 	networkName string
 	tagName     string
 	remarkName  string
+
+	rulesetName   string
+	callerName    string
+	createdAtName string
 )
@@ -15,6 +15,65 @@
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/api/audit": {
+            "post": {
+                "description": "get the screening decisions matching the query, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "get the audit log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Application ID",
+                        "name": "appid",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Timestamp of the request",
+                        "name": "timestamp",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "A unique nonce for the request",
+                        "name": "nonce",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Query string parameters included in the request",
+                        "name": "queryString",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signature for request validation",
+                        "name": "signature",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Audit log query",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/client.AuditLogQuery"
+                        }
+                    }
+                ],
+                "responses": {}
+            }
+        },
         "/api/data/sync": {
             "post": {
                 "description": "blacklist an address",
@@ -133,9 +192,130 @@
                     }
                 }
             }
+        },
+        "/{ruleset}/addresses": {
+            "post": {
+                "description": "Assess the risk associated with up to 100 addresses using specified rulesets.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "address"
+                ],
+                "summary": "Screen addresses for risk",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ruleset to use for screening the addresses",
+                        "name": "ruleset",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Addresses to be screened",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/client.BatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Returns the risk assessment result of each address, in the order of the request",
+                        "schema": {
+                            "$ref": "#/definitions/client.BatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Returns error if the required parameters are missing or invalid",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Returns error if there are problems processing the indicators",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
         }
     },
     "definitions": {
+        "client.AddressRisk": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "risk": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "client.AuditLogQuery": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "caller": {
+                    "type": "string"
+                },
+                "from": {
+                    "description": "From is the time of the earliest entry (inclusive)",
+                    "type": "string"
+                },
+                "limit": {
+                    "description": "Limit is the maximum number of entries returned, defaults to (and is capped at) MaxAuditLogLimit",
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "ruleset": {
+                    "type": "string"
+                },
+                "to": {
+                    "description": "To is the time of the latest entry (exclusive)",
+                    "type": "string"
+                }
+            }
+        },
+        "client.BatchRequest": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "client.BatchResponse": {
+            "type": "object",
+            "properties": {
+                "risks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/client.AddressRisk"
+                    }
+                }
+            }
+        },
         "db.BlacklistedAddress": {
             "type": "object",
             "properties": {
@@ -0,0 +1,116 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/synapsecns/sanguine/contrib/screener-api/config"
+	"github.com/synapsecns/sanguine/contrib/screener-api/trmlabs"
+)
+
+// sdnAddressRegex matches the evm digital currency addresses of the remarks of the OFAC SDN list,
+// e.g. "Digital Currency Address - ETH 0x8589427373D6D84E98730D7795D8f6f8731FDA16;".
+var sdnAddressRegex = regexp.MustCompile(`Digital Currency Address - [A-Za-z0-9]+ (0x[0-9a-fA-F]{40})`)
+
+// ParseSDNList returns the lowercased evm addresses of an OFAC SDN list, e.g. sdn.csv.
+func ParseSDNList(reader io.Reader) (map[string]bool, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("could not read sdn list: %w", err)
+	}
+
+	addresses := make(map[string]bool)
+	for _, match := range sdnAddressRegex.FindAllStringSubmatch(string(content), -1) {
+		addresses[strings.ToLower(match[1])] = true
+	}
+	return addresses, nil
+}
+
+// OFACProvider screens addresses against a local copy of the OFAC SDN list.
+type OFACProvider struct {
+	source      string
+	addresses   map[string]bool
+	addressesMu sync.RWMutex
+}
+
+// NewOFACProvider creates a new provider and imports the SDN list from the source, which is either a file path or a url.
+func NewOFACProvider(ctx context.Context, source string) (*OFACProvider, error) {
+	provider := &OFACProvider{source: source}
+	err := provider.Import(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+// Name is the name of the provider.
+func (o *OFACProvider) Name() string {
+	return config.OFACProvider
+}
+
+// Import reimports the SDN list from the source, keeping the previous list if it fails.
+func (o *OFACProvider) Import(ctx context.Context) error {
+	reader, err := o.open(ctx)
+	if err != nil {
+		return fmt.Errorf("could not open sdn list: %w", err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	addresses, err := ParseSDNList(reader)
+	if err != nil {
+		return fmt.Errorf("could not import sdn list: %w", err)
+	}
+
+	o.addressesMu.Lock()
+	defer o.addressesMu.Unlock()
+	o.addresses = addresses
+
+	return nil
+}
+
+func (o *OFACProvider) open(ctx context.Context) (io.ReadCloser, error) {
+	if !strings.HasPrefix(o.source, "http://") && !strings.HasPrefix(o.source, "https://") {
+		//nolint: gosec
+		file, err := os.Open(o.source)
+		if err != nil {
+			return nil, fmt.Errorf("could not open file: %w", err)
+		}
+		return file, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.source, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch list: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("expected status code 200, got %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+// ScreenAddress returns the sanctions indicator if the address is on the SDN list.
+func (o *OFACProvider) ScreenAddress(_ context.Context, address string) ([]trmlabs.AddressRiskIndicator, error) {
+	o.addressesMu.RLock()
+	defer o.addressesMu.RUnlock()
+
+	if !o.addresses[strings.ToLower(address)] {
+		return nil, nil
+	}
+	return []trmlabs.AddressRiskIndicator{sanctionsIndicator()}, nil
+}
+
+var _ Provider = &OFACProvider{}
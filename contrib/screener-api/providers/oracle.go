@@ -0,0 +1,71 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/contrib/screener-api/config"
+	"github.com/synapsecns/sanguine/contrib/screener-api/trmlabs"
+)
+
+// DefaultSanctionsOracleAddress is the address of the Chainalysis sanctions oracle, which is the same on every chain it is deployed to.
+const DefaultSanctionsOracleAddress = "0x40C57923924B5c5c5455c48D93317139ADDaC8fb"
+
+const sanctionsOracleABI = `[{"inputs":[{"internalType":"address","name":"addr","type":"address"}],"name":"isSanctioned","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"}]`
+
+// sanctionsOracleProvider screens addresses against an on-chain sanctions oracle.
+type sanctionsOracleProvider struct {
+	caller ethereum.ContractCaller
+	oracle common.Address
+	abi    abi.ABI
+}
+
+// NewSanctionsOracleProvider creates a new provider screening addresses with the isSanctioned method of a
+// Chainalysis style sanctions oracle. The caller is usually an ethergo client.
+func NewSanctionsOracleProvider(caller ethereum.ContractCaller, oracle common.Address) (Provider, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(sanctionsOracleABI))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse sanctions oracle abi: %w", err)
+	}
+
+	return &sanctionsOracleProvider{
+		caller: caller,
+		oracle: oracle,
+		abi:    parsedABI,
+	}, nil
+}
+
+func (s *sanctionsOracleProvider) Name() string {
+	return config.SanctionsOracleProvider
+}
+
+func (s *sanctionsOracleProvider) ScreenAddress(ctx context.Context, address string) ([]trmlabs.AddressRiskIndicator, error) {
+	if !common.IsHexAddress(address) {
+		return nil, nil
+	}
+
+	input, err := s.abi.Pack("isSanctioned", common.HexToAddress(address))
+	if err != nil {
+		return nil, fmt.Errorf("could not pack isSanctioned call: %w", err)
+	}
+
+	output, err := s.caller.CallContract(ctx, ethereum.CallMsg{To: &s.oracle, Data: input}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not call sanctions oracle: %w", err)
+	}
+
+	var sanctioned bool
+	err = s.abi.UnpackIntoInterface(&sanctioned, "isSanctioned", output)
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack isSanctioned result: %w", err)
+	}
+
+	if !sanctioned {
+		return nil, nil
+	}
+	return []trmlabs.AddressRiskIndicator{sanctionsIndicator()}, nil
+}
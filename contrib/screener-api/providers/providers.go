@@ -0,0 +1,100 @@
+// Package providers provides the screening providers of the screener-api.
+package providers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synapsecns/sanguine/contrib/screener-api/config"
+	"github.com/synapsecns/sanguine/contrib/screener-api/trmlabs"
+)
+
+// Provider screens an address and returns its risk indicators.
+type Provider interface {
+	// Name is the name of the provider.
+	Name() string
+	// ScreenAddress returns the risk indicators of an address.
+	ScreenAddress(ctx context.Context, address string) ([]trmlabs.AddressRiskIndicator, error)
+}
+
+// SanctionsCategory is the category of the indicators of sanctioned addresses.
+const SanctionsCategory = "Sanctions"
+
+// SanctionsRiskType is the risk type of the indicators of sanctioned addresses.
+const SanctionsRiskType = "OWNERSHIP"
+
+// sanctionsIndicator is the indicator of list based providers. It has no volumes, so it is blocked
+// whenever the ruleset enables the sanctions risk, and it is the same for every provider so the combinator
+// merges them.
+func sanctionsIndicator() trmlabs.AddressRiskIndicator {
+	return trmlabs.AddressRiskIndicator{
+		Category:                    SanctionsCategory,
+		CategoryRiskScoreLevel:      15,
+		CategoryRiskScoreLevelLabel: "Severe",
+		RiskType:                    SanctionsRiskType,
+	}
+}
+
+// trmProvider screens addresses with the TRM Labs API.
+type trmProvider struct {
+	client trmlabs.Client
+}
+
+// NewTRMProvider creates a new provider screening addresses with the TRM Labs API.
+func NewTRMProvider(client trmlabs.Client) Provider {
+	return &trmProvider{client: client}
+}
+
+func (t *trmProvider) Name() string {
+	return config.TRMProvider
+}
+
+func (t *trmProvider) ScreenAddress(ctx context.Context, address string) ([]trmlabs.AddressRiskIndicator, error) {
+	response, err := t.client.ScreenAddress(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("could not screen address: %w", err)
+	}
+
+	var indicators []trmlabs.AddressRiskIndicator
+	for _, ri := range response {
+		indicators = append(indicators, ri.AddressRiskIndicators...)
+	}
+	return indicators, nil
+}
+
+// combinator screens addresses with several providers.
+type combinator struct {
+	providers []Provider
+}
+
+// NewCombinator creates a provider merging the risk indicators of the given providers.
+// Rulesets decide which of the merged indicators block an address.
+func NewCombinator(providers ...Provider) Provider {
+	return &combinator{providers: providers}
+}
+
+func (c *combinator) Name() string {
+	return "combinator"
+}
+
+// ScreenAddress screens the address with each provider, dropping duplicate indicators.
+// It fails if any of the providers fails, so an address is never let through on partial data.
+func (c *combinator) ScreenAddress(ctx context.Context, address string) ([]trmlabs.AddressRiskIndicator, error) {
+	seen := make(map[trmlabs.AddressRiskIndicator]bool)
+	var indicators []trmlabs.AddressRiskIndicator
+	for _, provider := range c.providers {
+		providerIndicators, err := provider.ScreenAddress(ctx, address)
+		if err != nil {
+			return nil, fmt.Errorf("could not screen address with %s: %w", provider.Name(), err)
+		}
+
+		for _, indicator := range providerIndicators {
+			if seen[indicator] {
+				continue
+			}
+			seen[indicator] = true
+			indicators = append(indicators, indicator)
+		}
+	}
+	return indicators, nil
+}
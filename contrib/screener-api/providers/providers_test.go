@@ -0,0 +1,76 @@
+package providers_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/contrib/screener-api/providers"
+	"github.com/synapsecns/sanguine/contrib/screener-api/trmlabs"
+)
+
+const testSDNList = `36216,"LAZARUS GROUP","-0- ",DPRK3,-0-,-0-,-0-,-0-,-0-,-0-,-0-,"Digital Currency Address - ETH 0x098B716B8Aaf21512996dC57EB0615e2383E2f96; alt. Digital Currency Address - ETH 0xa0e1c89Ef1a489c9C7dE96311eD5Ce5D32c20E4B; Secondary sanctions risk."
+40000,"SOMEONE","individual",CYBER2,-0-,-0-,-0-,-0-,-0-,-0-,-0-,"Digital Currency Address - XBT 1AjZPMsnmpdK2Rv9KQNfMurTXinscVro9V."
+40001,"SOMEONE ELSE","individual",CYBER2,-0-,-0-,-0-,-0-,-0-,-0-,-0-,"Digital Currency Address - USDT 0x7F367cC41522cE07553e823bf3be79A889DEbe1B."`
+
+func TestParseSDNList(t *testing.T) {
+	addresses, err := providers.ParseSDNList(strings.NewReader(testSDNList))
+	Nil(t, err)
+	Equal(t, map[string]bool{
+		"0x098b716b8aaf21512996dc57eb0615e2383e2f96": true,
+		"0xa0e1c89ef1a489c9c7de96311ed5ce5d32c20e4b": true,
+		"0x7f367cc41522ce07553e823bf3be79a889debe1b": true,
+	}, addresses)
+}
+
+// mockOracle is a sanctions oracle sanctioning a set of addresses.
+type mockOracle map[common.Address]bool
+
+func (m mockOracle) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	// the address is the last 20 bytes of the only argument.
+	result := make([]byte, 32)
+	if m[common.BytesToAddress(call.Data[4:])] {
+		result[31] = 1
+	}
+	return result, nil
+}
+
+// mockClient is a trm client returning the same indicators for every address.
+type mockClient []trmlabs.AddressRiskIndicator
+
+func (m mockClient) ScreenAddress(_ context.Context, _ string) ([]trmlabs.ScreenResponse, error) {
+	return []trmlabs.ScreenResponse{{AddressRiskIndicators: m}}, nil
+}
+
+func TestCombinator(t *testing.T) {
+	ctx := context.Background()
+	sanctioned := "0x098B716B8Aaf21512996dC57EB0615e2383E2f96"
+
+	ofac, err := providers.NewOFACProvider(ctx, filet.TmpFile(t, "", testSDNList).Name())
+	Nil(t, err)
+
+	oracle, err := providers.NewSanctionsOracleProvider(mockOracle{common.HexToAddress(sanctioned): true}, common.HexToAddress(providers.DefaultSanctionsOracleAddress))
+	Nil(t, err)
+
+	trmIndicator := trmlabs.AddressRiskIndicator{Category: "Gambling", RiskType: "COUNTERPARTY", IncomingVolumeUsd: "10", OutgoingVolumeUsd: "0"}
+	trm := providers.NewTRMProvider(mockClient{trmIndicator})
+
+	combinator := providers.NewCombinator(trm, ofac, oracle)
+
+	// the sanctions indicators of the oracle and the list are merged.
+	indicators, err := combinator.ScreenAddress(ctx, sanctioned)
+	Nil(t, err)
+	Len(t, indicators, 2)
+	Equal(t, trmIndicator, indicators[0])
+	Equal(t, providers.SanctionsCategory, indicators[1].Category)
+	Equal(t, providers.SanctionsRiskType, indicators[1].RiskType)
+
+	indicators, err = combinator.ScreenAddress(ctx, "0x0000000000000000000000000000000000000001")
+	Nil(t, err)
+	Equal(t, []trmlabs.AddressRiskIndicator{trmIndicator}, indicators)
+}
@@ -0,0 +1,127 @@
+package screener
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/synapsecns/sanguine/contrib/screener-api/client"
+	"github.com/synapsecns/sanguine/contrib/screener-api/db"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// auditPruneInterval is how often entries past retention are removed from the audit log.
+const auditPruneInterval = time.Hour
+
+// callerOf returns the caller of a request, the caller header or the ip of the client if it is not set.
+func callerOf(c *gin.Context) string {
+	caller := c.GetHeader(client.CallerHeader)
+	if caller == "" {
+		return c.ClientIP()
+	}
+	return caller
+}
+
+// recordDecisions appends screening decisions to the audit log.
+// Failing to record a decision is logged, but does not fail the screening.
+func (s *screenerImpl) recordDecisions(ctx context.Context, caller string, decisions ...db.AuditLog) {
+	for i := range decisions {
+		decisions[i].Caller = caller
+	}
+
+	err := s.db.PutAuditLogs(ctx, decisions...)
+	if err != nil {
+		logger.Errorf("could not record screening decisions: %s", err)
+	}
+}
+
+// pruneAuditLogs removes the entries past retention from the audit log every prune interval.
+func (s *screenerImpl) pruneAuditLogs(ctx context.Context) {
+	ticker := time.NewTicker(auditPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		retention := time.Duration(s.cfg.AuditRetention) * time.Second
+		deleted, err := s.db.DeleteAuditLogsBefore(ctx, time.Now().Add(-retention))
+		if err != nil {
+			logger.Errorf("could not prune audit logs: %s", err)
+		} else if deleted > 0 {
+			logger.Infof("pruned %d audit logs", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// @dev Protected Method
+// @Summary get the audit log
+// @Description get the screening decisions matching the query, newest first
+// @Param appid header string true "Application ID"
+// @Param timestamp header string true "Timestamp of the request"
+// @Param nonce header string true "A unique nonce for the request"
+// @Param queryString header string true "Query string parameters included in the request"
+// @Param signature header string true "Signature for request validation"
+// @Param request body client.AuditLogQuery true "Audit log query"
+// @Accept json
+// @Produce json
+// @Router /api/audit [post].
+func (s *screenerImpl) getAuditLogs(c *gin.Context) {
+	var err error
+	ctx, span := s.metrics.Tracer().Start(c.Request.Context(), "getAuditLogs")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	var query client.AuditLogQuery
+	if err := c.ShouldBindBodyWith(&query, binding.JSON); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if query.Limit <= 0 || query.Limit > client.MaxAuditLogLimit {
+		query.Limit = client.MaxAuditLogLimit
+	}
+
+	span.SetAttributes(
+		attribute.String("address", query.Address),
+		attribute.String("ruleset", query.Ruleset),
+		attribute.String("caller", query.Caller),
+	)
+
+	logs, err := s.db.GetAuditLogs(ctx, db.AuditLogFilter{
+		Address: query.Address,
+		Ruleset: query.Ruleset,
+		Caller:  query.Caller,
+		From:    query.From,
+		To:      query.To,
+		Limit:   query.Limit,
+		Offset:  query.Offset,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	entries := make([]client.AuditLogEntry, len(logs))
+	for i, auditLog := range logs {
+		entries[i] = client.AuditLogEntry{
+			CreatedAt:  auditLog.CreatedAt,
+			Address:    auditLog.Address,
+			Ruleset:    auditLog.Ruleset,
+			Caller:     auditLog.Caller,
+			Risk:       auditLog.Risk,
+			Provider:   auditLog.Provider,
+			CacheHit:   auditLog.CacheHit,
+			Indicators: auditLog.Indicators.ToTRMLabs(),
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"logs": entries})
+}
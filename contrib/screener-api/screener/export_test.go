@@ -4,6 +4,7 @@
 	"context"
 	"fmt"
 	"github.com/synapsecns/sanguine/contrib/screener-api/config"
+	"github.com/synapsecns/sanguine/contrib/screener-api/providers"
 	"github.com/synapsecns/sanguine/contrib/screener-api/trmlabs"
 	"github.com/synapsecns/sanguine/core/metrics"
 )
@@ -28,7 +29,7 @@ func NewTestScreener(ctx context.Context, cfg config.Config, metricHandler metri
 }
 
 func (s *screenerImpl) SetClient(client trmlabs.Client) {
-	s.client = client
+	s.provider = providers.NewTRMProvider(client)
 }
 
 func SplitCSV(file string) (map[string][]Set, error) {
@@ -81,6 +81,15 @@ func (cr *CallerRuler) HasAddressIndicators(thresholds []config.VolumeThreshold,
 	anyIndicatorBlocked := false
 
 	for _, ri := range riskIndicators {
+		// indicators of list based providers (e.g. sanctions lists) have no volumes, they are blocked if the ruleset enables them.
+		if ri.IncomingVolumeUsd == "" && ri.OutgoingVolumeUsd == "" {
+			if cr.HasRisk(MakeParam(ri.Category, ri.RiskType)) {
+				anyIndicatorBlocked = true
+				break
+			}
+			continue
+		}
+
 		incoming, err := strconv.ParseFloat(ri.IncomingVolumeUsd, 32)
 		if err != nil {
 			return false, fmt.Errorf("could not parse incoming volume: %w", err)
@@ -94,3 +94,25 @@ func TestHasAddressIndicators(t *testing.T) {
 	_, err = cr.HasAddressIndicators(thresholds, indicators...)
 	require.Error(t, err)
 }
+
+func TestHasListIndicators(t *testing.T) {
+	cr := internal.NewRuleset(map[string]bool{
+		"sanctions_ownership": true,
+	})
+
+	// Test case where the ruleset enables the indicator
+	indicators := []trmlabs.AddressRiskIndicator{
+		{Category: "Sanctions", RiskType: "OWNERSHIP"},
+	}
+	result, err := cr.HasAddressIndicators(nil, indicators...)
+	require.NoError(t, err)
+	assert.True(t, result)
+
+	// Test case where the ruleset does not enable the indicator
+	indicators = []trmlabs.AddressRiskIndicator{
+		{Category: "Sanctions", RiskType: "COUNTERPARTY"},
+	}
+	result, err = cr.HasAddressIndicators(nil, indicators...)
+	require.NoError(t, err)
+	assert.False(t, result)
+}
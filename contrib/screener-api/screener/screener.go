@@ -12,6 +12,7 @@
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/ipfs/go-log"
@@ -20,6 +21,7 @@
 	"github.com/synapsecns/sanguine/contrib/screener-api/db"
 	"github.com/synapsecns/sanguine/contrib/screener-api/db/sql"
 	"github.com/synapsecns/sanguine/contrib/screener-api/docs"
+	"github.com/synapsecns/sanguine/contrib/screener-api/providers"
 	"github.com/synapsecns/sanguine/contrib/screener-api/screener/internal"
 	"github.com/synapsecns/sanguine/contrib/screener-api/trmlabs"
 	"github.com/synapsecns/sanguine/core"
@@ -27,9 +29,11 @@
 	"github.com/synapsecns/sanguine/core/ginhelper"
 	"github.com/synapsecns/sanguine/core/metrics"
 	baseServer "github.com/synapsecns/sanguine/core/server"
+	etherClient "github.com/synapsecns/sanguine/ethergo/client"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
 
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -47,7 +51,8 @@ type screenerImpl struct {
 	router       *gin.Engine
 	metrics      metrics.Handler
 	cfg          config.Config
-	client       trmlabs.Client
+	provider     providers.Provider
+	ofac         *providers.OFACProvider
 	blacklist    []string
 	blacklistMux sync.RWMutex
 	whitelist    []string
@@ -65,9 +70,9 @@ func NewScreener(ctx context.Context, cfg config.Config, metricHandler metrics.H
 	docs.SwaggerInfo.Title = "Screener API"
 	docs.SwaggerInfo.Host = fmt.Sprintf("localhost:%d", cfg.Port)
 
-	screener.client, err = trmlabs.NewClient(cfg.TRMKey, core.GetEnv("TRM_URL", "https://api.trmlabs.com"))
+	err = screener.setupProviders(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("could not create trm client: %w", err)
+		return nil, fmt.Errorf("could not setup providers: %w", err)
 	}
 	screener.thresholds = cfg.VolumeThresholds
 
@@ -93,8 +98,10 @@ func NewScreener(ctx context.Context, cfg config.Config, metricHandler metrics.H
 	screener.router = ginhelper.New(logger)
 	screener.router.Use(screener.metrics.Gin())
 	screener.router.Handle(http.MethodGet, "/:ruleset/address/:address", screener.screenAddress)
+	screener.router.Handle(http.MethodPost, "/:ruleset/addresses", screener.screenAddresses)
 
-	screener.router.Handle(http.MethodPost, "/api/data/sync", screener.authMiddleware(cfg), screener.blacklistAddress)
+	screener.router.Handle(http.MethodPost, "/api/data/sync", screener.authMiddleware(cfg, "/api/data/sync/"), screener.blacklistAddress)
+	screener.router.Handle(http.MethodPost, "/api/audit", screener.authMiddleware(cfg, "/api/audit/"), screener.getAuditLogs)
 	screener.router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 
 	return &screener, nil
@@ -132,6 +139,65 @@ func (s *screenerImpl) fetchBlacklist(ctx context.Context) {
 	}
 }
 
+// setupProviders creates the configured screening providers and merges them.
+func (s *screenerImpl) setupProviders(ctx context.Context) error {
+	var screenProviders []providers.Provider
+	for _, name := range s.cfg.GetProviders() {
+		switch name {
+		case config.TRMProvider:
+			trmClient, err := trmlabs.NewClient(s.cfg.TRMKey, core.GetEnv("TRM_URL", "https://api.trmlabs.com"))
+			if err != nil {
+				return fmt.Errorf("could not create trm client: %w", err)
+			}
+			screenProviders = append(screenProviders, providers.NewTRMProvider(trmClient))
+		case config.SanctionsOracleProvider:
+			evmClient, err := etherClient.DialBackend(ctx, s.cfg.SanctionsOracle.RPCURL, s.metrics)
+			if err != nil {
+				return fmt.Errorf("could not dial sanctions oracle rpc: %w", err)
+			}
+			oracleAddress := s.cfg.SanctionsOracle.Address
+			if oracleAddress == "" {
+				oracleAddress = providers.DefaultSanctionsOracleAddress
+			}
+			oracle, err := providers.NewSanctionsOracleProvider(evmClient, common.HexToAddress(oracleAddress))
+			if err != nil {
+				return fmt.Errorf("could not create sanctions oracle provider: %w", err)
+			}
+			screenProviders = append(screenProviders, oracle)
+		case config.OFACProvider:
+			var err error
+			s.ofac, err = providers.NewOFACProvider(ctx, s.cfg.OFAC.List)
+			if err != nil {
+				return fmt.Errorf("could not create ofac provider: %w", err)
+			}
+			screenProviders = append(screenProviders, s.ofac)
+		default:
+			return fmt.Errorf("unknown provider %s", name)
+		}
+	}
+
+	s.provider = providers.NewCombinator(screenProviders...)
+	return nil
+}
+
+// refreshOFAC reimports the OFAC SDN list every refresh interval.
+func (s *screenerImpl) refreshOFAC(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(s.cfg.OFAC.RefreshInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.ofac.Import(ctx)
+			if err != nil {
+				logger.Errorf("could not import ofac list: %s", err)
+			}
+		}
+	}
+}
+
 // @dev Protected Method
 // @Summary blacklist an address
 // @Description blacklist an address
@@ -220,7 +286,8 @@ func (s *screenerImpl) blacklistAddress(c *gin.Context) {
 
 // This function takes the HTTP headers and the body of the request and reconstructs the signature to
 // compare it with the signature provided. If they match, the request is allowed to pass through.
-func (s *screenerImpl) authMiddleware(cfg config.Config) gin.HandlerFunc {
+// The path is the path the client signs, which may differ from the routed path by a trailing slash.
+func (s *screenerImpl) authMiddleware(cfg config.Config, path string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		_, span := s.metrics.Tracer().Start(c.Request.Context(), "authMiddleware")
 		defer span.End()
@@ -245,7 +312,7 @@ func (s *screenerImpl) authMiddleware(cfg config.Config) gin.HandlerFunc {
 		)
 
 		message := fmt.Sprintf("%s%s%s%s%s%s%s",
-			appID, timestamp, nonce, "POST", "/api/data/sync/", queryString, bodyStr)
+			appID, timestamp, nonce, "POST", path, queryString, bodyStr)
 
 		span.AddEvent("message", trace.WithAttributes(attribute.String("message", message)))
 
@@ -276,6 +343,12 @@ func (s *screenerImpl) Start(ctx context.Context) error {
 			}
 		}
 	}()
+	if s.ofac != nil && s.cfg.OFAC.RefreshInterval > 0 {
+		go s.refreshOFAC(ctx)
+	}
+	if s.cfg.AuditRetention > 0 {
+		go s.pruneAuditLogs(ctx)
+	}
 	connection := baseServer.Server{}
 	err := connection.ListenAndServe(ctx, fmt.Sprintf(":%d", s.cfg.Port), s.router)
 	if err != nil {
@@ -311,77 +384,191 @@ func (s *screenerImpl) screenAddress(c *gin.Context) {
 		return
 	}
 
-	s.blacklistMux.RLock()
-	if slices.Contains(s.blacklist, address) {
-		c.JSON(http.StatusOK, gin.H{"risk": true})
-		s.blacklistMux.RUnlock()
+	ctx, span := s.metrics.Tracer().Start(c.Request.Context(), "screenAddress", trace.WithAttributes(attribute.String("address", address)))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	var decision db.AuditLog
+	decision, err = s.screen(ctx, ruleset, address)
+	if errors.Is(err, errRulesetNotFound) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ruleset not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	s.blacklistMux.RUnlock()
 
-	if slices.Contains(s.whitelist, address) {
-		c.JSON(http.StatusOK, gin.H{"risk": false})
+	s.recordDecisions(ctx, callerOf(c), decision)
+	c.JSON(http.StatusOK, gin.H{"risk": decision.Risk})
+}
+
+// screenAddresses returns whether each address is risky or not given a ruleset.
+// @Summary Screen addresses for risk
+// @Description Assess the risk associated with up to 100 addresses using specified rulesets.
+// @Tags address
+// @Accept  json
+// @Produce  json
+// @Param ruleset path string true "Ruleset to use for screening the addresses"
+// @Param request body client.BatchRequest true "Addresses to be screened"
+// @Success 200 {object} client.BatchResponse "Returns the risk assessment result of each address, in the order of the request"
+// @Failure 400 {object} map[string]string "Returns error if the required parameters are missing or invalid"
+// @Failure 500 {object} map[string]string "Returns error if there are problems processing the indicators"
+// @Router /{ruleset}/addresses [post].
+func (s *screenerImpl) screenAddresses(c *gin.Context) {
+	var err error
+
+	ruleset := strings.ToLower(c.Param("ruleset"))
+	if ruleset == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ruleset is required"})
 		return
 	}
 
-	ctx, span := s.metrics.Tracer().Start(c.Request.Context(), "screenAddress", trace.WithAttributes(attribute.String("address", address)))
+	var request client.BatchRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if len(request.Addresses) == 0 || len(request.Addresses) > client.MaxBatchSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("between 1 and %d addresses are required", client.MaxBatchSize)})
+		return
+	}
+
+	if s.rulesManager.GetRuleset(ruleset) == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ruleset not found"})
+		return
+	}
+
+	ctx, span := s.metrics.Tracer().Start(c.Request.Context(), "screenAddresses", trace.WithAttributes(attribute.Int("addresses", len(request.Addresses))))
 	defer func() {
 		metrics.EndSpanWithErr(span, err)
 	}()
 
+	addresses := make([]string, len(request.Addresses))
+	for i, address := range request.Addresses {
+		addresses[i] = strings.ToLower(address)
+		if addresses[i] == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "address is required"})
+			return
+		}
+	}
+
+	decisions := make([]db.AuditLog, len(addresses))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(batchConcurrency)
+	for i, address := range addresses {
+		i, address := i, address
+		g.Go(func() error {
+			decision, err := s.screen(gctx, ruleset, address)
+			if err != nil {
+				return err
+			}
+			decisions[i] = decision
+			return nil
+		})
+	}
+
+	err = g.Wait()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := client.BatchResponse{Risks: make([]client.AddressRisk, len(decisions))}
+	for i, decision := range decisions {
+		response.Risks[i] = client.AddressRisk{Address: decision.Address, Blocked: decision.Risk}
+	}
+
+	s.recordDecisions(ctx, callerOf(c), decisions...)
+	c.JSON(http.StatusOK, response)
+}
+
+var errRulesetNotFound = errors.New("ruleset not found")
+
+// batchConcurrency is the max number of addresses of a batch screened at once.
+const batchConcurrency = 10
+
+// names of the sources of the decisions made without the screening providers.
+const (
+	blacklistDecision = "blacklist"
+	whitelistDecision = "whitelist"
+)
+
+// screen decides whether an address is risky given a ruleset.
+func (s *screenerImpl) screen(ctx context.Context, ruleset, address string) (decision db.AuditLog, err error) {
+	decision = db.AuditLog{Address: address, Ruleset: ruleset}
+
+	s.blacklistMux.RLock()
+	blacklisted := slices.Contains(s.blacklist, address)
+	s.blacklistMux.RUnlock()
+	if blacklisted {
+		decision.Risk = true
+		decision.Provider = blacklistDecision
+		return decision, nil
+	}
+
+	if slices.Contains(s.whitelist, address) {
+		decision.Provider = whitelistDecision
+		return decision, nil
+	}
+
 	currentRules := s.rulesManager.GetRuleset(ruleset)
 	if currentRules == nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "ruleset not found"})
-		return
+		return decision, errRulesetNotFound
 	}
 
 	goodUntil := time.Now().Add(-1 * s.cfg.GetCacheTime(ruleset))
-	var indicators []trmlabs.AddressRiskIndicator
-	if indicators, err = s.getIndicators(ctx, address, goodUntil); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+	indicators, provider, cacheHit, err := s.getIndicators(ctx, address, goodUntil)
+	if err != nil {
+		return decision, err
 	}
+	decision.Provider = provider
+	decision.CacheHit = cacheHit
+	decision.Indicators = indicators
 
-	var hasIndicator bool
-	if hasIndicator, err = currentRules.HasAddressIndicators(s.thresholds, indicators...); err != nil {
-		c.JSON(http.StatusOK, gin.H{"risk": true})
-		return
+	decision.Risk, err = currentRules.HasAddressIndicators(s.thresholds, indicators...)
+	if err != nil {
+		// indicators that can't be processed are treated as risky.
+		decision.Risk = true
 	}
 
-	c.JSON(http.StatusOK, gin.H{"risk": hasIndicator})
+	return decision, nil
 }
 
-func (s *screenerImpl) getIndicators(parentCtx context.Context, address string, goodUntil time.Time) (indicators []trmlabs.AddressRiskIndicator, err error) {
+// getIndicators gets the indicators of an address from the cache, or screens it if they are missing or expired.
+// The provider is the set of providers the indicators were screened by, which differs from the configured set
+// for indicators cached before the providers were changed.
+func (s *screenerImpl) getIndicators(parentCtx context.Context, address string, goodUntil time.Time) (indicators []trmlabs.AddressRiskIndicator, provider string, cacheHit bool, err error) {
 	ctx, span := s.metrics.Tracer().Start(parentCtx, "get-indicators")
 	defer func() {
 		// nolint: errchkjson
 		marshalledIndicators, _ := json.Marshal(indicators)
 		span.AddEvent("indicators", trace.WithAttributes(attribute.String("indicators", string(marshalledIndicators))))
+		span.SetAttributes(attribute.Bool("cache_hit", cacheHit), attribute.String("provider", provider))
 		metrics.EndSpanWithErr(span, err)
 	}()
 
-	riskIndicators, err := s.db.GetAddressIndicators(ctx, address, goodUntil)
+	riskIndicators, provider, err := s.db.GetAddressIndicators(ctx, address, goodUntil)
 	if err == nil {
-		return riskIndicators, nil
+		return riskIndicators, provider, true, nil
 	}
 
 	if !errors.Is(err, db.ErrNoAddressNotCached) {
-		return nil, fmt.Errorf("could not get address indicators: %w", err)
+		return nil, "", false, fmt.Errorf("could not get address indicators: %w", err)
 	}
 
-	response, err := s.client.ScreenAddress(ctx, address)
+	riskIndicators, err = s.provider.ScreenAddress(ctx, address)
 	if err != nil {
-		return nil, fmt.Errorf("could not screen address: %w", err)
-	}
-
-	for _, ri := range response {
-		riskIndicators = append(riskIndicators, ri.AddressRiskIndicators...)
+		return nil, "", false, fmt.Errorf("could not screen address: %w", err)
 	}
 
-	err = s.db.PutAddressIndicators(ctx, address, riskIndicators)
+	provider = strings.Join(s.cfg.GetProviders(), ",")
+	err = s.db.PutAddressIndicators(ctx, address, provider, riskIndicators)
 	if err != nil {
-		return nil, fmt.Errorf("could not put address indicators: %w", err)
+		return nil, "", false, fmt.Errorf("could not put address indicators: %w", err)
 	}
 
-	return riskIndicators, nil
+	return riskIndicators, provider, false, nil
 }
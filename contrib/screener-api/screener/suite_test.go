@@ -140,7 +140,7 @@ func (s *ScreenerSuite) TestScreener() {
 	realScreener.SetClient(m)
 	time.Sleep(time.Second)
 
-	apiClient, err := client.NewClient(s.metrics, fmt.Sprintf("http://localhost:%d", s.port))
+	apiClient, err := client.NewClient(s.metrics, fmt.Sprintf("http://localhost:%d", s.port), client.WithCaller("test-caller"))
 	Nil(s.T(), err)
 
 	// http://localhost:63575/testrule/address/0x123: true
@@ -153,6 +153,31 @@ func (s *ScreenerSuite) TestScreener() {
 	Nil(s.T(), err)
 	False(s.T(), out)
 
+	// batch screening, in the order of the request
+	batchOut, err := apiClient.ScreenAddresses(s.GetTestContext(), "testrule", []string{"0x00", "0x123"})
+	Nil(s.T(), err)
+	Equal(s.T(), []bool{false, true}, batchOut)
+
+	_, err = apiClient.ScreenAddresses(s.GetTestContext(), "testrule", nil)
+	NotNil(s.T(), err)
+
+	// every decision is in the audit log
+	auditLogs, err := apiClient.GetAuditLogs(s.GetTestContext(), cfg.AppSecret, cfg.AppID, client.AuditLogQuery{Address: "0x123"})
+	Nil(s.T(), err)
+	Len(s.T(), auditLogs, 2)
+	Equal(s.T(), "test-caller", auditLogs[0].Caller)
+	Equal(s.T(), "testrule", auditLogs[0].Ruleset)
+	True(s.T(), auditLogs[0].Risk)
+	True(s.T(), auditLogs[0].CacheHit)
+	// cache hits are attributed to the providers that screened the cached indicators
+	Equal(s.T(), "trm", auditLogs[0].Provider)
+	False(s.T(), auditLogs[1].CacheHit)
+	Equal(s.T(), "trm", auditLogs[1].Provider)
+	NotEmpty(s.T(), auditLogs[1].Indicators)
+
+	_, err = apiClient.GetAuditLogs(s.GetTestContext(), "bad", cfg.AppID, client.AuditLogQuery{})
+	NotNil(s.T(), err)
+
 	// now test crud screener
 	blacklistBody := client.BlackListBody{
 		Type:    "create",
@@ -19,6 +19,8 @@
 	MysqlHostVar = "MYSQL_HOST"
 	// MysqlPortVar is the environment variable for the mysql port.
 	MysqlPortVar = "MYSQL_PORT"
+	// EnablePostgresTestVar is the environment variable to enable postgres tests.
+	EnablePostgresTestVar = "ENABLE_POSTGRES_TEST"
 )
 
 // GetTestConnString returns the connection string for the mysql test database.
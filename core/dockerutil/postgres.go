@@ -0,0 +1,98 @@
+package dockerutil
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+)
+
+const (
+	// PostgresUser is the user the test postgres container is created with.
+	PostgresUser = "postgres_test"
+	// PostgresPassword is the password the test postgres container is created with.
+	PostgresPassword = "postgres_test"
+	// PostgresDatabase is the default database the test postgres container is created with.
+	PostgresDatabase = "postgres_test"
+)
+
+// StartPostgres starts a postgres container for the lifetime of the test and returns the host port it listens on.
+// Use PostgresConnString to build a dsn for a database on it. The container is purged on test cleanup.
+func StartPostgres(ctx context.Context, tb testing.TB) string {
+	tb.Helper()
+
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		tb.Fatalf("could not create docker pool: %v", err)
+	}
+
+	runOptions := &dockertest.RunOptions{
+		Repository: "postgres",
+		Tag:        "16-alpine",
+		Env: []string{
+			"POSTGRES_USER=" + PostgresUser,
+			"POSTGRES_PASSWORD=" + PostgresPassword,
+			"POSTGRES_DB=" + PostgresDatabase,
+		},
+		ExposedPorts: []string{"5432"},
+		Labels:       map[string]string{"postgres_test_" + tb.Name(): "1"},
+	}
+	resource, err := pool.RunWithOptions(runOptions, func(config *docker.HostConfig) {
+		// set AutoRemove to true so that stopped container goes away by itself
+		config.AutoRemove = true
+		config.RestartPolicy = docker.RestartPolicy{Name: "no"}
+	})
+	if err != nil {
+		tb.Fatalf("could not start postgres: %v", err)
+	}
+
+	tb.Cleanup(func() {
+		if err := pool.Purge(resource); err != nil {
+			tb.Logf("failed to purge postgres resource: %v", err)
+		}
+	})
+
+	// Docker will hard kill the container in 360 seconds (this is a test env).
+	// In a continuous integration environment, this is increased to allow for the lower cpu count
+	resourceLifetime := uint(360)
+	pool.MaxWait = time.Minute * 2
+
+	if os.Getenv("CI") != "" {
+		resourceLifetime = 900
+		pool.MaxWait = time.Minute * 5
+	}
+
+	if err = resource.Expire(resourceLifetime); err != nil {
+		tb.Fatalf("could not set postgres expiry: %v", err)
+	}
+
+	// the entrypoint runs a temporary unix socket only server while initializing, so we check readiness over tcp.
+	err = pool.Retry(func() error {
+		if ctx.Err() != nil {
+			return fmt.Errorf("context canceled: %w", ctx.Err())
+		}
+
+		exitCode, err := resource.Exec([]string{"pg_isready", "-h", "127.0.0.1", "-U", PostgresUser, "-d", PostgresDatabase}, dockertest.ExecOptions{})
+		if err != nil {
+			return fmt.Errorf("could not check postgres readiness: %w", err)
+		}
+		if exitCode != 0 {
+			return fmt.Errorf("postgres not ready, pg_isready exited with %d", exitCode)
+		}
+		return nil
+	})
+	if err != nil {
+		tb.Fatalf("could not connect to postgres: %v", err)
+	}
+
+	return GetPort(resource, "5432/tcp")
+}
+
+// PostgresConnString returns a dsn for a database on the test postgres container listening on port.
+func PostgresConnString(port, database string) string {
+	return fmt.Sprintf("host=localhost port=%s user=%s password=%s dbname=%s sslmode=disable", port, PostgresUser, PostgresPassword, database)
+}
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/types"
+	"gorm.io/gorm"
+)
+
+// MessageEventResult is a state reached by a message.
+type MessageEventResult struct {
+	State     string `json:"state"`
+	TxHash    string `json:"tx_hash,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// MessageHistoryResult is the result of a successful /messages/:hash request.
+type MessageHistoryResult struct {
+	Message MessageResult        `json:"message"`
+	History []MessageEventResult `json:"history"`
+}
+
+func toMessageResult(msg types.Message) MessageResult {
+	return MessageResult{
+		MessageHash:     msg.MessageHash,
+		Sender:          msg.Sender,
+		OriginHash:      msg.OriginTxHash,
+		DestinationHash: msg.DestTxHash,
+		Origin:          msg.OriginChainID,
+		Destination:     msg.DestChainID,
+		RequestID:       msg.RequestID,
+		State:           msg.State.String(),
+		RelayerFee:      msg.RelayerFee,
+		RelayCost:       msg.RelayCost,
+	}
+}
+
+// GetMessages handles the /messages endpoint, listing the messages matching the query parameters.
+func (r RelayerAPIServer) GetMessages(ctx *gin.Context) {
+	filter, err := getMessageFilterParams(ctx)
+	if err != nil {
+		encodeError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	messages, err := r.db.GetMessages(ctx, filter)
+	if err != nil {
+		encodeError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	results := make([]MessageResult, len(messages))
+	for i, msg := range messages {
+		results[i] = toMessageResult(msg)
+	}
+	ctx.JSON(http.StatusOK, RelayerResponse{
+		Success: true,
+		Result:  results,
+	})
+}
+
+// GetMessage handles the /messages/:hash endpoint, returning a message along with its history.
+func (r RelayerAPIServer) GetMessage(ctx *gin.Context) {
+	messageHash := common.HexToHash(ctx.Param(hashParamName))
+
+	msg, err := r.db.GetMessageByHash(ctx, messageHash)
+	if err != nil {
+		encodeDBError(ctx, err)
+		return
+	}
+
+	events, err := r.db.GetMessageHistory(ctx, msg.MessageHash)
+	if err != nil {
+		encodeError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	history := make([]MessageEventResult, len(events))
+	for i, event := range events {
+		history[i] = MessageEventResult{
+			State:     event.State.String(),
+			TxHash:    event.TxHash,
+			Timestamp: event.CreatedAt.Unix(),
+		}
+	}
+	ctx.JSON(http.StatusOK, RelayerResponse{
+		Success: true,
+		Result: MessageHistoryResult{
+			Message: toMessageResult(*msg),
+			History: history,
+		},
+	})
+}
+
+// RetryMessage handles the /messages/:hash/retry endpoint, queueing a message for relay again.
+// Attested messages are submitted again, others are attested again. Submitted messages are only retried with the force
+// parameter, since their relay may still land. The profitability checks of the message are cleared, so unprofitable
+// messages are priced again as new messages.
+func (r RelayerAPIServer) RetryMessage(ctx *gin.Context) {
+	force, err := getOptionalBoolParam(ctx, forceParamName)
+	if err != nil {
+		encodeError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	r.setMessageState(ctx, func(msg *types.Message) (types.MessageState, error) {
+		if msg.State == types.Submitted && !force {
+			return 0, fmt.Errorf("message %s is already submitted, retry with %s=true to submit it again", msg.MessageHash, forceParamName)
+		}
+		if len(msg.Attestation) > 0 {
+			return types.Attested, nil
+		}
+		return types.Pending, nil
+	}, r.db.ResetMessageState)
+}
+
+// AbandonMessage handles the /messages/:hash/abandon endpoint, stopping the relay of a message.
+func (r RelayerAPIServer) AbandonMessage(ctx *gin.Context) {
+	r.setMessageState(ctx, func(*types.Message) (types.MessageState, error) {
+		return types.Abandoned, nil
+	}, r.db.UpdateMessageState)
+}
+
+// setMessageState sets the state of an incomplete message with update. getState returns an error if the message
+// can't be moved to a new state.
+func (r RelayerAPIServer) setMessageState(ctx *gin.Context, getState func(msg *types.Message) (types.MessageState, error),
+	update func(ctx context.Context, messageHash string, state types.MessageState) error) {
+	messageHash := common.HexToHash(ctx.Param(hashParamName))
+
+	msg, err := r.db.GetMessageByHash(ctx, messageHash)
+	if err != nil {
+		encodeDBError(ctx, err)
+		return
+	}
+	if msg.State == types.Complete {
+		encodeError(ctx, http.StatusConflict, fmt.Errorf("message %s is already complete", msg.MessageHash))
+		return
+	}
+
+	msg.State, err = getState(msg)
+	if err != nil {
+		encodeError(ctx, http.StatusConflict, err)
+		return
+	}
+	err = update(ctx, msg.MessageHash, msg.State)
+	if err != nil {
+		encodeDBError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, RelayerResponse{
+		Success: true,
+		Result:  toMessageResult(*msg),
+	})
+}
+
+// authenticate rejects requests without the admin token.
+func (r RelayerAPIServer) authenticate(ctx *gin.Context) {
+	token := strings.TrimPrefix(ctx.GetHeader("Authorization"), "Bearer ")
+	if r.adminToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(r.adminToken)) != 1 {
+		encodeError(ctx, http.StatusUnauthorized, errors.New("invalid admin token"))
+		ctx.Abort()
+		return
+	}
+	ctx.Next()
+}
+
+func encodeDBError(ctx *gin.Context, err error) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		encodeError(ctx, http.StatusNotFound, errors.New("message not found"))
+		return
+	}
+	encodeError(ctx, http.StatusInternalServerError, err)
+}
+
+// maxMessagesLimit is the maximum number of messages returned by /messages.
+const maxMessagesLimit = 1000
+
+// defaultMessagesLimit is the number of messages returned by /messages if no limit is set.
+const defaultMessagesLimit = 100
@@ -0,0 +1,198 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/phayes/freeport"
+	"github.com/synapsecns/sanguine/ethergo/mocks"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/api"
+	relayTypes "github.com/synapsecns/sanguine/services/cctp-relayer/types"
+)
+
+const testAdminToken = "admin-token"
+
+// rawRelayerResponse is a relayer response whose result is decoded later.
+type rawRelayerResponse struct {
+	Success bool            `json:"success"`
+	Result  json.RawMessage `json:"result"`
+}
+
+// startServer starts a relayer api server and returns its base url.
+func (s *RelayerAPISuite) startServer() string {
+	port, err := freeport.GetFreePort()
+	s.Require().NoError(err)
+
+	server := api.NewRelayerAPIServer(uint16(port), "localhost", s.testStore, make(chan *api.RelayRequest, 1000), testAdminToken)
+	ctx, cancel := context.WithCancel(s.GetTestContext())
+	s.T().Cleanup(cancel)
+	//nolint:errcheck
+	go server.Start(ctx)
+
+	baseURL := fmt.Sprintf("http://localhost:%d", port)
+	s.Eventually(func() bool {
+		//nolint:noctx
+		resp, err := http.Get(baseURL + "/messages")
+		if err != nil {
+			return false
+		}
+		_ = resp.Body.Close()
+		return true
+	})
+
+	return baseURL
+}
+
+// doRequest makes a request to the relayer api and decodes the result of the response into res.
+func (s *RelayerAPISuite) doRequest(method, url, token string, res interface{}) int {
+	req, err := http.NewRequestWithContext(s.GetTestContext(), method, url, nil)
+	s.Require().NoError(err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	var relayerResp rawRelayerResponse
+	s.Require().NoError(json.Unmarshal(body, &relayerResp))
+	if relayerResp.Success && res != nil {
+		s.Require().NoError(json.Unmarshal(relayerResp.Result, res))
+	}
+
+	return resp.StatusCode
+}
+
+func (s *RelayerAPISuite) TestGetMessages() {
+	baseURL := s.startServer()
+
+	pending := s.mockMessage(10, relayTypes.Pending)
+	pending.Sender = mocks.MockAddress().String()
+	complete := s.mockMessage(10, relayTypes.Complete)
+	for _, msg := range []relayTypes.Message{pending, complete} {
+		s.Require().NoError(s.testStore.StoreMessage(s.GetTestContext(), msg))
+	}
+
+	var results []api.MessageResult
+	status := s.doRequest(http.MethodGet, baseURL+"/messages?origin=10", "", &results)
+	s.Equal(http.StatusOK, status)
+	s.Len(results, 2)
+
+	status = s.doRequest(http.MethodGet, baseURL+"/messages?origin=10&state=pending", "", &results)
+	s.Equal(http.StatusOK, status)
+	s.Require().Len(results, 1)
+	s.Equal(pending.MessageHash, results[0].MessageHash)
+
+	status = s.doRequest(http.MethodGet, fmt.Sprintf("%s/messages?sender=%s", baseURL, pending.Sender), "", &results)
+	s.Equal(http.StatusOK, status)
+	s.Require().Len(results, 1)
+	s.Equal(pending.Sender, results[0].Sender)
+
+	status = s.doRequest(http.MethodGet, fmt.Sprintf("%s/messages?origin=10&from=%d", baseURL, time.Now().Add(time.Hour).Unix()), "", &results)
+	s.Equal(http.StatusOK, status)
+	s.Empty(results)
+
+	status = s.doRequest(http.MethodGet, baseURL+"/messages?state=unknown", "", nil)
+	s.Equal(http.StatusBadRequest, status)
+}
+
+func (s *RelayerAPISuite) TestGetMessageHistory() {
+	baseURL := s.startServer()
+
+	msg := s.mockMessage(11, relayTypes.Pending)
+	s.Require().NoError(s.testStore.StoreMessage(s.GetTestContext(), msg))
+	msg.State = relayTypes.Complete
+	s.Require().NoError(s.testStore.StoreMessage(s.GetTestContext(), msg))
+
+	var result api.MessageHistoryResult
+	status := s.doRequest(http.MethodGet, baseURL+"/messages/"+msg.MessageHash, "", &result)
+	s.Equal(http.StatusOK, status)
+	s.Equal(msg.MessageHash, result.Message.MessageHash)
+	s.Equal(relayTypes.Complete.String(), result.Message.State)
+	s.Require().Len(result.History, 2)
+	s.Equal(relayTypes.Pending.String(), result.History[0].State)
+	s.Equal(msg.OriginTxHash, result.History[0].TxHash)
+	s.Equal(relayTypes.Complete.String(), result.History[1].State)
+	s.Equal(msg.DestTxHash, result.History[1].TxHash)
+
+	status = s.doRequest(http.MethodGet, baseURL+"/messages/"+mocks.NewMockHash(s.T()).String(), "", nil)
+	s.Equal(http.StatusNotFound, status)
+}
+
+func (s *RelayerAPISuite) TestRetryAndAbandonMessage() {
+	baseURL := s.startServer()
+
+	msg := s.mockMessage(12, relayTypes.Submitted)
+	s.Require().NoError(s.testStore.StoreMessage(s.GetTestContext(), msg))
+
+	// admin endpoints require the admin token
+	status := s.doRequest(http.MethodPost, baseURL+"/messages/"+msg.MessageHash+"/retry", "", nil)
+	s.Equal(http.StatusUnauthorized, status)
+	status = s.doRequest(http.MethodPost, baseURL+"/messages/"+msg.MessageHash+"/retry", "wrong-token", nil)
+	s.Equal(http.StatusUnauthorized, status)
+
+	// the message is submitted, so it is only submitted again when forced
+	status = s.doRequest(http.MethodPost, baseURL+"/messages/"+msg.MessageHash+"/retry", testAdminToken, nil)
+	s.Equal(http.StatusConflict, status)
+	status = s.doRequest(http.MethodPost, baseURL+"/messages/"+msg.MessageHash+"/retry?force=maybe", testAdminToken, nil)
+	s.Equal(http.StatusBadRequest, status)
+
+	var result api.MessageResult
+	status = s.doRequest(http.MethodPost, baseURL+"/messages/"+msg.MessageHash+"/retry?force=true", testAdminToken, &result)
+	s.Equal(http.StatusOK, status)
+	s.Equal(relayTypes.Attested.String(), result.State)
+
+	status = s.doRequest(http.MethodPost, baseURL+"/messages/"+msg.MessageHash+"/abandon", testAdminToken, &result)
+	s.Equal(http.StatusOK, status)
+	s.Equal(relayTypes.Abandoned.String(), result.State)
+
+	var history api.MessageHistoryResult
+	status = s.doRequest(http.MethodGet, baseURL+"/messages/"+msg.MessageHash, "", &history)
+	s.Equal(http.StatusOK, status)
+	s.Equal(relayTypes.Abandoned.String(), history.Message.State)
+
+	// complete messages can't be changed
+	complete := s.mockMessage(12, relayTypes.Complete)
+	s.Require().NoError(s.testStore.StoreMessage(s.GetTestContext(), complete))
+	status = s.doRequest(http.MethodPost, baseURL+"/messages/"+complete.MessageHash+"/abandon", testAdminToken, nil)
+	s.Equal(http.StatusConflict, status)
+}
+
+func (s *RelayerAPISuite) TestRetryUnprofitableMessage() {
+	baseURL := s.startServer()
+
+	for _, state := range []relayTypes.MessageState{relayTypes.Unprofitable, relayTypes.WillNotComplete} {
+		msg := s.mockMessage(13, relayTypes.Pending)
+		s.Require().NoError(s.testStore.StoreMessage(s.GetTestContext(), msg))
+		checkedAt := time.Now().Add(-time.Hour)
+		msg.State = relayTypes.Unprofitable
+		msg.UnprofitableSince = &checkedAt
+		msg.ProfitabilityCheckedAt = &checkedAt
+		s.Require().NoError(s.testStore.StoreMessage(s.GetTestContext(), msg))
+		if state != relayTypes.Unprofitable {
+			s.Require().NoError(s.testStore.UpdateMessageState(s.GetTestContext(), msg.MessageHash, state))
+		}
+
+		// the message is priced again as a new message, so it is not given up on because of its past checks.
+		var result api.MessageResult
+		status := s.doRequest(http.MethodPost, baseURL+"/messages/"+msg.MessageHash+"/retry", testAdminToken, &result)
+		s.Equal(http.StatusOK, status, state.String())
+		s.Equal(relayTypes.Attested.String(), result.State, state.String())
+
+		storedMsg, err := s.testStore.GetMessageByHash(s.GetTestContext(), common.HexToHash(msg.MessageHash))
+		s.Require().NoError(err)
+		s.Equal(relayTypes.Attested, storedMsg.State, state.String())
+		s.Nil(storedMsg.UnprofitableSince, state.String())
+		s.Nil(storedMsg.ProfitabilityCheckedAt, state.String())
+	}
+}
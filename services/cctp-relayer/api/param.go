@@ -3,9 +3,13 @@
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/db"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/types"
 )
 
 func getRawParam(name string, ctx *gin.Context) (string, error) {
@@ -39,3 +43,110 @@ func getHashParam(ctx *gin.Context) (string, error) {
 	value = common.HexToHash(value).String()
 	return value, nil
 }
+
+const (
+	stateParamName       = "state"
+	destinationParamName = "destination"
+	senderParamName      = "sender"
+	fromParamName        = "from"
+	toParamName          = "to"
+	limitParamName       = "limit"
+	offsetParamName      = "offset"
+	forceParamName       = "force"
+)
+
+// getMessageFilterParams parses the optional filters of /messages.
+// States are comma separated, times are unix timestamps.
+func getMessageFilterParams(ctx *gin.Context) (filter db.MessageFilter, err error) {
+	if rawStates := ctx.Query(stateParamName); rawStates != "" {
+		for _, rawState := range strings.Split(rawStates, ",") {
+			state, err := types.MessageStateFromString(strings.TrimSpace(rawState))
+			if err != nil {
+				return filter, err
+			}
+			filter.States = append(filter.States, state)
+		}
+	}
+
+	filter.OriginChainID, err = getOptionalUintParam(ctx, originParamName)
+	if err != nil {
+		return filter, err
+	}
+	filter.DestChainID, err = getOptionalUintParam(ctx, destinationParamName)
+	if err != nil {
+		return filter, err
+	}
+
+	if sender := ctx.Query(senderParamName); sender != "" {
+		if !common.IsHexAddress(sender) {
+			return filter, fmt.Errorf("could not parse sender: %s", sender)
+		}
+		filter.Sender = common.HexToAddress(sender).String()
+	}
+
+	filter.From, err = getOptionalTimeParam(ctx, fromParamName)
+	if err != nil {
+		return filter, err
+	}
+	filter.To, err = getOptionalTimeParam(ctx, toParamName)
+	if err != nil {
+		return filter, err
+	}
+
+	limit, err := getOptionalUintParam(ctx, limitParamName)
+	if err != nil {
+		return filter, err
+	}
+	switch {
+	case limit == 0:
+		filter.Limit = defaultMessagesLimit
+	case limit > maxMessagesLimit:
+		return filter, fmt.Errorf("limit cannot be more than %d", maxMessagesLimit)
+	default:
+		filter.Limit = int(limit)
+	}
+
+	offset, err := getOptionalUintParam(ctx, offsetParamName)
+	if err != nil {
+		return filter, err
+	}
+	filter.Offset = int(offset)
+
+	return filter, nil
+}
+
+func getOptionalUintParam(ctx *gin.Context, name string) (uint32, error) {
+	rawValue := ctx.Query(name)
+	if rawValue == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseUint(rawValue, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse %s: %s", name, rawValue)
+	}
+	return uint32(value), nil
+}
+
+func getOptionalTimeParam(ctx *gin.Context, name string) (time.Time, error) {
+	rawValue := ctx.Query(name)
+	if rawValue == "" {
+		return time.Time{}, nil
+	}
+	value, err := strconv.ParseInt(rawValue, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not parse %s: %s", name, rawValue)
+	}
+	return time.Unix(value, 0), nil
+}
+
+func getOptionalBoolParam(ctx *gin.Context, name string) (bool, error) {
+	rawValue := ctx.Query(name)
+	if rawValue == "" {
+		return false, nil
+	}
+	value, err := strconv.ParseBool(rawValue)
+	if err != nil {
+		return false, fmt.Errorf("could not parse %s: %s", name, rawValue)
+	}
+	return value, nil
+}
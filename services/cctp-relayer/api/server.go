@@ -20,15 +20,19 @@ type RelayerAPIServer struct {
 	host             string
 	db               db2.CCTPRelayerDB
 	relayRequestChan chan *RelayRequest
+	// adminToken is the bearer token of the endpoints that change messages.
+	adminToken string
 }
 
 // NewRelayerAPIServer creates a new RelayerAPIServer.
-func NewRelayerAPIServer(port uint16, host string, db db2.CCTPRelayerDB, relayRequestChan chan *RelayRequest) *RelayerAPIServer {
+// The endpoints that change messages are disabled if adminToken is empty.
+func NewRelayerAPIServer(port uint16, host string, db db2.CCTPRelayerDB, relayRequestChan chan *RelayRequest, adminToken string) *RelayerAPIServer {
 	return &RelayerAPIServer{
 		port:             port,
 		host:             host,
 		db:               db,
 		relayRequestChan: relayRequestChan,
+		adminToken:       adminToken,
 	}
 }
 
@@ -38,6 +42,19 @@ func (r RelayerAPIServer) Start(ctx context.Context) error {
 	engine.GET("/tx", func(ctx *gin.Context) {
 		r.GetTx(ctx)
 	})
+	engine.GET("/messages", func(ctx *gin.Context) {
+		r.GetMessages(ctx)
+	})
+	engine.GET("/messages/:hash", func(ctx *gin.Context) {
+		r.GetMessage(ctx)
+	})
+	admin := engine.Group("/messages", r.authenticate)
+	admin.POST("/:hash/retry", func(ctx *gin.Context) {
+		r.RetryMessage(ctx)
+	})
+	admin.POST("/:hash/abandon", func(ctx *gin.Context) {
+		r.AbandonMessage(ctx)
+	})
 	server := &http.Server{
 		Addr:              fmt.Sprintf(":%d", r.port),
 		ReadHeaderTimeout: 5 * time.Second,
@@ -102,14 +119,7 @@ func (r RelayerAPIServer) GetTx(ctx *gin.Context) {
 		// return if found
 		resp := RelayerResponse{
 			Success: true,
-			Result: MessageResult{
-				OriginHash:      hash,
-				DestinationHash: msg.DestTxHash,
-				Origin:          msg.OriginChainID,
-				Destination:     msg.DestChainID,
-				RequestID:       msg.RequestID,
-				State:           msg.State.String(),
-			},
+			Result:  toMessageResult(*msg),
 		}
 		ctx.JSON(http.StatusOK, resp)
 		return
@@ -132,12 +142,19 @@ func (r RelayerAPIServer) GetTx(ctx *gin.Context) {
 
 // MessageResult is the result of a successful /tx request.
 type MessageResult struct {
+	MessageHash     string `json:"message_hash,omitempty"`
+	Sender          string `json:"sender,omitempty"`
 	OriginHash      string `json:"origin_hash"`
 	DestinationHash string `json:"destination_hash"`
 	Origin          uint32 `json:"origin"`
 	Destination     uint32 `json:"destination"`
 	RequestID       string `json:"request_id"`
 	State           string `json:"state"`
+	// RelayerFee is the fee of the message compared to its relay cost, in the bridged token's units. It is only set
+	// once the message has been priced.
+	RelayerFee string `json:"relayer_fee,omitempty"`
+	// RelayCost is the estimated cost of relaying the message, in the bridged token's units.
+	RelayCost string `json:"relay_cost,omitempty"`
 }
 
 // RelayerResponse is a wrapper struct for a relayer API response.
@@ -59,7 +59,7 @@ func (s *RelayerAPISuite) TestPendingTx() {
 	port, err := freeport.GetFreePort()
 	s.Nil(err)
 
-	server := api.NewRelayerAPIServer(uint16(port), "localhost", s.testStore, reqChan)
+	server := api.NewRelayerAPIServer(uint16(port), "localhost", s.testStore, reqChan, "")
 	ctx, cancel := context.WithCancel(s.GetTestContext())
 	//nolint:errcheck
 	go server.Start(ctx)
@@ -114,7 +114,7 @@ func (s *RelayerAPISuite) TestMissingTx() {
 	port, err := freeport.GetFreePort()
 	s.Nil(err)
 
-	server := api.NewRelayerAPIServer(uint16(port), "localhost", s.testStore, reqChan)
+	server := api.NewRelayerAPIServer(uint16(port), "localhost", s.testStore, reqChan, "")
 	ctx, cancel := context.WithCancel(s.GetTestContext())
 	//nolint:errcheck
 	go server.Start(ctx)
@@ -159,7 +159,7 @@ func (s *RelayerAPISuite) TestBadRequest() {
 	port, err := freeport.GetFreePort()
 	s.Nil(err)
 
-	server := api.NewRelayerAPIServer(uint16(port), "localhost", s.testStore, reqChan)
+	server := api.NewRelayerAPIServer(uint16(port), "localhost", s.testStore, reqChan, "")
 	ctx, cancel := context.WithCancel(s.GetTestContext())
 	//nolint:errcheck
 	go server.Start(ctx)
@@ -0,0 +1,94 @@
+package attestation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/mocks"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/attestation"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/db/sql/base"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/db/sql/sqlite"
+)
+
+func TestCircleAPIPending(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"attestation":"PENDING","status":"pending_confirmations"}`))
+	}))
+	defer server.Close()
+
+	_, err := attestation.NewCircleAPI(server.URL).GetAttestation(context.Background(), mocks.NewMockHash(t).String())
+	assert.ErrorIs(t, err, attestation.ErrAttestationPending)
+}
+
+func TestCircleAPIComplete(t *testing.T) {
+	expected := []byte("attestation")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"attestation":%q,"status":"complete"}`, hexutil.Encode(expected))))
+	}))
+	defer server.Close()
+
+	res, err := attestation.NewCircleAPI(server.URL).GetAttestation(context.Background(), mocks.NewMockHash(t).String())
+	require.NoError(t, err)
+	assert.Equal(t, expected, res)
+}
+
+func TestFailoverAPI(t *testing.T) {
+	failing := attestation.NewMockCircleAPI()
+	failing.SetGetAttestation(func(context.Context, string) ([]byte, error) {
+		return nil, errors.New("unavailable")
+	})
+
+	expected := []byte("attestation")
+	working := attestation.NewMockCircleAPI()
+	working.SetGetAttestation(func(context.Context, string) ([]byte, error) {
+		return expected, nil
+	})
+
+	res, err := attestation.NewFailoverAPI(failing, working).GetAttestation(context.Background(), mocks.NewMockHash(t).String())
+	require.NoError(t, err)
+	assert.Equal(t, expected, res)
+
+	_, err = attestation.NewFailoverAPI(failing, failing).GetAttestation(context.Background(), mocks.NewMockHash(t).String())
+	assert.ErrorContains(t, err, "attestation source 1: unavailable")
+
+	_, err = attestation.NewFailoverAPI().GetAttestation(context.Background(), mocks.NewMockHash(t).String())
+	assert.Error(t, err)
+}
+
+func TestCachedAPI(t *testing.T) {
+	ctx := context.Background()
+	sqliteStore, err := sqlite.NewSqliteStore(ctx, filet.TmpDir(t, ""), metrics.NewNullHandler(), false)
+	require.NoError(t, err)
+	store := base.NewStore(sqliteStore.DB(), metrics.NewNullHandler())
+
+	calls := 0
+	expected := []byte("attestation")
+	api := attestation.NewMockCircleAPI()
+	api.SetGetAttestation(func(context.Context, string) ([]byte, error) {
+		calls++
+		if calls > 1 {
+			return nil, errors.New("fetched twice")
+		}
+		return expected, nil
+	})
+
+	messageHash := mocks.NewMockHash(t).String()
+	res, err := attestation.NewCachedAPI(api, store).GetAttestation(ctx, messageHash)
+	require.NoError(t, err)
+	assert.Equal(t, expected, res)
+
+	// a new api over the same store, like after a restart, uses the stored attestation.
+	res, err = attestation.NewCachedAPI(api, store).GetAttestation(ctx, messageHash)
+	require.NoError(t, err)
+	assert.Equal(t, expected, res)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,57 @@
+package attestation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/synapsecns/sanguine/services/cctp-relayer/db"
+	"gorm.io/gorm"
+)
+
+// attestationStore is the part of the relayer db used to persist attestations.
+type attestationStore interface {
+	GetAttestation(ctx context.Context, messageHash string) ([]byte, error)
+	StoreAttestation(ctx context.Context, messageHash string, attestation []byte) error
+}
+
+// CachedAPI persists the attestations of an attestation source in the relayer db so they are not fetched again,
+// including after a restart.
+type CachedAPI struct {
+	api   CCTPAPI
+	store attestationStore
+}
+
+// NewCachedAPI creates a new CachedAPI.
+func NewCachedAPI(api CCTPAPI, store db.CCTPRelayerDB) *CachedAPI {
+	return &CachedAPI{
+		api:   api,
+		store: store,
+	}
+}
+
+// GetAttestation gets the stored attestation of a message, or fetches and stores it if there is none.
+func (c *CachedAPI) GetAttestation(ctx context.Context, messageHash string) ([]byte, error) {
+	attestation, err := c.store.GetAttestation(ctx, messageHash)
+	if err == nil {
+		return attestation, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Warnf("could not get stored attestation for %s: %v", messageHash, err)
+	}
+
+	attestation, err = c.api.GetAttestation(ctx, messageHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not get attestation: %w", err)
+	}
+
+	err = c.store.StoreAttestation(ctx, messageHash, attestation)
+	if err != nil {
+		// the attestation is still usable, it will just be fetched again.
+		logger.Warnf("could not store attestation for %s: %v", messageHash, err)
+	}
+
+	return attestation, nil
+}
+
+var _ CCTPAPI = &CachedAPI{}
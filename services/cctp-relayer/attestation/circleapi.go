@@ -3,6 +3,7 @@
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -29,6 +30,12 @@ func NewCircleAPI(url string) CircleAPI {
 	}
 }
 
+// attestationStatusComplete is the status of an attestation that is ready to be used.
+const attestationStatusComplete = "complete"
+
+// ErrAttestationPending is returned when a message is known to the attestation source but not attested yet.
+var ErrAttestationPending = errors.New("attestation is pending")
+
 type circleAttestationResponse struct {
 	Attestation string `json:"attestation"`
 	Status      string `json:"status"`
@@ -66,7 +73,10 @@ func (c CircleAPI) GetAttestation(ctx context.Context, txHash string) (attestati
 		return nil, fmt.Errorf("could not unmarshal body: %w", err)
 	}
 
-	// TODO: check status
+	if attestationResp.Status != attestationStatusComplete {
+		return nil, fmt.Errorf("%w: status %s", ErrAttestationPending, attestationResp.Status)
+	}
+
 	attestation, err = hexutil.Decode(attestationResp.Attestation)
 	if err != nil {
 		return nil, fmt.Errorf("could not decode signature: %w", err)
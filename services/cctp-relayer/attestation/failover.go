@@ -0,0 +1,43 @@
+package attestation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// FailoverAPI fetches attestations from several sources, trying each in order until one returns an attestation.
+type FailoverAPI struct {
+	apis []CCTPAPI
+}
+
+// NewFailoverAPI creates a new FailoverAPI. Sources are tried in the order they are passed in.
+func NewFailoverAPI(apis ...CCTPAPI) *FailoverAPI {
+	return &FailoverAPI{
+		apis: apis,
+	}
+}
+
+// GetAttestation gets an attestation from the first source that returns one.
+func (f *FailoverAPI) GetAttestation(ctx context.Context, messageHash string) ([]byte, error) {
+	if len(f.apis) == 0 {
+		return nil, fmt.Errorf("no attestation sources")
+	}
+
+	var errs []error
+	for i, api := range f.apis {
+		attestation, err := api.GetAttestation(ctx, messageHash)
+		if err == nil {
+			return attestation, nil
+		}
+		errs = append(errs, fmt.Errorf("attestation source %d: %w", i, err))
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return nil, fmt.Errorf("could not get attestation from any source: %w", errors.Join(errs...))
+}
+
+var _ CCTPAPI = &FailoverAPI{}
@@ -0,0 +1,178 @@
+package attestation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/contracts/mockmessagetransmitter"
+)
+
+// LocalAttesterChain is a chain watched by a LocalAttester.
+type LocalAttesterChain struct {
+	// ChainID is the chain id of the chain.
+	ChainID uint32
+	// Client is used to read the logs of the message transmitter.
+	Client bind.ContractFilterer
+	// MessageTransmitter is the address of the (mock) message transmitter.
+	MessageTransmitter common.Address
+}
+
+// localAttesterChain is a chain watched by the attester along with the indexing progress.
+type localAttesterChain struct {
+	chainID  uint32
+	filterer *mockmessagetransmitter.MockMessageTransmitterFilterer
+	// fromBlock is the block the next scan starts from.
+	fromBlock uint64
+}
+
+// LocalAttester is a stand-in for Circle's attester. It attests the messages sent by the message transmitters of the
+// watched chains with its own key, so the relayer can be tested end to end without Circle.
+// It can be used directly as a CCTPAPI or served over http in the format of Circle's attestation API.
+type LocalAttester struct {
+	signer signer.Signer
+	chains []*localAttesterChain
+	// mux protects messages and the chain indexing progress.
+	mux sync.Mutex
+	// messages holds the raw messages by their lowercase message hash.
+	messages map[string][]byte
+}
+
+// NewLocalAttester creates a new LocalAttester.
+func NewLocalAttester(attester signer.Signer, chains ...LocalAttesterChain) (*LocalAttester, error) {
+	l := &LocalAttester{
+		signer:   attester,
+		messages: make(map[string][]byte),
+	}
+
+	for _, chain := range chains {
+		filterer, err := mockmessagetransmitter.NewMockMessageTransmitterFilterer(chain.MessageTransmitter, chain.Client)
+		if err != nil {
+			return nil, fmt.Errorf("could not create message transmitter filterer for chain %d: %w", chain.ChainID, err)
+		}
+		l.chains = append(l.chains, &localAttesterChain{
+			chainID:  chain.ChainID,
+			filterer: filterer,
+		})
+	}
+
+	return l, nil
+}
+
+// Address is the address of the attester. It should be enabled as an attester on the message transmitters.
+func (l *LocalAttester) Address() common.Address {
+	return l.signer.Address()
+}
+
+// GetAttestation attests a message sent by one of the watched message transmitters.
+func (l *LocalAttester) GetAttestation(ctx context.Context, messageHash string) ([]byte, error) {
+	messageHash = strings.ToLower(messageHash)
+
+	message, ok := l.getMessage(messageHash)
+	if !ok {
+		err := l.index(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not index messages: %w", err)
+		}
+
+		message, ok = l.getMessage(messageHash)
+		if !ok {
+			return nil, fmt.Errorf("%w: message %s not seen yet", ErrAttestationPending, messageHash)
+		}
+	}
+
+	sig, err := l.signer.SignMessage(ctx, crypto.Keccak256(message), false)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign message: %w", err)
+	}
+
+	attestation := signer.Encode(sig)
+	// circle's attestations use the 27/28 recovery ids expected by ecrecover.
+	if attestation[crypto.RecoveryIDOffset] < 27 {
+		attestation[crypto.RecoveryIDOffset] += 27
+	}
+
+	return attestation, nil
+}
+
+func (l *LocalAttester) getMessage(messageHash string) ([]byte, bool) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	message, ok := l.messages[messageHash]
+	return message, ok
+}
+
+// index reads the messages sent since the last scan of each chain.
+func (l *LocalAttester) index(ctx context.Context) error {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	for _, chain := range l.chains {
+		iterator, err := chain.filterer.FilterMessageSent(&bind.FilterOpts{
+			Start:   chain.fromBlock,
+			Context: ctx,
+		})
+		if err != nil {
+			return fmt.Errorf("could not filter message sent logs on chain %d: %w", chain.chainID, err)
+		}
+
+		for iterator.Next() {
+			l.messages[crypto.Keccak256Hash(iterator.Event.Message).String()] = iterator.Event.Message
+			// the last block is scanned again, since it might not have been complete.
+			if iterator.Event.Raw.BlockNumber > chain.fromBlock {
+				chain.fromBlock = iterator.Event.Raw.BlockNumber
+			}
+		}
+
+		err = iterator.Error()
+		//nolint:errcheck
+		iterator.Close()
+		if err != nil {
+			return fmt.Errorf("could not iterate message sent logs on chain %d: %w", chain.chainID, err)
+		}
+	}
+
+	return nil
+}
+
+// ServeHTTP serves GET /{messageHash} in the format of Circle's attestation API.
+func (l *LocalAttester) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	attestation, err := l.GetAttestation(r.Context(), path.Base(r.URL.Path))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, ErrAttestationPending) {
+			status = http.StatusNotFound
+		}
+		w.WriteHeader(status)
+		//nolint:errcheck
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	//nolint:errcheck
+	json.NewEncoder(w).Encode(circleAttestationResponse{
+		Attestation: hexutil.Encode(attestation),
+		Status:      attestationStatusComplete,
+	})
+}
+
+var _ CCTPAPI = &LocalAttester{}
+var _ http.Handler = &LocalAttester{}
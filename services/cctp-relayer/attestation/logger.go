@@ -0,0 +1,5 @@
+package attestation
+
+import "github.com/ipfs/go-log"
+
+var logger = log.Logger("cctp-attestation")
@@ -1,7 +1,13 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	signerConfig "github.com/synapsecns/sanguine/ethergo/signer/config"
 
 	"github.com/synapsecns/sanguine/core/commandline"
 
@@ -78,7 +84,11 @@
 		}
 
 		omnirpcClient := omniClient.NewOmnirpcClient(cfg.BaseOmnirpcURL, metricsProvider, omniClient.WithCaptureReqRes())
-		attAPI := attestation.NewCircleAPI(c.String(cfg.CircleAPIURl))
+		var attAPIs []attestation.CCTPAPI
+		for _, url := range cfg.GetAttestationAPIURLs() {
+			attAPIs = append(attAPIs, attestation.NewCircleAPI(url))
+		}
+		attAPI := attestation.NewCachedAPI(attestation.NewFailoverAPI(attAPIs...), store)
 
 		cctpRelayer, err := relayer.NewCCTPRelayer(c.Context, cfg, store, omnirpcClient, metricsProvider, attAPI)
 		if err != nil {
@@ -92,3 +102,65 @@
 		return nil
 	},
 }
+
+// attesterCommand runs a local attester in place of Circle's attester.
+var attesterCommand = &cli.Command{
+	Name:        "attester",
+	Description: "run a local attester for the mock message transmitters, serving a circle compatible attestation api",
+	Flags:       []cli.Flag{configFlag, &commandline.LogLevel},
+	Action: func(c *cli.Context) (err error) {
+		commandline.SetLogLevel(c)
+		cfg, err := config.DecodeConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		if err != nil {
+			return fmt.Errorf("could not read config file: %w", err)
+		}
+
+		_, err = cfg.LocalAttester.IsValid(c.Context)
+		if err != nil {
+			return fmt.Errorf("could not validate local attester config: %w", err)
+		}
+
+		attesterSigner, err := signerConfig.SignerFromConfig(c.Context, cfg.LocalAttester.Signer)
+		if err != nil {
+			return fmt.Errorf("could not create attester signer: %w", err)
+		}
+
+		omnirpcClient := omniClient.NewOmnirpcClient(cfg.BaseOmnirpcURL, metrics.Get(), omniClient.WithCaptureReqRes())
+
+		var chains []attestation.LocalAttesterChain
+		for chainID, address := range cfg.LocalAttester.MessageTransmitters {
+			chainClient, err := omnirpcClient.GetConfirmationsClient(c.Context, int(chainID), 1)
+			if err != nil {
+				return fmt.Errorf("could not get client for chain %d: %w", chainID, err)
+			}
+			chains = append(chains, attestation.LocalAttesterChain{
+				ChainID:            chainID,
+				Client:             chainClient,
+				MessageTransmitter: common.HexToAddress(address),
+			})
+		}
+
+		attester, err := attestation.NewLocalAttester(attesterSigner, chains...)
+		if err != nil {
+			return fmt.Errorf("could not create local attester: %w", err)
+		}
+
+		server := &http.Server{
+			Addr:              fmt.Sprintf(":%d", cfg.LocalAttester.Port),
+			Handler:           attester,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			<-c.Context.Done()
+			//nolint:errcheck
+			server.Close()
+		}()
+
+		fmt.Printf("serving attestations signed by %s on port %d\n", attester.Address(), cfg.LocalAttester.Port)
+		err = server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("could not serve attestations: %w", err)
+		}
+		return nil
+	},
+}
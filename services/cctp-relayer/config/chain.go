@@ -16,6 +16,33 @@ type ChainConfig struct {
 	CCTPAddress string `yaml:"cctp_address"`
 	// CCTP start block is the block at which the chain listener will listen for CCTP events.
 	CCTPStartBlock uint64 `yaml:"cctp_start_block"`
+	// GasEstimate is the gas used to relay a message on the chain, used to price relays.
+	GasEstimate uint64 `yaml:"gas_estimate"`
+	// NativeToken is the symbol of the native token of the chain, used to price relays.
+	NativeToken string `yaml:"native_token"`
+	// NativeTokenPriceUSD is the price of the native token, used when it cannot be fetched.
+	NativeTokenPriceUSD float64 `yaml:"native_token_price_usd"`
+}
+
+const (
+	defaultGasEstimate = 300000
+	defaultNativeToken = "ETH"
+)
+
+// GetGasEstimate returns the gas used to relay a message on the chain.
+func (c ChainConfig) GetGasEstimate() uint64 {
+	if c.GasEstimate == 0 {
+		return defaultGasEstimate
+	}
+	return c.GasEstimate
+}
+
+// GetNativeToken returns the symbol of the native token of the chain.
+func (c ChainConfig) GetNativeToken() string {
+	if c.NativeToken == "" {
+		return defaultNativeToken
+	}
+	return c.NativeToken
 }
 
 // GetCCTPAddress returns the CCTP address.
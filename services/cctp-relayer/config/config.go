@@ -5,8 +5,10 @@
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/ImVexed/fasturl"
+	"github.com/ethereum/go-ethereum/common"
 	submitterConfig "github.com/synapsecns/sanguine/ethergo/submitter/config"
 
 	"github.com/davecgh/go-spew/spew"
@@ -22,8 +24,13 @@ type Config struct {
 	Port uint16 `yaml:"port"`
 	// Host is the RelayerAPIServer host
 	Host string `yaml:"host"`
+	// AdminToken is the bearer token of the RelayerAPIServer endpoints that change messages.
+	// These endpoints are disabled if it is empty.
+	AdminToken string `yaml:"admin_token"`
 	// CircleAPIURl is the URL for the Circle API
 	CircleAPIURl string `yaml:"circle_api_url"`
+	// AttestationAPIURLs are attestation APIs in the format of Circle's, tried in order when the Circle API fails.
+	AttestationAPIURLs []string `yaml:"attestation_api_urls"`
 	// CCTPType is the method for executing CCTP transactions.
 	CCTPType string `yaml:"cctp_type"`
 	// Chains stores all chain information
@@ -42,6 +49,135 @@ type Config struct {
 	SubmitterConfig submitterConfig.Config `yaml:"submitter_config"`
 	// ScreenerAPIUrl is the TRM API url.
 	ScreenerAPIUrl string `yaml:"screener_api_url"`
+	// Fees is the config for fee-aware relaying.
+	Fees FeeConfig `yaml:"fees"`
+	// LocalAttester is the config for the local attester, used in place of Circle's attester in tests.
+	LocalAttester LocalAttesterConfig `yaml:"local_attester"`
+}
+
+// FeeConfig is the config for fee-aware relaying.
+type FeeConfig struct {
+	// Enabled defers the relay of synapse cctp messages whose request fee does not cover the cost of relaying them.
+	Enabled bool `yaml:"enabled"`
+	// RelayerShareOnly compares only the relayer's share of the request fee to the relay cost. The relayer only earns a
+	// share of the fee if it has a fee collector set on the SynapseCCTP contract, so without one every message with a
+	// cost is unprofitable.
+	RelayerShareOnly bool `yaml:"relayer_share_only"`
+	// CostMultiplier is applied to the estimated relay cost before it is compared to the request fee.
+	CostMultiplier float64 `yaml:"cost_multiplier"`
+	// TokenDecimals is the number of decimals of the bridged token.
+	TokenDecimals uint8 `yaml:"token_decimals"`
+	// TokenPriceUSD is the price of the bridged token.
+	TokenPriceUSD float64 `yaml:"token_price_usd"`
+	// GasPriceCacheTTLSeconds is how long gas prices are cached for.
+	GasPriceCacheTTLSeconds int `yaml:"gas_price_cache_ttl_seconds"`
+	// TokenPriceCacheTTLSeconds is how long token prices are cached for.
+	TokenPriceCacheTTLSeconds int `yaml:"token_price_cache_ttl_seconds"`
+	// UnprofitableRecheckIntervalSeconds is how long to wait before checking the profitability of an unprofitable
+	// message again.
+	UnprofitableRecheckIntervalSeconds int `yaml:"unprofitable_recheck_interval_seconds"`
+	// UnprofitableMaxAgeSeconds is how long a message can stay unprofitable before it is given up on.
+	UnprofitableMaxAgeSeconds int `yaml:"unprofitable_max_age_seconds"`
+}
+
+const (
+	defaultCostMultiplier                     = 1
+	defaultTokenDecimals                      = 6
+	defaultTokenPriceUSD                      = 1
+	defaultGasPriceCacheTTLSeconds            = 60
+	defaultTokenPriceCacheTTLSeconds          = 3600
+	defaultUnprofitableRecheckIntervalSeconds = 60
+	defaultUnprofitableMaxAgeSeconds          = 7 * 24 * 3600
+)
+
+// GetCostMultiplier returns the multiplier applied to the estimated relay cost.
+func (f FeeConfig) GetCostMultiplier() float64 {
+	if f.CostMultiplier == 0 {
+		return defaultCostMultiplier
+	}
+	return f.CostMultiplier
+}
+
+// GetTokenDecimals returns the number of decimals of the bridged token.
+func (f FeeConfig) GetTokenDecimals() uint8 {
+	if f.TokenDecimals == 0 {
+		return defaultTokenDecimals
+	}
+	return f.TokenDecimals
+}
+
+// GetTokenPriceUSD returns the price of the bridged token.
+func (f FeeConfig) GetTokenPriceUSD() float64 {
+	if f.TokenPriceUSD == 0 {
+		return defaultTokenPriceUSD
+	}
+	return f.TokenPriceUSD
+}
+
+// GetGasPriceCacheTTL returns how long gas prices are cached for.
+func (f FeeConfig) GetGasPriceCacheTTL() time.Duration {
+	if f.GasPriceCacheTTLSeconds == 0 {
+		return defaultGasPriceCacheTTLSeconds * time.Second
+	}
+	return time.Duration(f.GasPriceCacheTTLSeconds) * time.Second
+}
+
+// GetTokenPriceCacheTTL returns how long token prices are cached for.
+func (f FeeConfig) GetTokenPriceCacheTTL() time.Duration {
+	if f.TokenPriceCacheTTLSeconds == 0 {
+		return defaultTokenPriceCacheTTLSeconds * time.Second
+	}
+	return time.Duration(f.TokenPriceCacheTTLSeconds) * time.Second
+}
+
+// GetUnprofitableRecheckInterval returns how long to wait before checking an unprofitable message again.
+func (f FeeConfig) GetUnprofitableRecheckInterval() time.Duration {
+	if f.UnprofitableRecheckIntervalSeconds == 0 {
+		return defaultUnprofitableRecheckIntervalSeconds * time.Second
+	}
+	return time.Duration(f.UnprofitableRecheckIntervalSeconds) * time.Second
+}
+
+// GetUnprofitableMaxAge returns how long a message can stay unprofitable before it will not be completed.
+func (f FeeConfig) GetUnprofitableMaxAge() time.Duration {
+	if f.UnprofitableMaxAgeSeconds == 0 {
+		return defaultUnprofitableMaxAgeSeconds * time.Second
+	}
+	return time.Duration(f.UnprofitableMaxAgeSeconds) * time.Second
+}
+
+// LocalAttesterConfig is the config for the local attester.
+type LocalAttesterConfig struct {
+	// Port is the port the attestation API is served on.
+	Port uint16 `yaml:"port"`
+	// Signer is the key attestations are signed with.
+	Signer ethConfig.SignerConfig `yaml:"signer"`
+	// MessageTransmitters are the addresses of the (mock) message transmitters by chain id.
+	MessageTransmitters map[uint32]string `yaml:"message_transmitters"`
+}
+
+// IsValid makes sure the local attester config is valid.
+func (l LocalAttesterConfig) IsValid(ctx context.Context) (ok bool, err error) {
+	if len(l.MessageTransmitters) == 0 {
+		return false, fmt.Errorf("at least one message transmitter is required")
+	}
+
+	for chainID, address := range l.MessageTransmitters {
+		if !common.IsHexAddress(address) {
+			return false, fmt.Errorf("invalid message transmitter address %s for chain %d", address, chainID)
+		}
+	}
+
+	if ok, err = l.Signer.IsValid(ctx); !ok {
+		return false, fmt.Errorf("attester signer is invalid: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetAttestationAPIURLs gets the urls of the attestation APIs in the order they are tried.
+func (c Config) GetAttestationAPIURLs() []string {
+	return append([]string{c.CircleAPIURl}, c.AttestationAPIURLs...)
 }
 
 // IsValid makes sure the config is valid. This is done by calling IsValid() on each
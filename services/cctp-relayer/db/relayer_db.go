@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	listenerDB "github.com/synapsecns/sanguine/ethergo/listener/db"
@@ -22,12 +23,44 @@ type CCTPRelayerDBReader interface {
 	GetMessageByRequestID(ctx context.Context, requestID string) (*types.Message, error)
 	// GetMessageByHash gets a message by its message hash.
 	GetMessageByHash(ctx context.Context, messageHash common.Hash) (*types.Message, error)
+	// GetAttestation gets the stored attestation of a message by its message hash.
+	GetAttestation(ctx context.Context, messageHash string) ([]byte, error)
+	// GetMessages gets the messages matching a filter, most recent first.
+	GetMessages(ctx context.Context, filter MessageFilter) ([]types.Message, error)
+	// GetMessageHistory gets the states reached by a message in the order they were reached.
+	GetMessageHistory(ctx context.Context, messageHash string) ([]types.MessageEvent, error)
 }
 
 // CCTPRelayerDBWriter is the interface for writing to the database.
 type CCTPRelayerDBWriter interface {
 	// StoreMessage stores a message in the database.
 	StoreMessage(ctx context.Context, message types.Message) error
+	// StoreAttestation stores the attestation of a message.
+	StoreAttestation(ctx context.Context, messageHash string, attestation []byte) error
+	// UpdateMessageState sets the state of a stored message.
+	UpdateMessageState(ctx context.Context, messageHash string, state types.MessageState) error
+	// ResetMessageState sets the state of a stored message and clears its profitability checks.
+	ResetMessageState(ctx context.Context, messageHash string, state types.MessageState) error
+}
+
+// MessageFilter filters the messages returned by GetMessages. Zero values match all messages.
+type MessageFilter struct {
+	// States are the states of the messages.
+	States []types.MessageState
+	// OriginChainID is the origin chain of the messages.
+	OriginChainID uint32
+	// DestChainID is the destination chain of the messages.
+	DestChainID uint32
+	// Sender is the sender of the messages.
+	Sender string
+	// From is the earliest time the messages were first stored at.
+	From time.Time
+	// To is the latest time the messages were first stored at.
+	To time.Time
+	// Limit is the maximum number of messages returned.
+	Limit int
+	// Offset is the number of messages skipped.
+	Offset int
 }
 
 // CCTPRelayerDB is the interface for the database service.
@@ -2,12 +2,14 @@
 
 import (
 	"strings"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/synapsecns/sanguine/ethergo/mocks"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/db"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/types"
+	"gorm.io/gorm"
 )
 
 func (d *DBSuite) mockMessage(originChainID, destinationChainID, blockNumber uint32) types.Message {
@@ -128,3 +130,114 @@ func (d *DBSuite) TestUpsertStoreMessage() {
 		d.Equal(fetchedMessage.State, types.Attested)
 	})
 }
+
+func (d *DBSuite) TestStoreAttestation() {
+	d.RunOnAllDBs(func(testDB db.CCTPRelayerDB) {
+		messageHash := mocks.NewMockHash(d.T()).String()
+
+		_, err := testDB.GetAttestation(d.GetTestContext(), messageHash)
+		d.Require().ErrorIs(err, gorm.ErrRecordNotFound)
+
+		attestation := []byte(gofakeit.Paragraph(1, 1, 10, " "))
+		err = testDB.StoreAttestation(d.GetTestContext(), messageHash, attestation)
+		d.Nil(err)
+
+		// storing the attestation again is a no-op
+		err = testDB.StoreAttestation(d.GetTestContext(), messageHash, attestation)
+		d.Nil(err)
+
+		storedAttestation, err := testDB.GetAttestation(d.GetTestContext(), messageHash)
+		d.Nil(err)
+		d.Equal(attestation, storedAttestation)
+	})
+}
+
+func (d *DBSuite) TestGetMessages() {
+	d.RunOnAllDBs(func(testDB db.CCTPRelayerDB) {
+		originChainID := gofakeit.Uint32()
+		sender := mocks.MockAddress().String()
+
+		pending := d.mockMessage(originChainID, originChainID+1, 1)
+		pending.Sender = sender
+		complete := d.mockMessage(originChainID, originChainID+2, 2)
+		complete.State = types.Complete
+		other := d.mockMessage(originChainID+1, originChainID, 3)
+
+		for _, msg := range []types.Message{pending, complete, other} {
+			err := testDB.StoreMessage(d.GetTestContext(), msg)
+			d.Require().NoError(err)
+		}
+
+		messageHashes := func(filter db.MessageFilter) (hashes []string) {
+			messages, err := testDB.GetMessages(d.GetTestContext(), filter)
+			d.Require().NoError(err)
+			for _, msg := range messages {
+				hashes = append(hashes, msg.MessageHash)
+			}
+			return hashes
+		}
+
+		d.Equal([]string{complete.MessageHash, pending.MessageHash}, messageHashes(db.MessageFilter{OriginChainID: originChainID}))
+		d.Equal([]string{pending.MessageHash}, messageHashes(db.MessageFilter{OriginChainID: originChainID, States: []types.MessageState{types.Pending}}))
+		d.Equal([]string{complete.MessageHash}, messageHashes(db.MessageFilter{DestChainID: originChainID + 2}))
+		d.Equal([]string{pending.MessageHash}, messageHashes(db.MessageFilter{Sender: sender}))
+		d.Equal([]string{complete.MessageHash}, messageHashes(db.MessageFilter{OriginChainID: originChainID, Limit: 1}))
+		d.Equal([]string{pending.MessageHash}, messageHashes(db.MessageFilter{OriginChainID: originChainID, Limit: 1, Offset: 1}))
+		d.Len(messageHashes(db.MessageFilter{OriginChainID: originChainID, From: time.Now().Add(-time.Hour), To: time.Now().Add(time.Hour)}), 2)
+		d.Empty(messageHashes(db.MessageFilter{OriginChainID: originChainID, From: time.Now().Add(time.Hour)}))
+	})
+}
+
+func (d *DBSuite) TestMessageHistory() {
+	d.RunOnAllDBs(func(testDB db.CCTPRelayerDB) {
+		msg := d.mockMessage(gofakeit.Uint32(), gofakeit.Uint32(), gofakeit.Uint32())
+
+		err := testDB.StoreMessage(d.GetTestContext(), msg)
+		d.Require().NoError(err)
+
+		// storing the pending message again does not change its history
+		err = testDB.StoreMessage(d.GetTestContext(), msg)
+		d.Require().NoError(err)
+
+		msg.State = types.Submitted
+		err = testDB.StoreMessage(d.GetTestContext(), msg)
+		d.Require().NoError(err)
+
+		err = testDB.UpdateMessageState(d.GetTestContext(), msg.MessageHash, types.Abandoned)
+		d.Require().NoError(err)
+
+		storedMsg, err := testDB.GetMessageByHash(d.GetTestContext(), common.HexToHash(msg.MessageHash))
+		d.Require().NoError(err)
+		d.Equal(types.Abandoned, storedMsg.State)
+
+		history, err := testDB.GetMessageHistory(d.GetTestContext(), msg.MessageHash)
+		d.Require().NoError(err)
+		d.Require().Len(history, 3)
+		d.Equal(types.Pending, history[0].State)
+		d.Equal(msg.OriginTxHash, history[0].TxHash)
+		d.Equal(types.Submitted, history[1].State)
+		d.Equal(msg.DestTxHash, history[1].TxHash)
+		d.Equal(types.Abandoned, history[2].State)
+
+		err = testDB.UpdateMessageState(d.GetTestContext(), mocks.NewMockHash(d.T()).String(), types.Abandoned)
+		d.ErrorIs(err, gorm.ErrRecordNotFound)
+
+		// resetting the state clears the profitability checks.
+		checkedAt := time.Now()
+		msg.State = types.Unprofitable
+		msg.UnprofitableSince = &checkedAt
+		msg.ProfitabilityCheckedAt = &checkedAt
+		err = testDB.StoreMessage(d.GetTestContext(), msg)
+		d.Require().NoError(err)
+		err = testDB.ResetMessageState(d.GetTestContext(), msg.MessageHash, types.Attested)
+		d.Require().NoError(err)
+		storedMsg, err = testDB.GetMessageByHash(d.GetTestContext(), common.HexToHash(msg.MessageHash))
+		d.Require().NoError(err)
+		d.Equal(types.Attested, storedMsg.State)
+		d.Nil(storedMsg.UnprofitableSince)
+		d.Nil(storedMsg.ProfitabilityCheckedAt)
+
+		err = testDB.ResetMessageState(d.GetTestContext(), mocks.NewMockHash(d.T()).String(), types.Attested)
+		d.ErrorIs(err, gorm.ErrRecordNotFound)
+	})
+}
@@ -0,0 +1,43 @@
+package base
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/synapsecns/sanguine/services/cctp-relayer/types"
+)
+
+// StoreAttestation stores the attestation of a message. Storing an attestation twice is a no-op.
+func (s Store) StoreAttestation(ctx context.Context, messageHash string, attestation []byte) error {
+	dbTx := s.DB().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: MessageHashFieldName}},
+			DoNothing: true,
+		}).
+		Create(&types.Attestation{
+			MessageHash: messageHash,
+			Attestation: attestation,
+		})
+	if dbTx.Error != nil {
+		return fmt.Errorf("failed to store attestation: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// GetAttestation gets the stored attestation of a message.
+func (s Store) GetAttestation(ctx context.Context, messageHash string) ([]byte, error) {
+	var attestation types.Attestation
+
+	dbTx := s.DB().WithContext(ctx).
+		Model(&types.Attestation{}).
+		Where(fmt.Sprintf("%s = ?", MessageHashFieldName), messageHash).
+		First(&attestation)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get attestation: %w", dbTx.Error)
+	}
+
+	return attestation.Attestation, nil
+}
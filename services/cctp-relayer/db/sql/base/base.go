@@ -39,7 +39,7 @@ func (s Store) SubmitterDB() submitterDB.Service {
 func GetAllModels() (allModels []interface{}) {
 	allModels = append(allModels, txdb.GetAllModels()...)
 	allModels = append(allModels, listenerDB.GetAllModels()...)
-	allModels = append(allModels, &types.Message{})
+	allModels = append(allModels, &types.Message{}, &types.Attestation{}, &types.MessageEvent{})
 	return allModels
 }
 
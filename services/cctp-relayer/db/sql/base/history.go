@@ -0,0 +1,154 @@
+package base
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/synapsecns/sanguine/services/cctp-relayer/db"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/types"
+)
+
+// messageEventTxHash gets the transaction that moved a message to its current state.
+func messageEventTxHash(msg types.Message) string {
+	switch msg.State {
+	case types.Pending, types.WillNotComplete:
+		return msg.OriginTxHash
+	case types.Submitted, types.Complete:
+		return msg.DestTxHash
+	default:
+		return ""
+	}
+}
+
+// storeMessageEvent records that a message reached a state.
+func (s Store) storeMessageEvent(ctx context.Context, messageHash string, state types.MessageState, txHash string) error {
+	// fulfilled messages that were not sent through the relayer can't be identified.
+	if messageHash == "" {
+		return nil
+	}
+
+	dbTx := s.DB().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: MessageHashFieldName}, {Name: StateFieldName}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				TxHashFieldName,
+				CreatedAtFieldName,
+			}),
+		}).
+		Create(&types.MessageEvent{
+			MessageHash: messageHash,
+			State:       state,
+			TxHash:      txHash,
+		})
+	if dbTx.Error != nil {
+		return fmt.Errorf("failed to store message event: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// UpdateMessageState sets the state of a stored message.
+func (s Store) UpdateMessageState(ctx context.Context, messageHash string, state types.MessageState) error {
+	dbTx := s.DB().WithContext(ctx).
+		Model(&types.Message{}).
+		Where(fmt.Sprintf("%s = ?", MessageHashFieldName), messageHash).
+		Update(StateFieldName, state)
+	if dbTx.Error != nil {
+		return fmt.Errorf("failed to update message state: %w", dbTx.Error)
+	}
+	if dbTx.RowsAffected == 0 {
+		return fmt.Errorf("failed to update message state: %w", gorm.ErrRecordNotFound)
+	}
+
+	return s.storeMessageEvent(ctx, messageHash, state, "")
+}
+
+// ResetMessageState sets the state of a stored message and clears its profitability checks, so an unprofitable message
+// is priced again as a new one.
+func (s Store) ResetMessageState(ctx context.Context, messageHash string, state types.MessageState) error {
+	dbTx := s.DB().WithContext(ctx).
+		Model(&types.Message{}).
+		Where(fmt.Sprintf("%s = ?", MessageHashFieldName), messageHash).
+		Updates(map[string]interface{}{
+			StateFieldName:                  state,
+			UnprofitableSinceFieldName:      nil,
+			ProfitabilityCheckedAtFieldName: nil,
+		})
+	if dbTx.Error != nil {
+		return fmt.Errorf("failed to reset message state: %w", dbTx.Error)
+	}
+	if dbTx.RowsAffected == 0 {
+		return fmt.Errorf("failed to reset message state: %w", gorm.ErrRecordNotFound)
+	}
+
+	return s.storeMessageEvent(ctx, messageHash, state, "")
+}
+
+// GetMessages gets the messages matching a filter, most recent first.
+func (s Store) GetMessages(ctx context.Context, filter db.MessageFilter) ([]types.Message, error) {
+	var messages []types.Message
+
+	dbTx := s.DB().WithContext(ctx).Model(&types.Message{})
+	if len(filter.States) > 0 {
+		stateArgs := make([]int, len(filter.States))
+		for i := range filter.States {
+			stateArgs[i] = int(filter.States[i])
+		}
+		dbTx = dbTx.Where(fmt.Sprintf("%s IN ?", StateFieldName), stateArgs)
+	}
+	if filter.OriginChainID != 0 {
+		dbTx = dbTx.Where(fmt.Sprintf("%s = ?", OriginChainIDFieldName), filter.OriginChainID)
+	}
+	if filter.DestChainID != 0 {
+		dbTx = dbTx.Where(fmt.Sprintf("%s = ?", DestChainIDFieldName), filter.DestChainID)
+	}
+	if filter.Sender != "" {
+		dbTx = dbTx.Where(fmt.Sprintf("%s = ?", SenderFieldName), filter.Sender)
+	}
+	if !filter.From.IsZero() || !filter.To.IsZero() {
+		// the time of a message is the time it was first stored, which is its earliest event.
+		firstStored := s.DB().WithContext(ctx).
+			Model(&types.MessageEvent{}).
+			Select(MessageHashFieldName).
+			Group(MessageHashFieldName)
+		if !filter.From.IsZero() {
+			firstStored = firstStored.Having(fmt.Sprintf("MIN(%s) >= ?", CreatedAtFieldName), filter.From)
+		}
+		if !filter.To.IsZero() {
+			firstStored = firstStored.Having(fmt.Sprintf("MIN(%s) <= ?", CreatedAtFieldName), filter.To)
+		}
+		dbTx = dbTx.Where(fmt.Sprintf("%s IN (?)", MessageHashFieldName), firstStored)
+	}
+	if filter.Limit > 0 {
+		dbTx = dbTx.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		dbTx = dbTx.Offset(filter.Offset)
+	}
+
+	dbTx = dbTx.Order(fmt.Sprintf("%s DESC", BlockNumberFieldName)).Find(&messages)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", dbTx.Error)
+	}
+
+	return messages, nil
+}
+
+// GetMessageHistory gets the states reached by a message in the order they were reached.
+func (s Store) GetMessageHistory(ctx context.Context, messageHash string) ([]types.MessageEvent, error) {
+	var events []types.MessageEvent
+
+	dbTx := s.DB().WithContext(ctx).
+		Model(&types.MessageEvent{}).
+		Where(fmt.Sprintf("%s = ?", MessageHashFieldName), messageHash).
+		Order(fmt.Sprintf("%s ASC, %s ASC", CreatedAtFieldName, StateFieldName)).
+		Find(&events)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get message history: %w", dbTx.Error)
+	}
+
+	return events, nil
+}
@@ -68,6 +68,19 @@ func (s Store) StoreMessage(ctx context.Context, msg types.Message) error {
 				DestTxHashFieldName,
 				StateFieldName,
 				NonceFieldName,
+				RelayerFeeFieldName,
+				RelayCostFieldName,
+			}),
+		}
+	case types.Unprofitable:
+		clauses = clause.OnConflict{
+			Columns: []clause.Column{{Name: MessageHashFieldName}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				StateFieldName,
+				RelayerFeeFieldName,
+				RelayCostFieldName,
+				UnprofitableSinceFieldName,
+				ProfitabilityCheckedAtFieldName,
 			}),
 		}
 	case types.Complete:
@@ -85,7 +98,13 @@ func (s Store) StoreMessage(ctx context.Context, msg types.Message) error {
 	if dbTx.Error != nil {
 		return fmt.Errorf("failed to store message: %w", dbTx.Error)
 	}
-	return nil
+
+	// a pending message that was already stored is not sent again.
+	if msg.State == types.Pending && dbTx.RowsAffected == 0 {
+		return nil
+	}
+
+	return s.storeMessageEvent(ctx, msg.MessageHash, msg.State, messageEventTxHash(msg))
 }
 
 // GetMessagesByState gets messages by state.
@@ -145,7 +164,7 @@ func (s Store) GetMessageByHash(ctx context.Context, messageHash common.Hash) (*
 
 	dbTx := s.DB().WithContext(ctx).
 		Model(&types.Message{}).
-		Where(fmt.Sprintf("%s = ?", MessageHashFieldName), messageHash).
+		Where(fmt.Sprintf("%s = ?", MessageHashFieldName), messageHash.String()).
 		First(&message)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get message by hash: %w", dbTx.Error)
@@ -22,6 +22,13 @@ func init() {
 	RequestIDFieldName = namer.GetConsistentName("RequestID")
 	BlockNumberFieldName = namer.GetConsistentName("BlockNumber")
 	StateFieldName = namer.GetConsistentName("State")
+	RelayerFeeFieldName = namer.GetConsistentName("RelayerFee")
+	RelayCostFieldName = namer.GetConsistentName("RelayCost")
+	UnprofitableSinceFieldName = namer.GetConsistentName("UnprofitableSince")
+	ProfitabilityCheckedAtFieldName = namer.GetConsistentName("ProfitabilityCheckedAt")
+	SenderFieldName = namer.GetConsistentName("Sender")
+	TxHashFieldName = namer.GetConsistentName("TxHash")
+	CreatedAtFieldName = namer.GetConsistentName("CreatedAt")
 }
 
 var (
@@ -51,4 +58,18 @@ func init() {
 	BlockNumberFieldName string
 	// StateFieldName gets the state field name.
 	StateFieldName string
+	// RelayerFeeFieldName gets the relayer fee field name.
+	RelayerFeeFieldName string
+	// RelayCostFieldName gets the relay cost field name.
+	RelayCostFieldName string
+	// UnprofitableSinceFieldName gets the unprofitable since field name.
+	UnprofitableSinceFieldName string
+	// ProfitabilityCheckedAtFieldName gets the profitability checked at field name.
+	ProfitabilityCheckedAtFieldName string
+	// SenderFieldName gets the sender field name.
+	SenderFieldName string
+	// TxHashFieldName gets the tx hash field name.
+	TxHashFieldName string
+	// CreatedAtFieldName gets the created at field name.
+	CreatedAtFieldName string
 )
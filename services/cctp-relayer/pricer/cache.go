@@ -0,0 +1,50 @@
+package pricer
+
+import (
+	"sync"
+	"time"
+)
+
+// ttlCache is a map whose entries expire after a ttl.
+type ttlCache[K comparable, V any] struct {
+	mux     sync.Mutex
+	ttl     time.Duration
+	entries map[K]ttlEntry[V]
+	now     func() time.Time
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+func newTTLCache[K comparable, V any](ttl time.Duration) *ttlCache[K, V] {
+	return &ttlCache[K, V]{
+		ttl:     ttl,
+		entries: make(map[K]ttlEntry[V]),
+		now:     time.Now,
+	}
+}
+
+// get gets an entry if it is not expired.
+func (c *ttlCache[K, V]) get(key K) (value V, ok bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return value, false
+	}
+	return entry.value, true
+}
+
+// set sets an entry.
+func (c *ttlCache[K, V]) set(key K, value V) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.entries[key] = ttlEntry[V]{
+		value:     value,
+		expiresAt: c.now().Add(c.ttl),
+	}
+}
@@ -0,0 +1,2 @@
+// Package pricer prices the relay of cctp messages in the bridged token.
+package pricer
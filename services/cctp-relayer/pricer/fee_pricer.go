@@ -0,0 +1,146 @@
+package pricer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// FeePricer prices the relay of cctp messages.
+type FeePricer interface {
+	// GetRelayCost returns the cost of relaying a message on a chain, denominated in the bridged token.
+	// value is the native value sent along with the relay transaction.
+	GetRelayCost(ctx context.Context, chainID uint32, value *big.Int) (*big.Int, error)
+	// GetGasPrice returns the gas price for a given chainID in native units.
+	GetGasPrice(ctx context.Context, chainID uint32) (*big.Int, error)
+}
+
+type feePricer struct {
+	// config is the relayer config.
+	config config.Config
+	// gasPriceCache maps chainID -> gas price
+	gasPriceCache *ttlCache[uint32, *big.Int]
+	// tokenPriceCache maps token symbol -> token price
+	tokenPriceCache *ttlCache[string, float64]
+	// clientFetcher is used to fetch clients.
+	clientFetcher submitter.ClientFetcher
+	// handler is the metrics handler.
+	handler metrics.Handler
+	// priceFetcher is used to fetch prices from coingecko.
+	priceFetcher CoingeckoPriceFetcher
+}
+
+// NewFeePricer creates a new fee pricer.
+func NewFeePricer(cfg config.Config, clientFetcher submitter.ClientFetcher, priceFetcher CoingeckoPriceFetcher, handler metrics.Handler) FeePricer {
+	return &feePricer{
+		config:          cfg,
+		gasPriceCache:   newTTLCache[uint32, *big.Int](cfg.Fees.GetGasPriceCacheTTL()),
+		tokenPriceCache: newTTLCache[string, float64](cfg.Fees.GetTokenPriceCacheTTL()),
+		clientFetcher:   clientFetcher,
+		handler:         handler,
+		priceFetcher:    priceFetcher,
+	}
+}
+
+var nativeDecimalsFactor = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+func (f *feePricer) GetRelayCost(parentCtx context.Context, chainID uint32, value *big.Int) (_ *big.Int, err error) {
+	ctx, span := f.handler.Tracer().Start(parentCtx, "getRelayCost", trace.WithAttributes(
+		attribute.Int(metrics.ChainID, int(chainID)),
+	))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	chainConfig, err := f.config.GetChainConfig(chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get chain config: %w", err)
+	}
+
+	gasPrice, err := f.GetGasPrice(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	nativeTokenPrice, err := f.getTokenPrice(ctx, chainConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// cost of the gas plus the native value sent along, in wei.
+	costWei := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(chainConfig.GetGasEstimate()))
+	if value != nil {
+		costWei.Add(costWei, value)
+	}
+
+	// Convert the cost from wei to the bridged token's units.
+	tokenDecimalsFactor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(f.config.Fees.GetTokenDecimals())), nil)
+	costNative := new(big.Float).Quo(new(big.Float).SetInt(costWei), new(big.Float).SetInt(nativeDecimalsFactor))
+	costUSD := new(big.Float).Mul(costNative, big.NewFloat(nativeTokenPrice))
+	costToken := new(big.Float).Quo(costUSD, big.NewFloat(f.config.Fees.GetTokenPriceUSD()))
+	costTokenScaled := new(big.Float).Mul(costToken, new(big.Float).SetInt(tokenDecimalsFactor))
+
+	// Apply the cost multiplier, rounding up to not underestimate the cost.
+	costTokenScaled.Mul(costTokenScaled, big.NewFloat(f.config.Fees.GetCostMultiplier()))
+	cost, accuracy := costTokenScaled.Int(nil)
+	if accuracy == big.Below {
+		cost.Add(cost, big.NewInt(1))
+	}
+
+	span.SetAttributes(
+		attribute.String("gas_price", gasPrice.String()),
+		attribute.Float64("native_token_price", nativeTokenPrice),
+		attribute.String("cost_wei", costWei.String()),
+		attribute.String("cost", cost.String()),
+	)
+	return cost, nil
+}
+
+// GetGasPrice returns the gas price for a given chainID in native units.
+func (f *feePricer) GetGasPrice(ctx context.Context, chainID uint32) (*big.Int, error) {
+	gasPrice, ok := f.gasPriceCache.get(chainID)
+	if ok {
+		return gasPrice, nil
+	}
+
+	client, err := f.clientFetcher.GetClient(ctx, big.NewInt(int64(chainID)))
+	if err != nil {
+		return nil, fmt.Errorf("could not get client for chain %d: %w", chainID, err)
+	}
+	gasPrice, err = client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest gas price on chain %d: %w", chainID, err)
+	}
+	if gasPrice == nil {
+		return nil, fmt.Errorf("gas price is nil on chain %d", chainID)
+	}
+	f.gasPriceCache.set(chainID, gasPrice)
+
+	return gasPrice, nil
+}
+
+// getTokenPrice returns the price of the native token of a chain in USD.
+func (f *feePricer) getTokenPrice(ctx context.Context, chainConfig config.ChainConfig) (float64, error) {
+	token := chainConfig.GetNativeToken()
+	price, ok := f.tokenPriceCache.get(token)
+	if ok {
+		return price, nil
+	}
+
+	price, err := f.priceFetcher.GetPrice(ctx, token)
+	if err != nil {
+		// Fallback to the configured token price.
+		if chainConfig.NativeTokenPriceUSD == 0 {
+			return 0, fmt.Errorf("could not get price for token %s: %w", token, err)
+		}
+		return chainConfig.NativeTokenPriceUSD, nil
+	}
+	f.tokenPriceCache.set(token, price)
+
+	return price, nil
+}
@@ -0,0 +1,103 @@
+package pricer_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/config"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/pricer"
+)
+
+// gasPriceClient is a client that only suggests gas prices.
+type gasPriceClient struct {
+	client.EVM
+	gasPrice *big.Int
+	calls    *int
+}
+
+func (g gasPriceClient) SuggestGasPrice(context.Context) (*big.Int, error) {
+	*g.calls++
+	return g.gasPrice, nil
+}
+
+// clientFetcher fetches the same client for all chains.
+type clientFetcher struct {
+	client client.EVM
+}
+
+func (c clientFetcher) GetClient(context.Context, *big.Int) (client.EVM, error) {
+	return c.client, nil
+}
+
+// priceFetcher returns a fixed price, or an error if there is none.
+type priceFetcher struct {
+	price float64
+}
+
+func (p priceFetcher) GetPrice(context.Context, string) (float64, error) {
+	if p.price == 0 {
+		return 0, errors.New("no price")
+	}
+	return p.price, nil
+}
+
+func testConfig() config.Config {
+	return config.Config{
+		Chains: config.ChainConfigs{
+			{
+				ChainID:             1,
+				GasEstimate:         100000,
+				NativeTokenPriceUSD: 1000,
+			},
+		},
+		Fees: config.FeeConfig{
+			Enabled: true,
+		},
+	}
+}
+
+func TestGetRelayCost(t *testing.T) {
+	calls := 0
+	fetcher := clientFetcher{client: gasPriceClient{gasPrice: big.NewInt(10e9), calls: &calls}}
+	feePricer := pricer.NewFeePricer(testConfig(), fetcher, priceFetcher{price: 2000}, metrics.NewNullHandler())
+
+	// 100000 gas at 10 gwei is 0.001 eth, or 2 usdc at 2000 usd per eth.
+	cost, err := feePricer.GetRelayCost(context.Background(), 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2000000), cost)
+
+	// the value sent along is part of the cost.
+	cost, err = feePricer.GetRelayCost(context.Background(), 1, big.NewInt(1e15))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(4000000), cost)
+
+	// gas prices are cached.
+	assert.Equal(t, 1, calls)
+
+	_, err = feePricer.GetRelayCost(context.Background(), 2, nil)
+	assert.Error(t, err)
+}
+
+func TestGetRelayCostMultiplierAndFallbackPrice(t *testing.T) {
+	cfg := testConfig()
+	cfg.Fees.CostMultiplier = 1.5
+	calls := 0
+	fetcher := clientFetcher{client: gasPriceClient{gasPrice: big.NewInt(10e9), calls: &calls}}
+	feePricer := pricer.NewFeePricer(cfg, fetcher, priceFetcher{}, metrics.NewNullHandler())
+
+	// the configured price of 1000 usd per eth is used: 0.001 eth is 1 usdc, times 1.5.
+	cost, err := feePricer.GetRelayCost(context.Background(), 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1500000), cost)
+
+	cfg.Chains[0].NativeTokenPriceUSD = 0
+	feePricer = pricer.NewFeePricer(cfg, fetcher, priceFetcher{}, metrics.NewNullHandler())
+	_, err = feePricer.GetRelayCost(context.Background(), 1, nil)
+	assert.Error(t, err)
+}
@@ -259,6 +259,7 @@ func (c *circleCCTPHandler) handleDepositForBurn(parentCtx context.Context, log
 		Message:       message,
 		MessageHash:   crypto.Keccak256Hash(message).String(),
 		BlockNumber:   log.BlockNumber,
+		Sender:        event.Depositor.String(),
 	}
 
 	span.SetAttributes(
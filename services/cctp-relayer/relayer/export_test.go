@@ -46,6 +46,11 @@ func (c *CCTPRelayer) SubmitReceiveMessage(parentCtx context.Context, msg *relay
 	return c.cctpHandler.SubmitReceiveMessage(parentCtx, msg)
 }
 
+// ProcessMessage wraps processMessage for testing.
+func (c *CCTPRelayer) ProcessMessage(parentCtx context.Context, msg *relayTypes.Message) error {
+	return c.processMessage(parentCtx, msg)
+}
+
 // SetOmnirpcClient sets the omnirpc client for testing.
 func (c *CCTPRelayer) SetOmnirpcClient(client omniClient.RPCClient) {
 	c.omnirpcClient = client
@@ -106,7 +106,7 @@ func NewCCTPRelayer(ctx context.Context, cfg config.Config, store db2.CCTPRelaye
 		txSubmitter = submitter.NewTransactionSubmitter(handler, signer, omniRPCClient, store.SubmitterDB(), &cfg.SubmitterConfig)
 	}
 	relayerRequestChan := make(chan *api.RelayRequest, 1000)
-	relayerAPI := api.NewRelayerAPIServer(cfg.Port, cfg.Host, store, relayerRequestChan)
+	relayerAPI := api.NewRelayerAPIServer(cfg.Port, cfg.Host, store, relayerRequestChan, cfg.AdminToken)
 
 	cctpType, err := cfg.GetCCTPType()
 	if err != nil {
@@ -201,7 +201,7 @@ func (c *CCTPRelayer) processQueue(parentCtx context.Context) (err error) {
 		metrics.EndSpanWithErr(span, err)
 	}()
 
-	attestations, err := c.db.GetMessagesByState(ctx, relayTypes.Pending, relayTypes.Attested)
+	attestations, err := c.db.GetMessagesByState(ctx, relayTypes.Pending, relayTypes.Attested, relayTypes.Unprofitable)
 	if err != nil {
 		return fmt.Errorf("could not get pending messages: %w", err)
 	}
@@ -268,7 +268,18 @@ func (c *CCTPRelayer) processMessage(parentCtx context.Context, msg *relayTypes.
 		}
 	}
 
-	if msg.State == relayTypes.Attested {
+	if msg.State == relayTypes.Unprofitable {
+		recheck, err := c.recheckUnprofitable(ctx, msg)
+		if err != nil {
+			return fmt.Errorf("could not recheck unprofitable message: %w", err)
+		}
+		if !recheck {
+			return nil
+		}
+	}
+
+	// unprofitable messages are attested, their profitability is checked again on submission.
+	if msg.State == relayTypes.Attested || msg.State == relayTypes.Unprofitable {
 		err := c.cctpHandler.SubmitReceiveMessage(ctx, msg)
 		if err != nil {
 			return fmt.Errorf("could not submit receive circle token: %w", err)
@@ -278,6 +289,27 @@ func (c *CCTPRelayer) processMessage(parentCtx context.Context, msg *relayTypes.
 	return nil
 }
 
+// recheckUnprofitable checks if the profitability of an unprofitable message should be checked again. Messages are
+// rechecked at most once per recheck interval, and messages that stayed unprofitable for longer than the max age are
+// marked as will not complete.
+func (c *CCTPRelayer) recheckUnprofitable(ctx context.Context, msg *relayTypes.Message) (bool, error) {
+	now := time.Now()
+	if msg.UnprofitableSince != nil && now.Sub(*msg.UnprofitableSince) > c.cfg.Fees.GetUnprofitableMaxAge() {
+		err := c.db.UpdateMessageState(ctx, msg.MessageHash, relayTypes.WillNotComplete)
+		if err != nil {
+			return false, fmt.Errorf("could not mark message as will not complete: %w", err)
+		}
+		msg.State = relayTypes.WillNotComplete
+		return false, nil
+	}
+
+	if msg.ProfitabilityCheckedAt != nil && now.Sub(*msg.ProfitabilityCheckedAt) < c.cfg.Fees.GetUnprofitableRecheckInterval() {
+		return false, nil
+	}
+
+	return true, nil
+}
+
 // Run starts the CCTPRelayer.
 func (c *CCTPRelayer) Run(parentCtx context.Context) error {
 	g, ctx := errgroup.WithContext(parentCtx)
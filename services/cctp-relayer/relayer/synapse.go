@@ -6,6 +6,7 @@
 	"fmt"
 	"github.com/synapsecns/sanguine/contrib/screener-api/client"
 	"math/big"
+	"time"
 
 	relayTypes "github.com/synapsecns/sanguine/services/cctp-relayer/types"
 
@@ -14,11 +15,13 @@
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/synapsecns/sanguine/core/metrics"
+	signerConfig "github.com/synapsecns/sanguine/ethergo/signer/config"
 	"github.com/synapsecns/sanguine/ethergo/submitter"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/config"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/contracts/cctp"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/contracts/messagetransmitter"
 	db2 "github.com/synapsecns/sanguine/services/cctp-relayer/db"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/pricer"
 	omniClient "github.com/synapsecns/sanguine/services/omnirpc/client"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -34,6 +37,10 @@ type synapseCCTPHandler struct {
 	handler           metrics.Handler
 	// screener is used to screen addresses.
 	screener client.ScreenerClient
+	// feePricer prices relays. It is nil if fee-aware relaying is disabled.
+	feePricer pricer.FeePricer
+	// relayerAddress is the address relays are submitted from.
+	relayerAddress common.Address
 }
 
 // NewSynapseCCTPHandler creates a new SynapseCCTPHandler.
@@ -52,12 +59,22 @@ func NewSynapseCCTPHandler(ctx context.Context, cfg config.Config, db db2.CCTPRe
 
 	var ss client.ScreenerClient
 	if cfg.ScreenerAPIUrl != "" {
-		ss, err = client.NewClient(handler, cfg.ScreenerAPIUrl)
+		ss, err = client.NewClient(handler, cfg.ScreenerAPIUrl, client.WithCaller("cctp-relayer"))
 		if err != nil {
 			return nil, fmt.Errorf("error creating screener client: %w", err)
 		}
 	}
 
+	var feePricer pricer.FeePricer
+	if cfg.Fees.Enabled {
+		feePricer = pricer.NewFeePricer(cfg, omniRPCClient, pricer.NewCoingeckoPriceFetcher(coingeckoTimeout), handler)
+	}
+
+	signer, err := signerConfig.SignerFromConfig(ctx, cfg.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("could not make cctp signer: %w", err)
+	}
+
 	return &synapseCCTPHandler{
 		cfg:               cfg,
 		db:                db,
@@ -66,11 +83,16 @@ func NewSynapseCCTPHandler(ctx context.Context, cfg config.Config, db db2.CCTPRe
 		boundSynapseCCTPs: boundSynapseCCTPs,
 		txSubmitter:       txSubmitter,
 		handler:           handler,
+		feePricer:         feePricer,
+		relayerAddress:    signer.Address(),
 	}, nil
 }
 
 const screenerRuleset = "cctp"
 
+// coingeckoTimeout is the timeout of token price requests.
+const coingeckoTimeout = 10 * time.Second
+
 func (s *synapseCCTPHandler) HandleLog(ctx context.Context, log *types.Log, chainID uint32) (processQueue bool, err error) {
 	if log == nil {
 		return false, fmt.Errorf("log is nil")
@@ -193,6 +215,7 @@ func (s *synapseCCTPHandler) FetchAndProcessSentEvent(parentCtx context.Context,
 		Message:       messageSentEvent.Message,
 		MessageHash:   crypto.Keccak256Hash(messageSentEvent.Message).String(),
 		RequestID:     common.Bytes2Hex(circleRequestSentEvent.RequestID[:]),
+		Sender:        circleRequestSentEvent.Sender.String(),
 
 		//Attestation: //comes from the api
 		RequestVersion:   circleRequestSentEvent.RequestVersion,
@@ -259,6 +282,28 @@ func (s *synapseCCTPHandler) SubmitReceiveMessage(parentCtx context.Context, msg
 	}
 	// end: functionalization
 
+	if s.feePricer != nil {
+		profitable, err := s.checkProfitability(ctx, contract, msg)
+		if err != nil {
+			return fmt.Errorf("could not check profitability: %w", err)
+		}
+		if !profitable {
+			// the message is checked again once the recheck interval has passed.
+			now := time.Now()
+			if msg.UnprofitableSince == nil {
+				msg.UnprofitableSince = &now
+			}
+			msg.ProfitabilityCheckedAt = &now
+			msg.State = relayTypes.Unprofitable
+			err = s.db.StoreMessage(ctx, *msg)
+			if err != nil {
+				return fmt.Errorf("could not store unprofitable message: %w", err)
+			}
+
+			return nil
+		}
+	}
+
 	var nonce uint64
 	var destTxHash common.Hash
 	nonce, err = s.txSubmitter.SubmitTransaction(ctx, big.NewInt(int64(msg.DestChainID)), func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
@@ -293,6 +338,82 @@ func (s *synapseCCTPHandler) SubmitReceiveMessage(parentCtx context.Context, msg
 	return nil
 }
 
+// feeDenominator is the denominator of the protocol fee share of the SynapseCCTP contract.
+var feeDenominator = big.NewInt(1e10)
+
+// checkProfitability compares the fee of a message to the cost of relaying it, and records both on the message.
+// The full request fee is compared unless only the relayer's share of it is configured to count.
+func (s *synapseCCTPHandler) checkProfitability(parentCtx context.Context, contract *cctp.SynapseCCTP, msg *relayTypes.Message) (_ bool, err error) {
+	ctx, span := s.handler.Tracer().Start(parentCtx, "checkProfitability", trace.WithAttributes(
+		attribute.String(MessageHash, msg.MessageHash),
+		attribute.Int(metrics.Destination, int(msg.DestChainID)),
+	))
+
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	request, err := DecodeBaseRequest(msg.RequestVersion, msg.FormattedRequest)
+	if err != nil {
+		return false, fmt.Errorf("could not decode request: %w", err)
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	localToken, err := contract.GetLocalToken(opts, request.OriginDomain, request.OriginBurnToken)
+	if err != nil {
+		return false, fmt.Errorf("could not get local token: %w", err)
+	}
+	fee, err := contract.CalculateFeeAmount(opts, localToken, request.Amount, msg.RequestVersion == requestVersionSwap)
+	if err != nil {
+		return false, fmt.Errorf("could not calculate fee amount: %w", err)
+	}
+
+	if s.cfg.Fees.RelayerShareOnly {
+		fee, err = s.relayerShare(opts, contract, fee)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	gasAmount, err := contract.ChainGasAmount(opts)
+	if err != nil {
+		return false, fmt.Errorf("could not get chain gas amount: %w", err)
+	}
+	// the gas airdrop is sent along with every relay, so it is part of the cost.
+	cost, err := s.feePricer.GetRelayCost(ctx, msg.DestChainID, gasAmount)
+	if err != nil {
+		return false, fmt.Errorf("could not get relay cost: %w", err)
+	}
+
+	msg.RelayerFee = fee.String()
+	msg.RelayCost = cost.String()
+	span.SetAttributes(
+		attribute.String("relayer_fee", msg.RelayerFee),
+		attribute.String("relay_cost", msg.RelayCost),
+	)
+
+	return fee.Cmp(cost) >= 0, nil
+}
+
+// relayerShare returns the share of a request fee earned by the relayer. The protocol collects the full fee unless the
+// relayer has a fee collector.
+func (s *synapseCCTPHandler) relayerShare(opts *bind.CallOpts, contract *cctp.SynapseCCTP, fee *big.Int) (*big.Int, error) {
+	feeCollector, err := contract.RelayerFeeCollectors(opts, s.relayerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not get relayer fee collector: %w", err)
+	}
+	if feeCollector == (common.Address{}) {
+		return big.NewInt(0), nil
+	}
+
+	protocolFee, err := contract.ProtocolFee(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not get protocol fee: %w", err)
+	}
+	protocolFeeAmount := new(big.Int).Div(new(big.Int).Mul(fee, protocolFee), feeDenominator)
+	return new(big.Int).Sub(fee, protocolFeeAmount), nil
+}
+
 // handleCircleRequestFulfilled handles the CircleRequestFulfilled event.
 //
 //nolint:cyclop
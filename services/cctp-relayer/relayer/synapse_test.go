@@ -3,10 +3,17 @@
 import (
 	"context"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/localsigner"
+	"github.com/synapsecns/sanguine/ethergo/signer/wallet"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/attestation"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/config"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/contracts/cctp"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/relayer"
 	relayTypes "github.com/synapsecns/sanguine/services/cctp-relayer/types"
@@ -228,3 +235,209 @@ func (s *CCTPRelayerSuite) TestBridgeSynapseCCTP() {
 	// 	return c.Equal(expectedBalance, balance)
 	// })
 }
+
+func (s *CCTPRelayerSuite) TestFetchAttestationFromLocalAttester() {
+	// setup
+	originChain := s.testBackends[0]
+	destChain := s.testBackends[1]
+	_, originSynapseCCTP := s.deployManager.GetSynapseCCTP(s.GetTestContext(), originChain)
+	_, originMockUsdc := s.deployManager.GetMockMintBurnTokenType(s.GetTestContext(), originChain)
+	_, originMessageTransmitter := s.deployManager.GetMockMessageTransmitterType(s.GetTestContext(), originChain)
+
+	// create a local attester, served behind an unavailable attestation api
+	attesterWallet, err := wallet.FromRandom()
+	s.Require().NoError(err)
+	attester, err := attestation.NewLocalAttester(localsigner.NewSigner(attesterWallet.PrivateKey()), attestation.LocalAttesterChain{
+		ChainID:            uint32(originChain.GetChainID()),
+		Client:             originChain,
+		MessageTransmitter: originMessageTransmitter.Address(),
+	})
+	s.Require().NoError(err)
+	attesterServer := httptest.NewServer(attester)
+	defer attesterServer.Close()
+
+	unavailableServer := httptest.NewServer(http.NotFoundHandler())
+	defer unavailableServer.Close()
+
+	attAPI := attestation.NewCachedAPI(attestation.NewFailoverAPI(attestation.NewCircleAPI(unavailableServer.URL), attestation.NewCircleAPI(attesterServer.URL)), s.testStore)
+
+	// create a new relayer
+	omniRPCClient := omniClient.NewOmnirpcClient(s.testOmnirpc, s.metricsHandler, omniClient.WithCaptureReqRes())
+	relay, err := relayer.NewCCTPRelayer(s.GetTestContext(), s.GetTestConfig(), s.testStore, omniRPCClient, s.metricsHandler, attAPI)
+	s.Nil(err)
+
+	// mint, approve and send token
+	opts := originChain.GetTxContext(s.GetTestContext(), nil)
+	amount := big.NewInt(1000000000000000000)
+	tx, err := originMockUsdc.MintPublic(opts.TransactOpts, opts.From, amount)
+	s.Nil(err)
+	originChain.WaitForConfirmation(s.GetTestContext(), tx)
+
+	tx, err = originMockUsdc.Approve(opts.TransactOpts, originSynapseCCTP.Address(), amount)
+	s.Nil(err)
+	originChain.WaitForConfirmation(s.GetTestContext(), tx)
+
+	tx, err = originSynapseCCTP.SendCircleToken(opts.TransactOpts, opts.From, big.NewInt(int64(destChain.GetChainID())), originMockUsdc.Address(), amount, 0, []byte{})
+	s.Nil(err)
+	originChain.WaitForConfirmation(s.GetTestContext(), tx)
+
+	msg, err := relay.FetchAndProcessSentEvent(s.GetTestContext(), tx.Hash(), uint32(originChain.GetChainID()))
+	s.Require().NoError(err)
+
+	// the attestation is signed by the local attester over the message hash
+	msg, err = relay.FetchAttestation(s.GetTestContext(), msg)
+	s.Require().NoError(err)
+	s.Equal(relayTypes.Attested, msg.State)
+	s.Require().Len(msg.Attestation, crypto.SignatureLength)
+
+	sig := common.CopyBytes(msg.Attestation)
+	sig[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(msg.Message), sig)
+	s.Require().NoError(err)
+	s.Equal(attester.Address(), crypto.PubkeyToAddress(*pubKey))
+
+	// the attestation is persisted
+	storedAttestation, err := s.testStore.GetAttestation(s.GetTestContext(), msg.MessageHash)
+	s.Require().NoError(err)
+	s.Equal(msg.Attestation, storedAttestation)
+}
+
+// sendPricedMessage sends a synapse cctp message and returns its attested message, along with a config with fee-aware
+// relaying enabled. The burnt token is linked to the destination token, so the fee of the message can be calculated.
+func (s *CCTPRelayerSuite) sendPricedMessage() (config.Config, *relayTypes.Message) {
+	originChain := s.testBackends[0]
+	destChain := s.testBackends[1]
+	_, originSynapseCCTP := s.deployManager.GetSynapseCCTP(s.GetTestContext(), originChain)
+	_, originMockUsdc := s.deployManager.GetMockMintBurnTokenType(s.GetTestContext(), originChain)
+
+	cfg := s.GetTestConfig()
+	cfg.Fees.Enabled = true
+	for i := range cfg.Chains {
+		cfg.Chains[i].NativeTokenPriceUSD = 2000
+	}
+	relay := s.newFeeRelayer(cfg)
+
+	// mint, approve and send token
+	opts := originChain.GetTxContext(s.GetTestContext(), nil)
+	amount := big.NewInt(1000000000000000000)
+	tx, err := originMockUsdc.MintPublic(opts.TransactOpts, opts.From, amount)
+	s.Require().NoError(err)
+	originChain.WaitForConfirmation(s.GetTestContext(), tx)
+
+	tx, err = originMockUsdc.Approve(opts.TransactOpts, originSynapseCCTP.Address(), amount)
+	s.Require().NoError(err)
+	originChain.WaitForConfirmation(s.GetTestContext(), tx)
+
+	tx, err = originSynapseCCTP.SendCircleToken(opts.TransactOpts, opts.From, big.NewInt(int64(destChain.GetChainID())), originMockUsdc.Address(), amount, 0, []byte{})
+	s.Require().NoError(err)
+	originChain.WaitForConfirmation(s.GetTestContext(), tx)
+
+	msg, err := relay.FetchAndProcessSentEvent(s.GetTestContext(), tx.Hash(), uint32(originChain.GetChainID()))
+	s.Require().NoError(err)
+	msg, err = relay.FetchAttestation(s.GetTestContext(), msg)
+	s.Require().NoError(err)
+
+	request, err := relayer.DecodeBaseRequest(msg.RequestVersion, msg.FormattedRequest)
+	s.Require().NoError(err)
+	_, destMockUsdc := s.deployManager.GetMockMintBurnTokenType(s.GetTestContext(), destChain)
+	_, destTokenMinter := s.deployManager.GetMockTokenMinter(s.GetTestContext(), destChain)
+	destOpts := destChain.GetTxContext(s.GetTestContext(), nil)
+	tx, err = destTokenMinter.SetLocalToken(destOpts.TransactOpts, request.OriginDomain, common.BytesToHash(request.OriginBurnToken.Bytes()), destMockUsdc.Address())
+	s.Require().NoError(err)
+	destChain.WaitForConfirmation(s.GetTestContext(), tx)
+
+	return cfg, msg
+}
+
+// newFeeRelayer creates a relayer with the given config.
+func (s *CCTPRelayerSuite) newFeeRelayer(cfg config.Config) *relayer.CCTPRelayer {
+	omniRPCClient := omniClient.NewOmnirpcClient(s.testOmnirpc, s.metricsHandler, omniClient.WithCaptureReqRes())
+	relay, err := relayer.NewCCTPRelayer(s.GetTestContext(), cfg, s.testStore, omniRPCClient, s.metricsHandler, attestation.NewMockCircleAPI())
+	s.Require().NoError(err)
+	return relay
+}
+
+func (s *CCTPRelayerSuite) TestSubmitUnprofitableReceiveCircleToken() {
+	// the token has no fee, so no message covers its relay cost.
+	cfg, msg := s.sendPricedMessage()
+	relay := s.newFeeRelayer(cfg)
+
+	// the message is deferred instead of submitted
+	err := relay.SubmitReceiveMessage(s.GetTestContext(), msg)
+	s.Require().NoError(err)
+
+	storedMsg, err := s.testStore.GetMessageByHash(s.GetTestContext(), common.HexToHash(msg.MessageHash))
+	s.Require().NoError(err)
+	s.Equal(relayTypes.Unprofitable, storedMsg.State)
+	s.Equal("0", storedMsg.RelayerFee)
+	relayCost, ok := new(big.Int).SetString(storedMsg.RelayCost, 10)
+	s.Require().True(ok)
+	s.Positive(relayCost.Sign())
+	s.Empty(storedMsg.DestTxHash)
+	s.Require().NotNil(storedMsg.UnprofitableSince)
+	s.Require().NotNil(storedMsg.ProfitabilityCheckedAt)
+	unprofitableSince := *storedMsg.UnprofitableSince
+	checkedAt := *storedMsg.ProfitabilityCheckedAt
+
+	// the message is not checked again before the recheck interval.
+	err = relay.ProcessMessage(s.GetTestContext(), storedMsg)
+	s.Require().NoError(err)
+	storedMsg, err = s.testStore.GetMessageByHash(s.GetTestContext(), common.HexToHash(msg.MessageHash))
+	s.Require().NoError(err)
+	s.Equal(relayTypes.Unprofitable, storedMsg.State)
+	s.WithinDuration(checkedAt, *storedMsg.ProfitabilityCheckedAt, time.Millisecond)
+
+	// once the recheck interval has passed, the message is checked again.
+	lastCheck := time.Now().Add(-2 * cfg.Fees.GetUnprofitableRecheckInterval())
+	storedMsg.ProfitabilityCheckedAt = &lastCheck
+	s.Require().NoError(s.testStore.StoreMessage(s.GetTestContext(), *storedMsg))
+	err = relay.ProcessMessage(s.GetTestContext(), storedMsg)
+	s.Require().NoError(err)
+	storedMsg, err = s.testStore.GetMessageByHash(s.GetTestContext(), common.HexToHash(msg.MessageHash))
+	s.Require().NoError(err)
+	s.Equal(relayTypes.Unprofitable, storedMsg.State)
+	s.True(storedMsg.ProfitabilityCheckedAt.After(lastCheck.Add(time.Second)))
+	s.WithinDuration(unprofitableSince, *storedMsg.UnprofitableSince, time.Millisecond)
+
+	// a message that stays unprofitable past the max age will not complete.
+	firstCheck := time.Now().Add(-2 * cfg.Fees.GetUnprofitableMaxAge())
+	storedMsg.UnprofitableSince = &firstCheck
+	s.Require().NoError(s.testStore.StoreMessage(s.GetTestContext(), *storedMsg))
+	err = relay.ProcessMessage(s.GetTestContext(), storedMsg)
+	s.Require().NoError(err)
+	storedMsg, err = s.testStore.GetMessageByHash(s.GetTestContext(), common.HexToHash(msg.MessageHash))
+	s.Require().NoError(err)
+	s.Equal(relayTypes.WillNotComplete, storedMsg.State)
+}
+
+func (s *CCTPRelayerSuite) TestSubmitProfitableReceiveCircleToken() {
+	cfg, msg := s.sendPricedMessage()
+
+	// charge a fee that covers the relay cost on the destination chain.
+	destChain := s.testBackends[1]
+	destCCTP, destCCTPHandle := s.deployManager.GetSynapseCCTP(s.GetTestContext(), destChain)
+	_, destMockUsdc := s.deployManager.GetMockMintBurnTokenType(s.GetTestContext(), destChain)
+	fee := big.NewInt(1e15)
+	ownerOpts := destChain.GetTxContext(s.GetTestContext(), destCCTP.OwnerPtr())
+	tx, err := destCCTPHandle.SetTokenFee(ownerOpts.TransactOpts, destMockUsdc.Address(), big.NewInt(0), fee, fee, fee)
+	s.Require().NoError(err)
+	destChain.WaitForConfirmation(s.GetTestContext(), tx)
+
+	// only counting the relayer's share, the relayer has no fee collector so it earns nothing.
+	shareCfg := cfg
+	shareCfg.Fees.RelayerShareOnly = true
+	err = s.newFeeRelayer(shareCfg).SubmitReceiveMessage(s.GetTestContext(), msg)
+	s.Require().NoError(err)
+	storedMsg, err := s.testStore.GetMessageByHash(s.GetTestContext(), common.HexToHash(msg.MessageHash))
+	s.Require().NoError(err)
+	s.Equal(relayTypes.Unprofitable, storedMsg.State)
+	s.Equal("0", storedMsg.RelayerFee)
+
+	// the request fee covers the relay cost.
+	err = s.newFeeRelayer(cfg).SubmitReceiveMessage(s.GetTestContext(), msg)
+	s.Require().NoError(err)
+	storedMsg, err = s.testStore.GetMessageByHash(s.GetTestContext(), common.HexToHash(msg.MessageHash))
+	s.Require().NoError(err)
+	s.Equal(relayTypes.Submitted, storedMsg.State)
+	s.Equal(fee.String(), storedMsg.RelayerFee)
+}
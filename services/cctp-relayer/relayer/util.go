@@ -5,7 +5,9 @@
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/synapsecns/sanguine/ethergo/client"
@@ -70,3 +72,57 @@ func GetMessageTransmitterAddress(ctx context.Context, tokenMessengerAddr common
 	}
 	return transmitterAddr, nil
 }
+
+const (
+	// requestVersionBase is the version of a request without a swap on the destination chain.
+	requestVersionBase = 0
+	// requestVersionSwap is the version of a request with a swap on the destination chain.
+	requestVersionSwap = 1
+	// baseRequestLength is the length of a formatted base request: originDomain, nonce, originBurnToken, amount, recipient.
+	baseRequestLength = 5 * 32
+)
+
+// BaseRequest is the part of a formatted SynapseCCTP request shared by all request versions.
+type BaseRequest struct {
+	// OriginDomain is the cctp domain of the origin chain.
+	OriginDomain uint32
+	// OriginBurnToken is the token burnt on the origin chain.
+	OriginBurnToken common.Address
+	// Amount is the amount of tokens bridged, before fees.
+	Amount *big.Int
+}
+
+// DecodeBaseRequest decodes the base request of a formatted SynapseCCTP request.
+func DecodeBaseRequest(requestVersion uint32, formattedRequest []byte) (*BaseRequest, error) {
+	baseRequest := formattedRequest
+	switch requestVersion {
+	case requestVersionBase:
+	case requestVersionSwap:
+		// swap requests are formatted as abi.encode(baseRequest, swapParams).
+		bytesType, err := abi.NewType("bytes", "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not create bytes type: %w", err)
+		}
+		decoded, err := abi.Arguments{{Type: bytesType}, {Type: bytesType}}.Unpack(formattedRequest)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode swap request: %w", err)
+		}
+		var ok bool
+		baseRequest, ok = decoded[0].([]byte)
+		if !ok {
+			return nil, fmt.Errorf("could not decode swap request: unexpected base request type %T", decoded[0])
+		}
+	default:
+		return nil, fmt.Errorf("unknown request version: %d", requestVersion)
+	}
+
+	if len(baseRequest) != baseRequestLength {
+		return nil, fmt.Errorf("invalid base request length: %d", len(baseRequest))
+	}
+
+	return &BaseRequest{
+		OriginDomain:    binary.BigEndian.Uint32(baseRequest[28:32]),
+		OriginBurnToken: common.BytesToAddress(baseRequest[64:96]),
+		Amount:          new(big.Int).SetBytes(baseRequest[96:128]),
+	}, nil
+}
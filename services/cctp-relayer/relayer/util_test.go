@@ -1,9 +1,12 @@
 package relayer_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/alecthomas/assert"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/relayer"
 )
@@ -17,3 +20,37 @@ func TestParseDestDomain(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, uint32(6), domain)
 }
+
+func TestDecodeBaseRequest(t *testing.T) {
+	uint32Type, _ := abi.NewType("uint32", "", nil)
+	uint64Type, _ := abi.NewType("uint64", "", nil)
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	uint8Type, _ := abi.NewType("uint8", "", nil)
+	addressType, _ := abi.NewType("address", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+
+	token := common.HexToAddress("0x1c7d4b196cb0c7b01d743fbc6116a902379c7238")
+	amount := big.NewInt(10000000)
+	baseRequest, err := abi.Arguments{{Type: uint32Type}, {Type: uint64Type}, {Type: addressType}, {Type: uint256Type}, {Type: addressType}}.
+		Pack(uint32(6), uint64(1), token, amount, common.HexToAddress("0x2703483b1a5a7c577e8680de9df8be03c6f30e3c"))
+	assert.NoError(t, err)
+	swapParams, err := abi.Arguments{{Type: uint8Type}, {Type: uint8Type}, {Type: uint256Type}, {Type: uint256Type}}.
+		Pack(uint8(0), uint8(1), big.NewInt(1), big.NewInt(2))
+	assert.NoError(t, err)
+	swapRequest, err := abi.Arguments{{Type: bytesType}, {Type: bytesType}}.Pack(baseRequest, swapParams)
+	assert.NoError(t, err)
+
+	for version, formattedRequest := range [][]byte{baseRequest, swapRequest} {
+		request, err := relayer.DecodeBaseRequest(uint32(version), formattedRequest)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(6), request.OriginDomain)
+		assert.Equal(t, token, request.OriginBurnToken)
+		assert.Equal(t, amount, request.Amount)
+	}
+
+	_, err = relayer.DecodeBaseRequest(2, baseRequest)
+	assert.Error(t, err)
+
+	_, err = relayer.DecodeBaseRequest(0, swapRequest)
+	assert.Error(t, err)
+}
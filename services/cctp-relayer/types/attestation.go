@@ -0,0 +1,13 @@
+package types
+
+import "time"
+
+// Attestation is an attestation fetched for a message, stored so it is not fetched again after a restart.
+type Attestation struct {
+	// Keccak256 hash of message bytes
+	MessageHash string `gorm:"column:message_hash;primaryKey"`
+	// Attestation produced by the attestation source
+	Attestation []byte `gorm:"column:attestation"`
+	// CreatedAt is the time the attestation was stored.
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
@@ -0,0 +1,17 @@
+package types
+
+import "time"
+
+// MessageEvent records when a message reached a state, making up the history of the message.
+// Only the last time a message reached each state is kept.
+type MessageEvent struct {
+	// Keccak256 hash of message bytes
+	MessageHash string `gorm:"column:message_hash;primaryKey"`
+	// State is the state the message reached.
+	State MessageState `gorm:"column:state;primaryKey;autoIncrement:false"`
+	// TxHash is the transaction that moved the message to the state: the origin transaction for a sent message, the
+	// destination transaction for a submitted or completed message, and empty otherwise.
+	TxHash string `gorm:"column:tx_hash"`
+	// CreatedAt is the time the message reached the state.
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // Message is the information about a message parsed by the CCTPRelayer.
 type Message struct {
 	// Hash of USDC burn transaction
@@ -28,4 +30,15 @@ type Message struct {
 	BlockNumber uint64 `gorm:"column:block_number"`
 	// State is the state of the message.
 	State MessageState `gorm:"column:state"`
+	// Sender is the address that sent the USDC transfer on the origin chain.
+	Sender string `gorm:"column:sender"`
+	// RelayerFee is the fee of the message compared to its relay cost, in the bridged token's units. It is the full
+	// request fee, or the relayer's share of it with fees.relayer_share_only.
+	RelayerFee string `gorm:"column:relayer_fee"`
+	// RelayCost is the estimated cost of relaying the message, in the bridged token's units.
+	RelayCost string `gorm:"column:relay_cost"`
+	// UnprofitableSince is when the message was first found to be unprofitable.
+	UnprofitableSince *time.Time `gorm:"column:unprofitable_since"`
+	// ProfitabilityCheckedAt is when the profitability of an unprofitable message was last checked.
+	ProfitabilityCheckedAt *time.Time `gorm:"column:profitability_checked_at"`
 }
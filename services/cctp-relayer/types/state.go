@@ -1,5 +1,10 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+)
+
 // MessageState represents the state transitions of a CCTP transfer.
 type MessageState int
 
@@ -14,6 +19,12 @@
 	Complete
 	// WillNotComplete  indicates the USDC transfer will not complete on the destination chain.
 	WillNotComplete
+	// Unprofitable indicates the request fee of the USDC transfer does not cover the cost of relaying it.
+	// The transfer is checked again once per recheck interval, since the cost depends on the gas price, until it reaches
+	// the max age and will not complete.
+	Unprofitable
+	// Abandoned indicates the USDC transfer was abandoned by an operator and will not be relayed.
+	Abandoned
 )
 
 func (m MessageState) String() string {
@@ -28,6 +39,23 @@ func (m MessageState) String() string {
 		return "Complete"
 	case WillNotComplete:
 		return "WillNotComplete"
+	case Unprofitable:
+		return "Unprofitable"
+	case Abandoned:
+		return "Abandoned"
 	}
 	return ""
 }
+
+// AllMessageStates are all the message states.
+var AllMessageStates = []MessageState{Pending, Attested, Submitted, Complete, WillNotComplete, Unprofitable, Abandoned}
+
+// MessageStateFromString parses a message state from its string representation.
+func MessageStateFromString(state string) (MessageState, error) {
+	for _, candidate := range AllMessageStates {
+		if strings.EqualFold(candidate.String(), state) {
+			return candidate, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown message state: %s", state)
+}
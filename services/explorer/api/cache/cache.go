@@ -1,48 +1,268 @@
 package cache
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/ipfs/go-log"
+	serverConfig "github.com/synapsecns/sanguine/services/explorer/config/server"
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
 )
 
-// Service provides price data about tokens using either a cache or defillama
-// cache keys sare always ${coin gecko id}_${timestamp}.
+var logger = log.Logger("explorer-api-cache")
+
+// Service caches api responses. Responses are kept in memory and, if the cache is shared, in the database so every
+// api replica can serve the responses computed by the others.
 type Service interface {
-	// CacheResponse saves a response to cache .
-	CacheResponse(string, any) error
-	// GetCache attempts to get api response data from the cache.
-	GetCache(string) any
+	// CacheResponse saves a response to cache.
+	CacheResponse(ctx context.Context, key Key, data any) error
+	// GetCache attempts to get a response from the cache, decoding it into res. It returns false if the response is not
+	// cached, expired or was invalidated.
+	GetCache(ctx context.Context, key Key, res any) (bool, error)
+	// ShouldHydrate checks if this replica should hydrate the cache and, if so, claims the hydration for the interval.
+	// Replicas of a shared cache hydrate it in turns, claims are best-effort.
+	ShouldHydrate(ctx context.Context, interval time.Duration) (bool, error)
+	// Start invalidates the responses computed from chains that stored new blocks, until the context is canceled.
+	Start(ctx context.Context) error
 }
 
-const cacheSize = 100
+// hydrationKey is the key of the hydration claim of a shared cache.
+var hydrationKey = Key{Query: "hydration"}
+
+// entry is a cached response.
+type entry struct {
+	// chainID is the chain the response is computed from.
+	chainID uint32
+	// value is the json encoded response.
+	value []byte
+	// createdAt is the time the response was computed.
+	createdAt time.Time
+	// expiresAt is the time the response expires at.
+	expiresAt time.Time
+}
 
 type apiCacheServiceImpl struct {
+	// cfg is the cache config.
+	cfg serverConfig.CacheConfig
 	// responseCache is the cache of the api responses
-	responseCache *lru.TwoQueueCache[string, any]
+	responseCache *lru.TwoQueueCache[string, entry]
+	// db is the consumer db, used to detect new blocks and to share responses. It may be nil.
+	db db.ConsumerDB
+	// mux protects the fields below.
+	mux sync.RWMutex
+	// lastBlocks are the last stored blocks of each chain.
+	lastBlocks map[uint32]uint64
+	// invalidatedAt is the last time new blocks were stored for each chain.
+	invalidatedAt map[uint32]time.Time
+	// lastInvalidatedAt is the last time new blocks were stored for any chain.
+	lastInvalidatedAt time.Time
+	// now is the clock of the cache.
+	now func() time.Time
 }
 
-// NewAPICacheService creates a new api response data service.
-func NewAPICacheService() (Service, error) {
-	cache, err := lru.New2Q[string, any](cacheSize)
+// NewAPICacheService creates a new api response cache. The consumer db is required by shared caches and by queries
+// invalidated on new blocks, it may be nil otherwise.
+func NewAPICacheService(cfg serverConfig.CacheConfig, consumerDB db.ConsumerDB) (Service, error) {
+	if cfg.Shared && consumerDB == nil {
+		return nil, fmt.Errorf("a shared cache requires a database")
+	}
+
+	cache, err := lru.New2Q[string, entry](cfg.GetSize())
 	if err != nil {
 		return nil, fmt.Errorf("could not create api response data service: %w", err)
 	}
 	return &apiCacheServiceImpl{
+		cfg:           cfg,
 		responseCache: cache,
+		db:            consumerDB,
+		invalidatedAt: make(map[uint32]time.Time),
+		now:           time.Now,
 	}, nil
 }
 
-func (t *apiCacheServiceImpl) CacheResponse(callID string, data any) error {
-	t.responseCache.Add(callID, data)
-	fmt.Println("added cache data", callID)
+func (t *apiCacheServiceImpl) CacheResponse(ctx context.Context, key Key, data any) error {
+	value, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("could not encode response: %w", err)
+	}
+
+	now := t.now()
+	return t.store(ctx, key, entry{
+		chainID:   key.ChainID,
+		value:     value,
+		createdAt: now,
+		expiresAt: now.Add(t.cfg.GetTTL(key.Query)),
+	})
+}
+
+func (t *apiCacheServiceImpl) GetCache(ctx context.Context, key Key, res any) (bool, error) {
+	cached, err := t.get(ctx, key)
+	if err != nil || cached == nil {
+		return false, err
+	}
+
+	err = json.Unmarshal(cached.value, res)
+	if err != nil {
+		return false, fmt.Errorf("could not decode cached response: %w", err)
+	}
+
+	return true, nil
+}
+
+func (t *apiCacheServiceImpl) ShouldHydrate(ctx context.Context, interval time.Duration) (bool, error) {
+	if !t.cfg.Shared {
+		return true, nil
+	}
+
+	claim, err := t.get(ctx, hydrationKey)
+	if err != nil {
+		return false, err
+	}
+	if claim != nil {
+		return false, nil
+	}
+
+	now := t.now()
+	err = t.store(ctx, hydrationKey, entry{createdAt: now, expiresAt: now.Add(interval)})
+	if err != nil {
+		return false, fmt.Errorf("could not claim hydration: %w", err)
+	}
+
+	return true, nil
+}
+
+func (t *apiCacheServiceImpl) Start(ctx context.Context) error {
+	if t.db == nil || !t.invalidates() {
+		return nil
+	}
+
+	ticker := time.NewTicker(t.cfg.GetInvalidationInterval())
+	defer ticker.Stop()
+
+	for {
+		err := t.invalidate(ctx)
+		if err != nil {
+			logger.Warnf("could not check for new blocks: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// invalidates checks if any query is invalidated on new blocks.
+func (t *apiCacheServiceImpl) invalidates() bool {
+	for query := range t.cfg.Queries {
+		if t.cfg.InvalidateOnNewBlocks(query) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// invalidate records the chains that stored new blocks since the last check.
+func (t *apiCacheServiceImpl) invalidate(ctx context.Context) error {
+	lastBlocks, err := t.db.GetLastStoredBlocks(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get last stored blocks: %w", err)
+	}
+
+	now := t.now()
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	for chainID, lastBlock := range lastBlocks {
+		previous, ok := t.lastBlocks[chainID]
+		if ok && lastBlock > previous {
+			t.invalidatedAt[chainID] = now
+			t.lastInvalidatedAt = now
+		}
+	}
+	t.lastBlocks = lastBlocks
+
 	return nil
 }
 
-func (t *apiCacheServiceImpl) GetCache(callID string) any {
-	if data, ok := t.responseCache.Get(callID); ok {
-		fmt.Println("getting cache data")
-		return &data
+// get gets a valid entry from memory or, if the cache is shared, from the database. It returns nil if the key has no
+// valid entry.
+func (t *apiCacheServiceImpl) get(ctx context.Context, key Key) (*entry, error) {
+	if cached, ok := t.responseCache.Get(key.String()); ok && t.isValid(key, cached) {
+		return &cached, nil
+	}
+	if !t.cfg.Shared {
+		return nil, nil
+	}
+
+	response, err := t.db.GetCachedResponse(ctx, key.String())
+	if err != nil {
+		return nil, fmt.Errorf("could not get shared response: %w", err)
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	cached := entry{
+		chainID:   response.ChainID,
+		value:     []byte(response.Value),
+		createdAt: time.Unix(0, int64(response.CreatedAt)),
+		expiresAt: time.Unix(int64(response.ExpiresAt), 0),
 	}
+	if !t.isValid(key, cached) {
+		return nil, nil
+	}
+	t.responseCache.Add(key.String(), cached)
+
+	return &cached, nil
+}
+
+// store stores an entry in memory and, if the cache is shared, in the database.
+func (t *apiCacheServiceImpl) store(ctx context.Context, key Key, cached entry) error {
+	t.responseCache.Add(key.String(), cached)
+	if !t.cfg.Shared {
+		return nil
+	}
+
+	err := t.db.StoreCachedResponse(ctx, sql.CachedResponse{
+		CacheKey:  key.String(),
+		ChainID:   cached.chainID,
+		Value:     string(cached.value),
+		CreatedAt: uint64(cached.createdAt.UnixNano()),
+		ExpiresAt: uint64(cached.expiresAt.Unix()),
+	})
+	if err != nil {
+		return fmt.Errorf("could not store shared response: %w", err)
+	}
+
 	return nil
 }
+
+// isValid checks if an entry has not expired and, if its query is invalidated on new blocks, was computed after the
+// last new blocks of its chain.
+func (t *apiCacheServiceImpl) isValid(key Key, cached entry) bool {
+	if !t.now().Before(cached.expiresAt) {
+		return false
+	}
+	if !t.cfg.InvalidateOnNewBlocks(key.Query) {
+		return true
+	}
+
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+
+	invalidatedAt := t.lastInvalidatedAt
+	if cached.chainID != 0 {
+		invalidatedAt = t.invalidatedAt[cached.chainID]
+	}
+
+	return !cached.createdAt.Before(invalidatedAt)
+}
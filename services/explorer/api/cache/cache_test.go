@@ -0,0 +1,152 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/services/explorer/api/cache"
+	serverConfig "github.com/synapsecns/sanguine/services/explorer/config/server"
+	"github.com/synapsecns/sanguine/services/explorer/db/mocks"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+)
+
+var now = time.Unix(1_700_000_000, 0)
+
+func amountKey(chainID *int) cache.Key {
+	return cache.AmountStatisticKey(model.StatisticTypeTotalVolumeUsd, core.PtrTo(model.PlatformAll), core.PtrTo(model.DurationAllTime), chainID, nil, nil)
+}
+
+func TestCacheKeys(t *testing.T) {
+	assert.Equal(t, "amountStatistic, TOTAL_VOLUME_USD, ALL, ALL_TIME, , , ", amountKey(nil).String())
+	assert.Equal(t, uint32(10), amountKey(core.PtrTo(10)).ChainID)
+	assert.Equal(t, "dailyStatisticsByChain, 10, FEE, PAST_MONTH, BRIDGE",
+		cache.DailyStatisticsByChainKey(core.PtrTo(10), core.PtrTo(model.DailyStatisticTypeFee), core.PtrTo(model.DurationPastMonth), core.PtrTo(model.PlatformBridge)).String())
+}
+
+func TestCacheTTL(t *testing.T) {
+	service, err := cache.NewAPICacheService(serverConfig.CacheConfig{
+		DefaultTTL: 60,
+		Queries:    map[string]serverConfig.QueryCacheConfig{"amountStatistic": {TTL: 10}},
+	}, nil)
+	require.NoError(t, err)
+	clock := now
+	cache.SetClock(service, func() time.Time { return clock })
+	ctx := context.Background()
+
+	value := "1.5"
+	require.NoError(t, service.CacheResponse(ctx, amountKey(nil), &model.ValueResult{Value: &value}))
+
+	var res *model.ValueResult
+	ok, err := service.GetCache(ctx, amountKey(nil), &res)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, value, *res.Value)
+
+	// the query ttl overrides the default one.
+	clock = now.Add(10 * time.Second)
+	ok, err = service.GetCache(ctx, amountKey(nil), &res)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestCacheEviction(t *testing.T) {
+	service, err := cache.NewAPICacheService(serverConfig.CacheConfig{Size: 2}, nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for chainID := 1; chainID <= 3; chainID++ {
+		require.NoError(t, service.CacheResponse(ctx, amountKey(core.PtrTo(chainID)), chainID))
+	}
+
+	var res int
+	ok, err := service.GetCache(ctx, amountKey(core.PtrTo(1)), &res)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = service.GetCache(ctx, amountKey(core.PtrTo(3)), &res)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 3, res)
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("GetLastStoredBlocks", mock.Anything).Return(map[uint32]uint64{1: 100, 10: 100}, nil).Once()
+	consumerDB.On("GetLastStoredBlocks", mock.Anything).Return(map[uint32]uint64{1: 100, 10: 101}, nil)
+
+	service, err := cache.NewAPICacheService(serverConfig.CacheConfig{
+		Queries: map[string]serverConfig.QueryCacheConfig{"amountStatistic": {InvalidateOnNewBlocks: true}},
+	}, consumerDB)
+	require.NoError(t, err)
+	clock := now
+	cache.SetClock(service, func() time.Time { return clock })
+	ctx := context.Background()
+
+	require.NoError(t, cache.Invalidate(ctx, service))
+	for _, key := range []cache.Key{amountKey(nil), amountKey(core.PtrTo(1)), amountKey(core.PtrTo(10))} {
+		require.NoError(t, service.CacheResponse(ctx, key, 1))
+	}
+
+	clock = now.Add(time.Second)
+	require.NoError(t, cache.Invalidate(ctx, service))
+
+	var res int
+	// chain 10 stored new blocks, which invalidates its responses and the responses computed from every chain.
+	for _, tc := range []struct {
+		key   cache.Key
+		valid bool
+	}{{amountKey(nil), false}, {amountKey(core.PtrTo(1)), true}, {amountKey(core.PtrTo(10)), false}} {
+		ok, err := service.GetCache(ctx, tc.key, &res)
+		require.NoError(t, err)
+		assert.Equal(t, tc.valid, ok, tc.key.String())
+	}
+}
+
+func TestSharedCache(t *testing.T) {
+	_, err := cache.NewAPICacheService(serverConfig.CacheConfig{Shared: true}, nil)
+	require.Error(t, err)
+
+	consumerDB := new(mocks.ConsumerDB)
+	service, err := cache.NewAPICacheService(serverConfig.CacheConfig{Shared: true}, consumerDB)
+	require.NoError(t, err)
+	cache.SetClock(service, func() time.Time { return now })
+	ctx := context.Background()
+
+	// a response computed by another replica is read from the database.
+	consumerDB.On("GetCachedResponse", mock.Anything, amountKey(nil).String()).Return(&sql.CachedResponse{
+		CacheKey:  amountKey(nil).String(),
+		Value:     `{"value":"2.5"}`,
+		CreatedAt: uint64(now.Add(-time.Minute).UnixNano()),
+		ExpiresAt: uint64(now.Add(time.Minute).Unix()),
+	}, nil).Once()
+	var res *model.ValueResult
+	ok, err := service.GetCache(ctx, amountKey(nil), &res)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "2.5", *res.Value)
+
+	// it is kept in memory afterwards.
+	ok, err = service.GetCache(ctx, amountKey(nil), &res)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	consumerDB.AssertNumberOfCalls(t, "GetCachedResponse", 1)
+
+	consumerDB.On("StoreCachedResponse", mock.Anything, mock.MatchedBy(func(response sql.CachedResponse) bool {
+		return response.CacheKey == "hydration" && response.ExpiresAt == uint64(now.Add(time.Hour).Unix())
+	})).Return(nil).Once()
+	consumerDB.On("GetCachedResponse", mock.Anything, "hydration").Return(nil, nil).Once()
+	shouldHydrate, err := service.ShouldHydrate(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, shouldHydrate)
+
+	// the claim is kept until the interval passes.
+	shouldHydrate, err = service.ShouldHydrate(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, shouldHydrate)
+	consumerDB.AssertExpectations(t)
+}
@@ -1,2 +1,5 @@
-// Package cache caches data for the frontend
+// Package cache caches api responses for the frontend. Responses expire after a per-query ttl, the least recently used
+// ones are evicted when the cache is full and, if configured, responses are dropped when new blocks are stored for their
+// chain. A shared cache stores the responses in the database as well, so api replicas reuse each other's responses and
+// hydrate the cache in turns.
 package cache
@@ -0,0 +1,16 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// SetClock sets the clock of a cache.
+func SetClock(service Service, now func() time.Time) {
+	service.(*apiCacheServiceImpl).now = now
+}
+
+// Invalidate checks a cache for new blocks once.
+func Invalidate(ctx context.Context, service Service) error {
+	return service.(*apiCacheServiceImpl).invalidate(ctx)
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+)
+
+// Key identifies a cached api response.
+type Key struct {
+	// Query is the name of the cached query. It selects the cache config of the response.
+	Query string
+	// ChainID is the chain the response is computed from, or 0 if it is computed from every chain.
+	ChainID uint32
+	// Args are the arguments the response is computed from.
+	Args []string
+}
+
+// String formats the key as "query, arg, ...".
+func (k Key) String() string {
+	return strings.Join(append([]string{k.Query}, k.Args...), ", ")
+}
+
+// AmountStatisticKey gets the key of an amountStatistic response.
+func AmountStatisticKey(typeArg model.StatisticType, platform *model.Platform, duration *model.Duration, chainID *int, address, tokenAddress *string) Key {
+	return Key{
+		Query:   "amountStatistic",
+		ChainID: keyChainID(chainID),
+		Args:    []string{typeArg.String(), keyArg(platform), keyArg(duration), keyIntArg(chainID), keyArg(address), keyArg(tokenAddress)},
+	}
+}
+
+// DailyStatisticsByChainKey gets the key of a dailyStatisticsByChain response.
+func DailyStatisticsByChainKey(chainID *int, typeArg *model.DailyStatisticType, duration *model.Duration, platform *model.Platform) Key {
+	return Key{
+		Query:   "dailyStatisticsByChain",
+		ChainID: keyChainID(chainID),
+		Args:    []string{keyIntArg(chainID), keyArg(typeArg), keyArg(duration), keyArg(platform)},
+	}
+}
+
+func keyChainID(chainID *int) uint32 {
+	if chainID == nil {
+		return 0
+	}
+
+	return uint32(*chainID)
+}
+
+func keyIntArg(item *int) string {
+	if item == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", *item)
+}
+
+func keyArg[T ~string](item *T) string {
+	if item == nil {
+		return ""
+	}
+
+	return string(*item)
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/synapsecns/sanguine/services/explorer/export"
+)
+
+// ExportEndpoint is the endpoint exporting the bridge history of an address.
+// Query parameters: format (csv or json, defaults to csv), start_time and end_time (unix seconds).
+const ExportEndpoint = "/export/:address"
+
+// exportHandler streams the export of an address as the response. At most maxConcurrent exports are streamed at
+// once, other requests are rejected with a 429 so exports can't exhaust the database.
+func exportHandler(exporter *export.Exporter, maxConcurrent int) gin.HandlerFunc {
+	slots := make(chan struct{}, maxConcurrent)
+
+	return func(c *gin.Context) {
+		address := c.Param("address")
+		if !common.IsHexAddress(address) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid address %q", address)})
+			return
+		}
+
+		format, err := export.ParseFormat(c.DefaultQuery("format", string(export.FormatCSV)))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		startTime, err := strconv.ParseUint(c.DefaultQuery("start_time", "0"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid start_time: %v", err)})
+			return
+		}
+
+		endTime, err := strconv.ParseUint(c.DefaultQuery("end_time", strconv.FormatUint(math.MaxUint32, 10)), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid end_time: %v", err)})
+			return
+		}
+
+		select {
+		case slots <- struct{}{}:
+			defer func() {
+				<-slots
+			}()
+		default:
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "too many exports in progress, retry later"})
+			return
+		}
+
+		c.Header("Content-Type", format.ContentType())
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s.%s", common.HexToAddress(address).Hex(), format)))
+		c.Status(http.StatusOK)
+
+		// the status is sent with the first records, so errors while streaming are reported by the trailing error
+		// record the exporter writes.
+		err = exporter.Export(c.Request.Context(), address, startTime, endTime, format, c.Writer)
+		if err != nil {
+			logger.Errorf("could not export %s: %v", address, err)
+		}
+	}
+}
@@ -15,6 +15,8 @@
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
+	"github.com/synapsecns/sanguine/services/explorer/types/fastbridge"
 )
 
 //nolint:cyclop
@@ -764,3 +766,146 @@ func (g APISuite) TestGetBlockHeight() {
 	Equal(g.T(), int(block1), *results.Response[0].BlockNumber)
 	Equal(g.T(), int(block2), *results.Response[1].BlockNumber)
 }
+
+func (g APISuite) TestFeeStatistics() {
+	chainID := g.chainIDs[0]
+	bridgeContract := common.BigToAddress(big.NewInt(gofakeit.Int64())).String()
+	rfqContract := common.BigToAddress(big.NewInt(gofakeit.Int64())).String()
+	tokenAddr := common.BigToAddress(big.NewInt(gofakeit.Int64())).String()
+	relayers := []string{common.BigToAddress(big.NewInt(gofakeit.Int64())).String(), common.BigToAddress(big.NewInt(gofakeit.Int64())).String()}
+	timestamp := uint64(time.Now().Unix())
+
+	totalFees := 0.0
+	for blockNumber := uint64(1); blockNumber <= 4; blockNumber++ {
+		amountUSD := 100.0
+		feeUSD := float64(blockNumber)
+		totalFees += feeUSD
+		g.db.UNSAFE_DB().WithContext(g.GetTestContext()).Create(&sql.BridgeEvent{
+			InsertTime:         uint64(time.Now().UnixNano()),
+			ChainID:            chainID,
+			ContractAddress:    bridgeContract,
+			EventType:          bridge.MintEvent.Int(),
+			BlockNumber:        blockNumber,
+			TxHash:             common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+			EventIndex:         blockNumber,
+			Token:              tokenAddr,
+			Amount:             big.NewInt(100),
+			DestinationChainID: big.NewInt(0),
+			Fee:                big.NewInt(int64(blockNumber)),
+			AmountUSD:          &amountUSD,
+			FeeUSD:             &feeUSD,
+			TokenSymbol:        gosql.NullString{String: "USDC", Valid: true},
+			TimeStamp:          &timestamp,
+		})
+
+		// the first relayer fills three of the four transfers and keeps a spread of 1%.
+		g.db.UNSAFE_DB().WithContext(g.GetTestContext()).Create(&sql.RFQEvent{
+			InsertTime:         uint64(time.Now().UnixNano()),
+			ChainID:            chainID,
+			TxHash:             common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+			ContractAddress:    rfqContract,
+			BlockNumber:        blockNumber,
+			EventType:          fastbridge.BridgeRelayedEvent.Int(),
+			EventIndex:         blockNumber,
+			TransactionID:      common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+			Relayer:            gosql.NullString{String: relayers[blockNumber/4], Valid: true},
+			OriginChainID:      big.NewInt(int64(g.chainIDs[1])),
+			DestinationChainID: big.NewInt(int64(chainID)),
+			OriginToken:        tokenAddr,
+			DestinationToken:   tokenAddr,
+			OriginAmount:       big.NewInt(101_000_000),
+			DestinationAmount:  big.NewInt(100_000_000),
+			ChainGasAmount:     big.NewInt(0),
+			AmountUSD:          100,
+			TokenSymbol:        "USDC",
+			TimeStamp:          &timestamp,
+		})
+	}
+
+	chainIDInt := int(chainID)
+	duration := model.DurationPastDay
+	platform := model.FeePlatformBridge
+	feeResult, err := g.client.GetFeeStatistics(g.GetTestContext(), &chainIDInt, &platform, &duration)
+	Nil(g.T(), err)
+	found := false
+	for _, statistic := range feeResult.Response {
+		if *statistic.ContractAddress != bridgeContract {
+			continue
+		}
+		found = true
+		Equal(g.T(), 4, *statistic.Count)
+		Equal(g.T(), totalFees, *statistic.FeeUsd)
+		Equal(g.T(), totalFees, *statistic.ProtocolFeeUsd)
+		Equal(g.T(), "USDC", *statistic.TokenSymbol)
+	}
+	True(g.T(), found)
+
+	relayerResult, err := g.client.GetRelayerStatistics(g.GetTestContext(), &chainIDInt, &relayers[0], &duration)
+	Nil(g.T(), err)
+	Equal(g.T(), 1, len(relayerResult.Response))
+	Equal(g.T(), 3, *relayerResult.Response[0].Fills)
+	Equal(g.T(), 0.75, *relayerResult.Response[0].FillShare)
+	InDelta(g.T(), 3.0, *relayerResult.Response[0].RevenueUsd, 0.0001)
+}
+
+func (g APISuite) TestChainFlows() {
+	chainIDFrom := g.chainIDs[0]
+	chainIDTo := g.chainIDs[1]
+	contractAddress := common.BigToAddress(big.NewInt(gofakeit.Int64())).String()
+	sender := common.BigToAddress(big.NewInt(gofakeit.Int64())).String()
+	timestamp := uint64(time.Now().Unix())
+
+	// transfers 1 to 4 complete after 10 to 40 seconds, transfer 5 is pending.
+	for blockNumber := uint64(1); blockNumber <= 5; blockNumber++ {
+		amountUSD := 100.0
+		originTime := timestamp - 100
+		destinationTime := originTime + 10*blockNumber
+		kappa := gosql.NullString{String: common.BigToHash(big.NewInt(gofakeit.Int64())).String(), Valid: blockNumber < 5}
+		g.db.UNSAFE_DB().WithContext(g.GetTestContext()).Create(&MvBridgeEvent{
+			InsertTime:          1,
+			FChainID:            chainIDFrom,
+			FContractAddress:    contractAddress,
+			FEventType:          bridge.DepositEvent.Int(),
+			FBlockNumber:        blockNumber,
+			FTxHash:             common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+			FEventIndex:         blockNumber,
+			FDestinationChainID: big.NewInt(int64(chainIDTo)),
+			FAmountUSD:          &amountUSD,
+			FSender:             sender,
+			FTokenSymbol:        gosql.NullString{String: "USDC", Valid: true},
+			FTimeStamp:          &originTime,
+			TChainID:            chainIDTo,
+			TContractAddress:    contractAddress,
+			TEventType:          bridge.WithdrawEvent.Int(),
+			TBlockNumber:        blockNumber,
+			TTxHash:             common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+			TEventIndex:         blockNumber,
+			TKappa:              kappa,
+			TSender:             sender,
+			TTimeStamp:          &destinationTime,
+		})
+	}
+
+	chainIDFromInt := int(chainIDFrom)
+	chainIDToInt := int(chainIDTo)
+	byToken := true
+	duration := model.DurationPastDay
+	result, err := g.client.GetChainFlows(g.GetTestContext(), &chainIDFromInt, &chainIDToInt, nil, &byToken, &duration)
+	Nil(g.T(), err)
+	Equal(g.T(), 1, len(result.Response))
+
+	flow := result.Response[0]
+	Equal(g.T(), chainIDFromInt, *flow.ChainIDFrom)
+	Equal(g.T(), chainIDToInt, *flow.ChainIDTo)
+	Equal(g.T(), "USDC", *flow.TokenSymbol)
+	Equal(g.T(), 4, *flow.Count)
+	Equal(g.T(), 400.0, *flow.VolumeUsd)
+	InDelta(g.T(), 25.0, *flow.MedianCompletionSeconds, 5)
+	InDelta(g.T(), 40.0, *flow.P95CompletionSeconds, 1)
+
+	byToken = false
+	result, err = g.client.GetChainFlows(g.GetTestContext(), &chainIDFromInt, &chainIDToInt, nil, &byToken, &duration)
+	Nil(g.T(), err)
+	Equal(g.T(), 1, len(result.Response))
+	Nil(g.T(), result.Response[0].TokenSymbol)
+}
@@ -22,7 +22,10 @@
 	"github.com/synapsecns/sanguine/services/explorer/contracts/cctp"
 	"github.com/synapsecns/sanguine/services/explorer/contracts/fastbridge"
 	"github.com/synapsecns/sanguine/services/explorer/contracts/swap"
+	"github.com/synapsecns/sanguine/services/explorer/export"
 	"github.com/synapsecns/sanguine/services/explorer/static"
+	"github.com/synapsecns/sanguine/services/explorer/stuck"
+	"github.com/synapsecns/sanguine/services/explorer/subscription"
 	"github.com/synapsecns/sanguine/services/explorer/types"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -180,7 +183,7 @@ func Start(ctx context.Context, cfg serverConfig.Config, handler metrics.Handler
 	fetcher := fetcherpkg.NewFetcher(client.NewClient(httpClient, cfg.ScribeURL), handler)
 
 	// response cache
-	responseCache, err := cache.NewAPICacheService()
+	responseCache, err := cache.NewAPICacheService(cfg.Cache, consumerDB)
 	if err != nil {
 		return fmt.Errorf("error creating api cache service, %w", err)
 	}
@@ -197,7 +200,14 @@ func Start(ctx context.Context, cfg serverConfig.Config, handler metrics.Handler
 	if err != nil {
 		return fmt.Errorf("could not create parsers: %w", err)
 	}
-	gqlServer.EnableGraphql(router, consumerDB, fetcher, responseCache, clients, serverParsers, serverRefs, swapFilters, cfg, handler)
+	hub := subscription.NewHub(consumerDB, cfg.Subscriptions)
+	gqlServer.EnableGraphql(router, consumerDB, fetcher, responseCache, clients, serverParsers, serverRefs, swapFilters, cfg, hub, handler)
+
+	exporter, err := export.NewExporter(consumerDB)
+	if err != nil {
+		return fmt.Errorf("could not create exporter: %w", err)
+	}
+	router.GET(ExportEndpoint, exportHandler(exporter, cfg.Export.GetMaxConcurrent()))
 
 	fmt.Printf("started graphiql gqlServer on port: http://localhost:%d/graphiql\n", cfg.HTTPPort)
 
@@ -214,6 +224,25 @@ func Start(ctx context.Context, cfg serverConfig.Config, handler metrics.Handler
 		return fmt.Errorf("could not register observable metrics: %w", err)
 	}
 
+	g.Go(func() error {
+		return hub.Start(ctx)
+	})
+
+	g.Go(func() error {
+		return responseCache.Start(ctx)
+	})
+
+	if cfg.StuckTransfers.Enabled {
+		detector, err := stuck.NewDetector(consumerDB, cfg, httpClient, handler)
+		if err != nil {
+			return fmt.Errorf("could not create stuck transfer detector: %w", err)
+		}
+
+		g.Go(func() error {
+			return detector.Start(ctx)
+		})
+	}
+
 	if cfg.HydrateCache {
 		// refill cache
 		go func() {
@@ -223,14 +252,14 @@ func Start(ctx context.Context, cfg serverConfig.Config, handler metrics.Handler
 					ticker.Stop()
 					return
 				case <-ticker.C:
-					err = RehydrateCache(ctx, client, responseCache, handler)
+					err = hydrateCache(ctx, client, responseCache, handler)
 					if err != nil {
 						logger.Warnf("rehydration failed: %s", err)
 					}
 				case <-first:
 					// buffer to wait for everything to get initialized
 					time.Sleep(10 * time.Second)
-					err = RehydrateCache(ctx, client, responseCache, handler)
+					err = hydrateCache(ctx, client, responseCache, handler)
 					if err != nil {
 						logger.Errorf("initial rehydration failed: %s", err)
 					}
@@ -312,6 +341,19 @@ func InitDB(ctx context.Context, address string, readOnly bool, handler metrics.
 	return clickhouseDB, nil
 }
 
+// hydrateCache rehydrates the cache unless another replica of a shared cache hydrated it during the last interval.
+func hydrateCache(ctx context.Context, client *gqlClient.Client, service cache.Service, handler metrics.Handler) error {
+	shouldHydrate, err := service.ShouldHydrate(ctx, cacheRehydrationInterval*time.Second)
+	if err != nil {
+		return fmt.Errorf("could not claim hydration: %w", err)
+	}
+	if !shouldHydrate {
+		return nil
+	}
+
+	return RehydrateCache(ctx, client, service, handler)
+}
+
 // TODO make this nicer. make a yaml of the queries needed for rehydration w/refresh rate and iterate on that.
 
 // RehydrateCache rehydrates the cache.
@@ -353,7 +395,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, TOTAL_VOLUME_USD, ALL, ALL_TIME, , , ", HandleJSONAmountStat(statsVolAll))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(totalVolumeType, &allPlatformType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsVolAll))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -361,7 +403,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, TOTAL_FEE_USD, ALL, ALL_TIME, , , ", HandleJSONAmountStat(statsFeeAll))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(totalFeeType, &allPlatformType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsFeeAll))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -369,7 +411,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, COUNT_ADDRESSES, ALL, ALL_TIME, , , ", HandleJSONAmountStat(statsAddrAll))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(countAddressType, &allPlatformType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsAddrAll))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -377,7 +419,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, COUNT_TRANSACTIONS, ALL, ALL_TIME, , , ", HandleJSONAmountStat(statsTxAll))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(countTxType, &allPlatformType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsTxAll))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -388,7 +430,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, TOTAL_VOLUME_USD, BRIDGE, ALL_TIME, , , ", HandleJSONAmountStat(statsVolBridge))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(totalVolumeType, &bridgeType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsVolBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -396,7 +438,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, TOTAL_FEE_USD, BRIDGE, ALL_TIME, , , ", HandleJSONAmountStat(statsFeeBridge))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(totalFeeType, &bridgeType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsFeeBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -404,7 +446,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, COUNT_ADDRESSES, BRIDGE, ALL_TIME, , , ", HandleJSONAmountStat(statsAddrBridge))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(countAddressType, &bridgeType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsAddrBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -412,7 +454,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, COUNT_TRANSACTIONS, BRIDGE, ALL_TIME, , , ", HandleJSONAmountStat(statsTxBridge))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(countTxType, &bridgeType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsTxBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -423,7 +465,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, TOTAL_VOLUME_USD, SWAP, ALL_TIME, , , ", HandleJSONAmountStat(statsVolSwap))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(totalVolumeType, &swapType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsVolSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -431,7 +473,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, TOTAL_FEE_USD, SWAP, ALL_TIME, , , ", HandleJSONAmountStat(statsFeeSwap))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(totalFeeType, &swapType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsFeeSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -439,7 +481,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, COUNT_ADDRESSES, SWAP, ALL_TIME, , , ", HandleJSONAmountStat(statsAddrSwap))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(countAddressType, &swapType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsAddrSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -447,7 +489,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, COUNT_TRANSACTIONS, SWAP, ALL_TIME, , , ", HandleJSONAmountStat(statsTxSwap))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(countTxType, &swapType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsTxSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -458,7 +500,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, TOTAL_FEE_USD, MESSAGE_BUS, ALL_TIME, , , ", HandleJSONAmountStat(statsFeeMsg))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(totalFeeType, &messagingType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsFeeMsg))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -466,7 +508,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, COUNT_ADDRESSES, MESSAGE_BUS, ALL_TIME, , , ", HandleJSONAmountStat(statsAddrMsg))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(countAddressType, &messagingType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsAddrMsg))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -474,7 +516,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("amountStatistic, COUNT_TRANSACTIONS, MESSAGE_BUS, ALL_TIME, , , ", HandleJSONAmountStat(statsTxMsg))
+		err = service.CacheResponse(ctx, cache.AmountStatisticKey(countTxType, &messagingType, &allTimeType, nil, nil, nil), HandleJSONAmountStat(statsTxMsg))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -485,7 +527,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , VOLUME, PAST_MONTH, ALL", HandleJSONDailyStat(dailyVolMonth))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &volumeType, &monthType, &allPlatformType), HandleJSONDailyStat(dailyVolMonth))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -493,7 +535,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_MONTH, ALL", HandleJSONDailyStat(dailyFeeMonth))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &monthType, &allPlatformType), HandleJSONDailyStat(dailyFeeMonth))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -501,7 +543,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_MONTH, ALL", HandleJSONDailyStat(dailyTxMonth))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &monthType, &allPlatformType), HandleJSONDailyStat(dailyTxMonth))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -509,7 +551,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_MONTH, ALL", HandleJSONDailyStat(dailyAddrMonth))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &monthType, &allPlatformType), HandleJSONDailyStat(dailyAddrMonth))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -520,7 +562,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , VOLUME, PAST_3_MONTHS, ALL", HandleJSONDailyStat(dailyVolYear))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &volumeType, &threeMonthType, &allPlatformType), HandleJSONDailyStat(dailyVolYear))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -528,7 +570,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_3_MONTHS, ALL", HandleJSONDailyStat(dailyFeeYear))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &threeMonthType, &allPlatformType), HandleJSONDailyStat(dailyFeeYear))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -536,7 +578,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_3_MONTHS, ALL", HandleJSONDailyStat(dailyTxYear))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &threeMonthType, &allPlatformType), HandleJSONDailyStat(dailyTxYear))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -544,7 +586,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_3_MONTHS, ALL", HandleJSONDailyStat(dailyAddrYear))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &threeMonthType, &allPlatformType), HandleJSONDailyStat(dailyAddrYear))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -555,7 +597,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , VOLUME, PAST_6_MONTHS, ALL", HandleJSONDailyStat(dailyVolAllTime))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &volumeType, &sixMonthType, &allPlatformType), HandleJSONDailyStat(dailyVolAllTime))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -563,7 +605,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_6_MONTHS, ALL", HandleJSONDailyStat(dailyFeeAllTime))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &sixMonthType, &allPlatformType), HandleJSONDailyStat(dailyFeeAllTime))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -571,7 +613,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_6_MONTHS, ALL", HandleJSONDailyStat(dailyTxAllTime))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &sixMonthType, &allPlatformType), HandleJSONDailyStat(dailyTxAllTime))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -579,7 +621,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_6_MONTHS, ALL", HandleJSONDailyStat(dailyAddrAllTime))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &sixMonthType, &allPlatformType), HandleJSONDailyStat(dailyAddrAllTime))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -591,7 +633,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , VOLUME, PAST_MONTH, BRIDGE", HandleJSONDailyStat(dailyVolMonthBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &volumeType, &monthType, &bridgeType), HandleJSONDailyStat(dailyVolMonthBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -599,7 +641,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_MONTH, BRIDGE", HandleJSONDailyStat(dailyFeeMonthBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &monthType, &bridgeType), HandleJSONDailyStat(dailyFeeMonthBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -607,7 +649,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_MONTH, BRIDGE", HandleJSONDailyStat(dailyTxMonthBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &monthType, &bridgeType), HandleJSONDailyStat(dailyTxMonthBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -615,7 +657,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_MONTH, BRIDGE", HandleJSONDailyStat(dailyAddrMonthBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &monthType, &bridgeType), HandleJSONDailyStat(dailyAddrMonthBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -626,7 +668,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , VOLUME, PAST_3_MONTHS, BRIDGE", HandleJSONDailyStat(dailyVolYearBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &volumeType, &threeMonthType, &bridgeType), HandleJSONDailyStat(dailyVolYearBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -634,7 +676,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_3_MONTHS, BRIDGE", HandleJSONDailyStat(dailyFeeYearBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &threeMonthType, &bridgeType), HandleJSONDailyStat(dailyFeeYearBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -642,7 +684,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_3_MONTHS, BRIDGE", HandleJSONDailyStat(dailyTxYearBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &threeMonthType, &bridgeType), HandleJSONDailyStat(dailyTxYearBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -650,7 +692,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_3_MONTHS, BRIDGE", HandleJSONDailyStat(dailyAddrYearBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &threeMonthType, &bridgeType), HandleJSONDailyStat(dailyAddrYearBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -661,7 +703,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , VOLUME, PAST_6_MONTHS, BRIDGE", HandleJSONDailyStat(dailyVolAllTimeBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &volumeType, &sixMonthType, &bridgeType), HandleJSONDailyStat(dailyVolAllTimeBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -669,7 +711,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_6_MONTHS, BRIDGE", HandleJSONDailyStat(dailyFeeAllTimeBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &sixMonthType, &bridgeType), HandleJSONDailyStat(dailyFeeAllTimeBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -677,7 +719,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_6_MONTHS, BRIDGE", HandleJSONDailyStat(dailyTxAllTimeBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &sixMonthType, &bridgeType), HandleJSONDailyStat(dailyTxAllTimeBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -685,7 +727,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_6_MONTHS, BRIDGE", HandleJSONDailyStat(dailyAddrAllTimeBridge))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &sixMonthType, &bridgeType), HandleJSONDailyStat(dailyAddrAllTimeBridge))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -697,7 +739,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , VOLUME, PAST_MONTH, SWAP", HandleJSONDailyStat(dailyVolMonthSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &volumeType, &monthType, &swapType), HandleJSONDailyStat(dailyVolMonthSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -705,7 +747,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_MONTH, SWAP", HandleJSONDailyStat(dailyFeeMonthSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &monthType, &swapType), HandleJSONDailyStat(dailyFeeMonthSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -713,7 +755,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_MONTH, SWAP", HandleJSONDailyStat(dailyTxMonthSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &monthType, &swapType), HandleJSONDailyStat(dailyTxMonthSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -722,7 +764,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
 
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_MONTH, SWAP", HandleJSONDailyStat(dailyAddrMonthSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &monthType, &swapType), HandleJSONDailyStat(dailyAddrMonthSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -733,7 +775,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , VOLUME, PAST_3_MONTHS, SWAP", HandleJSONDailyStat(dailyVolYearSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &volumeType, &threeMonthType, &swapType), HandleJSONDailyStat(dailyVolYearSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -741,7 +783,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_3_MONTHS, SWAP", HandleJSONDailyStat(dailyFeeYearSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &threeMonthType, &swapType), HandleJSONDailyStat(dailyFeeYearSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -749,7 +791,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_3_MONTHS, SWAP", HandleJSONDailyStat(dailyTxYearSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &threeMonthType, &swapType), HandleJSONDailyStat(dailyTxYearSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -757,7 +799,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_3_MONTHS, SWAP", HandleJSONDailyStat(dailyAddrYearSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &threeMonthType, &swapType), HandleJSONDailyStat(dailyAddrYearSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -768,7 +810,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , VOLUME, PAST_6_MONTHS, SWAP", HandleJSONDailyStat(dailyVolAllTimeSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &volumeType, &sixMonthType, &swapType), HandleJSONDailyStat(dailyVolAllTimeSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -776,7 +818,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_6_MONTHS, SWAP", HandleJSONDailyStat(dailyFeeAllTimeSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &sixMonthType, &swapType), HandleJSONDailyStat(dailyFeeAllTimeSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -784,7 +826,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_6_MONTHS, SWAP", HandleJSONDailyStat(dailyTxAllTimeSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &sixMonthType, &swapType), HandleJSONDailyStat(dailyTxAllTimeSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -792,7 +834,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_6_MONTHS, SWAP", HandleJSONDailyStat(dailyAddrAllTimeSwap))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &sixMonthType, &swapType), HandleJSONDailyStat(dailyAddrAllTimeSwap))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -804,7 +846,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_MONTH, MESSAGE_BUS", HandleJSONDailyStat(dailyFeeMonthMessageBus))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &monthType, &messagingType), HandleJSONDailyStat(dailyFeeMonthMessageBus))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -812,7 +854,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_MONTH, MESSAGE_BUS", HandleJSONDailyStat(dailyTxMonthMessageBus))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &monthType, &messagingType), HandleJSONDailyStat(dailyTxMonthMessageBus))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -820,7 +862,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_MONTH, MESSAGE_BUS", HandleJSONDailyStat(dailyAddrMonthMessageBus))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &monthType, &messagingType), HandleJSONDailyStat(dailyAddrMonthMessageBus))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -831,7 +873,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_3_MONTHS, MESSAGE_BUS", HandleJSONDailyStat(dailyFeeYearMessageBus))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &threeMonthType, &messagingType), HandleJSONDailyStat(dailyFeeYearMessageBus))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -839,7 +881,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_3_MONTHS, MESSAGE_BUS", HandleJSONDailyStat(dailyTxYearMessageBus))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &threeMonthType, &messagingType), HandleJSONDailyStat(dailyTxYearMessageBus))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -847,7 +889,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_3_MONTHS, MESSAGE_BUS", HandleJSONDailyStat(dailyAddrYearMessageBus))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &threeMonthType, &messagingType), HandleJSONDailyStat(dailyAddrYearMessageBus))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -858,7 +900,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , FEE, PAST_6_MONTHS, MESSAGE_BUS", HandleJSONDailyStat(dailyFeeAllTimeMessageBus))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &feeType, &sixMonthType, &messagingType), HandleJSONDailyStat(dailyFeeAllTimeMessageBus))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -866,7 +908,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , TRANSACTIONS, PAST_6_MONTHS, MESSAGE_BUS", HandleJSONDailyStat(dailyTxAllTimeMessageBus))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &txType, &sixMonthType, &messagingType), HandleJSONDailyStat(dailyTxAllTimeMessageBus))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -874,7 +916,7 @@ func RehydrateCache(parentCtx context.Context, client *gqlClient.Client, service
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
-		err = service.CacheResponse("dailyStatisticsByChain, , ADDRESSES, PAST_6_MONTHS, MESSAGE_BUS", HandleJSONDailyStat(dailyAddrAllTimeMessageBus))
+		err = service.CacheResponse(ctx, cache.DailyStatisticsByChainKey(nil, &addrType, &sixMonthType, &messagingType), HandleJSONDailyStat(dailyAddrAllTimeMessageBus))
 		if err != nil {
 			return fmt.Errorf("error rehydrating cache: %w", err)
 		}
@@ -9,6 +9,7 @@
 	. "github.com/stretchr/testify/assert"
 	"github.com/synapsecns/sanguine/services/explorer/api"
 	"github.com/synapsecns/sanguine/services/explorer/api/cache"
+	serverConfig "github.com/synapsecns/sanguine/services/explorer/config/server"
 	"github.com/synapsecns/sanguine/services/explorer/db/sql"
 	gqlClient "github.com/synapsecns/sanguine/services/explorer/graphql/client"
 
@@ -70,7 +71,7 @@ func TestHandleJSONDailyStat(t *testing.T) {
 }
 
 func (g APISuite) TestRehydrateCache() {
-	responseCache, err := cache.NewAPICacheService()
+	responseCache, err := cache.NewAPICacheService(serverConfig.CacheConfig{}, nil)
 	Nil(g.T(), err)
 	chainID := g.chainIDs[0]
 	chainID2 := g.chainIDs[1]
@@ -5,6 +5,7 @@
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/synapsecns/sanguine/ethergo/util"
@@ -23,20 +24,18 @@
 type ChainBackfiller struct {
 	// consumerDB is the database that the backfiller will use to store the events.
 	consumerDB db.ConsumerDB
-	// bridgeParser is the parser to use to parse bridge events.
-	bridgeParser *parser.BridgeParser
-	// swapParsers is a map from contract address -> parser.
-	swapParsers map[common.Address]*parser.SwapParser
-	// messageBusParser is the parser to use to parse message bus events.
-	messageBusParser *parser.MessageBusParser
-	// cctpParser is the parser to use to parse cctp events.
-	cctpParser *parser.CCTPParser
-	// rfqParser is the parser to use to parse rfq events.
-	rfqParser *parser.RFQParser
+	// parsers is a map from contract address -> parser.
+	parsers map[common.Address]parser.Parser
 	// Fetcher is the Fetcher to use to fetch logs.
 	Fetcher fetcher.ScribeFetcher
 	// chainConfig is the chain config for the chain.
 	chainConfig indexerconfig.ChainConfig
+	// cursorMux protects the cursor fields.
+	cursorMux sync.Mutex
+	// processedBlocks is a map from contract address -> last block stored.
+	processedBlocks map[string]uint64
+	// reportedCursor is the last cursor reported to Scribe.
+	reportedCursor uint64
 }
 
 type contextKey string
@@ -45,17 +44,46 @@ type ChainBackfiller struct {
 	chainKey contextKey = "chainID"
 )
 
-// NewChainBackfiller creates a new backfiller for a chain.
+// NewChainBackfiller creates a new backfiller for a chain from the parsers of the built-in contract types.
 func NewChainBackfiller(consumerDB db.ConsumerDB, bridgeParser *parser.BridgeParser, swapParsers map[common.Address]*parser.SwapParser, messageBusParser *parser.MessageBusParser, cctpParser *parser.CCTPParser, rfqParser *parser.RFQParser, fetcher fetcher.ScribeFetcher, chainConfig indexerconfig.ChainConfig) *ChainBackfiller {
+	parsers := make(map[common.Address]parser.Parser)
+	for _, contract := range chainConfig.Contracts {
+		address := common.HexToAddress(contract.Address)
+		switch contract.ContractType {
+		case indexerconfig.BridgeContractType.String():
+			if bridgeParser != nil {
+				parsers[address] = bridgeParser
+			}
+		case indexerconfig.SwapContractType.String(), indexerconfig.MetaSwapContractType.String():
+			if swapParser, ok := swapParsers[address]; ok && swapParser != nil {
+				parsers[address] = swapParser
+			}
+		case indexerconfig.MessageBusContractType.String():
+			if messageBusParser != nil {
+				parsers[address] = messageBusParser
+			}
+		case indexerconfig.CCTPContractType.String():
+			if cctpParser != nil {
+				parsers[address] = cctpParser
+			}
+		case indexerconfig.RFQContractType.String():
+			if rfqParser != nil {
+				parsers[address] = rfqParser
+			}
+		}
+	}
+
+	return NewChainBackfillerWithParsers(consumerDB, parsers, fetcher, chainConfig)
+}
+
+// NewChainBackfillerWithParsers creates a new backfiller for a chain with the parser of each contract, by address.
+func NewChainBackfillerWithParsers(consumerDB db.ConsumerDB, parsers map[common.Address]parser.Parser, fetcher fetcher.ScribeFetcher, chainConfig indexerconfig.ChainConfig) *ChainBackfiller {
 	return &ChainBackfiller{
-		consumerDB:       consumerDB,
-		bridgeParser:     bridgeParser,
-		swapParsers:      swapParsers,
-		messageBusParser: messageBusParser,
-		cctpParser:       cctpParser,
-		rfqParser:        rfqParser,
-		Fetcher:          fetcher,
-		chainConfig:      chainConfig,
+		consumerDB:      consumerDB,
+		parsers:         parsers,
+		Fetcher:         fetcher,
+		chainConfig:     chainConfig,
+		processedBlocks: make(map[string]uint64),
 	}
 }
 
@@ -118,27 +146,30 @@ func (c *ChainBackfiller) Backfill(ctx context.Context, livefill bool, refreshRa
 }
 
 // makeEventParser returns a parser for a contract using it's config.
-// in the event one is not present, this function will return an error.
+// in the event the contract type is not registered, this function will return an error.
 func (c *ChainBackfiller) makeEventParser(contract indexerconfig.ContractConfig) (eventParser parser.Parser, err error) {
-	contractType, err := indexerconfig.ContractTypeFromString(contract.ContractType)
-	if err != nil {
+	if _, ok := parser.GetRegistration(contract.ContractType); !ok {
 		return nil, fmt.Errorf("could not create event parser for unknown contract type: %s", contract.ContractType)
 	}
-	switch contractType {
-	case indexerconfig.BridgeContractType:
-		eventParser = c.bridgeParser
-	case indexerconfig.SwapContractType:
-		eventParser = c.swapParsers[common.HexToAddress(contract.Address)]
-	case indexerconfig.MessageBusContractType:
-		eventParser = c.messageBusParser
-	case indexerconfig.MetaSwapContractType:
-		eventParser = c.swapParsers[common.HexToAddress(contract.Address)]
-	case indexerconfig.CCTPContractType:
-		eventParser = c.cctpParser
-	case indexerconfig.RFQContractType:
-		eventParser = c.rfqParser
+
+	return c.parsers[common.HexToAddress(contract.Address)], nil
+}
+
+// filterLogs removes the logs that are not events of the abi registered for the contract type.
+func filterLogs(contract indexerconfig.ContractConfig, logs []ethTypes.Log) []ethTypes.Log {
+	registration, ok := parser.GetRegistration(contract.ContractType)
+	if !ok || registration.ABI == nil {
+		return logs
+	}
+
+	filtered := make([]ethTypes.Log, 0, len(logs))
+	for _, log := range logs {
+		if registration.HandlesLog(log) {
+			filtered = append(filtered, log)
+		}
 	}
-	return eventParser, nil
+
+	return filtered
 }
 
 // backfillContractLogs creates a backfiller for a given contract with an independent context
@@ -214,7 +245,7 @@ func (c *ChainBackfiller) backfillContractLogs(parentCtx context.Context, contra
 							continue
 						}
 
-						parsedLogs, err := ProcessLogs(groupCtx, logs, c.chainConfig.ChainID, eventParser)
+						parsedLogs, err := ProcessLogs(groupCtx, filterLogs(contract, logs), c.chainConfig.ChainID, eventParser)
 						if err != nil {
 							timeout = b.Duration()
 							logger.Warnf("could not process logs for chain %d: %s", c.chainConfig.ChainID, err)
@@ -249,11 +280,44 @@ func (c *ChainBackfiller) backfillContractLogs(parentCtx context.Context, contra
 			return fmt.Errorf("could not store last block for chain %d: %w", c.chainConfig.ChainID, err)
 		}
 		currentHeight = chunkEnd + 1
+		c.reportCursor(parentCtx, contract.Address, chunkEnd)
+	}
+	if currentHeight > 0 {
+		c.reportCursor(parentCtx, contract.Address, currentHeight-1)
 	}
 
 	return nil
 }
 
+// reportCursor records the last block stored for a contract and reports the lowest block stored across the contracts
+// of the chain to Scribe once every contract has stored one. A failed report is retried on the next chunk.
+func (c *ChainBackfiller) reportCursor(ctx context.Context, contractAddress string, blockNumber uint64) {
+	c.cursorMux.Lock()
+	defer c.cursorMux.Unlock()
+
+	c.processedBlocks[contractAddress] = blockNumber
+	if len(c.processedBlocks) < len(c.chainConfig.Contracts) {
+		return
+	}
+
+	cursor := blockNumber
+	for _, processed := range c.processedBlocks {
+		if processed < cursor {
+			cursor = processed
+		}
+	}
+	if cursor == c.reportedCursor {
+		return
+	}
+
+	err := c.Fetcher.UpdateConsumerCursor(ctx, c.chainConfig.ChainID, cursor)
+	if err != nil {
+		logger.Warnf("could not report cursor %d for chain %d: %v", cursor, c.chainConfig.ChainID, err)
+		return
+	}
+	c.reportedCursor = cursor
+}
+
 // ProcessLogs processes the logs and stores them in the consumer database.
 //
 //nolint:gocognit,cyclop
@@ -24,6 +24,7 @@
 	"github.com/synapsecns/sanguine/services/explorer/consumer/parser"
 	parserpkg "github.com/synapsecns/sanguine/services/explorer/consumer/parser"
 	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/testutil"
 	"github.com/synapsecns/sanguine/services/explorer/testutil/testcontracts"
 	bridgeTypes "github.com/synapsecns/sanguine/services/explorer/types/bridge"
 	cctpTypes "github.com/synapsecns/sanguine/services/explorer/types/cctp"
@@ -407,7 +408,7 @@ func (b *BackfillSuite) TestBackfill() {
 	spMap[swapContractA.Address()] = spA
 	spMap[swapContractB.Address()] = spB
 	spMap[metaSwapContract.Address()] = msp
-	f := fetcher.NewFetcher(b.gqlClient, b.metrics)
+	f := fetcher.NewFetcher(b.gqlClient, b.metrics, fetcher.WithConsumerToken(testutil.ConsumerToken))
 
 	// Set up message bus parser
 	mbp, err := parser.NewMessageBusParser(b.db, messageBusContract.Address(), b.consumerFetcher, tokenPriceService)
@@ -442,6 +443,13 @@ func (b *BackfillSuite) TestBackfill() {
 	Nil(b.T(), rfqEvents.Error)
 	Equal(b.T(), int64(2), count)
 
+	// the backfiller reports the last block it stored to scribe
+	cursors, err := b.eventDB.RetrieveConsumerCursors(b.GetTestContext(), uint32(testChainID.Uint64()))
+	Nil(b.T(), err)
+	Len(b.T(), cursors, 1)
+	Equal(b.T(), "explorer", cursors[0].Consumer)
+	Equal(b.T(), lastBlock, cursors[0].BlockNumber)
+
 	// Test cctp parity
 	err = b.sendCircleTokenParity(requestSentLog, cp)
 	Nil(b.T(), err)
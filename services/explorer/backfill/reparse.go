@@ -0,0 +1,312 @@
+package backfill
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	indexerconfig "github.com/synapsecns/sanguine/services/explorer/config/indexer"
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	model "github.com/synapsecns/sanguine/services/explorer/db/sql"
+)
+
+// CaptureDB is a consumer db that keeps the events stored by parsers in memory instead of writing them.
+// Some parsers store derived events themselves (e.g. the bridge events of cctp and rfq events), capturing them lets
+// the reparser diff and replace them together with the parsed events.
+type CaptureDB struct {
+	db.ConsumerDB
+	// mux protects events.
+	mux sync.Mutex
+	// events are the captured events.
+	events []interface{}
+}
+
+// NewCaptureDB creates a new capture db. Every call other than storing events is passed to the underlying db.
+func NewCaptureDB(consumerDB db.ConsumerDB) *CaptureDB {
+	return &CaptureDB{ConsumerDB: consumerDB}
+}
+
+// StoreEvent captures an event.
+func (c *CaptureDB) StoreEvent(_ context.Context, event interface{}) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.events = append(c.events, event)
+	return nil
+}
+
+// StoreEvents captures a list of events.
+func (c *CaptureDB) StoreEvents(_ context.Context, events []interface{}) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.events = append(c.events, events...)
+	return nil
+}
+
+// Drain returns the captured events and clears them.
+func (c *CaptureDB) Drain() []interface{} {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	events := c.events
+	c.events = nil
+	return events
+}
+
+// Reparser re-parses the logs stored by scribe for a contract and replaces the events stored by the explorer.
+type Reparser struct {
+	// consumerDB is the database the events are replaced in.
+	consumerDB db.ConsumerDB
+	// capture captures the events stored by the parsers of the chain backfiller.
+	capture *CaptureDB
+	// chainBackfiller holds the parsers and the fetcher. Its parsers must store events into capture.
+	chainBackfiller *ChainBackfiller
+	// contract is the contract to reparse.
+	contract indexerconfig.ContractConfig
+}
+
+// NewReparser creates a new reparser for a contract of the chain backfiller.
+func NewReparser(consumerDB db.ConsumerDB, capture *CaptureDB, chainBackfiller *ChainBackfiller, contract indexerconfig.ContractConfig) *Reparser {
+	return &Reparser{
+		consumerDB:      consumerDB,
+		capture:         capture,
+		chainBackfiller: chainBackfiller,
+		contract:        contract,
+	}
+}
+
+// RowDiff is the difference between a stored row and a reparsed row. Old is nil for added rows and New is nil for
+// removed rows.
+type RowDiff struct {
+	// Table is the table of the row.
+	Table string
+	// Key identifies the row in the table.
+	Key string
+	// Old are the column values of the stored row.
+	Old map[string]string
+	// New are the column values of the reparsed row.
+	New map[string]string
+}
+
+// Columns gets the columns whose values differ, sorted by name.
+func (r RowDiff) Columns() []string {
+	var columns []string
+	for column, value := range r.New {
+		if oldValue, ok := r.Old[column]; !ok || oldValue != value {
+			columns = append(columns, column)
+		}
+	}
+	for column := range r.Old {
+		if _, ok := r.New[column]; !ok {
+			columns = append(columns, column)
+		}
+	}
+	sort.Strings(columns)
+
+	return columns
+}
+
+// ReparseReport summarizes a reparse.
+type ReparseReport struct {
+	// Logs is the number of logs fetched from scribe.
+	Logs int
+	// Unchanged is the number of rows that were reparsed identically.
+	Unchanged int
+	// Diffs are the rows that were changed, added or removed.
+	Diffs []RowDiff
+}
+
+// Reparse re-parses the logs of the contract between the start and end block (inclusive). Unless dryRun is set,
+// the stored events of every chunk are deleted and replaced by the reparsed events, so an interrupted reparse can
+// safely be run again.
+func (r *Reparser) Reparse(ctx context.Context, startBlock, endBlock uint64, dryRun bool) (*ReparseReport, error) {
+	eventParser, err := r.chainBackfiller.makeEventParser(r.contract)
+	if err != nil {
+		return nil, err
+	}
+	if eventParser == nil {
+		return nil, fmt.Errorf("no parser for contract %s of type %s", r.contract.Address, r.contract.ContractType)
+	}
+
+	chainID := r.chainBackfiller.chainConfig.ChainID
+	increment := r.chainBackfiller.chainConfig.FetchBlockIncrement
+	if increment == 0 {
+		increment = 1
+	}
+
+	report := &ReparseReport{}
+	for chunkStart := startBlock; chunkStart <= endBlock; chunkStart += increment {
+		chunkEnd := chunkStart + increment - 1
+		if chunkEnd > endBlock {
+			chunkEnd = endBlock
+		}
+
+		logs, err := r.chainBackfiller.Fetcher.FetchLogsInRange(ctx, chainID, chunkStart, chunkEnd, common.HexToAddress(r.contract.Address))
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch logs from %d to %d: %w", chunkStart, chunkEnd, err)
+		}
+
+		r.capture.Drain()
+		parsedLogs, err := ProcessLogs(ctx, filterLogs(r.contract, logs), chainID, eventParser)
+		if err != nil {
+			return nil, fmt.Errorf("could not process logs from %d to %d: %w", chunkStart, chunkEnd, err)
+		}
+
+		var newEvents []interface{}
+		for _, parsedLog := range parsedLogs {
+			if parsedLog != nil {
+				newEvents = append(newEvents, parsedLog)
+			}
+		}
+		newEvents = append(newEvents, r.capture.Drain()...)
+
+		oldEvents, err := r.consumerDB.GetEventsInRange(ctx, chainID, r.contract.Address, chunkStart, chunkEnd)
+		if err != nil {
+			return nil, fmt.Errorf("could not get stored events from %d to %d: %w", chunkStart, chunkEnd, err)
+		}
+
+		diffs, unchanged := DiffEvents(oldEvents, newEvents)
+		report.Logs += len(logs)
+		report.Unchanged += unchanged
+		report.Diffs = append(report.Diffs, diffs...)
+
+		if !dryRun && len(diffs) > 0 {
+			err = r.consumerDB.DeleteEventsInRange(ctx, chainID, r.contract.Address, chunkStart, chunkEnd)
+			if err != nil {
+				return nil, fmt.Errorf("could not delete events from %d to %d: %w", chunkStart, chunkEnd, err)
+			}
+
+			if len(newEvents) > 0 {
+				err = r.consumerDB.StoreEvents(ctx, newEvents)
+				if err != nil {
+					return nil, fmt.Errorf("could not store events from %d to %d: %w", chunkStart, chunkEnd, err)
+				}
+			}
+		}
+
+		logger.Infof("reparsed contract %s on chain %d from %d to %d (%.1f%%): %d logs, %d rows changed",
+			r.contract.Address, chainID, chunkStart, chunkEnd, 100*float64(chunkEnd-startBlock+1)/float64(endBlock-startBlock+1), len(logs), len(diffs))
+	}
+
+	return report, nil
+}
+
+// DiffEvents compares stored events with reparsed events. It returns the rows that differ and the number of rows
+// that are identical. The insert time is ignored.
+func DiffEvents(oldEvents, newEvents []interface{}) (diffs []RowDiff, unchanged int) {
+	oldRows := make(map[string]map[string]string)
+	for _, event := range oldEvents {
+		table, key := eventKey(event)
+		oldRows[table+"/"+key] = rowValues(event)
+	}
+
+	seen := make(map[string]bool)
+	for _, event := range newEvents {
+		table, key := eventKey(event)
+		id := table + "/" + key
+		seen[id] = true
+
+		newRow := rowValues(event)
+		oldRow, ok := oldRows[id]
+		if !ok {
+			diffs = append(diffs, RowDiff{Table: table, Key: key, New: newRow})
+			continue
+		}
+
+		diff := RowDiff{Table: table, Key: key, Old: oldRow, New: newRow}
+		if len(diff.Columns()) == 0 {
+			unchanged++
+			continue
+		}
+		diffs = append(diffs, diff)
+	}
+
+	for _, event := range oldEvents {
+		table, key := eventKey(event)
+		if !seen[table+"/"+key] {
+			diffs = append(diffs, RowDiff{Table: table, Key: key, Old: oldRows[table+"/"+key]})
+		}
+	}
+
+	return diffs, unchanged
+}
+
+// eventKey gets the table of an event and the key identifying it in the table.
+func eventKey(event interface{}) (table string, key string) {
+	switch conv := event.(type) {
+	case *model.BridgeEvent:
+		return "bridge_events", fmt.Sprintf("%s/%d/%d", conv.TxHash, conv.EventIndex, conv.EventType)
+	case model.SwapEvent:
+		return "swap_events", fmt.Sprintf("%s/%d/%d", conv.TxHash, conv.EventIndex, conv.EventType)
+	case model.MessageBusEvent:
+		return "message_bus_events", fmt.Sprintf("%s/%d/%d", conv.TxHash, conv.EventIndex, conv.EventType)
+	case *model.CCTPEvent:
+		return "cctp_events", fmt.Sprintf("%s/%d/%d", conv.TxHash, conv.EventIndex, conv.EventType)
+	case *model.RFQEvent:
+		return "rfq_events", fmt.Sprintf("%s/%d/%d", conv.TxHash, conv.EventIndex, conv.EventType)
+	default:
+		return fmt.Sprintf("%T", event), fmt.Sprintf("%v", event)
+	}
+}
+
+// rowValues gets the value of each column of an event, formatted so that a value read from the database compares
+// equal to the value of a reparsed event (e.g. nil numbers and invalid null strings are stored as zero values).
+func rowValues(event interface{}) map[string]string {
+	value := reflect.Indirect(reflect.ValueOf(event))
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	values := make(map[string]string)
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		column := columnName(field)
+		if column == "" || column == "insert_time" {
+			continue
+		}
+		values[column] = formatValue(value.Field(i))
+	}
+
+	return values
+}
+
+// columnName gets the column name of a field from its gorm tag.
+func columnName(field reflect.StructField) string {
+	for _, setting := range strings.Split(field.Tag.Get("gorm"), ";") {
+		if name, ok := strings.CutPrefix(setting, "column:"); ok {
+			return name
+		}
+	}
+
+	return ""
+}
+
+func formatValue(value reflect.Value) string {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return formatValue(reflect.Zero(value.Type().Elem()))
+		}
+		return formatValue(value.Elem())
+	}
+
+	switch conv := value.Interface().(type) {
+	case big.Int:
+		return conv.String()
+	case sql.NullString:
+		return conv.String
+	case sql.NullInt64:
+		return fmt.Sprintf("%d", conv.Int64)
+	case sql.NullInt32:
+		return fmt.Sprintf("%d", conv.Int32)
+	default:
+		// maps are printed with sorted keys.
+		return fmt.Sprintf("%v", conv)
+	}
+}
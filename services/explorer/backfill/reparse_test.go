@@ -0,0 +1,222 @@
+package backfill_test
+
+import (
+	"context"
+	gosql "database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/services/explorer/backfill"
+	indexerConfig "github.com/synapsecns/sanguine/services/explorer/config/indexer"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher/tokenprice"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/parser"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/parser/tokendata"
+	"github.com/synapsecns/sanguine/services/explorer/db/mocks"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/static"
+	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
+)
+
+func TestDiffEvents(t *testing.T) {
+	stored := &sql.BridgeEvent{
+		InsertTime:  1,
+		TxHash:      "0x1",
+		EventIndex:  2,
+		EventType:   3,
+		Amount:      big.NewInt(100),
+		Fee:         big.NewInt(0),
+		Recipient:   gosql.NullString{String: "", Valid: true},
+		BlockNumber: 10,
+	}
+	// the insert time changes and nil values are stored as zero values, so the row is unchanged.
+	reparsed := &sql.BridgeEvent{
+		InsertTime:  2,
+		TxHash:      "0x1",
+		EventIndex:  2,
+		EventType:   3,
+		Amount:      big.NewInt(100),
+		BlockNumber: 10,
+	}
+	changedStored := &sql.BridgeEvent{TxHash: "0x2", Amount: big.NewInt(100)}
+	changedReparsed := &sql.BridgeEvent{TxHash: "0x2", Amount: big.NewInt(200)}
+	removed := sql.SwapEvent{TxHash: "0x3"}
+	added := &sql.CCTPEvent{TxHash: "0x4", RequestID: "0xabc"}
+
+	diffs, unchanged := backfill.DiffEvents([]interface{}{stored, changedStored, removed}, []interface{}{reparsed, changedReparsed, added})
+	assert.Equal(t, 1, unchanged)
+	require.Len(t, diffs, 3)
+
+	assert.Equal(t, "bridge_events", diffs[0].Table)
+	assert.Equal(t, []string{"amount"}, diffs[0].Columns())
+	assert.Equal(t, "100", diffs[0].Old["amount"])
+	assert.Equal(t, "200", diffs[0].New["amount"])
+
+	assert.Equal(t, "cctp_events", diffs[1].Table)
+	assert.Nil(t, diffs[1].Old)
+	assert.Equal(t, "0xabc", diffs[1].New["request_id"])
+
+	assert.Equal(t, "swap_events", diffs[2].Table)
+	assert.Nil(t, diffs[2].New)
+}
+
+func TestCaptureDB(t *testing.T) {
+	consumerDB := new(mocks.ConsumerDB)
+	capture := backfill.NewCaptureDB(consumerDB)
+
+	require.NoError(t, capture.StoreEvent(context.Background(), &sql.BridgeEvent{TxHash: "0x1"}))
+	require.NoError(t, capture.StoreEvents(context.Background(), []interface{}{sql.SwapEvent{TxHash: "0x2"}}))
+
+	assert.Len(t, capture.Drain(), 2)
+	assert.Empty(t, capture.Drain())
+	// nothing is written to the underlying db.
+	consumerDB.AssertNotCalled(t, "StoreEvent")
+	consumerDB.AssertNotCalled(t, "StoreEvents")
+}
+
+// mvBridgeRow is a row of the bridge materialized view, with the columns the reparse test checks.
+type mvBridgeRow struct {
+	FChainID     uint32           `gorm:"column:fchain_id"`
+	FBlockNumber uint64           `gorm:"column:fblock_number"`
+	TChainID     uint32           `gorm:"column:tchain_id"`
+	TBlockNumber uint64           `gorm:"column:tblock_number"`
+	TTxHash      string           `gorm:"column:ttx_hash"`
+	TKappa       gosql.NullString `gorm:"column:tkappa"`
+	TAmountUSD   *float64         `gorm:"column:tamount_usd"`
+}
+
+func (b *BackfillSuite) retrieveMvBridgeRows() []mvBridgeRow {
+	var rows []mvBridgeRow
+	err := b.db.UNSAFE_DB().WithContext(b.GetTestContext()).Raw("SELECT * FROM mv_bridge_events FINAL ORDER BY fchain_id, fblock_number").Scan(&rows).Error
+	b.Require().NoError(err)
+	return rows
+}
+
+// TestReparse tests that a reparse replaces the stored events of a contract, resets the bridge view rows of the
+// contract, and that running it again changes nothing.
+func (b *BackfillSuite) TestReparse() {
+	testChainID := b.testBackend.GetBigChainID()
+	chainID := uint32(testChainID.Uint64())
+	otherChainID := chainID + 1
+	lastBlock := uint64(4)
+
+	bridgeContract, bridgeRef := b.testDeployManager.GetTestSynapseBridge(b.GetTestContext(), b.testBackend)
+	transactOpts := b.testBackend.GetTxContext(b.GetTestContext(), nil)
+	contractConfig := indexerConfig.ContractConfig{
+		ContractType: "bridge",
+		Address:      bridgeContract.Address().String(),
+		StartBlock:   0,
+	}
+	chainConfig := indexerConfig.ChainConfig{
+		ChainID:             chainID,
+		RPCURL:              gofakeit.URL(),
+		FetchBlockIncrement: 2,
+		MaxGoroutines:       2,
+		Contracts:           []indexerConfig.ContractConfig{contractConfig},
+	}
+
+	for i := uint64(0); i <= lastBlock; i++ {
+		b.Require().NoError(b.eventDB.StoreBlockTime(b.GetTestContext(), chainID, i, i))
+	}
+
+	// an origin event at block 2 and a destination event at block 3.
+	bridgeTx, err := bridgeRef.TestDeposit(transactOpts.TransactOpts, common.BigToAddress(big.NewInt(gofakeit.Int64())), big.NewInt(int64(otherChainID)), common.HexToAddress(testTokens[0].TokenAddress), big.NewInt(int64(gofakeit.Uint32())))
+	b.Require().NoError(err)
+	b.storeEthTx(bridgeTx, testChainID, big.NewInt(2), 1)
+	_, err = b.storeTestLog(bridgeTx, chainID, 2)
+	b.Require().NoError(err)
+
+	kappa := [32]byte{byte(gofakeit.Uint64())}
+	mintTx, err := bridgeRef.TestMint(transactOpts.TransactOpts, common.BigToAddress(big.NewInt(gofakeit.Int64())), common.HexToAddress(testTokens[0].TokenAddress), big.NewInt(int64(gofakeit.Uint32())), big.NewInt(int64(gofakeit.Uint32())), kappa)
+	b.Require().NoError(err)
+	b.storeEthTx(mintTx, testChainID, big.NewInt(3), 1)
+	_, err = b.storeTestLog(mintTx, chainID, 3)
+	b.Require().NoError(err)
+
+	b.Require().NoError(b.eventDB.StoreLastIndexed(b.GetTestContext(), bridgeContract.Address(), chainID, lastBlock, scribeTypes.IndexingConfirmed))
+
+	bcf, err := fetcher.NewBridgeConfigFetcher(b.bridgeConfigContract.Address(), b.bridgeConfigContract)
+	b.Require().NoError(err)
+	tokenSymbolToIDs, err := parser.ParseYaml(static.GetTokenSymbolToTokenIDConfig())
+	b.Require().NoError(err)
+	tokenDataService, err := tokendata.NewTokenDataService(bcf, tokenSymbolToIDs)
+	b.Require().NoError(err)
+	tokenPriceService, err := tokenprice.NewPriceDataService()
+	b.Require().NoError(err)
+	f := fetcher.NewFetcher(b.gqlClient, b.metrics)
+
+	bp, err := parser.NewBridgeParser(b.db, bridgeContract.Address(), tokenDataService, b.consumerFetcher, tokenPriceService, false)
+	b.Require().NoError(err)
+	chainBackfiller := backfill.NewChainBackfillerWithParsers(b.db, map[common.Address]parser.Parser{bridgeContract.Address(): bp}, f, chainConfig)
+	b.Require().NoError(chainBackfiller.Backfill(b.GetTestContext(), false, 1))
+
+	storedEvents, err := b.db.GetEventsInRange(b.GetTestContext(), chainID, contractConfig.Address, 0, lastBlock)
+	b.Require().NoError(err)
+	b.Require().Len(storedEvents, 2)
+
+	// the bridge view is populated by a materialized view in production, so its rows are inserted directly.
+	b.Require().NoError(b.db.UNSAFE_DB().WithContext(b.GetTestContext()).Exec("CREATE TABLE IF NOT EXISTS mv_bridge_events (insert_time UInt64, fchain_id UInt32, fcontract_address String, fblock_number UInt64, ftx_hash String, tchain_id UInt32, tcontract_address String, tblock_number UInt64, ttx_hash String, tkappa Nullable(String), tamount_usd Nullable(Float64)) ENGINE = ReplacingMergeTree(insert_time) ORDER BY (fchain_id, fcontract_address, fblock_number, ftx_hash)").Error)
+	insertRow := "INSERT INTO mv_bridge_events VALUES (1, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	otherContract := common.BigToAddress(big.NewInt(gofakeit.Int64())).String()
+	// the deposit of the contract, completed on the other chain.
+	b.Require().NoError(b.db.UNSAFE_DB().Exec(insertRow, chainID, contractConfig.Address, 2, bridgeTx.Hash().String(), otherChainID, otherContract, 7, "0x1", "0x2", 1.5).Error)
+	// a deposit on the other chain, completed by the mint of the contract.
+	b.Require().NoError(b.db.UNSAFE_DB().Exec(insertRow, otherChainID, otherContract, 5, "0x3", chainID, contractConfig.Address, 3, mintTx.Hash().String(), "0x4", 2.5).Error)
+	// a deposit on the other chain, completed by the contract outside of the reparsed range.
+	b.Require().NoError(b.db.UNSAFE_DB().Exec(insertRow, otherChainID, otherContract, 6, "0x5", chainID, contractConfig.Address, 10, "0x6", "0x7", 3.5).Error)
+
+	// an event that is not in the logs of the contract is removed by the reparse.
+	b.Require().NoError(b.db.StoreEvent(b.GetTestContext(), &sql.BridgeEvent{
+		InsertTime:      1,
+		ChainID:         chainID,
+		ContractAddress: contractConfig.Address,
+		BlockNumber:     3,
+		TxHash:          common.BigToHash(big.NewInt(gofakeit.Int64())).String(),
+		EventType:       1,
+		Amount:          big.NewInt(1),
+	}))
+
+	capture := backfill.NewCaptureDB(b.db)
+	reparseParser, err := parser.NewBridgeParser(capture, bridgeContract.Address(), tokenDataService, b.consumerFetcher, tokenPriceService, false)
+	b.Require().NoError(err)
+	reparseBackfiller := backfill.NewChainBackfillerWithParsers(capture, map[common.Address]parser.Parser{bridgeContract.Address(): reparseParser}, f, chainConfig)
+	reparser := backfill.NewReparser(b.db, capture, reparseBackfiller, contractConfig)
+
+	report, err := reparser.Reparse(b.GetTestContext(), 0, lastBlock, false)
+	b.Require().NoError(err)
+	assert.Equal(b.T(), 2, report.Logs)
+	assert.Equal(b.T(), 2, report.Unchanged)
+	b.Require().Len(report.Diffs, 1)
+	assert.Nil(b.T(), report.Diffs[0].New)
+
+	reparsedEvents, err := b.db.GetEventsInRange(b.GetTestContext(), chainID, contractConfig.Address, 0, lastBlock)
+	b.Require().NoError(err)
+	diffs, unchanged := backfill.DiffEvents(storedEvents, reparsedEvents)
+	assert.Empty(b.T(), diffs)
+	assert.Equal(b.T(), 2, unchanged)
+
+	// the origin row of the contract is deleted, the row completed by the contract is pending until the mint is joined
+	// again, and the row outside of the range is untouched.
+	expectedRows := []mvBridgeRow{
+		{FChainID: otherChainID, FBlockNumber: 5},
+		{FChainID: otherChainID, FBlockNumber: 6, TChainID: chainID, TBlockNumber: 10, TTxHash: "0x6", TKappa: gosql.NullString{String: "0x7", Valid: true}, TAmountUSD: core.PtrTo(3.5)},
+	}
+	assert.Equal(b.T(), expectedRows, b.retrieveMvBridgeRows())
+
+	// running the reparse again changes nothing.
+	report, err = reparser.Reparse(b.GetTestContext(), 0, lastBlock, false)
+	b.Require().NoError(err)
+	assert.Empty(b.T(), report.Diffs)
+	assert.Equal(b.T(), 2, report.Unchanged)
+
+	rereparsedEvents, err := b.db.GetEventsInRange(b.GetTestContext(), chainID, contractConfig.Address, 0, lastBlock)
+	b.Require().NoError(err)
+	diffs, _ = backfill.DiffEvents(reparsedEvents, rereparsedEvents)
+	assert.Empty(b.T(), diffs)
+	assert.Equal(b.T(), expectedRows, b.retrieveMvBridgeRows())
+}
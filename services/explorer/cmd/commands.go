@@ -14,8 +14,11 @@
 	"github.com/synapsecns/sanguine/services/explorer/api"
 	indexerconfig "github.com/synapsecns/sanguine/services/explorer/config/indexer"
 	serverconfig "github.com/synapsecns/sanguine/services/explorer/config/server"
+	"github.com/synapsecns/sanguine/services/explorer/export"
 	"github.com/synapsecns/sanguine/services/explorer/node"
 	"github.com/urfave/cli/v2"
+	"math"
+	"os"
 )
 
 //go:embed cmd.md
@@ -140,6 +143,163 @@
 	},
 }
 
+var chainIDFlag = &cli.UintFlag{
+	Name:     "chain-id",
+	Usage:    "--chain-id 1",
+	Required: true,
+}
+
+var contractTypeFlag = &cli.StringFlag{
+	Name:     "contract-type",
+	Usage:    "--contract-type bridge (one of bridge, swap, metaswap, messagebus, cctp, rfq)",
+	Required: true,
+}
+
+var contractAddressFlag = &cli.StringFlag{
+	Name:  "contract-address",
+	Usage: "--contract-address 0x... required when the chain has several contracts of the type",
+}
+
+var startBlockFlag = &cli.Uint64Flag{
+	Name:     "start-block",
+	Usage:    "--start-block 1000",
+	Required: true,
+}
+
+var endBlockFlag = &cli.Uint64Flag{
+	Name:     "end-block",
+	Usage:    "--end-block 2000",
+	Required: true,
+}
+
+var dryRunFlag = &cli.BoolFlag{
+	Name:  "dry-run",
+	Usage: "--dry-run prints the difference between the stored and reparsed rows without changing them",
+}
+
+var reparseCommand = &cli.Command{
+	Name:        "reparse",
+	Description: "re-parses the stored scribe logs of a contract for a block range and replaces the stored events",
+	Flags:       []cli.Flag{configFlag, clickhouseAddressFlag, chainIDFlag, contractTypeFlag, contractAddressFlag, startBlockFlag, endBlockFlag, dryRunFlag},
+	Action: func(c *cli.Context) error {
+		decodeConfig, err := indexerconfig.DecodeConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		if err != nil {
+			return fmt.Errorf("could not decode config: %w", err)
+		}
+		if c.Uint64(startBlockFlag.Name) > c.Uint64(endBlockFlag.Name) {
+			return fmt.Errorf("start block %d is after end block %d", c.Uint64(startBlockFlag.Name), c.Uint64(endBlockFlag.Name))
+		}
+		db, err := api.InitDB(c.Context, c.String(clickhouseAddressFlag.Name), false, metrics.Get())
+		if err != nil {
+			return fmt.Errorf("could not initialize database: %w", err)
+		}
+		clients := make(map[uint32]bind.ContractBackend)
+		for _, client := range decodeConfig.Chains {
+			backendClient, err := ethclient.DialContext(c.Context, decodeConfig.RPCURL+fmt.Sprintf("%d", client.ChainID))
+			if err != nil {
+				return fmt.Errorf("could not start client for %s", client.RPCURL)
+			}
+			clients[client.ChainID] = backendClient
+		}
+		reparser, err := node.NewReparser(db, decodeConfig, clients, metrics.Get(), uint32(c.Uint(chainIDFlag.Name)), c.String(contractTypeFlag.Name), c.String(contractAddressFlag.Name))
+		if err != nil {
+			return fmt.Errorf("could not create reparser: %w", err)
+		}
+		report, err := reparser.Reparse(c.Context, c.Uint64(startBlockFlag.Name), c.Uint64(endBlockFlag.Name), c.Bool(dryRunFlag.Name))
+		if err != nil {
+			return fmt.Errorf("could not reparse: %w", err)
+		}
+
+		for _, diff := range report.Diffs {
+			switch {
+			case diff.Old == nil:
+				fmt.Printf("+ %s %s\n", diff.Table, diff.Key)
+			case diff.New == nil:
+				fmt.Printf("- %s %s\n", diff.Table, diff.Key)
+			default:
+				fmt.Printf("~ %s %s\n", diff.Table, diff.Key)
+				for _, column := range diff.Columns() {
+					fmt.Printf("    %s: %q -> %q\n", column, diff.Old[column], diff.New[column])
+				}
+			}
+		}
+		fmt.Printf("%d logs reparsed, %d rows unchanged, %d rows changed\n", report.Logs, report.Unchanged, len(report.Diffs))
+		if c.Bool(dryRunFlag.Name) {
+			fmt.Println("dry run, no rows were changed")
+		}
+
+		return nil
+	},
+}
+
+var walletFlag = &cli.StringFlag{
+	Name:     "wallet",
+	Usage:    "--wallet 0x... the address to export the bridge history of",
+	Required: true,
+}
+
+var formatFlag = &cli.StringFlag{
+	Name:  "format",
+	Usage: "--format csv (one of csv, json)",
+	Value: string(export.FormatCSV),
+}
+
+var outFlag = &cli.StringFlag{
+	Name:  "out",
+	Usage: "--out history.csv writes the export to a file instead of stdout",
+}
+
+var startTimeFlag = &cli.Uint64Flag{
+	Name:  "start-time",
+	Usage: "--start-time 1672531200 (unix seconds)",
+}
+
+var endTimeFlag = &cli.Uint64Flag{
+	Name:  "end-time",
+	Usage: "--end-time 1704067200 (unix seconds)",
+	Value: math.MaxUint32,
+}
+
+var exportCommand = &cli.Command{
+	Name:        "export",
+	Description: "exports the bridge, swap, cctp and rfq activity of an address across all chains as csv or json",
+	Flags:       []cli.Flag{clickhouseAddressFlag, walletFlag, formatFlag, outFlag, startTimeFlag, endTimeFlag},
+	Action: func(c *cli.Context) (err error) {
+		format, err := export.ParseFormat(c.String(formatFlag.Name))
+		if err != nil {
+			return fmt.Errorf("could not parse format: %w", err)
+		}
+		db, err := api.InitDB(c.Context, c.String(clickhouseAddressFlag.Name), true, metrics.Get())
+		if err != nil {
+			return fmt.Errorf("could not initialize database: %w", err)
+		}
+		exporter, err := export.NewExporter(db)
+		if err != nil {
+			return fmt.Errorf("could not create exporter: %w", err)
+		}
+
+		out := os.Stdout
+		if c.String(outFlag.Name) != "" {
+			out, err = os.Create(core.ExpandOrReturnPath(c.String(outFlag.Name)))
+			if err != nil {
+				return fmt.Errorf("could not create output file: %w", err)
+			}
+			defer func() {
+				if closeErr := out.Close(); closeErr != nil && err == nil {
+					err = fmt.Errorf("could not close output file: %w", closeErr)
+				}
+			}()
+		}
+
+		err = exporter.Export(c.Context, c.String(walletFlag.Name), c.Uint64(startTimeFlag.Name), c.Uint64(endTimeFlag.Name), format, out)
+		if err != nil {
+			return fmt.Errorf("could not export: %w", err)
+		}
+
+		return nil
+	},
+}
+
 func init() {
 	portFlag.Value = uint(freeport.GetPort())
 }
@@ -5,6 +5,8 @@
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/richardwilkes/toolbox/collection"
 	"github.com/synapsecns/sanguine/services/explorer/config"
@@ -56,12 +58,38 @@ func ContractTypeFromString(s string) (ContractType, error) {
 	}
 }
 
+var (
+	// registeredContractTypesMux protects registeredContractTypes.
+	registeredContractTypesMux sync.RWMutex
+	// registeredContractTypes are the contract types of parsers registered outside of the built-in contract types.
+	registeredContractTypes = make(map[string]bool)
+)
+
+// RegisterContractType makes a contract type of a registered parser valid in the config.
+func RegisterContractType(contractType string) {
+	registeredContractTypesMux.Lock()
+	defer registeredContractTypesMux.Unlock()
+
+	registeredContractTypes[contractType] = true
+}
+
+// IsRegisteredContractType checks if a contract type was registered by a parser.
+func IsRegisteredContractType(contractType string) bool {
+	registeredContractTypesMux.RLock()
+	defer registeredContractTypesMux.RUnlock()
+
+	return registeredContractTypes[contractType]
+}
+
 // Config is used to configure the explorer's data consumption.
 type Config struct {
 	// DefaultRefreshRate is the default rate at which data is refreshed.
 	DefaultRefreshRate int `yaml:"default_refresh_rate"`
 	// ScribeURL is the URL of the Scribe server.
 	ScribeURL string `yaml:"scribe_url"`
+	// ScribeConsumerToken is the consumer token of the Scribe server. When it is set the explorer reports the last
+	// block it processed on each chain, so Scribe's retention does not prune data the explorer has yet to parse.
+	ScribeConsumerToken string `yaml:"scribe_consumer_token"`
 	// RPCURL is the URL of the RPC server.
 	RPCURL string `yaml:"rpc_url"`
 	// BridgeConfigAddress is the address of BridgeConfig contract.
@@ -70,6 +98,96 @@ type Config struct {
 	BridgeConfigChainID uint32 `yaml:"bridge_config_chain_id"`
 	// Chains stores the chain configurations.
 	Chains []ChainConfig `yaml:"chains"`
+	// Prices is the configuration of the historical price service.
+	Prices PricesConfig `yaml:"prices"`
+}
+
+// PricesConfig is the configuration of the historical price service. When it is disabled every price is requested
+// from defillama and only cached in memory.
+type PricesConfig struct {
+	// Enabled stores the prices in the database and fetches them a window at a time.
+	Enabled bool `yaml:"enabled"`
+	// Granularity is the granularity of the stored prices, hourly or daily. Defaults to hourly.
+	Granularity string `yaml:"granularity"`
+	// Providers are the price providers in the order they are tried, defillama or coingecko. Defaults to defillama.
+	// Pool fallbacks are tried after the providers.
+	Providers []string `yaml:"providers"`
+	// CoinGeckoAPIKey is the coingecko pro api key, the public api is used without it.
+	CoinGeckoAPIKey string `yaml:"coingecko_api_key"`
+	// Pools are on-chain pools pricing tokens the providers do not know.
+	Pools []PricePoolConfig `yaml:"pools"`
+}
+
+// PricePoolConfig prices a token by quoting it against another token of a swap pool.
+type PricePoolConfig struct {
+	// CoinGeckoID is the coingecko id of the priced token.
+	CoinGeckoID string `yaml:"coingecko_id"`
+	// ChainID is the chain id of the pool.
+	ChainID uint32 `yaml:"chain_id"`
+	// Address is the address of the pool.
+	Address string `yaml:"address"`
+	// TokenIndex is the index of the priced token in the pool.
+	TokenIndex uint8 `yaml:"token_index"`
+	// TokenDecimals are the decimals of the priced token.
+	TokenDecimals uint8 `yaml:"token_decimals"`
+	// QuoteTokenIndex is the index of the token the priced token is quoted in.
+	QuoteTokenIndex uint8 `yaml:"quote_token_index"`
+	// QuoteTokenDecimals are the decimals of the quote token.
+	QuoteTokenDecimals uint8 `yaml:"quote_token_decimals"`
+	// QuoteCoinGeckoID is the coingecko id of the quote token, it is assumed to be worth 1 USD when empty.
+	QuoteCoinGeckoID string `yaml:"quote_coingecko_id"`
+}
+
+// GetGranularity gets the granularity of the stored prices.
+func (p PricesConfig) GetGranularity() (time.Duration, error) {
+	switch p.Granularity {
+	case "", "hourly":
+		return time.Hour, nil
+	case "daily":
+		return 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("unknown price granularity %s, expected hourly or daily", p.Granularity)
+	}
+}
+
+// GetProviders gets the names of the price providers.
+func (p PricesConfig) GetProviders() []string {
+	if len(p.Providers) == 0 {
+		return []string{"defillama"}
+	}
+
+	return p.Providers
+}
+
+// IsValid validates the prices config.
+func (p PricesConfig) IsValid() error {
+	if !p.Enabled {
+		return nil
+	}
+
+	_, err := p.GetGranularity()
+	if err != nil {
+		return err
+	}
+
+	for _, provider := range p.GetProviders() {
+		if provider != "defillama" && provider != "coingecko" {
+			return fmt.Errorf("unknown price provider %s, expected defillama or coingecko", provider)
+		}
+	}
+
+	for _, pool := range p.Pools {
+		switch {
+		case pool.CoinGeckoID == "":
+			return fmt.Errorf("coingecko_id, %w", config.ErrRequiredGlobalField)
+		case !common.IsHexAddress(pool.Address):
+			return fmt.Errorf("invalid pool address %s for %s", pool.Address, pool.CoinGeckoID)
+		case pool.TokenIndex == pool.QuoteTokenIndex:
+			return fmt.Errorf("pool of %s quotes the token in itself", pool.CoinGeckoID)
+		}
+	}
+
+	return nil
 }
 
 // ChainConfig is the configuration for a chain.
@@ -124,6 +242,11 @@ func (c *Config) IsValid() error {
 		}
 	}
 
+	err := c.Prices.IsValid()
+	if err != nil {
+		return fmt.Errorf("prices config is invalid: %w", err)
+	}
+
 	return nil
 }
 
@@ -154,7 +277,7 @@ func (c ChainConfig) IsValid() error {
 // IsValid validates the chain config.
 func (c ContractConfig) IsValid() error {
 	_, err := ContractTypeFromString(c.ContractType)
-	if err != nil {
+	if err != nil && !IsRegisteredContractType(c.ContractType) {
 		return fmt.Errorf("contract_type %s invalid for address %s", c.ContractType, c.Address)
 	}
 
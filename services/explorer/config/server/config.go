@@ -5,6 +5,7 @@
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/richardwilkes/toolbox/collection"
 	"github.com/synapsecns/sanguine/services/explorer/config"
@@ -33,6 +34,14 @@ type Config struct {
 	SwapTopicHash string `yaml:"swap_topic_hash"`
 	// Chains stores the chain configurations.
 	Chains map[uint32]ChainConfig `yaml:"chains"`
+	// StuckTransfers configures the detection of bridge transfers that were not completed on the destination chain.
+	StuckTransfers StuckTransfersConfig `yaml:"stuck_transfers"`
+	// Subscriptions configures the graphql subscriptions to transfer events.
+	Subscriptions SubscriptionsConfig `yaml:"subscriptions"`
+	// Cache configures the api response cache.
+	Cache CacheConfig `yaml:"cache"`
+	// Export configures the address export endpoint.
+	Export ExportConfig `yaml:"export"`
 }
 
 // ChainConfig is the config for each chain in the server config.
@@ -61,6 +70,251 @@ type ContractsConfig struct {
 	RFQ string `yaml:"rfq"`
 }
 
+// StuckTransfersConfig is the config for the stuck transfer detector.
+type StuckTransfersConfig struct {
+	// Enabled starts the background detector that emits metrics and webhooks for stuck transfers.
+	Enabled bool `yaml:"enabled"`
+	// DefaultSLA is the number of seconds after which a transfer without a destination event is considered stuck.
+	DefaultSLA int `yaml:"default_sla"`
+	// SLAs overrides the default sla for specific chain pairs.
+	SLAs []ChainPairSLA `yaml:"slas"`
+	// Lookback is the number of seconds to look back for stuck transfers.
+	Lookback int `yaml:"lookback"`
+	// CheckInterval is the number of seconds between two runs of the detector.
+	CheckInterval int `yaml:"check_interval"`
+	// WebhookURL is the url newly stuck transfers are posted to. No webhook is sent if empty.
+	WebhookURL string `yaml:"webhook_url"`
+}
+
+// ChainPairSLA is the sla of transfers between two chains.
+type ChainPairSLA struct {
+	// OriginChainID is the chain id of the origin chain.
+	OriginChainID uint32 `yaml:"origin_chain_id"`
+	// DestinationChainID is the chain id of the destination chain.
+	DestinationChainID uint32 `yaml:"destination_chain_id"`
+	// SLA is the number of seconds after which a transfer between the chains is considered stuck.
+	SLA int `yaml:"sla"`
+}
+
+const (
+	defaultStuckTransferSLA      = time.Hour
+	defaultStuckTransferLookback = 7 * 24 * time.Hour
+	defaultStuckTransferInterval = 5 * time.Minute
+)
+
+// GetSLA gets the sla of transfers from the origin to the destination chain.
+func (s StuckTransfersConfig) GetSLA(originChainID, destinationChainID uint32) time.Duration {
+	for _, pair := range s.SLAs {
+		if pair.OriginChainID == originChainID && pair.DestinationChainID == destinationChainID {
+			return time.Duration(pair.SLA) * time.Second
+		}
+	}
+
+	return s.GetDefaultSLA()
+}
+
+// GetDefaultSLA gets the sla of chain pairs without an override.
+func (s StuckTransfersConfig) GetDefaultSLA() time.Duration {
+	if s.DefaultSLA == 0 {
+		return defaultStuckTransferSLA
+	}
+
+	return time.Duration(s.DefaultSLA) * time.Second
+}
+
+// GetMinSLA gets the shortest sla of all chain pairs.
+func (s StuckTransfersConfig) GetMinSLA() time.Duration {
+	minSLA := s.GetDefaultSLA()
+	for _, pair := range s.SLAs {
+		if sla := time.Duration(pair.SLA) * time.Second; sla < minSLA {
+			minSLA = sla
+		}
+	}
+
+	return minSLA
+}
+
+// GetLookback gets how far back to look for stuck transfers.
+func (s StuckTransfersConfig) GetLookback() time.Duration {
+	if s.Lookback == 0 {
+		return defaultStuckTransferLookback
+	}
+
+	return time.Duration(s.Lookback) * time.Second
+}
+
+// GetCheckInterval gets the interval between two runs of the detector.
+func (s StuckTransfersConfig) GetCheckInterval() time.Duration {
+	if s.CheckInterval == 0 {
+		return defaultStuckTransferInterval
+	}
+
+	return time.Duration(s.CheckInterval) * time.Second
+}
+
+// IsValid checks if the entered StuckTransfersConfig is valid.
+func (s StuckTransfersConfig) IsValid() error {
+	if s.DefaultSLA < 0 || s.Lookback < 0 || s.CheckInterval < 0 {
+		return fmt.Errorf("stuck_transfers durations cannot be negative")
+	}
+	for _, pair := range s.SLAs {
+		switch {
+		case pair.OriginChainID == 0 || pair.DestinationChainID == 0:
+			return fmt.Errorf("stuck_transfers sla chain ids cannot be 0")
+		case pair.SLA <= 0:
+			return fmt.Errorf("stuck_transfers sla for %d to %d must be positive", pair.OriginChainID, pair.DestinationChainID)
+		}
+	}
+
+	return nil
+}
+
+// SubscriptionsConfig is the config for the graphql subscriptions to transfer events.
+type SubscriptionsConfig struct {
+	// PollInterval is the number of seconds between two reads of the newly stored events of every subscription.
+	PollInterval int `yaml:"poll_interval"`
+	// BufferSize is the number of events buffered per subscription. Events are dropped for subscribers that do not
+	// keep up.
+	BufferSize int `yaml:"buffer_size"`
+}
+
+const (
+	defaultSubscriptionPollInterval = 2 * time.Second
+	defaultSubscriptionBufferSize   = 100
+)
+
+// GetPollInterval gets the interval between two reads of the newly stored events.
+func (s SubscriptionsConfig) GetPollInterval() time.Duration {
+	if s.PollInterval == 0 {
+		return defaultSubscriptionPollInterval
+	}
+
+	return time.Duration(s.PollInterval) * time.Second
+}
+
+// GetBufferSize gets the number of events buffered per subscription.
+func (s SubscriptionsConfig) GetBufferSize() int {
+	if s.BufferSize == 0 {
+		return defaultSubscriptionBufferSize
+	}
+
+	return s.BufferSize
+}
+
+// IsValid checks if the entered SubscriptionsConfig is valid.
+func (s SubscriptionsConfig) IsValid() error {
+	if s.PollInterval < 0 || s.BufferSize < 0 {
+		return fmt.Errorf("subscriptions poll_interval and buffer_size cannot be negative")
+	}
+
+	return nil
+}
+
+// CacheConfig is the config for the api response cache.
+type CacheConfig struct {
+	// Size is the max number of responses kept in memory. The least recently used responses are evicted first.
+	Size int `yaml:"size"`
+	// DefaultTTL is the number of seconds responses of queries without a query config are cached for.
+	DefaultTTL int `yaml:"default_ttl"`
+	// Queries configures the caching of specific queries, by query name (e.g. amountStatistic).
+	Queries map[string]QueryCacheConfig `yaml:"queries"`
+	// Shared stores the responses in the database as well, so they are computed and hydrated by a single api replica.
+	// The table is created by the indexer's migrations.
+	Shared bool `yaml:"shared"`
+	// InvalidationInterval is the number of seconds between two checks for newly stored blocks.
+	InvalidationInterval int `yaml:"invalidation_interval"`
+}
+
+// QueryCacheConfig is the cache config of a query.
+type QueryCacheConfig struct {
+	// TTL is the number of seconds responses of the query are cached for.
+	TTL int `yaml:"ttl"`
+	// InvalidateOnNewBlocks drops the responses of the query when new blocks are stored for their chain. Responses
+	// computed from every chain are dropped when new blocks are stored for any chain.
+	InvalidateOnNewBlocks bool `yaml:"invalidate_on_new_blocks"`
+}
+
+const (
+	defaultCacheSize                 = 1000
+	defaultCacheTTL                  = time.Hour
+	defaultCacheInvalidationInterval = 30 * time.Second
+)
+
+// GetSize gets the max number of responses kept in memory.
+func (c CacheConfig) GetSize() int {
+	if c.Size == 0 {
+		return defaultCacheSize
+	}
+
+	return c.Size
+}
+
+// GetTTL gets how long the responses of a query are cached for.
+func (c CacheConfig) GetTTL(query string) time.Duration {
+	if queryConfig, ok := c.Queries[query]; ok && queryConfig.TTL != 0 {
+		return time.Duration(queryConfig.TTL) * time.Second
+	}
+	if c.DefaultTTL == 0 {
+		return defaultCacheTTL
+	}
+
+	return time.Duration(c.DefaultTTL) * time.Second
+}
+
+// InvalidateOnNewBlocks checks if the responses of a query are dropped when new blocks are stored.
+func (c CacheConfig) InvalidateOnNewBlocks(query string) bool {
+	return c.Queries[query].InvalidateOnNewBlocks
+}
+
+// GetInvalidationInterval gets the interval between two checks for newly stored blocks.
+func (c CacheConfig) GetInvalidationInterval() time.Duration {
+	if c.InvalidationInterval == 0 {
+		return defaultCacheInvalidationInterval
+	}
+
+	return time.Duration(c.InvalidationInterval) * time.Second
+}
+
+// IsValid checks if the entered CacheConfig is valid.
+func (c CacheConfig) IsValid() error {
+	if c.Size < 0 || c.DefaultTTL < 0 || c.InvalidationInterval < 0 {
+		return fmt.Errorf("cache size, default_ttl and invalidation_interval cannot be negative")
+	}
+	for query, queryConfig := range c.Queries {
+		if queryConfig.TTL < 0 {
+			return fmt.Errorf("cache ttl of %s cannot be negative", query)
+		}
+	}
+
+	return nil
+}
+
+// ExportConfig is the config for the address export endpoint.
+type ExportConfig struct {
+	// MaxConcurrent is the max number of exports streamed at once. Requests over the limit are rejected with a 429.
+	MaxConcurrent int `yaml:"max_concurrent"`
+}
+
+const defaultExportMaxConcurrent = 4
+
+// GetMaxConcurrent gets the max number of exports streamed at once.
+func (c ExportConfig) GetMaxConcurrent() int {
+	if c.MaxConcurrent == 0 {
+		return defaultExportMaxConcurrent
+	}
+
+	return c.MaxConcurrent
+}
+
+// IsValid checks if the entered ExportConfig is valid.
+func (c ExportConfig) IsValid() error {
+	if c.MaxConcurrent < 0 {
+		return fmt.Errorf("export max_concurrent cannot be negative")
+	}
+
+	return nil
+}
+
 // IsValid makes sure the config is valid.
 func (c *Config) IsValid() error {
 	switch {
@@ -87,6 +341,26 @@ func (c *Config) IsValid() error {
 		intSet.Add(chain.ChainID)
 	}
 
+	err := c.StuckTransfers.IsValid()
+	if err != nil {
+		return err
+	}
+
+	err = c.Subscriptions.IsValid()
+	if err != nil {
+		return err
+	}
+
+	err = c.Cache.IsValid()
+	if err != nil {
+		return err
+	}
+
+	err = c.Export.IsValid()
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -19,24 +19,35 @@ func NewClient(cli *http.Client, baseURL string, options ...client.HTTPRequestOp
 }
 
 type Query struct {
-	Logs                     []*model.Log         "json:\"logs\" graphql:\"logs\""
-	LogsRange                []*model.Log         "json:\"logsRange\" graphql:\"logsRange\""
-	Receipts                 []*model.Receipt     "json:\"receipts\" graphql:\"receipts\""
-	ReceiptsRange            []*model.Receipt     "json:\"receiptsRange\" graphql:\"receiptsRange\""
-	Transactions             []*model.Transaction "json:\"transactions\" graphql:\"transactions\""
-	TransactionsRange        []*model.Transaction "json:\"transactionsRange\" graphql:\"transactionsRange\""
-	BlockTime                *int                 "json:\"blockTime\" graphql:\"blockTime\""
-	LastStoredBlockNumber    *int                 "json:\"lastStoredBlockNumber\" graphql:\"lastStoredBlockNumber\""
-	FirstStoredBlockNumber   *int                 "json:\"firstStoredBlockNumber\" graphql:\"firstStoredBlockNumber\""
-	LastConfirmedBlockNumber *int                 "json:\"lastConfirmedBlockNumber\" graphql:\"lastConfirmedBlockNumber\""
-	TxSender                 *string              "json:\"txSender\" graphql:\"txSender\""
-	LastIndexed              *int                 "json:\"lastIndexed\" graphql:\"lastIndexed\""
-	LogCount                 *int                 "json:\"logCount\" graphql:\"logCount\""
-	ReceiptCount             *int                 "json:\"receiptCount\" graphql:\"receiptCount\""
-	BlockTimeCount           *int                 "json:\"blockTimeCount\" graphql:\"blockTimeCount\""
-	LogsAtHeadRange          []*model.Log         "json:\"logsAtHeadRange\" graphql:\"logsAtHeadRange\""
-	ReceiptsAtHeadRange      []*model.Receipt     "json:\"receiptsAtHeadRange\" graphql:\"receiptsAtHeadRange\""
-	TransactionsAtHeadRange  []*model.Transaction "json:\"transactionsAtHeadRange\" graphql:\"transactionsAtHeadRange\""
+	Logs                     []*model.Log                 "json:\"logs\" graphql:\"logs\""
+	LogsRange                []*model.Log                 "json:\"logsRange\" graphql:\"logsRange\""
+	Receipts                 []*model.Receipt             "json:\"receipts\" graphql:\"receipts\""
+	ReceiptsRange            []*model.Receipt             "json:\"receiptsRange\" graphql:\"receiptsRange\""
+	Transactions             []*model.Transaction         "json:\"transactions\" graphql:\"transactions\""
+	TransactionsRange        []*model.Transaction         "json:\"transactionsRange\" graphql:\"transactionsRange\""
+	BlockTime                *int                         "json:\"blockTime\" graphql:\"blockTime\""
+	LastStoredBlockNumber    *int                         "json:\"lastStoredBlockNumber\" graphql:\"lastStoredBlockNumber\""
+	FirstStoredBlockNumber   *int                         "json:\"firstStoredBlockNumber\" graphql:\"firstStoredBlockNumber\""
+	LastConfirmedBlockNumber *int                         "json:\"lastConfirmedBlockNumber\" graphql:\"lastConfirmedBlockNumber\""
+	TxSender                 *string                      "json:\"txSender\" graphql:\"txSender\""
+	LastIndexed              *int                         "json:\"lastIndexed\" graphql:\"lastIndexed\""
+	LogCount                 *int                         "json:\"logCount\" graphql:\"logCount\""
+	ReceiptCount             *int                         "json:\"receiptCount\" graphql:\"receiptCount\""
+	BlockTimeCount           *int                         "json:\"blockTimeCount\" graphql:\"blockTimeCount\""
+	LogsAtHeadRange          []*model.Log                 "json:\"logsAtHeadRange\" graphql:\"logsAtHeadRange\""
+	ReceiptsAtHeadRange      []*model.Receipt             "json:\"receiptsAtHeadRange\" graphql:\"receiptsAtHeadRange\""
+	TransactionsAtHeadRange  []*model.Transaction         "json:\"transactionsAtHeadRange\" graphql:\"transactionsAtHeadRange\""
+	BlockHeader              *model.BlockHeader           "json:\"blockHeader\" graphql:\"blockHeader\""
+	BlockHeaders             []*model.BlockHeader         "json:\"blockHeaders\" graphql:\"blockHeaders\""
+	InternalTransactions     []*model.InternalTransaction "json:\"internalTransactions\" graphql:\"internalTransactions\""
+	ConsumerCursors          []*model.ConsumerCursor      "json:\"consumerCursors\" graphql:\"consumerCursors\""
+	LogsConnection           *model.LogConnection         "json:\"logsConnection\" graphql:\"logsConnection\""
+	ReceiptsConnection       *model.ReceiptConnection     "json:\"receiptsConnection\" graphql:\"receiptsConnection\""
+	TransactionsConnection   *model.TransactionConnection "json:\"transactionsConnection\" graphql:\"transactionsConnection\""
+	LogAggregates            []*model.LogAggregate        "json:\"logAggregates\" graphql:\"logAggregates\""
+}
+type Mutation struct {
+	UpdateConsumerCursor *model.ConsumerCursor "json:\"updateConsumerCursor\" graphql:\"updateConsumerCursor\""
 }
 type GetLogsRange struct {
 	Response []*struct {
@@ -97,6 +108,14 @@ type GetReceiptCount struct {
 type GetBlockTimeCount struct {
 	Response *int "json:\"response\" graphql:\"response\""
 }
+type UpdateConsumerCursor struct {
+	Response *struct {
+		Consumer    string "json:\"consumer\" graphql:\"consumer\""
+		ChainID     int    "json:\"chain_id\" graphql:\"chain_id\""
+		BlockNumber int    "json:\"block_number\" graphql:\"block_number\""
+		UpdatedAt   int    "json:\"updated_at\" graphql:\"updated_at\""
+	} "json:\"response\" graphql:\"response\""
+}
 
 const GetLogsRangeDocument = `query GetLogsRange ($chain_id: Int!, $start_block: Int!, $end_block: Int!, $page: Int!, $contract_address: String) {
 	response: logsRange(chain_id: $chain_id, start_block: $start_block, end_block: $end_block, page: $page, contract_address: $contract_address) {
@@ -331,3 +350,28 @@ func (c *Client) GetBlockTimeCount(ctx context.Context, chainID int, httpRequest
 
 	return &res, nil
 }
+
+const UpdateConsumerCursorDocument = `mutation UpdateConsumerCursor ($consumer: String!, $chain_id: Int!, $block_number: Int!) {
+	response: updateConsumerCursor(consumer: $consumer, chain_id: $chain_id, block_number: $block_number) {
+		consumer
+		chain_id
+		block_number
+		updated_at
+	}
+}
+`
+
+func (c *Client) UpdateConsumerCursor(ctx context.Context, consumer string, chainID int, blockNumber int, httpRequestOptions ...client.HTTPRequestOption) (*UpdateConsumerCursor, error) {
+	vars := map[string]interface{}{
+		"consumer":     consumer,
+		"chain_id":     chainID,
+		"block_number": blockNumber,
+	}
+
+	var res UpdateConsumerCursor
+	if err := c.Client.Post(ctx, "UpdateConsumerCursor", UpdateConsumerCursorDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
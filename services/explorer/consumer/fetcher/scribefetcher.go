@@ -4,6 +4,7 @@
 	"context"
 	"fmt"
 	"github.com/synapsecns/sanguine/core/metrics"
+	"net/http"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -25,21 +26,42 @@ type ScribeFetcher interface {
 	FetchBlockTime(ctx context.Context, chainID int, blockNumber int) (*int, error)
 	// FetchTx fetches the transaction.
 	FetchTx(ctx context.Context, tx string, chainID int, blockNumber int) (*uint64, *string, error)
+	// UpdateConsumerCursor reports the last block processed on a chain to Scribe, so it does not prune unprocessed data.
+	UpdateConsumerCursor(ctx context.Context, chainID uint32, blockNumber uint64) error
 }
 
 type scribeFetcherImpl struct {
 	underlyingClient *client.Client
 	handler          metrics.Handler
+	// consumerToken authorizes the cursor updates. Cursors are not reported without it.
+	consumerToken string
 }
 
 const retryThreshold = 5
 
+// consumerName is the name the explorer reports its cursors under.
+const consumerName = "explorer"
+
+// Option configures the fetcher.
+type Option func(*scribeFetcherImpl)
+
+// WithConsumerToken sets the token used to report the consumer cursor to Scribe.
+func WithConsumerToken(token string) Option {
+	return func(s *scribeFetcherImpl) {
+		s.consumerToken = token
+	}
+}
+
 // NewFetcher creates a new fetcher.
-func NewFetcher(fetchClient *client.Client, handler metrics.Handler) ScribeFetcher {
-	return &scribeFetcherImpl{
+func NewFetcher(fetchClient *client.Client, handler metrics.Handler, options ...Option) ScribeFetcher {
+	fetcher := &scribeFetcherImpl{
 		underlyingClient: fetchClient,
 		handler:          handler,
 	}
+	for _, option := range options {
+		option(fetcher)
+	}
+	return fetcher
 }
 
 func (s scribeFetcherImpl) FetchTxSender(ctx context.Context, chainID uint32, txHash string) (string, error) {
@@ -195,3 +217,18 @@ func (s scribeFetcherImpl) FetchTx(ctx context.Context, tx string, chainID int,
 		return &blocktime, &sender, nil
 	}
 }
+
+// UpdateConsumerCursor reports the last block processed on a chain to Scribe. It is a no-op without a consumer token.
+func (s scribeFetcherImpl) UpdateConsumerCursor(ctx context.Context, chainID uint32, blockNumber uint64) error {
+	if s.consumerToken == "" {
+		return nil
+	}
+
+	_, err := s.underlyingClient.UpdateConsumerCursor(ctx, consumerName, int(chainID), int(blockNumber), func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+s.consumerToken)
+	})
+	if err != nil {
+		return fmt.Errorf("could not update consumer cursor for chain %d: %w", chainID, err)
+	}
+	return nil
+}
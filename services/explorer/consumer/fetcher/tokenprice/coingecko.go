@@ -0,0 +1,68 @@
+package tokenprice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// CoinGeckoURL is the url of the public coingecko api.
+	CoinGeckoURL = "https://api.coingecko.com/api/v3"
+	// CoinGeckoProURL is the url of the coingecko api for api keys.
+	CoinGeckoProURL = "https://pro-api.coingecko.com/api/v3"
+)
+
+// CoinGeckoProvider fetches market charts from coingecko. Coingecko returns hourly prices for ranges up to 90 days and
+// daily prices for longer ranges.
+type CoinGeckoProvider struct {
+	// baseURL is the url of the coingecko api.
+	baseURL string
+	// apiKey is the coingecko pro api key, it is optional.
+	apiKey string
+	// client is the http client.
+	client *http.Client
+}
+
+// NewCoinGeckoProvider creates a new coingecko provider.
+func NewCoinGeckoProvider(baseURL, apiKey string, client *http.Client) *CoinGeckoProvider {
+	return &CoinGeckoProvider{
+		baseURL: baseURL,
+		apiKey:  apiKey,
+		client:  client,
+	}
+}
+
+// Name gets the name of the provider.
+func (c *CoinGeckoProvider) Name() string {
+	return "coingecko"
+}
+
+type coinGeckoMarketChart struct {
+	// Prices are pairs of a timestamp in milliseconds and a price.
+	Prices [][2]float64 `json:"prices"`
+}
+
+// GetPrices gets the prices of a token from the coingecko market chart range endpoint.
+func (c *CoinGeckoProvider) GetPrices(ctx context.Context, coinGeckoID string, start, end time.Time, _ time.Duration) ([]Price, error) {
+	url := fmt.Sprintf("%s/coins/%s/market_chart/range?vs_currency=usd&from=%d&to=%d", c.baseURL, coinGeckoID, start.Unix(), end.Unix())
+
+	var headers map[string]string
+	if c.apiKey != "" {
+		headers = map[string]string{"x-cg-pro-api-key": c.apiKey}
+	}
+
+	var res coinGeckoMarketChart
+	err := getJSON(ctx, c.client, url, headers, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]Price, 0, len(res.Prices))
+	for _, price := range res.Prices {
+		prices = append(prices, Price{Time: time.UnixMilli(int64(price[0])), Price: price[1]})
+	}
+
+	return prices, nil
+}
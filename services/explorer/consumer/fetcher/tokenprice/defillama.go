@@ -0,0 +1,70 @@
+package tokenprice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DefiLlamaURL is the url of the defillama coins api.
+const DefiLlamaURL = "https://coins.llama.fi"
+
+// DefiLlamaProvider fetches price charts from defillama.
+type DefiLlamaProvider struct {
+	// baseURL is the url of the defillama coins api.
+	baseURL string
+	// client is the http client.
+	client *http.Client
+}
+
+// NewDefiLlamaProvider creates a new defillama provider.
+func NewDefiLlamaProvider(baseURL string, client *http.Client) *DefiLlamaProvider {
+	return &DefiLlamaProvider{
+		baseURL: baseURL,
+		client:  client,
+	}
+}
+
+// Name gets the name of the provider.
+func (d *DefiLlamaProvider) Name() string {
+	return "defillama"
+}
+
+type defiLlamaChart struct {
+	Coins map[string]struct {
+		Prices []struct {
+			Timestamp int64   `json:"timestamp"`
+			Price     float64 `json:"price"`
+		} `json:"prices"`
+	} `json:"coins"`
+}
+
+// GetPrices gets the prices of a token from the defillama chart endpoint.
+func (d *DefiLlamaProvider) GetPrices(ctx context.Context, coinGeckoID string, start, end time.Time, granularity time.Duration) ([]Price, error) {
+	coin := "coingecko:" + coinGeckoID
+	span := int64(end.Sub(start)/granularity) + 1
+	url := fmt.Sprintf("%s/chart/%s?start=%d&span=%d&period=%s", d.baseURL, coin, start.Unix(), span, period(granularity))
+
+	var res defiLlamaChart
+	err := getJSON(ctx, d.client, url, nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]Price, 0, len(res.Coins[coin].Prices))
+	for _, price := range res.Coins[coin].Prices {
+		prices = append(prices, Price{Time: time.Unix(price.Timestamp, 0), Price: price.Price})
+	}
+
+	return prices, nil
+}
+
+// period formats a granularity as a defillama period.
+func period(granularity time.Duration) string {
+	if granularity >= Daily && granularity%Daily == 0 {
+		return fmt.Sprintf("%dd", granularity/Daily)
+	}
+
+	return fmt.Sprintf("%dh", granularity/time.Hour)
+}
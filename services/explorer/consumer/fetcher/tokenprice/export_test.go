@@ -0,0 +1,8 @@
+package tokenprice
+
+import "time"
+
+// SetNow sets the clock of the historical service for testing.
+func (h *HistoricalService) SetNow(now func() time.Time) {
+	h.now = now
+}
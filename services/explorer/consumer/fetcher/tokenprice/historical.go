@@ -0,0 +1,286 @@
+package tokenprice
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// Hourly is the granularity of hourly prices.
+	Hourly = time.Hour
+	// Daily is the granularity of daily prices.
+	Daily = 24 * time.Hour
+)
+
+const (
+	// windowBuckets is the number of buckets loaded and fetched at once.
+	windowBuckets = 168
+	// maxBucketDistance is the number of buckets a price can be away from the requested bucket when the requested
+	// bucket has no price, providers skip buckets now and then.
+	maxBucketDistance = 3
+	// refreshInterval is the minimum time between two fetches of the missing prices of a window.
+	refreshInterval = 5 * time.Minute
+	// maxRefreshInterval caps the backoff of windows whose fetches keep returning no new prices.
+	maxRefreshInterval = 24 * time.Hour
+	// windowCacheSize is the number of windows kept in memory.
+	windowCacheSize = 1000
+)
+
+// window holds the prices of a token for windowBuckets buckets.
+type window struct {
+	// prices are the prices by bucket start.
+	prices map[int64]float64
+	// complete is true when every bucket that has started has a price.
+	complete bool
+	// fetchedAt is the time the missing prices were last fetched.
+	fetchedAt time.Time
+	// failedRefreshes is the number of consecutive refreshes that found no new prices.
+	failedRefreshes int
+}
+
+// refreshAt gets the time the missing prices of the window can be fetched again. The interval doubles with every
+// refresh that found no new prices, so buckets the providers never price are not refetched every refresh interval.
+func (w *window) refreshAt() time.Time {
+	interval := refreshInterval
+	for i := 0; i < w.failedRefreshes && interval < maxRefreshInterval; i++ {
+		interval *= 2
+	}
+	if interval > maxRefreshInterval {
+		interval = maxRefreshInterval
+	}
+
+	return w.fetchedAt.Add(interval)
+}
+
+// HistoricalService is a price service that stores the prices of tokens in the database. Prices are fetched from the
+// providers a window of buckets at a time, so once a window is stored lookups in it do not need network calls.
+type HistoricalService struct {
+	// db stores the prices.
+	db db.ConsumerDB
+	// granularity is the length of a price bucket.
+	granularity time.Duration
+	// providers are the providers, tried in order.
+	providers []Provider
+	// windows caches the windows by coingecko id and window start.
+	windows *lru.Cache[string, *window]
+	// group deduplicates concurrent loads of a window.
+	group singleflight.Group
+	// mux protects the windows while they are refreshed.
+	mux sync.Mutex
+	// now is the clock.
+	now func() time.Time
+}
+
+// NewHistoricalService creates a new historical price service.
+func NewHistoricalService(consumerDB db.ConsumerDB, granularity time.Duration, providers ...Provider) (*HistoricalService, error) {
+	if granularity <= 0 {
+		return nil, fmt.Errorf("granularity must be positive, got %s", granularity)
+	}
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("at least one price provider is required")
+	}
+
+	windows, err := lru.New[string, *window](windowCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("could not create price window cache: %w", err)
+	}
+
+	return &HistoricalService{
+		db:          consumerDB,
+		granularity: granularity,
+		providers:   providers,
+		windows:     windows,
+		now:         time.Now,
+	}, nil
+}
+
+// GetPriceData gets the price of a token at a timestamp from the stored prices, fetching the missing prices of the
+// surrounding window from the providers.
+func (h *HistoricalService) GetPriceData(ctx context.Context, timestamp int, coinGeckoID string) *float64 {
+	if coinGeckoID == "NO_TOKEN" || coinGeckoID == "NO_PRICE" {
+		zero := float64(0)
+		return &zero
+	}
+
+	bucket := int64(timestamp) - int64(timestamp)%h.bucketSeconds()
+	windowStart := bucket - bucket%h.windowSeconds()
+
+	prices, err := h.getWindow(ctx, coinGeckoID, windowStart, bucket)
+	if err != nil {
+		logger.Errorf("could not get prices of %s at %d: %v", coinGeckoID, timestamp, err)
+		return nil
+	}
+
+	return nearestPrice(prices, bucket, h.bucketSeconds())
+}
+
+func (h *HistoricalService) bucketSeconds() int64 {
+	return int64(h.granularity.Seconds())
+}
+
+func (h *HistoricalService) windowSeconds() int64 {
+	return windowBuckets * h.bucketSeconds()
+}
+
+// getWindow gets the prices of the window of a token, loading it if it is not cached or if it misses the bucket and
+// its refresh backoff has passed.
+func (h *HistoricalService) getWindow(ctx context.Context, coinGeckoID string, windowStart, bucket int64) (map[int64]float64, error) {
+	key := fmt.Sprintf("%s_%d", coinGeckoID, windowStart)
+
+	h.mux.Lock()
+	cached, ok := h.windows.Get(key)
+	if ok {
+		_, hasBucket := cached.prices[bucket]
+		if hasBucket || cached.complete || h.now().Before(cached.refreshAt()) {
+			h.mux.Unlock()
+			return cached.prices, nil
+		}
+	}
+	h.mux.Unlock()
+
+	res, err, _ := h.group.Do(key, func() (interface{}, error) {
+		loaded, err := h.loadWindow(ctx, coinGeckoID, windowStart)
+		if err != nil {
+			return nil, err
+		}
+		// a refresh is only successful if it found prices the cached window did not have.
+		if ok && !loaded.complete && len(loaded.prices) <= len(cached.prices) {
+			loaded.failedRefreshes = cached.failedRefreshes + 1
+		}
+
+		h.mux.Lock()
+		defer h.mux.Unlock()
+		h.windows.Add(key, loaded)
+
+		return loaded.prices, nil
+	})
+	if err != nil {
+		//nolint: wrapcheck
+		return nil, err
+	}
+
+	prices, _ := res.(map[int64]float64)
+	return prices, nil
+}
+
+// loadWindow reads the stored prices of a window and fetches and stores the prices of the buckets that have started
+// but have no price.
+func (h *HistoricalService) loadWindow(ctx context.Context, coinGeckoID string, windowStart int64) (*window, error) {
+	bucketSeconds := h.bucketSeconds()
+	windowEnd := windowStart + h.windowSeconds() - bucketSeconds
+
+	stored, err := h.db.GetTokenPrices(ctx, coinGeckoID, uint64(bucketSeconds), uint64(windowStart), uint64(windowEnd))
+	if err != nil {
+		return nil, fmt.Errorf("could not get stored prices: %w", err)
+	}
+
+	loaded := &window{
+		prices:    make(map[int64]float64, windowBuckets),
+		fetchedAt: h.now(),
+	}
+	for _, price := range stored {
+		loaded.prices[int64(price.TimeStamp)] = price.Price
+	}
+
+	missing := h.missingBuckets(loaded.prices, windowStart, windowEnd)
+	for _, provider := range h.providers {
+		if len(missing) == 0 {
+			break
+		}
+
+		fetched, err := provider.GetPrices(ctx, coinGeckoID, time.Unix(missing[0], 0), time.Unix(missing[len(missing)-1]+bucketSeconds-1, 0), h.granularity)
+		if err != nil {
+			logger.Warnf("could not get prices of %s from %s: %v", coinGeckoID, provider.Name(), err)
+			continue
+		}
+
+		newPrices := h.fillMissing(loaded.prices, missing, fetched)
+		if len(newPrices) == 0 {
+			continue
+		}
+
+		for i := range newPrices {
+			newPrices[i].CoinGeckoID = coinGeckoID
+			newPrices[i].Source = provider.Name()
+		}
+		err = h.db.StoreTokenPrices(ctx, newPrices)
+		if err != nil {
+			return nil, fmt.Errorf("could not store prices: %w", err)
+		}
+
+		missing = h.missingBuckets(loaded.prices, windowStart, windowEnd)
+	}
+	loaded.complete = len(missing) == 0 && windowEnd+bucketSeconds <= h.now().Unix()
+
+	return loaded, nil
+}
+
+// missingBuckets gets the buckets of the window that have started but have no price.
+func (h *HistoricalService) missingBuckets(prices map[int64]float64, windowStart, windowEnd int64) []int64 {
+	now := h.now().Unix()
+
+	var missing []int64
+	for bucket := windowStart; bucket <= windowEnd && bucket <= now; bucket += h.bucketSeconds() {
+		if _, ok := prices[bucket]; !ok {
+			missing = append(missing, bucket)
+		}
+	}
+
+	return missing
+}
+
+// fillMissing adds the fetched prices of the missing buckets to prices and returns them. When several prices fall in
+// a bucket the earliest one is used.
+func (h *HistoricalService) fillMissing(prices map[int64]float64, missing []int64, fetched []Price) []sql.TokenPrice {
+	isMissing := make(map[int64]bool, len(missing))
+	for _, bucket := range missing {
+		isMissing[bucket] = true
+	}
+
+	sort.Slice(fetched, func(i, j int) bool {
+		return fetched[i].Time.Before(fetched[j].Time)
+	})
+
+	insertTime := uint64(h.now().UnixNano())
+	var newPrices []sql.TokenPrice
+	for _, price := range fetched {
+		bucket := price.Time.Unix() - price.Time.Unix()%h.bucketSeconds()
+		if !isMissing[bucket] {
+			continue
+		}
+		isMissing[bucket] = false
+
+		prices[bucket] = price.Price
+		newPrices = append(newPrices, sql.TokenPrice{
+			InsertTime:  insertTime,
+			Granularity: uint64(h.bucketSeconds()),
+			TimeStamp:   uint64(bucket),
+			Price:       price.Price,
+		})
+	}
+
+	return newPrices
+}
+
+// nearestPrice gets the price of the bucket, or of the nearest bucket at most maxBucketDistance buckets away.
+func nearestPrice(prices map[int64]float64, bucket, bucketSeconds int64) *float64 {
+	for distance := int64(0); distance <= maxBucketDistance; distance++ {
+		for _, candidate := range []int64{bucket - distance*bucketSeconds, bucket + distance*bucketSeconds} {
+			if price, ok := prices[candidate]; ok {
+				return &price
+			}
+		}
+	}
+
+	return nil
+}
+
+var _ Service = &HistoricalService{}
@@ -0,0 +1,92 @@
+package tokenprice
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// SwapQuoter quotes a swap in a pool, it is implemented by the swap contract bindings.
+type SwapQuoter interface {
+	// CalculateSwap gets the amount of the to token received for an amount of the from token.
+	CalculateSwap(opts *bind.CallOpts, tokenIndexFrom uint8, tokenIndexTo uint8, dx *big.Int) (*big.Int, error)
+}
+
+// PoolQuote prices a token by quoting it against another token of an on-chain pool.
+type PoolQuote struct {
+	// Quoter quotes swaps in the pool.
+	Quoter SwapQuoter
+	// TokenIndex is the index of the priced token in the pool.
+	TokenIndex uint8
+	// TokenDecimals are the decimals of the priced token.
+	TokenDecimals uint8
+	// QuoteTokenIndex is the index of the token the priced token is quoted in.
+	QuoteTokenIndex uint8
+	// QuoteTokenDecimals are the decimals of the quote token.
+	QuoteTokenDecimals uint8
+	// QuoteCoinGeckoID is the coingecko id of the quote token. The quote token is assumed to be worth 1 USD when it
+	// is empty.
+	QuoteCoinGeckoID string
+}
+
+// PoolProvider prices tokens from on-chain pools. Pools are only quoted at the latest block, so it only returns the
+// price of the current time bucket and is meant as a fallback for tokens the other providers do not know.
+type PoolProvider struct {
+	// quotes are the pool quotes by coingecko id.
+	quotes map[string]PoolQuote
+	// quotePrices prices the quote tokens.
+	quotePrices Service
+	// now is the clock.
+	now func() time.Time
+}
+
+// NewPoolProvider creates a new pool provider. quotePrices prices the quote tokens that are not assumed to be worth
+// 1 USD, it must not use the pool provider itself.
+func NewPoolProvider(quotes map[string]PoolQuote, quotePrices Service) *PoolProvider {
+	return &PoolProvider{
+		quotes:      quotes,
+		quotePrices: quotePrices,
+		now:         time.Now,
+	}
+}
+
+// Name gets the name of the provider.
+func (p *PoolProvider) Name() string {
+	return "pool"
+}
+
+// GetPrices quotes the token if the current time bucket is in the range.
+func (p *PoolProvider) GetPrices(ctx context.Context, coinGeckoID string, start, end time.Time, granularity time.Duration) ([]Price, error) {
+	quote, ok := p.quotes[coinGeckoID]
+	if !ok {
+		return nil, nil
+	}
+
+	now := p.now()
+	bucket := now.Truncate(granularity)
+	if bucket.Before(start) || bucket.After(end) {
+		return nil, nil
+	}
+
+	amountIn := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(quote.TokenDecimals)), nil)
+	amountOut, err := quote.Quoter.CalculateSwap(&bind.CallOpts{Context: ctx}, quote.TokenIndex, quote.QuoteTokenIndex, amountIn)
+	if err != nil {
+		return nil, fmt.Errorf("could not quote %s: %w", coinGeckoID, err)
+	}
+
+	quoteAmount, _ := new(big.Rat).SetFrac(amountOut, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(quote.QuoteTokenDecimals)), nil)).Float64()
+
+	quotePrice := 1.0
+	if quote.QuoteCoinGeckoID != "" {
+		price := p.quotePrices.GetPriceData(ctx, int(now.Unix()), quote.QuoteCoinGeckoID)
+		if price == nil {
+			return nil, fmt.Errorf("could not price quote token %s", quote.QuoteCoinGeckoID)
+		}
+		quotePrice = *price
+	}
+
+	return []Price{{Time: now, Price: quoteAmount * quotePrice}}, nil
+}
@@ -0,0 +1,56 @@
+package tokenprice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Price is the USD price of a token at a point in time.
+type Price struct {
+	// Time is the time of the price.
+	Time time.Time
+	// Price is the USD price.
+	Price float64
+}
+
+// Provider fetches historical prices of tokens by coingecko id.
+type Provider interface {
+	// Name is the name of the provider, it is stored as the source of the prices.
+	Name() string
+	// GetPrices gets the prices of a token between start and end with about one price per granularity. Tokens the
+	// provider does not know return no prices or an error, the next provider is tried in both cases.
+	GetPrices(ctx context.Context, coinGeckoID string, start, end time.Time, granularity time.Duration) ([]Price, error)
+}
+
+// getJSON requests a url and decodes the json response into res.
+func getJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, res interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not get %s: %w", url, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not get %s: status %d", url, resp.StatusCode)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(res)
+	if err != nil {
+		return fmt.Errorf("could not decode response of %s: %w", url, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package tokenprice_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher/tokenprice"
+	"github.com/synapsecns/sanguine/services/explorer/db/mocks"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+)
+
+// windowStart is the start of a price window of hourly prices.
+const windowStart = 1_699_488_000
+
+// fakeProvider returns a price for every hour of the requested range except the skipped hours.
+type fakeProvider struct {
+	mux   sync.Mutex
+	calls int
+	skip  map[int64]bool
+}
+
+func (f *fakeProvider) Name() string {
+	return "fake"
+}
+
+func (f *fakeProvider) GetPrices(_ context.Context, _ string, start, end time.Time, granularity time.Duration) ([]tokenprice.Price, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	f.calls++
+
+	var prices []tokenprice.Price
+	for current := start; !current.After(end); current = current.Add(granularity) {
+		if f.skip[current.Unix()] {
+			continue
+		}
+		// providers do not return prices at the exact start of a bucket.
+		prices = append(prices, tokenprice.Price{Time: current.Add(time.Minute), Price: float64(current.Unix())})
+	}
+
+	return prices, nil
+}
+
+func TestHistoricalServiceFetchesWindowOnce(t *testing.T) {
+	var stored []sql.TokenPrice
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("GetTokenPrices", mock.Anything, "ethereum", uint64(3600), uint64(windowStart), uint64(windowStart+167*3600)).Return([]sql.TokenPrice{}, nil).Once()
+	consumerDB.On("StoreTokenPrices", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		stored, _ = args.Get(1).([]sql.TokenPrice)
+	}).Return(nil).Once()
+
+	provider := &fakeProvider{skip: map[int64]bool{windowStart + 3600: true}}
+	service, err := tokenprice.NewHistoricalService(consumerDB, tokenprice.Hourly, provider)
+	require.NoError(t, err)
+
+	price := service.GetPriceData(context.Background(), windowStart+7200+30, "ethereum")
+	require.NotNil(t, price)
+	assert.Equal(t, float64(windowStart+7200), *price)
+
+	// the skipped bucket uses the nearest price, without fetching the window again.
+	price = service.GetPriceData(context.Background(), windowStart+3600, "ethereum")
+	require.NotNil(t, price)
+	assert.Equal(t, float64(windowStart), *price)
+
+	assert.Equal(t, 1, provider.calls)
+	require.Len(t, stored, 167)
+	assert.Equal(t, "ethereum", stored[0].CoinGeckoID)
+	assert.Equal(t, "fake", stored[0].Source)
+	assert.Equal(t, uint64(windowStart), stored[0].TimeStamp)
+	consumerDB.AssertExpectations(t)
+}
+
+func TestHistoricalServiceBacksOffMissingPrices(t *testing.T) {
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("GetTokenPrices", mock.Anything, "ethereum", uint64(3600), uint64(windowStart), uint64(windowStart+167*3600)).Return([]sql.TokenPrice{}, nil)
+	consumerDB.On("StoreTokenPrices", mock.Anything, mock.Anything).Return(nil)
+
+	// the provider never has a price for the second bucket.
+	provider := &fakeProvider{skip: map[int64]bool{windowStart + 3600: true}}
+	service, err := tokenprice.NewHistoricalService(consumerDB, tokenprice.Hourly, provider)
+	require.NoError(t, err)
+
+	start := time.Unix(windowStart+200*3600, 0)
+	now := start
+	service.SetNow(func() time.Time {
+		return now
+	})
+
+	requestAt := func(elapsed time.Duration) int {
+		now = start.Add(elapsed)
+		service.GetPriceData(context.Background(), windowStart+3600, "ethereum")
+
+		provider.mux.Lock()
+		defer provider.mux.Unlock()
+		return provider.calls
+	}
+
+	assert.Equal(t, 1, requestAt(0))
+	// the first refresh waits the refresh interval.
+	assert.Equal(t, 1, requestAt(4*time.Minute))
+	assert.Equal(t, 2, requestAt(6*time.Minute))
+	// the refresh found nothing, so the next one waits twice as long.
+	assert.Equal(t, 2, requestAt(15*time.Minute))
+	assert.Equal(t, 3, requestAt(17*time.Minute))
+	assert.Equal(t, 3, requestAt(36*time.Minute))
+	assert.Equal(t, 4, requestAt(38*time.Minute))
+}
+
+func TestHistoricalServiceUsesStoredPrices(t *testing.T) {
+	stored := make([]sql.TokenPrice, 168)
+	for i := range stored {
+		stored[i] = sql.TokenPrice{CoinGeckoID: "ethereum", Granularity: 3600, TimeStamp: uint64(windowStart + i*3600), Price: 2000}
+	}
+
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("GetTokenPrices", mock.Anything, "ethereum", uint64(3600), uint64(windowStart), uint64(windowStart+167*3600)).Return(stored, nil).Once()
+
+	provider := &fakeProvider{}
+	service, err := tokenprice.NewHistoricalService(consumerDB, tokenprice.Hourly, provider)
+	require.NoError(t, err)
+
+	price := service.GetPriceData(context.Background(), windowStart+100*3600, "ethereum")
+	require.NotNil(t, price)
+	assert.Equal(t, float64(2000), *price)
+	assert.Equal(t, 0, provider.calls)
+	consumerDB.AssertNotCalled(t, "StoreTokenPrices", mock.Anything, mock.Anything)
+}
+
+func TestDefiLlamaProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/chart/coingecko:ethereum", r.URL.Path)
+		assert.Equal(t, "1699488000", r.URL.Query().Get("start"))
+		assert.Equal(t, "24", r.URL.Query().Get("span"))
+		assert.Equal(t, "1h", r.URL.Query().Get("period"))
+		_, _ = w.Write([]byte(`{"coins":{"coingecko:ethereum":{"symbol":"ETH","prices":[{"timestamp":1699488050,"price":2050.5}]}}}`))
+	}))
+	defer server.Close()
+
+	provider := tokenprice.NewDefiLlamaProvider(server.URL, server.Client())
+	prices, err := provider.GetPrices(context.Background(), "ethereum", time.Unix(windowStart, 0), time.Unix(windowStart+23*3600, 0), tokenprice.Hourly)
+	require.NoError(t, err)
+	assert.Equal(t, []tokenprice.Price{{Time: time.Unix(1699488050, 0), Price: 2050.5}}, prices)
+}
+
+func TestCoinGeckoProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/coins/ethereum/market_chart/range", r.URL.Path)
+		assert.Equal(t, "usd", r.URL.Query().Get("vs_currency"))
+		assert.Equal(t, "key", r.Header.Get("x-cg-pro-api-key"))
+		_, _ = w.Write([]byte(`{"prices":[[1699833650000,2050.5]]}`))
+	}))
+	defer server.Close()
+
+	provider := tokenprice.NewCoinGeckoProvider(server.URL, "key", server.Client())
+	prices, err := provider.GetPrices(context.Background(), "ethereum", time.Unix(windowStart, 0), time.Unix(windowStart+3600, 0), tokenprice.Hourly)
+	require.NoError(t, err)
+	assert.Equal(t, []tokenprice.Price{{Time: time.UnixMilli(1699833650000), Price: 2050.5}}, prices)
+}
+
+type fakeQuoter struct{}
+
+func (fakeQuoter) CalculateSwap(_ *bind.CallOpts, _ uint8, _ uint8, dx *big.Int) (*big.Int, error) {
+	// 1 token (18 decimals) is worth 0.5 quote tokens (6 decimals).
+	return new(big.Int).Div(dx, big.NewInt(2_000_000_000_000)), nil
+}
+
+func TestPoolProvider(t *testing.T) {
+	provider := tokenprice.NewPoolProvider(map[string]tokenprice.PoolQuote{
+		"token": {Quoter: fakeQuoter{}, TokenIndex: 0, TokenDecimals: 18, QuoteTokenIndex: 1, QuoteTokenDecimals: 6},
+	}, nil)
+
+	now := time.Now()
+	prices, err := provider.GetPrices(context.Background(), "token", now.Add(-time.Hour), now.Add(time.Hour), tokenprice.Hourly)
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	assert.Equal(t, 0.5, prices[0].Price)
+
+	// pools are only quoted at the latest block.
+	prices, err = provider.GetPrices(context.Background(), "token", now.Add(-48*time.Hour), now.Add(-24*time.Hour), tokenprice.Hourly)
+	require.NoError(t, err)
+	assert.Empty(t, prices)
+
+	prices, err = provider.GetPrices(context.Background(), "unknown", now.Add(-time.Hour), now.Add(time.Hour), tokenprice.Hourly)
+	require.NoError(t, err)
+	assert.Empty(t, prices)
+}
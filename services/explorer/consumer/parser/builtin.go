@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	indexerconfig "github.com/synapsecns/sanguine/services/explorer/config/indexer"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher"
+)
+
+// init registers the parsers of the built-in contract types.
+func init() {
+	MustRegister(Registration{
+		ContractType: indexerconfig.BridgeContractType.String(),
+		NewParser: func(deps Dependencies, contractAddress common.Address) (Parser, error) {
+			return NewBridgeParser(deps.ConsumerDB, contractAddress, deps.TokenDataService, deps.Fetcher, deps.PriceDataService, false)
+		},
+	})
+	MustRegister(Registration{
+		ContractType: indexerconfig.SwapContractType.String(),
+		NewParser:    newSwapParserFactory(false),
+	})
+	MustRegister(Registration{
+		ContractType: indexerconfig.MetaSwapContractType.String(),
+		NewParser:    newSwapParserFactory(true),
+	})
+	MustRegister(Registration{
+		ContractType: indexerconfig.MessageBusContractType.String(),
+		NewParser: func(deps Dependencies, contractAddress common.Address) (Parser, error) {
+			return NewMessageBusParser(deps.ConsumerDB, contractAddress, deps.Fetcher, deps.PriceDataService)
+		},
+	})
+	MustRegister(Registration{
+		ContractType: indexerconfig.CCTPContractType.String(),
+		NewParser: func(deps Dependencies, contractAddress common.Address) (Parser, error) {
+			cctpService, err := fetcher.NewCCTPFetcher(contractAddress, deps.Client)
+			if err != nil {
+				return nil, fmt.Errorf("could not create cctpService: %w", err)
+			}
+			return NewCCTPParser(deps.ConsumerDB, contractAddress, deps.Fetcher, cctpService, deps.TokenDataService, deps.PriceDataService, false)
+		},
+	})
+	MustRegister(Registration{
+		ContractType: indexerconfig.RFQContractType.String(),
+		NewParser: func(deps Dependencies, contractAddress common.Address) (Parser, error) {
+			rfqService, err := fetcher.NewRFQFetcher(contractAddress, deps.Client)
+			if err != nil {
+				return nil, fmt.Errorf("could not create rfqService: %w", err)
+			}
+			return NewRFQParser(deps.ConsumerDB, contractAddress, deps.Fetcher, rfqService, deps.TokenDataService, deps.PriceDataService, false)
+		},
+	})
+}
+
+// newSwapParserFactory creates the parser factory of swap or meta swap contracts.
+func newSwapParserFactory(metaSwap bool) Factory {
+	return func(deps Dependencies, contractAddress common.Address) (Parser, error) {
+		swapService, err := fetcher.NewSwapFetcher(contractAddress, deps.Client, metaSwap)
+		if err != nil {
+			return nil, fmt.Errorf("could not create swapService: %w", err)
+		}
+		return NewSwapParser(deps.ConsumerDB, contractAddress, metaSwap, deps.Fetcher, swapService, deps.TokenDataService, deps.PriceDataService)
+	}
+}
@@ -1,2 +1,6 @@
 // Package parser provides the logic to parse and store events.
+//
+// Every contract type of the indexer config has a parser registered with Register, which declares the contract
+// type, the factory of its parsers, and optionally the abi of the contract, the tables its events are stored in and a
+// graphql schema extension. New contract types are added by registering them from an init function.
 package parser
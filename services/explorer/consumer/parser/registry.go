@@ -0,0 +1,134 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	indexerconfig "github.com/synapsecns/sanguine/services/explorer/config/indexer"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/fetcher/tokenprice"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/parser/tokendata"
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	model "github.com/synapsecns/sanguine/services/explorer/db/sql"
+)
+
+// Dependencies are the services shared by the parsers of a chain.
+type Dependencies struct {
+	// ConsumerDB is the database the parsers store events in.
+	ConsumerDB db.ConsumerDB
+	// Fetcher is the scribe fetcher.
+	Fetcher fetcher.ScribeFetcher
+	// Client is the client of the chain.
+	Client bind.ContractBackend
+	// TokenDataService is the token data service.
+	TokenDataService tokendata.Service
+	// PriceDataService is the token price service.
+	PriceDataService tokenprice.Service
+}
+
+// Factory creates the parser of a contract.
+type Factory func(deps Dependencies, contractAddress common.Address) (Parser, error)
+
+// Registration declares a parser for a contract type.
+type Registration struct {
+	// ContractType is the contract_type of the contracts in the indexer config.
+	ContractType string
+	// NewParser creates the parser of a contract of the type.
+	NewParser Factory
+	// ABI is the abi of the contract. When it is set, logs whose topic is not an event of the abi are not parsed.
+	ABI *abi.ABI
+	// Tables are the tables the parser stores events in, other than the built-in event tables.
+	Tables []model.Table
+	// GraphQLSchema extends the explorer's graphql schema. It is written to the schema directory when the graphql
+	// server is generated, the resolvers of the new fields are generated next to it.
+	GraphQLSchema string
+}
+
+// HandlesLog checks if a log is an event of the abi of the registration.
+func (r Registration) HandlesLog(log ethTypes.Log) bool {
+	if r.ABI == nil {
+		return true
+	}
+	if len(log.Topics) == 0 {
+		return false
+	}
+
+	_, err := r.ABI.EventByID(log.Topics[0])
+	return err == nil
+}
+
+var (
+	// registryMux protects registry.
+	registryMux sync.RWMutex
+	// registry holds the registrations by contract type.
+	registry = make(map[string]Registration)
+)
+
+// Register registers a parser for a contract type. The tables of the registration are created when the consumer db
+// is opened and the contract type becomes valid in the indexer config.
+func Register(registration Registration) error {
+	if registration.ContractType == "" {
+		return fmt.Errorf("contract type is required")
+	}
+	if registration.NewParser == nil {
+		return fmt.Errorf("parser factory is required for contract type %s", registration.ContractType)
+	}
+
+	registryMux.Lock()
+	defer registryMux.Unlock()
+
+	if _, ok := registry[registration.ContractType]; ok {
+		return fmt.Errorf("contract type %s is already registered", registration.ContractType)
+	}
+
+	for _, table := range registration.Tables {
+		err := model.RegisterTable(table)
+		if err != nil {
+			return fmt.Errorf("could not register table of contract type %s: %w", registration.ContractType, err)
+		}
+	}
+
+	registry[registration.ContractType] = registration
+	indexerconfig.RegisterContractType(registration.ContractType)
+
+	return nil
+}
+
+// MustRegister registers a parser for a contract type and panics if it can't be registered. It is meant to be called
+// from init functions.
+func MustRegister(registration Registration) {
+	err := Register(registration)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetRegistration gets the registration of a contract type.
+func GetRegistration(contractType string) (Registration, bool) {
+	registryMux.RLock()
+	defer registryMux.RUnlock()
+
+	registration, ok := registry[contractType]
+	return registration, ok
+}
+
+// Registrations gets every registration, sorted by contract type.
+func Registrations() []Registration {
+	registryMux.RLock()
+	defer registryMux.RUnlock()
+
+	registrations := make([]Registration, 0, len(registry))
+	for _, registration := range registry {
+		registrations = append(registrations, registration)
+	}
+	sort.Slice(registrations, func(i, j int) bool {
+		return registrations[i].ContractType < registrations[j].ContractType
+	})
+
+	return registrations
+}
@@ -0,0 +1,77 @@
+package parser_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	indexerconfig "github.com/synapsecns/sanguine/services/explorer/config/indexer"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/parser"
+	model "github.com/synapsecns/sanguine/services/explorer/db/sql"
+)
+
+const pingABI = `[{"anonymous":false,"inputs":[{"indexed":false,"name":"value","type":"uint256"}],"name":"Ping","type":"event"}]`
+
+type pingEvent struct {
+	ChainID         uint32 `gorm:"column:chain_id"`
+	ContractAddress string `gorm:"column:contract_address"`
+	BlockNumber     uint64 `gorm:"column:block_number"`
+}
+
+type pingParser struct{}
+
+func (pingParser) Parse(_ context.Context, log ethTypes.Log, chainID uint32) (interface{}, error) {
+	return &pingEvent{ChainID: chainID, ContractAddress: log.Address.String(), BlockNumber: log.BlockNumber}, nil
+}
+
+func (pingParser) ParserType() string {
+	return "ping"
+}
+
+func TestRegistry(t *testing.T) {
+	pingContractABI, err := abi.JSON(strings.NewReader(pingABI))
+	require.NoError(t, err)
+
+	registration := parser.Registration{
+		ContractType: "ping",
+		NewParser: func(_ parser.Dependencies, _ common.Address) (parser.Parser, error) {
+			return pingParser{}, nil
+		},
+		ABI:    &pingContractABI,
+		Tables: []model.Table{{Model: &pingEvent{}, Options: "ENGINE=ReplacingMergeTree ORDER BY (chain_id, block_number)", Events: true}},
+	}
+	require.NoError(t, parser.Register(registration))
+	require.Error(t, parser.Register(registration))
+
+	got, ok := parser.GetRegistration("ping")
+	require.True(t, ok)
+	assert.Equal(t, "ping", got.ContractType)
+
+	// the built-in contract types are registered as well.
+	var contractTypes []string
+	for _, registration := range parser.Registrations() {
+		contractTypes = append(contractTypes, registration.ContractType)
+	}
+	assert.Equal(t, []string{"bridge", "cctp", "messagebus", "metaswap", "ping", "rfq", "swap"}, contractTypes)
+
+	contract := indexerconfig.ContractConfig{ContractType: "ping", Address: "0x1", StartBlock: 1}
+	require.NoError(t, contract.IsValid())
+	contract.ContractType = "pong"
+	require.Error(t, contract.IsValid())
+
+	assert.True(t, got.HandlesLog(ethTypes.Log{Topics: []common.Hash{pingContractABI.Events["Ping"].ID}}))
+	assert.False(t, got.HandlesLog(ethTypes.Log{Topics: []common.Hash{common.HexToHash("0x1")}}))
+	assert.False(t, got.HandlesLog(ethTypes.Log{}))
+}
+
+func TestRegisterRequiresFactory(t *testing.T) {
+	require.Error(t, parser.Register(parser.Registration{ContractType: "no-factory"}))
+	require.Error(t, parser.Register(parser.Registration{}))
+	_, ok := parser.GetRegistration("no-factory")
+	assert.False(t, ok)
+}
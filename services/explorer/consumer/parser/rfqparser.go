@@ -76,6 +76,12 @@ func (p *RFQParser) ParseLog(log ethTypes.Log, chainID uint32) (*model.RFQEvent,
 				return nil, fmt.Errorf("could not parse fastbridge bridge relayed: %w", err)
 			}
 			return iFace, nil
+		case fastbridge.Topic(rfqTypes.BridgeDepositRefundedEvent):
+			iFace, err := p.Filterer.ParseBridgeDepositRefunded(log)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse fastbridge bridge deposit refunded: %w", err)
+			}
+			return iFace, nil
 
 		default:
 			logger.Warnf("ErrUnknownTopic in rfq: %s %s chain: %d address: %s", log.TxHash, logTopic.String(), chainID, log.Address.Hex())
@@ -130,6 +136,11 @@ func (p *RFQParser) MatureLogs(ctx context.Context, rfqEvent *model.RFQEvent, iF
 	// find the price data for that specific token
 	p.applyPriceData(ctx, rfqEvent, curCoinGeckoID)
 
+	// Refunds have no bridge event, they only complete the origin transaction of the request.
+	if rfqEvent.EventType == rfqTypes.BridgeDepositRefundedEvent.Int() {
+		return rfqEvent, nil
+	}
+
 	// Would store into bridge database with a new goroutine but saw unreliable storage of events w/parent context cancellation.
 	bridgeEvent := rfqEventToBridgeEvent(*rfqEvent)
 	if p.fromAPI {
@@ -167,7 +178,7 @@ func (p *RFQParser) applyPriceData(ctx context.Context, rfqEvent *model.RFQEvent
 		tokenPrice = &one
 	}
 	// We can maybe hardcode this to be the integer of the event type if the second item is incorrect.
-	if rfqEvent.EventType == rfqTypes.BridgeRequestedEvent.Int() {
+	if rfqEvent.EventType == rfqTypes.BridgeRequestedEvent.Int() || rfqEvent.EventType == rfqTypes.BridgeDepositRefundedEvent.Int() {
 		amountUSD := GetAmountUSD(rfqEvent.OriginAmount, *rfqEvent.TokenDecimal, tokenPrice)
 		if amountUSD != nil {
 			logger.Warnf("RFQ GetAmountUSD properly found the token price for coingecko token: %s", coinGeckoID)
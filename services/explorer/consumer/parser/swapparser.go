@@ -493,6 +493,12 @@ func getAdjustedFee(fee uint64, decimal uint8) float64 {
 	return float64(fee) / math.Pow(10, float64(decimal))
 }
 
+// swapFeeQuery creates the query for the fee of the given type in effect at the block of the swap event.
+func swapFeeQuery(swapEvent model.SwapEvent, feeType string) model.Query {
+	return model.Format("SELECT fee FROM swap_fees WHERE chain_id = %s AND contract_address = %s AND fee_type = %s AND block_number <= %s ORDER BY block_number DESC LIMIT 1",
+		model.Param(swapEvent.ChainID), model.Param(swapEvent.ContractAddress), model.Param(feeType), model.Param(swapEvent.BlockNumber))
+}
+
 // TODO make more dynamic
 
 // GetCorrectSwapFee returns the correct swap fee for the given pool contract.
@@ -502,14 +508,14 @@ func (p *SwapParser) GetCorrectSwapFee(ctx context.Context, swapEvent model.Swap
 	var err error
 	g, groupCtx := errgroup.WithContext(ctx)
 	g.Go(func() error {
-		dbAdminFee, err = p.consumerDB.GetUint64(groupCtx, fmt.Sprintf("SELECT fee FROM swap_fees WHERE chain_id = %d AND contract_address = '%s' AND fee_type = '%s' AND block_number <= %d ORDER BY block_number DESC LIMIT 1", swapEvent.ChainID, swapEvent.ContractAddress, "admin", swapEvent.BlockNumber))
+		dbAdminFee, err = p.consumerDB.GetUint64(groupCtx, swapFeeQuery(swapEvent, "admin"))
 		if err != nil {
 			return fmt.Errorf("could not get admin fee: %w", err)
 		}
 		return nil
 	})
 	g.Go(func() error {
-		dbSwapFee, err = p.consumerDB.GetUint64(groupCtx, fmt.Sprintf("SELECT fee FROM swap_fees WHERE chain_id = %d AND contract_address = '%s' AND fee_type = '%s' AND block_number <= %d ORDER BY block_number DESC LIMIT 1", swapEvent.ChainID, swapEvent.ContractAddress, "swap", swapEvent.BlockNumber))
+		dbSwapFee, err = p.consumerDB.GetUint64(groupCtx, swapFeeQuery(swapEvent, "swap"))
 		if err != nil {
 			return fmt.Errorf("could not get swap fee: %w", err)
 		}
@@ -191,3 +191,94 @@ func (e FastBridgeBridgeRelayed) GetSendChainGas() *bool {
 func (e FastBridgeBridgeRelayed) GetSender() *string {
 	return nil
 }
+
+// GetTxHash gets the tx hash for the event.
+func (e FastBridgeBridgeDepositRefunded) GetTxHash() common.Hash {
+	return e.Raw.TxHash
+}
+
+// GetContractAddress gets the contract address the event occurred on.
+func (e FastBridgeBridgeDepositRefunded) GetContractAddress() common.Address {
+	return e.Raw.Address
+}
+
+// GetBlockNumber gets the block number for the event.
+func (e FastBridgeBridgeDepositRefunded) GetBlockNumber() uint64 {
+	return e.Raw.BlockNumber
+}
+
+// GetEventType gets the event type for the event.
+func (e FastBridgeBridgeDepositRefunded) GetEventType() fastbridge.EventType {
+	return fastbridge.BridgeDepositRefundedEvent
+}
+
+// GetEventIndex gets the event index for the event.
+func (e FastBridgeBridgeDepositRefunded) GetEventIndex() uint64 {
+	return uint64(e.Raw.TxIndex)
+}
+
+// GetTransactionID gets the transaction id for the event.
+func (e FastBridgeBridgeDepositRefunded) GetTransactionID() [32]byte {
+	return e.TransactionId
+}
+
+// GetRelayer gets the relayer address for the event.
+func (e FastBridgeBridgeDepositRefunded) GetRelayer() *string {
+	return nil
+}
+
+// GetTo gets the address the deposit is refunded to.
+func (e FastBridgeBridgeDepositRefunded) GetTo() *string {
+	str := e.To.String()
+	return &str
+}
+
+// GetSender gets the sender for the event.
+func (e FastBridgeBridgeDepositRefunded) GetSender() *string {
+	return nil
+}
+
+// GetRequest gets the request for the event.
+func (e FastBridgeBridgeDepositRefunded) GetRequest() *[]byte {
+	return nil
+}
+
+// GetOriginChainID gets the origin chain id for the event.
+func (e FastBridgeBridgeDepositRefunded) GetOriginChainID() *big.Int {
+	return nil
+}
+
+// GetDestChainID gets the destination chain id for the event.
+func (e FastBridgeBridgeDepositRefunded) GetDestChainID() *big.Int {
+	return nil
+}
+
+// GetOriginToken gets the refunded token for the event.
+func (e FastBridgeBridgeDepositRefunded) GetOriginToken() common.Address {
+	return e.Token
+}
+
+// GetDestToken gets the destination token for the event.
+func (e FastBridgeBridgeDepositRefunded) GetDestToken() common.Address {
+	return common.Address{}
+}
+
+// GetOriginAmount gets the refunded amount for the event.
+func (e FastBridgeBridgeDepositRefunded) GetOriginAmount() *big.Int {
+	return e.Amount
+}
+
+// GetDestAmount gets the destination amount for the event.
+func (e FastBridgeBridgeDepositRefunded) GetDestAmount() *big.Int {
+	return nil
+}
+
+// GetChainGasAmount gets the chain gas amount for the event.
+func (e FastBridgeBridgeDepositRefunded) GetChainGasAmount() *big.Int {
+	return nil
+}
+
+// GetSendChainGas gets the send chain gas for the event.
+func (e FastBridgeBridgeDepositRefunded) GetSendChainGas() *bool {
+	return nil
+}
@@ -19,6 +19,8 @@ func init() {
 	BridgeRequestedTopic = parsedRFQEvent.Events["BridgeRequested"].ID
 
 	BridgeRelayedTopic = parsedRFQEvent.Events["BridgeRelayed"].ID
+
+	BridgeDepositRefundedTopic = parsedRFQEvent.Events["BridgeDepositRefunded"].ID
 }
 
 // BridgeRequestedTopic is when a FastBridge request is sent out and has additional data.
@@ -27,12 +29,16 @@ func init() {
 // BridgeRelayedTopic is when a FastBridge request is relayed and has additional data.
 var BridgeRelayedTopic common.Hash
 
+// BridgeDepositRefundedTopic is when the deposit of a FastBridge request is refunded.
+var BridgeDepositRefundedTopic common.Hash
+
 // TopicMap maps events to topics.
 // this is returned as a function to assert immutability.
 func TopicMap() map[fastbridge.EventType]common.Hash {
 	return map[fastbridge.EventType]common.Hash{
-		fastbridge.BridgeRelayedEvent:   BridgeRelayedTopic,
-		fastbridge.BridgeRequestedEvent: BridgeRequestedTopic,
+		fastbridge.BridgeRelayedEvent:         BridgeRelayedTopic,
+		fastbridge.BridgeRequestedEvent:       BridgeRequestedTopic,
+		fastbridge.BridgeDepositRefundedEvent: BridgeDepositRefundedTopic,
 	}
 }
 
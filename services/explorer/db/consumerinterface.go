@@ -24,6 +24,12 @@ type ConsumerDBWriter interface {
 	StoreTokenIndex(ctx context.Context, chainID uint32, tokenIndex uint8, tokenAddress string, contractAddress string) error
 	// StoreSwapFee stores the swap fee data.
 	StoreSwapFee(ctx context.Context, chainID uint32, timestamp uint64, contractAddress string, fee uint64, feeType string) error
+	// StoreTokenPrices stores a list of token prices.
+	StoreTokenPrices(ctx context.Context, prices []sql.TokenPrice) error
+	// StoreCachedResponse stores an api response shared by the api replicas.
+	StoreCachedResponse(ctx context.Context, response sql.CachedResponse) error
+	// DeleteEventsInRange deletes the events of a contract for a block range.
+	DeleteEventsInRange(ctx context.Context, chainID uint32, contractAddress string, startBlock, endBlock uint64) error
 	// UNSAFE_DB gets the underlying gorm db. This is for testing only and not intended for use in production.
 	//
 	//nolint:golint
@@ -34,49 +40,69 @@ type ConsumerDBWriter interface {
 // nolint:interfacebloat
 type ConsumerDBReader interface {
 	// GetUint64 gets a uint64 for a given query.
-	GetUint64(ctx context.Context, query string) (uint64, error)
+	GetUint64(ctx context.Context, query sql.Query) (uint64, error)
 	// GetFloat64 gets a float64 out of the database
-	GetFloat64(ctx context.Context, query string) (float64, error)
+	GetFloat64(ctx context.Context, query sql.Query) (float64, error)
 	// GetString gets a string out of the database
-	GetString(ctx context.Context, query string) (string, error)
+	GetString(ctx context.Context, query sql.Query) (string, error)
 	// GetStringArray gets an array of strings from a given query.
-	GetStringArray(ctx context.Context, query string) ([]string, error)
+	GetStringArray(ctx context.Context, query sql.Query) ([]string, error)
 	// GetTxCounts gets the counts for each of tx_hash from a given query.
-	GetTxCounts(ctx context.Context, query string) ([]*model.TransactionCountResult, error)
+	GetTxCounts(ctx context.Context, query sql.Query) ([]*model.TransactionCountResult, error)
 	// GetTokenCounts gets the counts for each of token address from a given query.
-	GetTokenCounts(ctx context.Context, query string) ([]*model.TokenCountResult, error)
+	GetTokenCounts(ctx context.Context, query sql.Query) ([]*model.TokenCountResult, error)
 	// GetBridgeEvent returns a bridge event.
-	GetBridgeEvent(ctx context.Context, query string) (*sql.BridgeEvent, error)
+	GetBridgeEvent(ctx context.Context, query sql.Query) (*sql.BridgeEvent, error)
 	// GetBridgeEvents returns a bridge event.
-	GetBridgeEvents(ctx context.Context, query string) ([]sql.BridgeEvent, error)
+	GetBridgeEvents(ctx context.Context, query sql.Query) ([]sql.BridgeEvent, error)
 	// GetMVBridgeEvent returns a bridge event from the mv Table.
-	GetMVBridgeEvent(ctx context.Context, query string) (*sql.HybridBridgeEvent, error)
+	GetMVBridgeEvent(ctx context.Context, query sql.Query) (*sql.HybridBridgeEvent, error)
 	// GetAllBridgeEvents returns a bridge event.
-	GetAllBridgeEvents(ctx context.Context, query string) ([]sql.HybridBridgeEvent, error)
+	GetAllBridgeEvents(ctx context.Context, query sql.Query) ([]sql.HybridBridgeEvent, error)
 	// GetAllMessageBusEvents returns a bridge event.
-	GetAllMessageBusEvents(ctx context.Context, query string) ([]sql.HybridMessageBusEvent, error)
+	GetAllMessageBusEvents(ctx context.Context, query sql.Query) ([]sql.HybridMessageBusEvent, error)
 	// GetDateResults gets day by day data for a given query.
-	GetDateResults(ctx context.Context, query string) ([]*model.DateResult, error)
+	GetDateResults(ctx context.Context, query sql.Query) ([]*model.DateResult, error)
 	// GetAddressRanking gets AddressRanking for a given query.
-	GetAddressRanking(ctx context.Context, query string) ([]*model.AddressRanking, error)
+	GetAddressRanking(ctx context.Context, query sql.Query) ([]*model.AddressRanking, error)
 	// GetDailyTotals gets the daily stats for each date broken down by chain
-	GetDailyTotals(ctx context.Context, query string) ([]*model.DateResultByChain, error)
+	GetDailyTotals(ctx context.Context, query sql.Query) ([]*model.DateResultByChain, error)
 	// GetRankedChainsByVolume gets the volume for each chain
-	GetRankedChainsByVolume(ctx context.Context, query string) ([]*model.VolumeByChainID, error)
+	GetRankedChainsByVolume(ctx context.Context, query sql.Query) ([]*model.VolumeByChainID, error)
 	// GetLastStoredBlock gets the last stored block for a given chain.
 	GetLastStoredBlock(ctx context.Context, chainID uint32, contractAddress string) (uint64, error)
 	// GetAddressData gets data for an address
-	GetAddressData(ctx context.Context, query string) (float64, float64, int, error)
+	GetAddressData(ctx context.Context, query sql.Query) (float64, float64, int, error)
 	// GetAddressDailyData gets daily data for a given address
-	GetAddressDailyData(ctx context.Context, query string) ([]*model.AddressDailyCount, error)
+	GetAddressDailyData(ctx context.Context, query sql.Query) ([]*model.AddressDailyCount, error)
 	// GetAddressChainRanking gets ranking of an address's  chain activity
-	GetAddressChainRanking(ctx context.Context, query string) ([]*model.AddressChainRanking, error)
+	GetAddressChainRanking(ctx context.Context, query sql.Query) ([]*model.AddressChainRanking, error)
 	// GetLeaderboard gets the bridge leaderboard.
-	GetLeaderboard(ctx context.Context, query string) ([]*model.Leaderboard, error)
+	GetLeaderboard(ctx context.Context, query sql.Query) ([]*model.Leaderboard, error)
 	// GetPendingByChain gets the pending txs by chain.
 	GetPendingByChain(ctx context.Context) (res *immutable.Map[int, int], err error)
+	// GetEventsInRange gets the events of a contract for a block range.
+	GetEventsInRange(ctx context.Context, chainID uint32, contractAddress string, startBlock, endBlock uint64) ([]interface{}, error)
 	// GetBlockHeights gets the block heights for a given chain and contract type.
-	GetBlockHeights(ctx context.Context, query string, contractTypeMap map[string]model.ContractType) ([]*model.BlockHeight, error)
+	GetBlockHeights(ctx context.Context, query sql.Query, contractTypeMap map[string]model.ContractType) ([]*model.BlockHeight, error)
+	// GetTokenPrices gets the stored prices of a token with the given granularity in a time range.
+	GetTokenPrices(ctx context.Context, coinGeckoID string, granularity, startTime, endTime uint64) ([]sql.TokenPrice, error)
+	// GetFeeStatistics gets fee statistics for a given query.
+	GetFeeStatistics(ctx context.Context, query sql.Query) ([]*model.FeeStatistic, error)
+	// GetRelayerStatistics gets rfq relayer statistics for a given query.
+	GetRelayerStatistics(ctx context.Context, query sql.Query) ([]*model.RelayerStatistic, error)
+	// GetChainFlows gets chain to chain transfer flows for a given query.
+	GetChainFlows(ctx context.Context, query sql.Query) ([]*model.ChainFlow, error)
+	// StreamAddressActivity calls fn with every bridge and swap event of an address in a time range.
+	StreamAddressActivity(ctx context.Context, address string, startTime, endTime uint64, fn func(activity sql.AddressActivity) error) error
+	// GetTransferActivity gets the transfer events of the block ranges that involve one of the addresses or kappas.
+	GetTransferActivity(ctx context.Context, ranges []sql.ContractBlockRange, addresses []string, kappas []string) ([]sql.TransferActivity, error)
+	// GetContractLastStoredBlocks gets the last stored block of each contract.
+	GetContractLastStoredBlocks(ctx context.Context) ([]sql.LastBlock, error)
+	// GetLastStoredBlocks gets the highest last stored block of each chain.
+	GetLastStoredBlocks(ctx context.Context) (map[uint32]uint64, error)
+	// GetCachedResponse gets the latest cached api response of a key.
+	GetCachedResponse(ctx context.Context, key string) (*sql.CachedResponse, error)
 }
 
 // ConsumerDB is the interface for the ConsumerDB.
@@ -21,12 +21,26 @@ type ConsumerDB struct {
 	mock.Mock
 }
 
+// DeleteEventsInRange provides a mock function with given fields: ctx, chainID, contractAddress, startBlock, endBlock
+func (_m *ConsumerDB) DeleteEventsInRange(ctx context.Context, chainID uint32, contractAddress string, startBlock uint64, endBlock uint64) error {
+	ret := _m.Called(ctx, chainID, contractAddress, startBlock, endBlock)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, string, uint64, uint64) error); ok {
+		r0 = rf(ctx, chainID, contractAddress, startBlock, endBlock)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAddressChainRanking provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetAddressChainRanking(ctx context.Context, query string) ([]*model.AddressChainRanking, error) {
+func (_m *ConsumerDB) GetAddressChainRanking(ctx context.Context, query sql.Query) ([]*model.AddressChainRanking, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []*model.AddressChainRanking
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.AddressChainRanking); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.AddressChainRanking); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -35,7 +49,7 @@ func (_m *ConsumerDB) GetAddressChainRanking(ctx context.Context, query string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -45,11 +59,11 @@ func (_m *ConsumerDB) GetAddressChainRanking(ctx context.Context, query string)
 }
 
 // GetAddressDailyData provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetAddressDailyData(ctx context.Context, query string) ([]*model.AddressDailyCount, error) {
+func (_m *ConsumerDB) GetAddressDailyData(ctx context.Context, query sql.Query) ([]*model.AddressDailyCount, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []*model.AddressDailyCount
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.AddressDailyCount); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.AddressDailyCount); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -58,7 +72,7 @@ func (_m *ConsumerDB) GetAddressDailyData(ctx context.Context, query string) ([]
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -68,32 +82,32 @@ func (_m *ConsumerDB) GetAddressDailyData(ctx context.Context, query string) ([]
 }
 
 // GetAddressData provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetAddressData(ctx context.Context, query string) (float64, float64, int, error) {
+func (_m *ConsumerDB) GetAddressData(ctx context.Context, query sql.Query) (float64, float64, int, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 float64
-	if rf, ok := ret.Get(0).(func(context.Context, string) float64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) float64); ok {
 		r0 = rf(ctx, query)
 	} else {
 		r0 = ret.Get(0).(float64)
 	}
 
 	var r1 float64
-	if rf, ok := ret.Get(1).(func(context.Context, string) float64); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) float64); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Get(1).(float64)
 	}
 
 	var r2 int
-	if rf, ok := ret.Get(2).(func(context.Context, string) int); ok {
+	if rf, ok := ret.Get(2).(func(context.Context, sql.Query) int); ok {
 		r2 = rf(ctx, query)
 	} else {
 		r2 = ret.Get(2).(int)
 	}
 
 	var r3 error
-	if rf, ok := ret.Get(3).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(3).(func(context.Context, sql.Query) error); ok {
 		r3 = rf(ctx, query)
 	} else {
 		r3 = ret.Error(3)
@@ -103,11 +117,11 @@ func (_m *ConsumerDB) GetAddressData(ctx context.Context, query string) (float64
 }
 
 // GetAddressRanking provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetAddressRanking(ctx context.Context, query string) ([]*model.AddressRanking, error) {
+func (_m *ConsumerDB) GetAddressRanking(ctx context.Context, query sql.Query) ([]*model.AddressRanking, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []*model.AddressRanking
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.AddressRanking); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.AddressRanking); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -116,7 +130,7 @@ func (_m *ConsumerDB) GetAddressRanking(ctx context.Context, query string) ([]*m
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -126,11 +140,11 @@ func (_m *ConsumerDB) GetAddressRanking(ctx context.Context, query string) ([]*m
 }
 
 // GetAllBridgeEvents provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetAllBridgeEvents(ctx context.Context, query string) ([]sql.HybridBridgeEvent, error) {
+func (_m *ConsumerDB) GetAllBridgeEvents(ctx context.Context, query sql.Query) ([]sql.HybridBridgeEvent, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []sql.HybridBridgeEvent
-	if rf, ok := ret.Get(0).(func(context.Context, string) []sql.HybridBridgeEvent); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []sql.HybridBridgeEvent); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -139,7 +153,7 @@ func (_m *ConsumerDB) GetAllBridgeEvents(ctx context.Context, query string) ([]s
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -149,11 +163,11 @@ func (_m *ConsumerDB) GetAllBridgeEvents(ctx context.Context, query string) ([]s
 }
 
 // GetAllMessageBusEvents provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetAllMessageBusEvents(ctx context.Context, query string) ([]sql.HybridMessageBusEvent, error) {
+func (_m *ConsumerDB) GetAllMessageBusEvents(ctx context.Context, query sql.Query) ([]sql.HybridMessageBusEvent, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []sql.HybridMessageBusEvent
-	if rf, ok := ret.Get(0).(func(context.Context, string) []sql.HybridMessageBusEvent); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []sql.HybridMessageBusEvent); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -162,7 +176,7 @@ func (_m *ConsumerDB) GetAllMessageBusEvents(ctx context.Context, query string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -172,11 +186,11 @@ func (_m *ConsumerDB) GetAllMessageBusEvents(ctx context.Context, query string)
 }
 
 // GetBlockHeights provides a mock function with given fields: ctx, query, contractTypeMap
-func (_m *ConsumerDB) GetBlockHeights(ctx context.Context, query string, contractTypeMap map[string]model.ContractType) ([]*model.BlockHeight, error) {
+func (_m *ConsumerDB) GetBlockHeights(ctx context.Context, query sql.Query, contractTypeMap map[string]model.ContractType) ([]*model.BlockHeight, error) {
 	ret := _m.Called(ctx, query, contractTypeMap)
 
 	var r0 []*model.BlockHeight
-	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]model.ContractType) []*model.BlockHeight); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query, map[string]model.ContractType) []*model.BlockHeight); ok {
 		r0 = rf(ctx, query, contractTypeMap)
 	} else {
 		if ret.Get(0) != nil {
@@ -185,7 +199,7 @@ func (_m *ConsumerDB) GetBlockHeights(ctx context.Context, query string, contrac
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]model.ContractType) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query, map[string]model.ContractType) error); ok {
 		r1 = rf(ctx, query, contractTypeMap)
 	} else {
 		r1 = ret.Error(1)
@@ -195,11 +209,11 @@ func (_m *ConsumerDB) GetBlockHeights(ctx context.Context, query string, contrac
 }
 
 // GetBridgeEvent provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetBridgeEvent(ctx context.Context, query string) (*sql.BridgeEvent, error) {
+func (_m *ConsumerDB) GetBridgeEvent(ctx context.Context, query sql.Query) (*sql.BridgeEvent, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 *sql.BridgeEvent
-	if rf, ok := ret.Get(0).(func(context.Context, string) *sql.BridgeEvent); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) *sql.BridgeEvent); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -208,7 +222,7 @@ func (_m *ConsumerDB) GetBridgeEvent(ctx context.Context, query string) (*sql.Br
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -218,11 +232,11 @@ func (_m *ConsumerDB) GetBridgeEvent(ctx context.Context, query string) (*sql.Br
 }
 
 // GetBridgeEvents provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetBridgeEvents(ctx context.Context, query string) ([]sql.BridgeEvent, error) {
+func (_m *ConsumerDB) GetBridgeEvents(ctx context.Context, query sql.Query) ([]sql.BridgeEvent, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []sql.BridgeEvent
-	if rf, ok := ret.Get(0).(func(context.Context, string) []sql.BridgeEvent); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []sql.BridgeEvent); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -230,8 +244,54 @@ func (_m *ConsumerDB) GetBridgeEvents(ctx context.Context, query string) ([]sql.
 		}
 	}
 
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCachedResponse provides a mock function with given fields: ctx, key
+func (_m *ConsumerDB) GetCachedResponse(ctx context.Context, key string) (*sql.CachedResponse, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 *sql.CachedResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) *sql.CachedResponse); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sql.CachedResponse)
+		}
+	}
+
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChainFlows provides a mock function with given fields: ctx, query
+func (_m *ConsumerDB) GetChainFlows(ctx context.Context, query sql.Query) ([]*model.ChainFlow, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []*model.ChainFlow
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.ChainFlow); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ChainFlow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -240,12 +300,35 @@ func (_m *ConsumerDB) GetBridgeEvents(ctx context.Context, query string) ([]sql.
 	return r0, r1
 }
 
+// GetContractLastStoredBlocks provides a mock function with given fields: ctx
+func (_m *ConsumerDB) GetContractLastStoredBlocks(ctx context.Context) ([]sql.LastBlock, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []sql.LastBlock
+	if rf, ok := ret.Get(0).(func(context.Context) []sql.LastBlock); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sql.LastBlock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDailyTotals provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetDailyTotals(ctx context.Context, query string) ([]*model.DateResultByChain, error) {
+func (_m *ConsumerDB) GetDailyTotals(ctx context.Context, query sql.Query) ([]*model.DateResultByChain, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []*model.DateResultByChain
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.DateResultByChain); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.DateResultByChain); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -254,7 +337,7 @@ func (_m *ConsumerDB) GetDailyTotals(ctx context.Context, query string) ([]*mode
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -264,11 +347,11 @@ func (_m *ConsumerDB) GetDailyTotals(ctx context.Context, query string) ([]*mode
 }
 
 // GetDateResults provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetDateResults(ctx context.Context, query string) ([]*model.DateResult, error) {
+func (_m *ConsumerDB) GetDateResults(ctx context.Context, query sql.Query) ([]*model.DateResult, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []*model.DateResult
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.DateResult); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.DateResult); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -277,7 +360,53 @@ func (_m *ConsumerDB) GetDateResults(ctx context.Context, query string) ([]*mode
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventsInRange provides a mock function with given fields: ctx, chainID, contractAddress, startBlock, endBlock
+func (_m *ConsumerDB) GetEventsInRange(ctx context.Context, chainID uint32, contractAddress string, startBlock uint64, endBlock uint64) ([]interface{}, error) {
+	ret := _m.Called(ctx, chainID, contractAddress, startBlock, endBlock)
+
+	var r0 []interface{}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, string, uint64, uint64) []interface{}); ok {
+		r0 = rf(ctx, chainID, contractAddress, startBlock, endBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, chainID, contractAddress, startBlock, endBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFeeStatistics provides a mock function with given fields: ctx, query
+func (_m *ConsumerDB) GetFeeStatistics(ctx context.Context, query sql.Query) ([]*model.FeeStatistic, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []*model.FeeStatistic
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.FeeStatistic); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FeeStatistic)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -287,18 +416,18 @@ func (_m *ConsumerDB) GetDateResults(ctx context.Context, query string) ([]*mode
 }
 
 // GetFloat64 provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetFloat64(ctx context.Context, query string) (float64, error) {
+func (_m *ConsumerDB) GetFloat64(ctx context.Context, query sql.Query) (float64, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 float64
-	if rf, ok := ret.Get(0).(func(context.Context, string) float64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) float64); ok {
 		r0 = rf(ctx, query)
 	} else {
 		r0 = ret.Get(0).(float64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -328,12 +457,35 @@ func (_m *ConsumerDB) GetLastStoredBlock(ctx context.Context, chainID uint32, co
 	return r0, r1
 }
 
+// GetLastStoredBlocks provides a mock function with given fields: ctx
+func (_m *ConsumerDB) GetLastStoredBlocks(ctx context.Context) (map[uint32]uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 map[uint32]uint64
+	if rf, ok := ret.Get(0).(func(context.Context) map[uint32]uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint32]uint64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLeaderboard provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetLeaderboard(ctx context.Context, query string) ([]*model.Leaderboard, error) {
+func (_m *ConsumerDB) GetLeaderboard(ctx context.Context, query sql.Query) ([]*model.Leaderboard, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []*model.Leaderboard
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Leaderboard); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.Leaderboard); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -342,7 +494,7 @@ func (_m *ConsumerDB) GetLeaderboard(ctx context.Context, query string) ([]*mode
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -352,11 +504,11 @@ func (_m *ConsumerDB) GetLeaderboard(ctx context.Context, query string) ([]*mode
 }
 
 // GetMVBridgeEvent provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetMVBridgeEvent(ctx context.Context, query string) (*sql.HybridBridgeEvent, error) {
+func (_m *ConsumerDB) GetMVBridgeEvent(ctx context.Context, query sql.Query) (*sql.HybridBridgeEvent, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 *sql.HybridBridgeEvent
-	if rf, ok := ret.Get(0).(func(context.Context, string) *sql.HybridBridgeEvent); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) *sql.HybridBridgeEvent); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -365,7 +517,7 @@ func (_m *ConsumerDB) GetMVBridgeEvent(ctx context.Context, query string) (*sql.
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -398,11 +550,11 @@ func (_m *ConsumerDB) GetPendingByChain(ctx context.Context) (*immutable.Map[int
 }
 
 // GetRankedChainsByVolume provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetRankedChainsByVolume(ctx context.Context, query string) ([]*model.VolumeByChainID, error) {
+func (_m *ConsumerDB) GetRankedChainsByVolume(ctx context.Context, query sql.Query) ([]*model.VolumeByChainID, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []*model.VolumeByChainID
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.VolumeByChainID); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.VolumeByChainID); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -411,7 +563,30 @@ func (_m *ConsumerDB) GetRankedChainsByVolume(ctx context.Context, query string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRelayerStatistics provides a mock function with given fields: ctx, query
+func (_m *ConsumerDB) GetRelayerStatistics(ctx context.Context, query sql.Query) ([]*model.RelayerStatistic, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []*model.RelayerStatistic
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.RelayerStatistic); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RelayerStatistic)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -421,18 +596,18 @@ func (_m *ConsumerDB) GetRankedChainsByVolume(ctx context.Context, query string)
 }
 
 // GetString provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetString(ctx context.Context, query string) (string, error) {
+func (_m *ConsumerDB) GetString(ctx context.Context, query sql.Query) (string, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) string); ok {
 		r0 = rf(ctx, query)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -442,11 +617,11 @@ func (_m *ConsumerDB) GetString(ctx context.Context, query string) (string, erro
 }
 
 // GetStringArray provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetStringArray(ctx context.Context, query string) ([]string, error) {
+func (_m *ConsumerDB) GetStringArray(ctx context.Context, query sql.Query) ([]string, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []string); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -455,7 +630,7 @@ func (_m *ConsumerDB) GetStringArray(ctx context.Context, query string) ([]strin
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -465,11 +640,11 @@ func (_m *ConsumerDB) GetStringArray(ctx context.Context, query string) ([]strin
 }
 
 // GetTokenCounts provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetTokenCounts(ctx context.Context, query string) ([]*model.TokenCountResult, error) {
+func (_m *ConsumerDB) GetTokenCounts(ctx context.Context, query sql.Query) ([]*model.TokenCountResult, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []*model.TokenCountResult
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.TokenCountResult); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.TokenCountResult); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -478,7 +653,7 @@ func (_m *ConsumerDB) GetTokenCounts(ctx context.Context, query string) ([]*mode
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -487,12 +662,58 @@ func (_m *ConsumerDB) GetTokenCounts(ctx context.Context, query string) ([]*mode
 	return r0, r1
 }
 
+// GetTokenPrices provides a mock function with given fields: ctx, coinGeckoID, granularity, startTime, endTime
+func (_m *ConsumerDB) GetTokenPrices(ctx context.Context, coinGeckoID string, granularity uint64, startTime uint64, endTime uint64) ([]sql.TokenPrice, error) {
+	ret := _m.Called(ctx, coinGeckoID, granularity, startTime, endTime)
+
+	var r0 []sql.TokenPrice
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64, uint64) []sql.TokenPrice); ok {
+		r0 = rf(ctx, coinGeckoID, granularity, startTime, endTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sql.TokenPrice)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64, uint64) error); ok {
+		r1 = rf(ctx, coinGeckoID, granularity, startTime, endTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransferActivity provides a mock function with given fields: ctx, ranges, addresses, kappas
+func (_m *ConsumerDB) GetTransferActivity(ctx context.Context, ranges []sql.ContractBlockRange, addresses []string, kappas []string) ([]sql.TransferActivity, error) {
+	ret := _m.Called(ctx, ranges, addresses, kappas)
+
+	var r0 []sql.TransferActivity
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.ContractBlockRange, []string, []string) []sql.TransferActivity); ok {
+		r0 = rf(ctx, ranges, addresses, kappas)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sql.TransferActivity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []sql.ContractBlockRange, []string, []string) error); ok {
+		r1 = rf(ctx, ranges, addresses, kappas)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTxCounts provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetTxCounts(ctx context.Context, query string) ([]*model.TransactionCountResult, error) {
+func (_m *ConsumerDB) GetTxCounts(ctx context.Context, query sql.Query) ([]*model.TransactionCountResult, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 []*model.TransactionCountResult
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.TransactionCountResult); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) []*model.TransactionCountResult); ok {
 		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
@@ -501,7 +722,7 @@ func (_m *ConsumerDB) GetTxCounts(ctx context.Context, query string) ([]*model.T
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -511,18 +732,18 @@ func (_m *ConsumerDB) GetTxCounts(ctx context.Context, query string) ([]*model.T
 }
 
 // GetUint64 provides a mock function with given fields: ctx, query
-func (_m *ConsumerDB) GetUint64(ctx context.Context, query string) (uint64, error) {
+func (_m *ConsumerDB) GetUint64(ctx context.Context, query sql.Query) (uint64, error) {
 	ret := _m.Called(ctx, query)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(context.Context, string) uint64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sql.Query) uint64); ok {
 		r0 = rf(ctx, query)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sql.Query) error); ok {
 		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
@@ -531,6 +752,20 @@ func (_m *ConsumerDB) GetUint64(ctx context.Context, query string) (uint64, erro
 	return r0, r1
 }
 
+// StoreCachedResponse provides a mock function with given fields: ctx, response
+func (_m *ConsumerDB) StoreCachedResponse(ctx context.Context, response sql.CachedResponse) error {
+	ret := _m.Called(ctx, response)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.CachedResponse) error); ok {
+		r0 = rf(ctx, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StoreEvent provides a mock function with given fields: ctx, event
 func (_m *ConsumerDB) StoreEvent(ctx context.Context, event interface{}) error {
 	ret := _m.Called(ctx, event)
@@ -601,6 +836,34 @@ func (_m *ConsumerDB) StoreTokenIndex(ctx context.Context, chainID uint32, token
 	return r0
 }
 
+// StoreTokenPrices provides a mock function with given fields: ctx, prices
+func (_m *ConsumerDB) StoreTokenPrices(ctx context.Context, prices []sql.TokenPrice) error {
+	ret := _m.Called(ctx, prices)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []sql.TokenPrice) error); ok {
+		r0 = rf(ctx, prices)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamAddressActivity provides a mock function with given fields: ctx, address, startTime, endTime, fn
+func (_m *ConsumerDB) StreamAddressActivity(ctx context.Context, address string, startTime uint64, endTime uint64, fn func(sql.AddressActivity) error) error {
+	ret := _m.Called(ctx, address, startTime, endTime, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64, func(sql.AddressActivity) error) error); ok {
+		r0 = rf(ctx, address, startTime, endTime, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UNSAFE_DB provides a mock function with given fields:
 func (_m *ConsumerDB) UNSAFE_DB() *gorm.DB {
 	ret := _m.Called()
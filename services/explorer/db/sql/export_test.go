@@ -0,0 +1,8 @@
+package sql
+
+import "gorm.io/gorm"
+
+// NewStoreFromGorm creates a store from an existing gorm connection for testing.
+func NewStoreFromGorm(db *gorm.DB) *Store {
+	return &Store{db: db}
+}
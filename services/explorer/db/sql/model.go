@@ -366,6 +366,75 @@ type SwapFees struct {
 	Fee uint64 `gorm:"column:fee"`
 }
 
+// FeeEvent is a fee charged by a bridge, cctp, rfq or swap contract. Fee events are written by the materialized views
+// created in feeEventViews from the parsed event tables.
+type FeeEvent struct {
+	// InsertTime is the time the event was inserted into the database.
+	InsertTime uint64 `gorm:"column:insert_time"`
+	// ChainID is the chain id of the event.
+	ChainID uint32 `gorm:"column:chain_id"`
+	// Platform is the platform that charged the fee, one of bridge, cctp, rfq or swap.
+	Platform string `gorm:"column:platform"`
+	// ContractAddress is the address of the contract that generated the event, the pool for swaps.
+	ContractAddress string `gorm:"column:contract_address"`
+	// EventType is the type of the event.
+	EventType uint8 `gorm:"column:event_type"`
+	// TxHash is the transaction hash of the event.
+	TxHash string `gorm:"column:tx_hash"`
+	// EventIndex is the index of the log.
+	EventIndex uint64 `gorm:"column:event_index"`
+	// BlockNumber is the block number of the event.
+	BlockNumber uint64 `gorm:"column:block_number"`
+	// TimeStamp is the timestamp of the block in which the event occurred.
+	TimeStamp uint64 `gorm:"column:timestamp"`
+	// Token is the address of the token the fee was charged in, empty for swaps.
+	Token string `gorm:"column:token"`
+	// TokenSymbol is the symbol of the token. For swaps it is the sold and bought symbols, for other pool events the
+	// symbols of the pool tokens.
+	TokenSymbol string `gorm:"column:token_symbol"`
+	// Relayer is the relayer that filled an rfq transfer.
+	Relayer string `gorm:"column:relayer"`
+	// AmountUSD is the volume of the event in USD.
+	AmountUSD float64 `gorm:"column:amount_usd;type:Float64"`
+	// FeeUSD is the fee paid by the user in USD.
+	FeeUSD float64 `gorm:"column:fee_usd;type:Float64"`
+	// ProtocolFeeUSD is the part of the fee kept by the protocol in USD: the admin fee of swaps and the whole fee of
+	// bridge and cctp transfers.
+	ProtocolFeeUSD float64 `gorm:"column:protocol_fee_usd;type:Float64"`
+	// RevenueUSD is the revenue of the relayer of an rfq transfer in USD.
+	RevenueUSD float64 `gorm:"column:revenue_usd;type:Float64"`
+}
+
+// TokenPrice is the USD price of a token at the start of a time bucket.
+type TokenPrice struct {
+	// InsertTime is the time the price was inserted into the database.
+	InsertTime uint64 `gorm:"column:insert_time"`
+	// CoinGeckoID is the coingecko id of the token.
+	CoinGeckoID string `gorm:"column:coingecko_id"`
+	// Granularity is the length of the time bucket in seconds.
+	Granularity uint64 `gorm:"column:granularity"`
+	// TimeStamp is the start of the time bucket.
+	TimeStamp uint64 `gorm:"column:timestamp"`
+	// Price is the USD price of the token.
+	Price float64 `gorm:"column:price;type:Float64"`
+	// Source is the name of the provider the price was fetched from.
+	Source string `gorm:"column:source"`
+}
+
+// CachedResponse is an api response shared by the api replicas.
+type CachedResponse struct {
+	// CacheKey is the key of the response.
+	CacheKey string `gorm:"column:cache_key"`
+	// ChainID is the chain the response is computed from, or 0 if it is computed from every chain.
+	ChainID uint32 `gorm:"column:chain_id"`
+	// Value is the json encoded response.
+	Value string `gorm:"column:value"`
+	// CreatedAt is the time the response was computed in nanoseconds.
+	CreatedAt uint64 `gorm:"column:created_at"`
+	// ExpiresAt is the time the response expires at in seconds.
+	ExpiresAt uint64 `gorm:"column:expires_at"`
+}
+
 // MessageBusEvent stores data for emitted events from the message bus contract.
 type MessageBusEvent struct {
 	// InsertTime is the time the event was inserted into the database
@@ -637,3 +706,86 @@ type HybridMessageBusEvent struct {
 	// TimeStamp is the timestamp in which the record was inserted.
 	TTimeStamp *uint64 `gorm:"column:t.timestamp"`
 }
+
+// AddressActivity is a bridge or swap event of an address, as read by StreamAddressActivity.
+type AddressActivity struct {
+	// Platform is either bridge or swap.
+	Platform string `gorm:"column:platform"`
+	// ChainID is the chain id of the event.
+	ChainID uint32 `gorm:"column:chain_id"`
+	// EventType is the type of the event.
+	EventType uint8 `gorm:"column:event_type"`
+	// TxHash is the transaction hash of the event.
+	TxHash string `gorm:"column:tx_hash"`
+	// BlockNumber is the block number of the event.
+	BlockNumber uint64 `gorm:"column:block_number"`
+	// EventIndex is the index of the log.
+	EventIndex uint64 `gorm:"column:event_index"`
+	// TimeStamp is the timestamp of the block in which the event occurred.
+	TimeStamp uint64 `gorm:"column:timestamp"`
+	// Token is the address of the token, or of the pool for swaps.
+	Token string `gorm:"column:token"`
+	// TokenSymbol is the symbol of the token. For swaps it is the sold and bought symbols, for other pool events the
+	// symbols of the pool tokens.
+	TokenSymbol string `gorm:"column:token_symbol"`
+	// Amount is the amount of tokens. For swaps it is the amount sold and it is empty for other pool events.
+	Amount string `gorm:"column:amount"`
+	// TokenDecimal is the token's decimal.
+	TokenDecimal uint8 `gorm:"column:token_decimal"`
+	// AmountUSD is the amount in USD at the time of the event.
+	AmountUSD float64 `gorm:"column:amount_usd"`
+	// FeeUSD is the fee in USD at the time of the event.
+	FeeUSD float64 `gorm:"column:fee_usd"`
+	// DestinationChainID is the destination chain of bridge origin events.
+	DestinationChainID uint64 `gorm:"column:destination_chain_id"`
+	// Kappa links the origin and destination events of a bridge transfer.
+	Kappa string `gorm:"column:kappa"`
+}
+
+// ContractBlockRange is a range of blocks of a contract. The start of the range is exclusive and its end inclusive.
+type ContractBlockRange struct {
+	// ChainID is the chain id of the contract.
+	ChainID uint32
+	// ContractAddress is the address of the contract.
+	ContractAddress string
+	// After is the block the range starts after.
+	After uint64
+	// Until is the last block of the range.
+	Until uint64
+}
+
+// TransferActivity is an origin, destination or refund event of a transfer, as read by GetTransferActivity.
+type TransferActivity struct {
+	// Platform is bridge for bridge events (including the bridge events of cctp and rfq transfers) and rfq for refunds.
+	Platform string `gorm:"column:platform"`
+	// ChainID is the chain id of the event.
+	ChainID uint32 `gorm:"column:chain_id"`
+	// DestinationChainID is the destination chain of origin events.
+	DestinationChainID uint64 `gorm:"column:destination_chain_id"`
+	// EventType is the type of the event.
+	EventType uint8 `gorm:"column:event_type"`
+	// TxHash is the transaction hash of the event.
+	TxHash string `gorm:"column:tx_hash"`
+	// EventIndex is the index of the log.
+	EventIndex uint64 `gorm:"column:event_index"`
+	// BlockNumber is the block number of the event.
+	BlockNumber uint64 `gorm:"column:block_number"`
+	// TimeStamp is the timestamp of the block in which the event occurred.
+	TimeStamp uint64 `gorm:"column:timestamp"`
+	// Kappa links the events of a transfer, it is the transaction id of rfq transfers.
+	Kappa string `gorm:"column:kappa"`
+	// Sender is the sender of the transfer, empty for refunds.
+	Sender string `gorm:"column:sender"`
+	// Recipient is the recipient of the transfer, or the address the deposit is refunded to.
+	Recipient string `gorm:"column:recipient"`
+	// Token is the address of the token.
+	Token string `gorm:"column:token"`
+	// TokenSymbol is the symbol of the token.
+	TokenSymbol string `gorm:"column:token_symbol"`
+	// Amount is the amount of tokens.
+	Amount string `gorm:"column:amount"`
+	// TokenDecimal is the token's decimal.
+	TokenDecimal uint8 `gorm:"column:token_decimal"`
+	// AmountUSD is the amount in USD at the time of the event.
+	AmountUSD float64 `gorm:"column:amount_usd"`
+}
@@ -0,0 +1,123 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// Query is a parameterized query. Values added with Param are never written into the query text, they are bound
+// as parameters by the driver when the query is executed. Queries can be composed with Format and Concat,
+// and every fragment carries its own parameters so composing never has to track parameter order.
+type Query struct {
+	// text is the query text with a named placeholder for each parameter.
+	text string
+	// args maps placeholder names to their values.
+	args map[string]interface{}
+}
+
+// paramCount is used to give every parameter a unique placeholder name.
+var paramCount atomic.Uint64
+
+// placeholderPrefix is the prefix of the placeholder of a parameter.
+const placeholderPrefix = "@p"
+
+// Raw creates a query from trusted sql text. It must never be called with user supplied values, use Param instead.
+func Raw(text string) Query {
+	return Query{text: text}
+}
+
+// Param creates a query fragment that binds the value as a parameter.
+func Param(value interface{}) Query {
+	name := fmt.Sprintf("%s%d", placeholderPrefix, paramCount.Add(1))
+	return Query{
+		text: name,
+		args: map[string]interface{}{name: value},
+	}
+}
+
+// Format creates a query from a format string where each %s verb is replaced by the text of the matching part.
+// The parameters of all the parts are carried over to the resulting query.
+func Format(format string, parts ...Query) Query {
+	texts := make([]interface{}, len(parts))
+	for i := range parts {
+		texts[i] = parts[i].text
+	}
+
+	return Query{
+		text: fmt.Sprintf(format, texts...),
+		args: mergeArgs(parts...),
+	}
+}
+
+// Concat concatenates the given queries.
+func Concat(parts ...Query) Query {
+	var text strings.Builder
+	for i := range parts {
+		text.WriteString(parts[i].text)
+	}
+
+	return Query{
+		text: text.String(),
+		args: mergeArgs(parts...),
+	}
+}
+
+// IsEmpty returns true if the query has no text.
+func (q Query) IsEmpty() bool {
+	return q.text == ""
+}
+
+// SQL returns the query text with named placeholders for the parameters.
+func (q Query) SQL() string {
+	return q.text
+}
+
+// Build returns the query text with a positional placeholder (?) for every parameter and the parameters in order.
+func (q Query) Build() (string, []interface{}) {
+	var text strings.Builder
+	var args []interface{}
+
+	rest := q.text
+	for {
+		idx := strings.Index(rest, placeholderPrefix)
+		if idx == -1 {
+			text.WriteString(rest)
+			break
+		}
+
+		end := idx + len(placeholderPrefix)
+		for end < len(rest) && rest[end] >= '0' && rest[end] <= '9' {
+			end++
+		}
+
+		value, ok := q.args[rest[idx:end]]
+		if !ok {
+			// not a placeholder, copy the text as is.
+			text.WriteString(rest[:end])
+			rest = rest[end:]
+			continue
+		}
+
+		text.WriteString(rest[:idx])
+		text.WriteString("?")
+		args = append(args, value)
+		rest = rest[end:]
+	}
+
+	return text.String(), args
+}
+
+func mergeArgs(parts ...Query) map[string]interface{} {
+	var args map[string]interface{}
+	for i := range parts {
+		for name, value := range parts[i].args {
+			if args == nil {
+				args = make(map[string]interface{})
+			}
+			args[name] = value
+		}
+	}
+
+	return args
+}
@@ -0,0 +1,81 @@
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+func TestQueryBuild(t *testing.T) {
+	address := sql.Param("0xabc")
+	filter := sql.Concat(sql.Format(" WHERE chain_id = %s", sql.Param(1)), sql.Format(" AND sender = %s", address))
+	query := sql.Format("SELECT * FROM (SELECT * FROM bridge_events %s) WHERE recipient = %s LIMIT %s", filter, address, sql.Param(10))
+
+	text, args := query.Build()
+	assert.Equal(t, "SELECT * FROM (SELECT * FROM bridge_events  WHERE chain_id = ? AND sender = ?) WHERE recipient = ? LIMIT ?", text)
+	assert.Equal(t, []interface{}{1, "0xabc", "0xabc", 10}, args)
+}
+
+func TestQueryBuildKeepsValuesOutOfText(t *testing.T) {
+	value := "' OR 1=1 --"
+	query := sql.Format("SELECT * FROM bridge_events WHERE kappa = %s", sql.Param(value))
+
+	text, args := query.Build()
+	assert.NotContains(t, text, value)
+	assert.NotContains(t, query.SQL(), value)
+	assert.Equal(t, []interface{}{value}, args)
+}
+
+func TestQueryBuildIgnoresUnknownPlaceholders(t *testing.T) {
+	query := sql.Concat(sql.Raw("SELECT '@p' AS a, '@p0' AS b WHERE c = "), sql.Param(2))
+
+	text, args := query.Build()
+	assert.Equal(t, "SELECT '@p' AS a, '@p0' AS b WHERE c = ?", text)
+	assert.Equal(t, []interface{}{2}, args)
+}
+
+func TestQueryIsEmpty(t *testing.T) {
+	assert.True(t, sql.Query{}.IsEmpty())
+	assert.True(t, sql.Concat(sql.Query{}, sql.Query{}).IsEmpty())
+	assert.False(t, sql.Param(1).IsEmpty())
+}
+
+type tokenIndex struct {
+	ChainID      uint32
+	TokenAddress string
+	TokenIndex   uint8
+}
+
+// TestQueryAgainstEmbeddedStore makes sure the parameters are bound when the query is run.
+func TestQueryAgainstEmbeddedStore(t *testing.T) {
+	ctx := context.Background()
+	gormDB, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: gormLogger.Default.LogMode(gormLogger.Silent)})
+	require.NoError(t, err)
+	require.NoError(t, gormDB.AutoMigrate(&tokenIndex{}))
+	require.NoError(t, gormDB.Create([]tokenIndex{
+		{ChainID: 1, TokenAddress: "0xa", TokenIndex: 0},
+		{ChainID: 1, TokenAddress: "0xb", TokenIndex: 1},
+		{ChainID: 2, TokenAddress: "0xc", TokenIndex: 0},
+	}).Error)
+
+	store := sql.NewStoreFromGorm(gormDB)
+
+	address, err := store.GetString(ctx, sql.Format("SELECT token_address FROM token_indices WHERE chain_id = %s AND token_index = %s", sql.Param(1), sql.Param(1)))
+	require.NoError(t, err)
+	assert.Equal(t, "0xb", address)
+
+	count, err := store.GetUint64(ctx, sql.Format("SELECT count(*) FROM token_indices WHERE token_address = %s", sql.Param("0xa' OR '1'='1")))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), count)
+
+	chainID := sql.Param(1)
+	count, err = store.GetUint64(ctx, sql.Format("SELECT count(*) FROM token_indices WHERE chain_id = %s AND token_address IN (SELECT token_address FROM token_indices WHERE chain_id = %s)", chainID, chainID))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), count)
+}
@@ -3,20 +3,31 @@
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
+
 	"github.com/benbjohnson/immutable"
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+	"github.com/synapsecns/sanguine/services/explorer/types/fastbridge"
+	"gorm.io/gorm"
 )
 
+// raw creates a raw gorm query with the parameters of the query bound.
+func (s *Store) raw(ctx context.Context, query Query) *gorm.DB {
+	text, args := query.Build()
+	return s.db.WithContext(ctx).Raw(text, args...)
+}
+
 /*╔══════════════════════════════════════════════════════════════════════╗*\
 ▏*║                        Generic Read Functions                        ║*▕
 \*╚══════════════════════════════════════════════════════════════════════╝*/
 
 // GetUint64 gets a uint64 from a given query.
-func (s *Store) GetUint64(ctx context.Context, query string) (uint64, error) {
+func (s *Store) GetUint64(ctx context.Context, query Query) (uint64, error) {
 	var res int64
 
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return 0, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -25,9 +36,9 @@ func (s *Store) GetUint64(ctx context.Context, query string) (uint64, error) {
 }
 
 // GetFloat64 gets a float64 from a given query.
-func (s *Store) GetFloat64(ctx context.Context, query string) (float64, error) {
+func (s *Store) GetFloat64(ctx context.Context, query Query) (float64, error) {
 	var res float64
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return 0, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -36,9 +47,9 @@ func (s *Store) GetFloat64(ctx context.Context, query string) (float64, error) {
 }
 
 // GetString gets a string from a given query.
-func (s *Store) GetString(ctx context.Context, query string) (string, error) {
+func (s *Store) GetString(ctx context.Context, query Query) (string, error) {
 	var res string
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return "", fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -47,10 +58,10 @@ func (s *Store) GetString(ctx context.Context, query string) (string, error) {
 }
 
 // GetStringArray returns a string array for a given query.
-func (s *Store) GetStringArray(ctx context.Context, query string) ([]string, error) {
+func (s *Store) GetStringArray(ctx context.Context, query Query) ([]string, error) {
 	var res []string
 
-	dbTx := s.db.WithContext(ctx).Raw(query + " SETTINGS readonly=1").Find(&res)
+	dbTx := s.raw(ctx, Concat(query, Raw(" SETTINGS readonly=1"))).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -59,10 +70,10 @@ func (s *Store) GetStringArray(ctx context.Context, query string) ([]string, err
 }
 
 // GetBridgeEvent returns a bridge event.
-func (s *Store) GetBridgeEvent(ctx context.Context, query string) (*BridgeEvent, error) {
+func (s *Store) GetBridgeEvent(ctx context.Context, query Query) (*BridgeEvent, error) {
 	var res BridgeEvent
 
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -71,10 +82,10 @@ func (s *Store) GetBridgeEvent(ctx context.Context, query string) (*BridgeEvent,
 }
 
 // GetMVBridgeEvent gets a bridge event from the materialized view table.
-func (s *Store) GetMVBridgeEvent(ctx context.Context, query string) (*HybridBridgeEvent, error) {
+func (s *Store) GetMVBridgeEvent(ctx context.Context, query Query) (*HybridBridgeEvent, error) {
 	var res HybridBridgeEvent
 
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -83,9 +94,9 @@ func (s *Store) GetMVBridgeEvent(ctx context.Context, query string) (*HybridBrid
 }
 
 // GetBridgeEvents returns bridge events.
-func (s *Store) GetBridgeEvents(ctx context.Context, query string) ([]BridgeEvent, error) {
+func (s *Store) GetBridgeEvents(ctx context.Context, query Query) ([]BridgeEvent, error) {
 	var res []BridgeEvent
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -94,9 +105,9 @@ func (s *Store) GetBridgeEvents(ctx context.Context, query string) ([]BridgeEven
 }
 
 // GetAllBridgeEvents returns bridge events.
-func (s *Store) GetAllBridgeEvents(ctx context.Context, query string) ([]HybridBridgeEvent, error) {
+func (s *Store) GetAllBridgeEvents(ctx context.Context, query Query) ([]HybridBridgeEvent, error) {
 	var res []HybridBridgeEvent
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -104,10 +115,10 @@ func (s *Store) GetAllBridgeEvents(ctx context.Context, query string) ([]HybridB
 }
 
 // GetDailyTotals returns bridge events.
-func (s *Store) GetDailyTotals(ctx context.Context, query string) ([]*model.DateResultByChain, error) {
+func (s *Store) GetDailyTotals(ctx context.Context, query Query) ([]*model.DateResultByChain, error) {
 	var res []*model.DateResultByChain
 
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -115,9 +126,9 @@ func (s *Store) GetDailyTotals(ctx context.Context, query string) ([]*model.Date
 }
 
 // GetAllMessageBusEvents returns message bus events.
-func (s *Store) GetAllMessageBusEvents(ctx context.Context, query string) ([]HybridMessageBusEvent, error) {
+func (s *Store) GetAllMessageBusEvents(ctx context.Context, query Query) ([]HybridMessageBusEvent, error) {
 	var res []HybridMessageBusEvent
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read message bus event: %w", dbTx.Error)
 	}
@@ -125,9 +136,9 @@ func (s *Store) GetAllMessageBusEvents(ctx context.Context, query string) ([]Hyb
 }
 
 // GetTxCounts returns Tx counts.
-func (s *Store) GetTxCounts(ctx context.Context, query string) ([]*model.TransactionCountResult, error) {
+func (s *Store) GetTxCounts(ctx context.Context, query Query) ([]*model.TransactionCountResult, error) {
 	var res []*model.TransactionCountResult
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -136,9 +147,9 @@ func (s *Store) GetTxCounts(ctx context.Context, query string) ([]*model.Transac
 }
 
 // GetTokenCounts returns Tx counts.
-func (s *Store) GetTokenCounts(ctx context.Context, query string) ([]*model.TokenCountResult, error) {
+func (s *Store) GetTokenCounts(ctx context.Context, query Query) ([]*model.TokenCountResult, error) {
 	var res []*model.TokenCountResult
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -147,9 +158,9 @@ func (s *Store) GetTokenCounts(ctx context.Context, query string) ([]*model.Toke
 }
 
 // GetRankedChainsByVolume gets ranked chains by volume.
-func (s *Store) GetRankedChainsByVolume(ctx context.Context, query string) ([]*model.VolumeByChainID, error) {
+func (s *Store) GetRankedChainsByVolume(ctx context.Context, query Query) ([]*model.VolumeByChainID, error) {
 	var res []*model.VolumeByChainID
-	dbTx := s.db.WithContext(ctx).Raw(query).Find(&res)
+	dbTx := s.raw(ctx, query).Find(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -158,9 +169,9 @@ func (s *Store) GetRankedChainsByVolume(ctx context.Context, query string) ([]*m
 }
 
 // GetDateResults returns the dya by day data.
-func (s *Store) GetDateResults(ctx context.Context, query string) ([]*model.DateResult, error) {
+func (s *Store) GetDateResults(ctx context.Context, query Query) ([]*model.DateResult, error) {
 	var res []*model.DateResult
-	dbTx := s.db.WithContext(ctx).Raw(query + " SETTINGS readonly=1").Scan(&res)
+	dbTx := s.raw(ctx, Concat(query, Raw(" SETTINGS readonly=1"))).Scan(&res)
 
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get date results: %w", dbTx.Error)
@@ -170,7 +181,7 @@ func (s *Store) GetDateResults(ctx context.Context, query string) ([]*model.Date
 }
 
 // GetAddressData returns the address data.
-func (s *Store) GetAddressData(ctx context.Context, query string) (float64, float64, int, error) {
+func (s *Store) GetAddressData(ctx context.Context, query Query) (float64, float64, int, error) {
 	type addressData struct {
 		VolumeTotal float64 `gorm:"column:volumeTotal"`
 		FeeTotal    float64 `gorm:"column:feeTotal"`
@@ -178,7 +189,7 @@ type addressData struct {
 	}
 	var res addressData
 	// var test map[string]interface{}
-	dbTx := s.db.WithContext(ctx).Raw(query).Scan(&res)
+	dbTx := s.raw(ctx, query).Scan(&res)
 	if dbTx.Error != nil {
 		return 0, 0, 0, fmt.Errorf("failed to get address data: %w", dbTx.Error)
 	}
@@ -187,9 +198,9 @@ type addressData struct {
 }
 
 // GetAddressChainRanking ranks chains by volume for a given address.
-func (s *Store) GetAddressChainRanking(ctx context.Context, query string) ([]*model.AddressChainRanking, error) {
+func (s *Store) GetAddressChainRanking(ctx context.Context, query Query) ([]*model.AddressChainRanking, error) {
 	var res []*model.AddressChainRanking
-	dbTx := s.db.WithContext(ctx).Raw(query).Scan(&res)
+	dbTx := s.raw(ctx, query).Scan(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get address chain ranking: %w", dbTx.Error)
 	}
@@ -198,9 +209,9 @@ func (s *Store) GetAddressChainRanking(ctx context.Context, query string) ([]*mo
 }
 
 // GetAddressDailyData gets daily data (number of txs_ for a given address.
-func (s *Store) GetAddressDailyData(ctx context.Context, query string) ([]*model.AddressDailyCount, error) {
+func (s *Store) GetAddressDailyData(ctx context.Context, query Query) ([]*model.AddressDailyCount, error) {
 	var res []*model.AddressDailyCount
-	dbTx := s.db.WithContext(ctx).Raw(query).Scan(&res)
+	dbTx := s.raw(ctx, query).Scan(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get address daily data: %w", dbTx.Error)
 	}
@@ -209,10 +220,10 @@ func (s *Store) GetAddressDailyData(ctx context.Context, query string) ([]*model
 }
 
 // GetAddressRanking gets AddressRanking for a given query.
-func (s *Store) GetAddressRanking(ctx context.Context, query string) ([]*model.AddressRanking, error) {
+func (s *Store) GetAddressRanking(ctx context.Context, query Query) ([]*model.AddressRanking, error) {
 	var res []*model.AddressRanking
 
-	dbTx := s.db.WithContext(ctx).Raw(query + " SETTINGS readonly=1").Scan(&res)
+	dbTx := s.raw(ctx, Concat(query, Raw(" SETTINGS readonly=1"))).Scan(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to read bridge event: %w", dbTx.Error)
 	}
@@ -225,7 +236,7 @@ func (s *Store) GetAddressRanking(ctx context.Context, query string) ([]*model.A
 
 // GetLastStoredBlock returns the last stored block for a given chainID and contract.
 func (s *Store) GetLastStoredBlock(ctx context.Context, chainID uint32, contract string) (uint64, error) {
-	query := fmt.Sprintf("SELECT %s FROM last_blocks WHERE %s = %d AND %s = '%s' ORDER BY %s DESC LIMIT 1", BlockNumberFieldName, ChainIDFieldName, chainID, ContractAddressFieldName, contract, BlockNumberFieldName)
+	query := Format(fmt.Sprintf("SELECT %s FROM last_blocks WHERE %s = %%s AND %s = %%s ORDER BY %s DESC LIMIT 1", BlockNumberFieldName, ChainIDFieldName, ContractAddressFieldName, BlockNumberFieldName), Param(chainID), Param(contract))
 	lastBlock, err := s.GetUint64(ctx, query)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get last block: %w", err)
@@ -234,10 +245,42 @@ func (s *Store) GetLastStoredBlock(ctx context.Context, chainID uint32, contract
 	return lastBlock, nil
 }
 
+// GetLastStoredBlocks returns the highest last stored block of the contracts of each chain.
+func (s *Store) GetLastStoredBlocks(ctx context.Context) (map[uint32]uint64, error) {
+	var res []LastBlock
+	query := Raw(fmt.Sprintf("SELECT %s, max(%s) AS %s FROM last_blocks GROUP BY %s", ChainIDFieldName, BlockNumberFieldName, BlockNumberFieldName, ChainIDFieldName))
+	dbTx := s.raw(ctx, query).Scan(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get last blocks: %w", dbTx.Error)
+	}
+
+	lastBlocks := make(map[uint32]uint64, len(res))
+	for _, lastBlock := range res {
+		lastBlocks[lastBlock.ChainID] = lastBlock.BlockNumber
+	}
+
+	return lastBlocks, nil
+}
+
+// GetCachedResponse gets the latest cached api response of a key. It returns nil if the key is not cached.
+func (s *Store) GetCachedResponse(ctx context.Context, key string) (*CachedResponse, error) {
+	var res []CachedResponse
+	query := Format("SELECT * FROM cached_responses WHERE cache_key = %s ORDER BY created_at DESC LIMIT 1", Param(key))
+	dbTx := s.raw(ctx, query).Scan(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get cached response: %w", dbTx.Error)
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+
+	return &res[0], nil
+}
+
 // GetLeaderboard gets the bridge leaderboard.
-func (s *Store) GetLeaderboard(ctx context.Context, query string) ([]*model.Leaderboard, error) {
+func (s *Store) GetLeaderboard(ctx context.Context, query Query) ([]*model.Leaderboard, error) {
 	var res []*model.Leaderboard
-	dbTx := s.db.WithContext(ctx).Raw(query).Scan(&res)
+	dbTx := s.raw(ctx, query).Scan(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get leaderboard: %w", dbTx.Error)
 	}
@@ -248,6 +291,53 @@ func (s *Store) GetLeaderboard(ctx context.Context, query string) ([]*model.Lead
 	return res, nil
 }
 
+// GetFeeStatistics gets fee statistics for a given query.
+func (s *Store) GetFeeStatistics(ctx context.Context, query Query) ([]*model.FeeStatistic, error) {
+	var res []*model.FeeStatistic
+	dbTx := s.raw(ctx, query).Scan(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get fee statistics: %w", dbTx.Error)
+	}
+
+	return res, nil
+}
+
+// GetRelayerStatistics gets rfq relayer statistics for a given query.
+func (s *Store) GetRelayerStatistics(ctx context.Context, query Query) ([]*model.RelayerStatistic, error) {
+	var res []*model.RelayerStatistic
+	dbTx := s.raw(ctx, query).Scan(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get relayer statistics: %w", dbTx.Error)
+	}
+
+	return res, nil
+}
+
+// GetChainFlows gets chain to chain transfer flows for a given query.
+func (s *Store) GetChainFlows(ctx context.Context, query Query) ([]*model.ChainFlow, error) {
+	var res []*model.ChainFlow
+	dbTx := s.raw(ctx, query).Scan(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get chain flows: %w", dbTx.Error)
+	}
+
+	return res, nil
+}
+
+// GetTokenPrices gets the stored prices of a token with the given granularity between the start and end time
+// (inclusive), ordered by time.
+func (s *Store) GetTokenPrices(ctx context.Context, coinGeckoID string, granularity, startTime, endTime uint64) ([]TokenPrice, error) {
+	var res []TokenPrice
+	query := Format("SELECT * FROM token_prices FINAL WHERE coingecko_id = %s AND granularity = %s AND timestamp BETWEEN %s AND %s ORDER BY timestamp",
+		Param(coinGeckoID), Param(granularity), Param(startTime), Param(endTime))
+	dbTx := s.raw(ctx, query).Scan(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get token prices: %w", dbTx.Error)
+	}
+
+	return res, nil
+}
+
 // GetPendingByChain gets the bridge leaderboard by chain.
 // returns chainid, count
 // TODO: test this.
@@ -284,9 +374,9 @@ type PendingByChain struct {
 	return builder.Map(), nil
 }
 
-func (s *Store) GetBlockHeights(ctx context.Context, query string, contractTypeMap map[string]model.ContractType) ([]*model.BlockHeight, error) {
+func (s *Store) GetBlockHeights(ctx context.Context, query Query, contractTypeMap map[string]model.ContractType) ([]*model.BlockHeight, error) {
 	var res []*LastBlock
-	dbTx := s.db.WithContext(ctx).Raw(query).Scan(&res)
+	dbTx := s.raw(ctx, query).Scan(&res)
 	if dbTx.Error != nil {
 		return nil, fmt.Errorf("failed to get block heights: %w", dbTx.Error)
 	}
@@ -307,3 +397,211 @@ func (s *Store) GetBlockHeights(ctx context.Context, query string, contractTypeM
 
 	return formatted, nil
 }
+
+// GetEventsInRange gets the events of a contract stored in every event table for a block range. Bridge, cctp and rfq
+// events are returned as pointers and swap and message bus events as values, the same way they are passed to StoreEvents.
+// Rows of registered event tables are returned as pointers.
+func (s *Store) GetEventsInRange(ctx context.Context, chainID uint32, contractAddress string, startBlock, endBlock uint64) ([]interface{}, error) {
+	var events []interface{}
+	for _, event := range eventModels() {
+		table, err := s.tableName(event)
+		if err != nil {
+			return nil, err
+		}
+
+		query := Format("SELECT * FROM %s FINAL WHERE chain_id = %s AND contract_address = %s AND block_number BETWEEN %s AND %s",
+			Raw(table), Param(chainID), Param(contractAddress), Param(startBlock), Param(endBlock))
+
+		switch event.(type) {
+		case *BridgeEvent:
+			var res []*BridgeEvent
+			err = s.raw(ctx, query).Scan(&res).Error
+			for _, row := range res {
+				events = append(events, row)
+			}
+		case *SwapEvent:
+			var res []SwapEvent
+			err = s.raw(ctx, query).Scan(&res).Error
+			for _, row := range res {
+				events = append(events, row)
+			}
+		case *MessageBusEvent:
+			var res []MessageBusEvent
+			err = s.raw(ctx, query).Scan(&res).Error
+			for _, row := range res {
+				events = append(events, row)
+			}
+		case *CCTPEvent:
+			var res []*CCTPEvent
+			err = s.raw(ctx, query).Scan(&res).Error
+			for _, row := range res {
+				events = append(events, row)
+			}
+		case *RFQEvent:
+			var res []*RFQEvent
+			err = s.raw(ctx, query).Scan(&res).Error
+			for _, row := range res {
+				events = append(events, row)
+			}
+		default:
+			res := reflect.New(reflect.SliceOf(reflect.PointerTo(reflect.Indirect(reflect.ValueOf(event)).Type())))
+			err = s.raw(ctx, query).Scan(res.Interface()).Error
+			for i := 0; i < res.Elem().Len(); i++ {
+				events = append(events, res.Elem().Index(i).Interface())
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read events from %s: %w", table, err)
+		}
+	}
+
+	return events, nil
+}
+
+// addressActivityColumns are the columns StreamAddressActivity returns, in order.
+const addressActivityColumns = "platform, chain_id, event_type, tx_hash, block_number, event_index, timestamp, token, token_symbol, amount, token_decimal, amount_usd, fee_usd, destination_chain_id, kappa"
+
+// StreamAddressActivity calls fn with every bridge and swap event of an address between the start and end time,
+// ordered by time. Rows are read one at a time so the whole history is never held in memory.
+// The sender and recipient branches are read through the address skip indexes and deduplicated with LIMIT 1 BY
+// instead of FINAL, so only the granules holding the address are merged.
+func (s *Store) StreamAddressActivity(ctx context.Context, address string, startTime, endTime uint64, fn func(activity AddressActivity) error) error {
+	addressParam := Param(strings.ToLower(address))
+	startParam := Param(startTime)
+	endParam := Param(endTime)
+
+	bridgeColumns := Raw("'bridge' AS platform, chain_id, contract_address, event_type, tx_hash, block_number, event_index, toUInt64(coalesce(timestamp, 0)) AS timestamp, token, coalesce(token_symbol, '') AS token_symbol, toString(amount) AS amount, coalesce(token_decimal, 0) AS token_decimal, coalesce(amount_usd, 0) AS amount_usd, coalesce(fee_usd, 0) AS fee_usd, toUInt64(destination_chain_id) AS destination_chain_id, if(destination_kappa != '', destination_kappa, coalesce(kappa, '')) AS kappa, insert_time")
+	// Swaps and underlying swaps are priced by the sold token, like the swap volume, other events by all their tokens.
+	swapColumns := Raw("'swap' AS platform, chain_id, contract_address, event_type, tx_hash, block_number, event_index, toUInt64(coalesce(timestamp, 0)) AS timestamp, contract_address AS token, if(event_type IN (0, 10), concat(token_symbol[sold_id], '/', token_symbol[bought_id]), arrayStringConcat(mapValues(token_symbol), '/')) AS token_symbol, if(event_type IN (0, 10), amount[sold_id], '') AS amount, if(event_type IN (0, 10), token_decimal[sold_id], toUInt8(0)) AS token_decimal, if(event_type IN (0, 10), amount_usd[sold_id], arraySum(mapValues(amount_usd))) AS amount_usd, arraySum(mapValues(fee_usd)) AS fee_usd, toUInt64(0) AS destination_chain_id, '' AS kappa, insert_time")
+
+	branch := func(columns Query, table, addressColumn string) Query {
+		return Format("SELECT %s FROM %s WHERE %s = %s AND timestamp BETWEEN %s AND %s",
+			columns, Raw(table), Raw(addressColumn), addressParam, startParam, endParam)
+	}
+	events := Format("%s UNION ALL %s UNION ALL %s UNION ALL %s",
+		branch(bridgeColumns, "bridge_events", bridgeSenderIndexExpr),
+		branch(bridgeColumns, "bridge_events", bridgeRecipientIndexExpr),
+		branch(swapColumns, "swap_events", swapSenderIndexExpr),
+		branch(swapColumns, "swap_events", swapBuyerIndexExpr))
+	deduped := Format("SELECT * FROM (%s) ORDER BY insert_time DESC LIMIT 1 BY platform, chain_id, contract_address, event_type, block_number, event_index, tx_hash", events)
+	query := Format("SELECT %s FROM (%s) ORDER BY timestamp, chain_id, block_number, event_index", Raw(addressActivityColumns), deduped)
+
+	rows, err := s.raw(ctx, query).Rows()
+	if err != nil {
+		return fmt.Errorf("could not read address activity: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	for rows.Next() {
+		var activity AddressActivity
+		err = s.db.ScanRows(rows, &activity)
+		if err != nil {
+			return fmt.Errorf("could not scan address activity: %w", err)
+		}
+
+		err = fn(activity)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("could not read address activity: %w", err)
+	}
+
+	return nil
+}
+
+// GetContractLastStoredBlocks gets the last stored block of each contract.
+func (s *Store) GetContractLastStoredBlocks(ctx context.Context) ([]LastBlock, error) {
+	var res []LastBlock
+	query := Raw(fmt.Sprintf("SELECT %s, %s, max(%s) AS %s FROM last_blocks GROUP BY %s, %s", ChainIDFieldName, ContractAddressFieldName, BlockNumberFieldName, BlockNumberFieldName, ChainIDFieldName, ContractAddressFieldName))
+	dbTx := s.raw(ctx, query).Scan(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get contract last blocks: %w", dbTx.Error)
+	}
+
+	return res, nil
+}
+
+// GetTransferActivity gets the bridge events and rfq refunds of the block ranges whose sender or recipient is one of the
+// addresses or whose kappa is one of the kappas. Addresses and kappas must be lowercase and kappas have no 0x prefix,
+// like the stored kappas.
+func (s *Store) GetTransferActivity(ctx context.Context, ranges []ContractBlockRange, addresses []string, kappas []string) ([]TransferActivity, error) {
+	if len(ranges) == 0 || len(addresses) == 0 && len(kappas) == 0 {
+		return nil, nil
+	}
+
+	inRanges := matchRanges(ranges)
+	bridgeMatches := matchAny([]string{"lower(sender)", "lower(coalesce(recipient, ''))"}, addresses, []string{"lower(destination_kappa)", "lower(coalesce(kappa, ''))"}, kappas)
+	rfqMatches := matchAny([]string{"lower(coalesce(recipient, ''))"}, addresses, []string{"lower(transaction_id)"}, kappas)
+
+	bridgeQuery := Format("SELECT 'bridge' AS platform, chain_id, toUInt64(coalesce(destination_chain_id, 0)) AS destination_chain_id, event_type, tx_hash, event_index, block_number, toUInt64(coalesce(timestamp, 0)) AS timestamp, if(destination_kappa != '', destination_kappa, coalesce(kappa, '')) AS kappa, sender, coalesce(recipient, '') AS recipient, token, coalesce(token_symbol, '') AS token_symbol, toString(amount) AS amount, coalesce(token_decimal, 0) AS token_decimal, coalesce(amount_usd, 0) AS amount_usd FROM bridge_events WHERE (%s) AND (%s)",
+		inRanges, bridgeMatches)
+	rfqQuery := Format("SELECT 'rfq' AS platform, chain_id, toUInt64(0) AS destination_chain_id, event_type, tx_hash, event_index, block_number, toUInt64(coalesce(timestamp, 0)) AS timestamp, transaction_id AS kappa, coalesce(sender, '') AS sender, coalesce(recipient, '') AS recipient, origin_token AS token, token_symbol, toString(origin_amount) AS amount, coalesce(token_decimal, 0) AS token_decimal, amount_usd FROM rfq_events WHERE event_type = %s AND (%s) AND (%s)",
+		Param(fastbridge.BridgeDepositRefundedEvent.Int()), inRanges, rfqMatches)
+	query := Format("SELECT * FROM (%s UNION ALL %s) ORDER BY chain_id, block_number, event_index", bridgeQuery, rfqQuery)
+
+	var res []TransferActivity
+	dbTx := s.raw(ctx, query).Scan(&res)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not read transfer activity: %w", dbTx.Error)
+	}
+
+	return res, nil
+}
+
+// matchRanges generates the condition matching the events of the contract block ranges.
+func matchRanges(ranges []ContractBlockRange) Query {
+	condition := Raw("")
+	for i, blockRange := range ranges {
+		if i > 0 {
+			condition = Concat(condition, Raw(" OR "))
+		}
+		condition = Concat(condition, Format(fmt.Sprintf("(%s = %%s AND lower(%s) = %%s AND %s > %%s AND %s <= %%s)", ChainIDFieldName, ContractAddressFieldName, BlockNumberFieldName, BlockNumberFieldName),
+			Param(blockRange.ChainID), Param(strings.ToLower(blockRange.ContractAddress)), Param(blockRange.After), Param(blockRange.Until)))
+	}
+
+	return condition
+}
+
+// matchAny generates the condition matching rows where one of the address columns is one of the addresses or one of
+// the kappa columns is one of the kappas.
+func matchAny(addressColumns []string, addresses []string, kappaColumns []string, kappas []string) Query {
+	var conditions []Query
+	if len(addresses) > 0 {
+		for _, column := range addressColumns {
+			conditions = append(conditions, Format("%s IN %s", Raw(column), inList(addresses)))
+		}
+	}
+	if len(kappas) > 0 {
+		for _, column := range kappaColumns {
+			conditions = append(conditions, Format("%s IN %s", Raw(column), inList(kappas)))
+		}
+	}
+
+	condition := Raw("")
+	for i, c := range conditions {
+		if i > 0 {
+			condition = Concat(condition, Raw(" OR "))
+		}
+		condition = Concat(condition, c)
+	}
+
+	return condition
+}
+
+// inList creates the list of an IN clause over the values.
+func inList(values []string) Query {
+	list := Raw("(")
+	for i := range values {
+		if i > 0 {
+			list = Concat(list, Raw(", "))
+		}
+		list = Concat(list, Param(values[i]))
+	}
+
+	return Concat(list, Raw(")"))
+}
@@ -6,6 +6,9 @@
 	"time"
 
 	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
+	"github.com/synapsecns/sanguine/services/explorer/types/cctp"
+	"github.com/synapsecns/sanguine/services/explorer/types/fastbridge"
 
 	gormClickhouse "gorm.io/driver/clickhouse"
 	"gorm.io/gorm"
@@ -78,6 +81,36 @@ func OpenGormClickhouse(ctx context.Context, address string, readOnly bool, hand
 				return nil, fmt.Errorf("could not migrate last block number on clickhouse: %w", err)
 			}
 		}
+		if (!clickhouseDB.WithContext(ctx).Migrator().HasTable(&TokenPrice{})) {
+			err = clickhouseDB.WithContext(ctx).Set("gorm:table_options", "ENGINE=ReplacingMergeTree(insert_time) ORDER BY (coingecko_id, granularity, timestamp)").AutoMigrate(&TokenPrice{})
+			if err != nil {
+				return nil, fmt.Errorf("could not migrate token prices on clickhouse: %w", err)
+			}
+		}
+		if (!clickhouseDB.WithContext(ctx).Migrator().HasTable(&CachedResponse{})) {
+			err = clickhouseDB.WithContext(ctx).Set("gorm:table_options", "ENGINE=ReplacingMergeTree(created_at) ORDER BY cache_key TTL toDateTime(expires_at)").AutoMigrate(&CachedResponse{})
+			if err != nil {
+				return nil, fmt.Errorf("could not migrate cached responses on clickhouse: %w", err)
+			}
+		}
+		if (!clickhouseDB.WithContext(ctx).Migrator().HasTable(&FeeEvent{})) {
+			err = clickhouseDB.WithContext(ctx).Set("gorm:table_options", "ENGINE=ReplacingMergeTree(insert_time) ORDER BY (platform, chain_id, contract_address, tx_hash, event_index, event_type)").AutoMigrate(&FeeEvent{})
+			if err != nil {
+				return nil, fmt.Errorf("could not migrate fee events on clickhouse: %w", err)
+			}
+		}
+		err = migrateFeeEventViews(ctx, clickhouseDB)
+		if err != nil {
+			return nil, err
+		}
+		err = migrateAddressIndexes(ctx, clickhouseDB)
+		if err != nil {
+			return nil, err
+		}
+		err = migrateRegisteredTables(ctx, clickhouseDB)
+		if err != nil {
+			return nil, err
+		}
 	}
 	db, err := clickhouseDB.DB()
 
@@ -90,3 +123,123 @@ func OpenGormClickhouse(ctx context.Context, address string, readOnly bool, hand
 
 	return &Store{clickhouseDB}, nil
 }
+
+// mvBridgeEventsTable is the materialized view joining origin and destination bridge events.
+const mvBridgeEventsTable = "mv_bridge_events"
+
+// feeEventsTable is the table the fee event views write to.
+const feeEventsTable = "fee_events"
+
+// feeEventColumns are the columns selected by each fee event view.
+const feeEventColumns = "insert_time, chain_id, platform, contract_address, event_type, tx_hash, event_index, block_number, timestamp, token, token_symbol, relayer, amount_usd, fee_usd, protocol_fee_usd, revenue_usd"
+
+// feeEventViews are the materialized views writing the fees of each event table to the fee events table, by view name.
+// RFQ relays have no explicit fee: the relayer keeps the spread between the origin and destination amount, which is
+// priced at the destination amount's USD value (origin and destination tokens are assumed to have the same decimals,
+// like the rfq parser does).
+var feeEventViews = map[string]string{
+	"mv_bridge_fee_events": fmt.Sprintf("SELECT insert_time, chain_id, 'bridge' AS platform, contract_address, event_type, tx_hash, event_index, block_number, coalesce(timestamp, 0) AS timestamp, token, coalesce(token_symbol, '') AS token_symbol, '' AS relayer, coalesce(amount_usd, 0) AS amount_usd, coalesce(fee_usd, 0) AS fee_usd, coalesce(fee_usd, 0) AS protocol_fee_usd, toFloat64(0) AS revenue_usd FROM bridge_events WHERE event_type IN (%d, %d, %d, %d)",
+		bridge.WithdrawEvent.Int(), bridge.MintEvent.Int(), bridge.MintAndSwapEvent.Int(), bridge.WithdrawAndRemoveEvent.Int()),
+	"mv_cctp_fee_events": fmt.Sprintf("SELECT insert_time, chain_id, 'cctp' AS platform, contract_address, event_type, tx_hash, event_index, block_number, coalesce(timestamp, 0) AS timestamp, token, token_symbol, '' AS relayer, amount_usd, coalesce(fee_usd, 0) AS fee_usd, coalesce(fee_usd, 0) AS protocol_fee_usd, toFloat64(0) AS revenue_usd FROM cctp_events WHERE event_type = %d",
+		cctp.CircleRequestFulfilledEvent.Int()),
+	"mv_rfq_fee_events": fmt.Sprintf("SELECT insert_time, chain_id, 'rfq' AS platform, contract_address, event_type, tx_hash, event_index, block_number, coalesce(timestamp, 0) AS timestamp, destination_token AS token, token_symbol, coalesce(relayer, '') AS relayer, amount_usd, if(destination_amount > 0 AND origin_amount > destination_amount, amount_usd * (toFloat64(origin_amount) - toFloat64(destination_amount)) / toFloat64(destination_amount), 0) AS revenue_usd, revenue_usd AS fee_usd, toFloat64(0) AS protocol_fee_usd FROM rfq_events WHERE event_type = %d",
+		fastbridge.BridgeRelayedEvent.Int()),
+	"mv_swap_fee_events": "SELECT insert_time, chain_id, 'swap' AS platform, contract_address, event_type, tx_hash, event_index, block_number, coalesce(timestamp, 0) AS timestamp, '' AS token, arrayStringConcat(mapValues(token_symbol), '/') AS token_symbol, '' AS relayer, arraySum(mapValues(amount_usd)) AS amount_usd, arraySum(mapValues(fee_usd)) AS fee_usd, arraySum(mapValues(admin_fee_usd)) AS protocol_fee_usd, toFloat64(0) AS revenue_usd FROM swap_events WHERE arraySum(mapValues(fee_usd)) > 0",
+}
+
+// migrateFeeEventViews creates the missing fee event views and backfills the fee events stored before each view
+// existed. It runs on every start and only touches missing views, so views added in later versions are created and
+// backfilled once. Rows inserted by both a view and its backfill are deduplicated by the fee events table.
+func migrateFeeEventViews(ctx context.Context, clickhouseDB *gorm.DB) error {
+	for name, query := range feeEventViews {
+		if clickhouseDB.WithContext(ctx).Migrator().HasTable(name) {
+			continue
+		}
+
+		err := clickhouseDB.WithContext(ctx).Exec(fmt.Sprintf("CREATE MATERIALIZED VIEW IF NOT EXISTS %s TO %s AS %s", name, feeEventsTable, query)).Error
+		if err != nil {
+			return fmt.Errorf("could not create view %s: %w", name, err)
+		}
+
+		err = clickhouseDB.WithContext(ctx).Exec(fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM (%s)", feeEventsTable, feeEventColumns, feeEventColumns, query)).Error
+		if err != nil {
+			return fmt.Errorf("could not backfill view %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// The lowercased address expressions of the event tables. Queries by address must filter on these exact expressions
+// to be served by the address indexes.
+const (
+	bridgeSenderIndexExpr    = "lower(sender)"
+	bridgeRecipientIndexExpr = "lower(coalesce(recipient, ''))"
+	swapSenderIndexExpr      = "lower(sender)"
+	swapBuyerIndexExpr       = "lower(coalesce(buyer, ''))"
+)
+
+// addressIndex is a bloom filter skip index on a lowercased address column.
+type addressIndex struct {
+	table string
+	name  string
+	expr  string
+}
+
+// addressIndexes are the address indexes of the event tables.
+var addressIndexes = []addressIndex{
+	{table: "bridge_events", name: "idx_sender", expr: bridgeSenderIndexExpr},
+	{table: "bridge_events", name: "idx_recipient", expr: bridgeRecipientIndexExpr},
+	{table: "swap_events", name: "idx_sender", expr: swapSenderIndexExpr},
+	{table: "swap_events", name: "idx_buyer", expr: swapBuyerIndexExpr},
+}
+
+// migrateAddressIndexes adds the address indexes missing from the event tables and materializes them for the rows
+// stored before the index existed. It runs on every start so tables created by earlier versions get the indexes too.
+func migrateAddressIndexes(ctx context.Context, clickhouseDB *gorm.DB) error {
+	for _, index := range addressIndexes {
+		var count int64
+		err := clickhouseDB.WithContext(ctx).Raw("SELECT count() FROM system.data_skipping_indices WHERE database = currentDatabase() AND table = ? AND name = ?", index.table, index.name).Scan(&count).Error
+		if err != nil {
+			return fmt.Errorf("could not check index %s on %s: %w", index.name, index.table, err)
+		}
+		if count > 0 {
+			continue
+		}
+
+		err = clickhouseDB.WithContext(ctx).Exec(fmt.Sprintf("ALTER TABLE %s ADD INDEX IF NOT EXISTS %s %s TYPE bloom_filter GRANULARITY 4", index.table, index.name, index.expr)).Error
+		if err != nil {
+			return fmt.Errorf("could not add index %s on %s: %w", index.name, index.table, err)
+		}
+
+		err = clickhouseDB.WithContext(ctx).Exec(fmt.Sprintf("ALTER TABLE %s MATERIALIZE INDEX %s", index.table, index.name)).Error
+		if err != nil {
+			return fmt.Errorf("could not materialize index %s on %s: %w", index.name, index.table, err)
+		}
+	}
+
+	return nil
+}
+
+// eventModels gets a model of each event table.
+func eventModels() []interface{} {
+	models := []interface{}{&BridgeEvent{}, &SwapEvent{}, &MessageBusEvent{}, &CCTPEvent{}, &RFQEvent{}}
+	for _, table := range getRegisteredTables() {
+		if table.Events {
+			models = append(models, table.Model)
+		}
+	}
+
+	return models
+}
+
+// tableName gets the name of the table of a model.
+func (s *Store) tableName(model interface{}) (string, error) {
+	stmt := &gorm.Statement{DB: s.db}
+	err := stmt.Parse(model)
+	if err != nil {
+		return "", fmt.Errorf("could not parse model: %w", err)
+	}
+
+	return stmt.Schema.Table, nil
+}
@@ -0,0 +1,113 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+// Table is a table registered by a parser outside of the built-in event tables.
+type Table struct {
+	// Model is the gorm model of the rows of the table.
+	Model interface{}
+	// Options are the gorm table options the table is created with, e.g. "ENGINE=ReplacingMergeTree(insert_time) ORDER BY (...)".
+	Options string
+	// Events is set when the rows are events with chain_id, contract_address and block_number columns. Event tables
+	// are read and cleared by block range with the built-in event tables (e.g. by the reparser).
+	Events bool
+}
+
+var (
+	// tablesMux protects registeredTables.
+	tablesMux sync.RWMutex
+	// registeredTables are the tables registered by parsers, by the type of their model.
+	registeredTables = make(map[reflect.Type]Table)
+	// registeredOrder is the order the tables were registered in.
+	registeredOrder []reflect.Type
+)
+
+// RegisterTable registers the table of a parser. Registered tables are created when the store is opened and their
+// models can be passed to StoreEvent and StoreEvents. Both the model and a pointer to it are accepted as events.
+func RegisterTable(table Table) error {
+	modelType := reflect.Indirect(reflect.ValueOf(table.Model)).Type()
+	if modelType.Kind() != reflect.Struct {
+		return fmt.Errorf("table model must be a struct, got %s", modelType)
+	}
+
+	tablesMux.Lock()
+	defer tablesMux.Unlock()
+
+	if _, ok := registeredTables[modelType]; ok {
+		return fmt.Errorf("table of %s is already registered", modelType)
+	}
+	registeredTables[modelType] = table
+	registeredOrder = append(registeredOrder, modelType)
+
+	return nil
+}
+
+// getRegisteredTables gets the registered tables in the order they were registered.
+func getRegisteredTables() []Table {
+	tablesMux.RLock()
+	defer tablesMux.RUnlock()
+
+	tables := make([]Table, 0, len(registeredOrder))
+	for _, modelType := range registeredOrder {
+		tables = append(tables, registeredTables[modelType])
+	}
+
+	return tables
+}
+
+// isRegisteredModel checks if an event is a row of a registered table.
+func isRegisteredModel(event interface{}) bool {
+	tablesMux.RLock()
+	defer tablesMux.RUnlock()
+
+	_, ok := registeredTables[reflect.Indirect(reflect.ValueOf(event)).Type()]
+	return ok
+}
+
+// migrateRegisteredTables creates the registered tables that do not exist yet.
+func migrateRegisteredTables(ctx context.Context, clickhouseDB *gorm.DB) error {
+	for _, table := range getRegisteredTables() {
+		if clickhouseDB.WithContext(ctx).Migrator().HasTable(table.Model) {
+			continue
+		}
+
+		err := clickhouseDB.WithContext(ctx).Set("gorm:table_options", table.Options).AutoMigrate(table.Model)
+		if err != nil {
+			return fmt.Errorf("could not migrate %T on clickhouse: %w", table.Model, err)
+		}
+	}
+
+	return nil
+}
+
+// storeRegisteredEvents stores the events of registered tables, in one batch per table.
+func (s *Store) storeRegisteredEvents(ctx context.Context, events []interface{}) error {
+	batches := make(map[reflect.Type]reflect.Value)
+	var order []reflect.Type
+	for _, event := range events {
+		eventType := reflect.TypeOf(event)
+		if _, ok := batches[eventType]; !ok {
+			batches[eventType] = reflect.MakeSlice(reflect.SliceOf(eventType), 0, len(events))
+			order = append(order, eventType)
+		}
+		batches[eventType] = reflect.Append(batches[eventType], reflect.ValueOf(event))
+	}
+
+	for _, eventType := range order {
+		batch := reflect.New(batches[eventType].Type())
+		batch.Elem().Set(batches[eventType])
+		dbTx := s.db.WithContext(ctx).Create(batch.Interface())
+		if dbTx.Error != nil {
+			return fmt.Errorf("failed to store %s events: %w", eventType, dbTx.Error)
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,9 @@
 import (
 	"context"
 	"fmt"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
 )
 
 // StoreEvent stores a generic event that has the proper fields set by `eventToBridgeEvent`.
@@ -33,6 +36,10 @@ func (s *Store) StoreEvent(ctx context.Context, event interface{}) error {
 		if dbTx.Error != nil {
 			return fmt.Errorf("failed to store rfq event: %w", dbTx.Error)
 		}
+	default:
+		if isRegisteredModel(event) {
+			return s.storeRegisteredEvents(ctx, []interface{}{event})
+		}
 	}
 	return nil
 }
@@ -46,6 +53,7 @@ func (s *Store) StoreEvents(ctx context.Context, events []interface{}) error {
 	var messageBusEvents []MessageBusEvent
 	var cctpEvents []*CCTPEvent
 	var rfqEvents []*RFQEvent
+	var registeredEvents []interface{}
 
 	for _, event := range events {
 		switch conv := event.(type) {
@@ -59,6 +67,10 @@ func (s *Store) StoreEvents(ctx context.Context, events []interface{}) error {
 			cctpEvents = append(cctpEvents, conv)
 		case *RFQEvent:
 			rfqEvents = append(rfqEvents, conv)
+		default:
+			if isRegisteredModel(event) {
+				registeredEvents = append(registeredEvents, event)
+			}
 		}
 	}
 
@@ -98,6 +110,10 @@ func (s *Store) StoreEvents(ctx context.Context, events []interface{}) error {
 		}
 	}
 
+	if len(registeredEvents) > 0 {
+		return s.storeRegisteredEvents(ctx, registeredEvents)
+	}
+
 	return nil
 }
 
@@ -192,3 +208,110 @@ func (s *Store) StoreSwapFee(ctx context.Context, chainID uint32, blockNumber ui
 	}
 	return nil
 }
+
+// StoreTokenPrices stores a list of token prices.
+func (s *Store) StoreTokenPrices(ctx context.Context, prices []TokenPrice) error {
+	if len(prices) == 0 {
+		return nil
+	}
+
+	dbTx := s.db.WithContext(ctx).Create(&prices)
+	if dbTx.Error != nil {
+		return fmt.Errorf("failed to store token prices: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// StoreCachedResponse stores an api response shared by the api replicas.
+func (s *Store) StoreCachedResponse(ctx context.Context, response CachedResponse) error {
+	dbTx := s.db.WithContext(ctx).Create(&response)
+	if dbTx.Error != nil {
+		return fmt.Errorf("failed to store cached response: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// DeleteEventsInRange deletes the events of a contract from every event table for a block range. The origin rows of
+// the bridge materialized view are deleted as well, the view is repopulated when the events are stored again. Rows
+// whose destination side is in the range keep their origin side, but their destination columns are reset so the rows
+// are pending until the destination events are stored again.
+func (s *Store) DeleteEventsInRange(ctx context.Context, chainID uint32, contractAddress string, startBlock, endBlock uint64) error {
+	// wait for the mutations to complete so the events can be stored again right away.
+	syncCtx := clickhouse.Context(ctx, clickhouse.WithSettings(clickhouse.Settings{"mutations_sync": 2}))
+
+	for _, event := range eventModels() {
+		table, err := s.tableName(event)
+		if err != nil {
+			return err
+		}
+
+		query := Format("ALTER TABLE %s DELETE WHERE chain_id = %s AND contract_address = %s AND block_number BETWEEN %s AND %s",
+			Raw(table), Param(chainID), Param(contractAddress), Param(startBlock), Param(endBlock))
+		text, args := query.Build()
+		dbTx := s.db.WithContext(syncCtx).Exec(text, args...)
+		if dbTx.Error != nil {
+			return fmt.Errorf("could not delete events from %s: %w", table, dbTx.Error)
+		}
+	}
+
+	if s.db.WithContext(ctx).Migrator().HasTable(mvBridgeEventsTable) {
+		query := Format("ALTER TABLE %s DELETE WHERE fchain_id = %s AND fcontract_address = %s AND fblock_number BETWEEN %s AND %s",
+			Raw(mvBridgeEventsTable), Param(chainID), Param(contractAddress), Param(startBlock), Param(endBlock))
+		text, args := query.Build()
+		dbTx := s.db.WithContext(syncCtx).Exec(text, args...)
+		if dbTx.Error != nil {
+			return fmt.Errorf("could not delete events from %s: %w", mvBridgeEventsTable, dbTx.Error)
+		}
+
+		err := s.resetBridgeDestinations(syncCtx, chainID, contractAddress, startBlock, endBlock)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the fee events of the stored events are written again by the fee event views.
+	if s.db.WithContext(ctx).Migrator().HasTable(feeEventsTable) {
+		query := Format("ALTER TABLE %s DELETE WHERE chain_id = %s AND contract_address = %s AND block_number BETWEEN %s AND %s",
+			Raw(feeEventsTable), Param(chainID), Param(contractAddress), Param(startBlock), Param(endBlock))
+		text, args := query.Build()
+		dbTx := s.db.WithContext(syncCtx).Exec(text, args...)
+		if dbTx.Error != nil {
+			return fmt.Errorf("could not delete events from %s: %w", feeEventsTable, dbTx.Error)
+		}
+	}
+
+	return nil
+}
+
+// resetBridgeDestinations resets the destination columns of the bridge materialized view rows whose destination event
+// is in a block range of a contract. The origin events of these rows are not in the range, so the rows are not deleted.
+func (s *Store) resetBridgeDestinations(ctx context.Context, chainID uint32, contractAddress string, startBlock, endBlock uint64) error {
+	// every destination column of the view is prefixed with t, columns of the sorting key cannot be updated.
+	var columns []string
+	query := Format("SELECT name FROM system.columns WHERE database = currentDatabase() AND table = %s AND startsWith(name, 't') AND is_in_sorting_key = 0 ORDER BY position",
+		Param(mvBridgeEventsTable))
+	err := s.raw(ctx, query).Scan(&columns).Error
+	if err != nil {
+		return fmt.Errorf("could not get destination columns of %s: %w", mvBridgeEventsTable, err)
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	assignments := make([]string, len(columns))
+	for i, column := range columns {
+		assignments[i] = fmt.Sprintf("%s = defaultValueOfArgumentType(%s)", column, column)
+	}
+
+	query = Format("ALTER TABLE %s UPDATE %s WHERE tchain_id = %s AND tcontract_address = %s AND tblock_number BETWEEN %s AND %s",
+		Raw(mvBridgeEventsTable), Raw(strings.Join(assignments, ", ")), Param(chainID), Param(contractAddress), Param(startBlock), Param(endBlock))
+	text, args := query.Build()
+	dbTx := s.db.WithContext(ctx).Exec(text, args...)
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not reset destinations of %s: %w", mvBridgeEventsTable, dbTx.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,3 @@
+// Package export exports the bridge, swap, cctp and rfq activity of an address across all chains as csv or json.
+// Rows are streamed from the database to the writer so large histories are never held in memory.
+package export
@@ -0,0 +1,269 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/static"
+	"gopkg.in/yaml.v2"
+)
+
+// Format is the format of an export.
+type Format string
+
+const (
+	// FormatCSV exports a csv file with a header row.
+	FormatCSV Format = "csv"
+	// FormatJSON exports a json array of records.
+	FormatJSON Format = "json"
+)
+
+// flushInterval is the number of records written between flushes of the writer.
+const flushInterval = 100
+
+// ParseFormat parses an export format.
+func ParseFormat(format string) (Format, error) {
+	switch Format(format) {
+	case FormatCSV, FormatJSON:
+		return Format(format), nil
+	default:
+		return "", fmt.Errorf("unknown export format %q, expected %s or %s", format, FormatCSV, FormatJSON)
+	}
+}
+
+// ContentType gets the http content type of the format.
+func (f Format) ContentType() string {
+	if f == FormatJSON {
+		return "application/json"
+	}
+
+	return "text/csv"
+}
+
+// Exporter exports the activity of addresses.
+type Exporter struct {
+	// db is the consumer db reader.
+	db db.ConsumerDBReader
+	// chainNames are the chain names by chain id.
+	chainNames map[string]string
+	// explorers are the block explorer urls by chain id.
+	explorers map[string]string
+}
+
+// NewExporter creates a new exporter.
+func NewExporter(consumerDB db.ConsumerDBReader) (*Exporter, error) {
+	exporter := &Exporter{db: consumerDB}
+
+	err := yaml.Unmarshal(static.GetChainIDsConfig(), &exporter.chainNames)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal chain names: %w", err)
+	}
+
+	err = yaml.Unmarshal(static.GetChainExplorersConfig(), &exporter.explorers)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal chain explorers: %w", err)
+	}
+
+	return exporter, nil
+}
+
+// Export writes the activity of an address between the start and end time (unix seconds, inclusive) to w. Records
+// are written as they are read, if w is an http.Flusher it is flushed regularly so clients receive them right away.
+// If reading fails partway, a trailing error record is written after the records already sent so a truncated
+// export can't be mistaken for a complete one.
+func (e *Exporter) Export(ctx context.Context, address string, startTime, endTime uint64, format Format, w io.Writer) error {
+	if !common.IsHexAddress(address) {
+		return fmt.Errorf("invalid address %q", address)
+	}
+
+	writer := newRecordWriter(format, w)
+	written := 0
+	err := e.db.StreamAddressActivity(ctx, address, startTime, endTime, func(activity sql.AddressActivity) error {
+		err := writer.Write(newRecord(activity, e.chainNames, e.explorers))
+		if err != nil {
+			return fmt.Errorf("could not write record: %w", err)
+		}
+
+		written++
+		if written%flushInterval == 0 {
+			return writer.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		err = fmt.Errorf("could not export activity of %s: %w", address, err)
+		if failErr := writer.Fail(err); failErr != nil {
+			return fmt.Errorf("could not write error record: %w (export error: %v)", failErr, err)
+		}
+		return err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return fmt.Errorf("could not finish export: %w", err)
+	}
+
+	return nil
+}
+
+// recordWriter writes records in an export format.
+type recordWriter interface {
+	// Write writes a record.
+	Write(record Record) error
+	// Flush flushes the written records to the underlying writer.
+	Flush() error
+	// Close finishes the export and flushes it.
+	Close() error
+	// Fail finishes the export with a trailing error record and flushes it.
+	Fail(exportErr error) error
+}
+
+// errorMessage is the message of the trailing error record of a failed export. The cause isn't exposed to clients.
+const errorMessage = "export failed before all records were written"
+
+func newRecordWriter(format Format, w io.Writer) recordWriter {
+	if format == FormatJSON {
+		return &jsonWriter{w: w}
+	}
+
+	return &csvWriter{w: w, csv: csv.NewWriter(w)}
+}
+
+// csvWriter writes records as csv rows after a header row.
+type csvWriter struct {
+	w             io.Writer
+	csv           *csv.Writer
+	headerWritten bool
+}
+
+func (c *csvWriter) writeHeader() error {
+	if c.headerWritten {
+		return nil
+	}
+	c.headerWritten = true
+
+	//nolint: wrapcheck
+	return c.csv.Write(csvHeader)
+}
+
+func (c *csvWriter) Write(record Record) error {
+	err := c.writeHeader()
+	if err != nil {
+		return err
+	}
+
+	//nolint: wrapcheck
+	return c.csv.Write(record.csvRow())
+}
+
+func (c *csvWriter) Flush() error {
+	c.csv.Flush()
+	if err := c.csv.Error(); err != nil {
+		return fmt.Errorf("could not flush csv: %w", err)
+	}
+	flush(c.w)
+
+	return nil
+}
+
+func (c *csvWriter) Close() error {
+	// an empty export still has a header.
+	err := c.writeHeader()
+	if err != nil {
+		return err
+	}
+
+	return c.Flush()
+}
+
+// Fail writes an error row with the message in the second column.
+func (c *csvWriter) Fail(_ error) error {
+	err := c.writeHeader()
+	if err != nil {
+		return err
+	}
+
+	err = c.csv.Write([]string{"error", errorMessage})
+	if err != nil {
+		return fmt.Errorf("could not write error row: %w", err)
+	}
+
+	return c.Flush()
+}
+
+// jsonWriter writes records as the elements of a json array.
+type jsonWriter struct {
+	w       io.Writer
+	written int
+}
+
+func (j *jsonWriter) Write(record Record) error {
+	prefix := ","
+	if j.written == 0 {
+		prefix = "["
+	}
+	j.written++
+
+	encoded, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("could not marshal record: %w", err)
+	}
+
+	_, err = j.w.Write(append([]byte(prefix), encoded...))
+	//nolint: wrapcheck
+	return err
+}
+
+func (j *jsonWriter) Flush() error {
+	flush(j.w)
+	return nil
+}
+
+func (j *jsonWriter) Close() error {
+	closing := "]"
+	if j.written == 0 {
+		closing = "[]"
+	}
+
+	_, err := io.WriteString(j.w, closing)
+	if err != nil {
+		return fmt.Errorf("could not close json array: %w", err)
+	}
+
+	return j.Flush()
+}
+
+// Fail closes the array with an {"error": ...} element.
+func (j *jsonWriter) Fail(_ error) error {
+	encoded, err := json.Marshal(map[string]string{"error": errorMessage})
+	if err != nil {
+		return fmt.Errorf("could not marshal error record: %w", err)
+	}
+
+	prefix := ","
+	if j.written == 0 {
+		prefix = "["
+	}
+	j.written++
+
+	_, err = j.w.Write(append(append([]byte(prefix), encoded...), ']'))
+	if err != nil {
+		return fmt.Errorf("could not write error record: %w", err)
+	}
+
+	return j.Flush()
+}
+
+// flush flushes w if it buffers writes to an http response.
+func flush(w io.Writer) {
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
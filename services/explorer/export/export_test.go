@@ -0,0 +1,195 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/services/explorer/db/mocks"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/export"
+	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
+	"github.com/synapsecns/sanguine/services/explorer/types/swap"
+)
+
+const address = "0x0000000000000000000000000000000000000001"
+
+func newConsumerDB(activities ...sql.AddressActivity) *mocks.ConsumerDB {
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("StreamAddressActivity", mock.Anything, address, uint64(0), uint64(2_000_000_000), mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn, _ := args.Get(4).(func(sql.AddressActivity) error)
+			for _, activity := range activities {
+				if fn(activity) != nil {
+					return
+				}
+			}
+		}).Return(nil)
+
+	return consumerDB
+}
+
+var activities = []sql.AddressActivity{
+	{
+		Platform:           "bridge",
+		ChainID:            1,
+		EventType:          bridge.CircleRequestSentEvent.Int(),
+		TxHash:             "0xaa",
+		BlockNumber:        100,
+		TimeStamp:          1_700_000_000,
+		Token:              "0xusdc",
+		TokenSymbol:        "USDC",
+		Amount:             "1500000",
+		TokenDecimal:       6,
+		AmountUSD:          1.5,
+		FeeUSD:             0.01,
+		DestinationChainID: 42161,
+		Kappa:              "0xkappa",
+	},
+	{
+		Platform:     "bridge",
+		ChainID:      42161,
+		EventType:    bridge.CircleRequestFulfilledEvent.Int(),
+		TxHash:       "0xbb",
+		BlockNumber:  200,
+		TimeStamp:    1_700_000_060,
+		Token:        "0xusdc",
+		TokenSymbol:  "USDC",
+		Amount:       "1490000",
+		TokenDecimal: 6,
+		AmountUSD:    1.49,
+		Kappa:        "0xkappa",
+	},
+	{
+		Platform:     "swap",
+		ChainID:      1,
+		EventType:    swap.TokenSwapEvent.Int(),
+		TxHash:       "0xcc",
+		BlockNumber:  300,
+		TimeStamp:    1_700_000_120,
+		Token:        "0xpool",
+		TokenSymbol:  "USDC/USDT",
+		Amount:       "10000000",
+		TokenDecimal: 6,
+		AmountUSD:    10,
+		FeeUSD:       0.004,
+	},
+}
+
+func TestExportJSON(t *testing.T) {
+	exporter, err := export.NewExporter(newConsumerDB(activities...))
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, exporter.Export(context.Background(), address, 0, 2_000_000_000, export.FormatJSON, &out))
+
+	var records []export.Record
+	require.NoError(t, json.Unmarshal(out.Bytes(), &records))
+	require.Len(t, records, 3)
+
+	assert.Equal(t, export.Record{
+		Platform:           export.PlatformCCTP,
+		Direction:          export.DirectionOrigin,
+		EventType:          "CircleRequestSentEvent",
+		ChainID:            1,
+		ChainName:          "Ethereum",
+		DestinationChainID: 42161,
+		TxHash:             "0xaa",
+		TxURL:              "https://etherscan.io/tx/0xaa",
+		BlockNumber:        100,
+		Time:               "2023-11-14T22:13:20Z",
+		Token:              "0xusdc",
+		TokenSymbol:        "USDC",
+		Amount:             "1.5",
+		AmountUSD:          1.5,
+		FeeUSD:             0.01,
+		Kappa:              "0xkappa",
+	}, records[0])
+
+	assert.Equal(t, export.DirectionDestination, records[1].Direction)
+	assert.Equal(t, uint64(0), records[1].DestinationChainID)
+	assert.Equal(t, "1.49", records[1].Amount)
+
+	assert.Equal(t, export.PlatformSwap, records[2].Platform)
+	assert.Equal(t, "TokenSwapEvent", records[2].EventType)
+	assert.Empty(t, records[2].Direction)
+	assert.Equal(t, "10", records[2].Amount)
+	assert.InDelta(t, 10, records[2].AmountUSD, 0)
+	assert.InDelta(t, 0.004, records[2].FeeUSD, 0)
+}
+
+func TestExportCSV(t *testing.T) {
+	exporter, err := export.NewExporter(newConsumerDB(activities...))
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, exporter.Export(context.Background(), address, 0, 2_000_000_000, export.FormatCSV, &out))
+
+	rows, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	assert.Equal(t, "platform", rows[0][0])
+	assert.Equal(t, []string{"cctp", "origin", "CircleRequestSentEvent", "1", "Ethereum", "42161", "0xaa",
+		"https://etherscan.io/tx/0xaa", "100", "2023-11-14T22:13:20Z", "0xusdc", "USDC", "1.5", "1.5", "0.01", "0xkappa"}, rows[1])
+	assert.Equal(t, "swap", rows[3][0])
+}
+
+func TestExportEmpty(t *testing.T) {
+	exporter, err := export.NewExporter(newConsumerDB())
+	require.NoError(t, err)
+
+	var jsonOut bytes.Buffer
+	require.NoError(t, exporter.Export(context.Background(), address, 0, 2_000_000_000, export.FormatJSON, &jsonOut))
+	assert.Equal(t, "[]", jsonOut.String())
+
+	var csvOut bytes.Buffer
+	require.NoError(t, exporter.Export(context.Background(), address, 0, 2_000_000_000, export.FormatCSV, &csvOut))
+	rows, err := csv.NewReader(&csvOut).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, rows, 1)
+}
+
+func TestExportStreamFailure(t *testing.T) {
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("StreamAddressActivity", mock.Anything, address, uint64(0), uint64(2_000_000_000), mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn, _ := args.Get(4).(func(sql.AddressActivity) error)
+			_ = fn(activities[0])
+		}).Return(errors.New("connection lost"))
+
+	exporter, err := export.NewExporter(consumerDB)
+	require.NoError(t, err)
+
+	var jsonOut bytes.Buffer
+	require.Error(t, exporter.Export(context.Background(), address, 0, 2_000_000_000, export.FormatJSON, &jsonOut))
+	var elements []map[string]interface{}
+	require.NoError(t, json.Unmarshal(jsonOut.Bytes(), &elements))
+	require.Len(t, elements, 2)
+	assert.Equal(t, "0xaa", elements[0]["tx_hash"])
+	assert.Contains(t, elements[1], "error")
+
+	var csvOut bytes.Buffer
+	require.Error(t, exporter.Export(context.Background(), address, 0, 2_000_000_000, export.FormatCSV, &csvOut))
+	reader := csv.NewReader(&csvOut)
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, "error", rows[2][0])
+}
+
+func TestExportInvalid(t *testing.T) {
+	exporter, err := export.NewExporter(new(mocks.ConsumerDB))
+	require.NoError(t, err)
+
+	require.Error(t, exporter.Export(context.Background(), "not an address", 0, 2_000_000_000, export.FormatCSV, &bytes.Buffer{}))
+
+	_, err = export.ParseFormat("xml")
+	require.Error(t, err)
+}
@@ -0,0 +1,162 @@
+package export
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
+	"github.com/synapsecns/sanguine/services/explorer/types/swap"
+)
+
+const (
+	// PlatformBridge is the platform of events of the synapse bridge.
+	PlatformBridge = "bridge"
+	// PlatformCCTP is the platform of events of the cctp contract.
+	PlatformCCTP = "cctp"
+	// PlatformRFQ is the platform of events of the rfq contract.
+	PlatformRFQ = "rfq"
+	// PlatformSwap is the platform of swap events.
+	PlatformSwap = "swap"
+)
+
+const (
+	// DirectionOrigin is the direction of an event sent on the origin chain of a transfer.
+	DirectionOrigin = "origin"
+	// DirectionDestination is the direction of an event completing a transfer on the destination chain.
+	DirectionDestination = "destination"
+)
+
+// Record is a row of an export.
+type Record struct {
+	// Platform is the platform of the event, one of bridge, cctp, rfq or swap.
+	Platform string `json:"platform"`
+	// Direction is origin or destination for bridge events and empty for swaps.
+	Direction string `json:"direction,omitempty"`
+	// EventType is the name of the event type.
+	EventType string `json:"event_type"`
+	// ChainID is the chain id of the event.
+	ChainID uint32 `json:"chain_id"`
+	// ChainName is the name of the chain of the event.
+	ChainName string `json:"chain_name"`
+	// DestinationChainID is the destination chain id of origin events.
+	DestinationChainID uint64 `json:"destination_chain_id,omitempty"`
+	// TxHash is the transaction hash of the event.
+	TxHash string `json:"tx_hash"`
+	// TxURL is the link to the transaction on the block explorer of the chain.
+	TxURL string `json:"tx_url,omitempty"`
+	// BlockNumber is the block number of the event.
+	BlockNumber uint64 `json:"block_number"`
+	// Time is the time of the event in RFC3339.
+	Time string `json:"time"`
+	// Token is the address of the token, or of the pool for swaps.
+	Token string `json:"token"`
+	// TokenSymbol is the symbol of the token.
+	TokenSymbol string `json:"token_symbol"`
+	// Amount is the amount of tokens adjusted for the token's decimals. For swaps it is the amount sold and it is
+	// empty for other pool events.
+	Amount string `json:"amount,omitempty"`
+	// AmountUSD is the value in USD at the time of the event.
+	AmountUSD float64 `json:"amount_usd"`
+	// FeeUSD is the fee in USD at the time of the event.
+	FeeUSD float64 `json:"fee_usd"`
+	// Kappa links the origin and destination events of a bridge transfer.
+	Kappa string `json:"kappa,omitempty"`
+}
+
+// csvHeader is the header of csv exports, in the order of csvRow.
+var csvHeader = []string{"platform", "direction", "event_type", "chain_id", "chain_name", "destination_chain_id",
+	"tx_hash", "tx_url", "block_number", "time", "token", "token_symbol", "amount", "amount_usd", "fee_usd", "kappa"}
+
+// csvRow gets the csv columns of the record.
+func (r Record) csvRow() []string {
+	destinationChainID := ""
+	if r.DestinationChainID != 0 {
+		destinationChainID = strconv.FormatUint(r.DestinationChainID, 10)
+	}
+
+	return []string{r.Platform, r.Direction, r.EventType, strconv.FormatUint(uint64(r.ChainID), 10), r.ChainName,
+		destinationChainID, r.TxHash, r.TxURL, strconv.FormatUint(r.BlockNumber, 10), r.Time, r.Token, r.TokenSymbol,
+		r.Amount, strconv.FormatFloat(r.AmountUSD, 'f', -1, 64), strconv.FormatFloat(r.FeeUSD, 'f', -1, 64), r.Kappa}
+}
+
+// newRecord creates a record from an activity. chainNames and explorers are keyed by chain id.
+func newRecord(activity sql.AddressActivity, chainNames, explorers map[string]string) Record {
+	chainID := strconv.FormatUint(uint64(activity.ChainID), 10)
+	record := Record{
+		ChainID:     activity.ChainID,
+		ChainName:   chainNames[chainID],
+		TxHash:      activity.TxHash,
+		BlockNumber: activity.BlockNumber,
+		Time:        time.Unix(int64(activity.TimeStamp), 0).UTC().Format(time.RFC3339),
+		Token:       activity.Token,
+		TokenSymbol: activity.TokenSymbol,
+		AmountUSD:   activity.AmountUSD,
+		FeeUSD:      activity.FeeUSD,
+	}
+	if explorer, ok := explorers[chainID]; ok && activity.TxHash != "" {
+		record.TxURL = strings.TrimSuffix(explorer, "/") + "/tx/" + activity.TxHash
+	}
+
+	if activity.Platform == PlatformSwap {
+		record.Platform = PlatformSwap
+		record.EventType = swap.EventType(activity.EventType).String()
+		if activity.Amount != "" {
+			record.Amount = formatAmount(activity.Amount, activity.TokenDecimal)
+		}
+		return record
+	}
+
+	eventType := bridge.EventType(activity.EventType)
+	record.Platform = platform(eventType)
+	record.EventType = eventType.String()
+	record.Amount = formatAmount(activity.Amount, activity.TokenDecimal)
+	record.Kappa = activity.Kappa
+	record.Direction = DirectionDestination
+	if isOrigin(eventType) {
+		record.Direction = DirectionOrigin
+		record.DestinationChainID = activity.DestinationChainID
+	}
+
+	return record
+}
+
+// platform gets the platform of a bridge event type.
+func platform(eventType bridge.EventType) string {
+	switch eventType {
+	case bridge.CircleRequestSentEvent, bridge.CircleRequestFulfilledEvent:
+		return PlatformCCTP
+	case bridge.BridgeRequestedEvent, bridge.BridgeRelayedEvent:
+		return PlatformRFQ
+	default:
+		return PlatformBridge
+	}
+}
+
+func isOrigin(eventType bridge.EventType) bool {
+	for _, originEventType := range bridge.OriginEventTypes() {
+		if eventType == originEventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// formatAmount adjusts a raw token amount for the token's decimals. Unparsable amounts are returned as is.
+func formatAmount(amount string, decimals uint8) string {
+	rawAmount, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return amount
+	}
+
+	adjusted := new(big.Rat).SetFrac(rawAmount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	formatted := adjusted.FloatString(int(decimals))
+	if strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+	}
+
+	return formatted
+}
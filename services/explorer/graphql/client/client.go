@@ -32,6 +32,10 @@ type Query struct {
 	GetOriginBridgeTx      *model.BridgeWatcherTx          "json:\"getOriginBridgeTx\" graphql:\"getOriginBridgeTx\""
 	GetDestinationBridgeTx *model.BridgeWatcherTx          "json:\"getDestinationBridgeTx\" graphql:\"getDestinationBridgeTx\""
 	GetBlockHeight         []*model.BlockHeight            "json:\"getBlockHeight\" graphql:\"getBlockHeight\""
+	PendingTransfers       []*model.PendingTransfer        "json:\"pendingTransfers\" graphql:\"pendingTransfers\""
+	FeeStatistics          []*model.FeeStatistic           "json:\"feeStatistics\" graphql:\"feeStatistics\""
+	RelayerStatistics      []*model.RelayerStatistic       "json:\"relayerStatistics\" graphql:\"relayerStatistics\""
+	ChainFlows             []*model.ChainFlow              "json:\"chainFlows\" graphql:\"chainFlows\""
 }
 type GetBridgeTransactions struct {
 	Response []*struct {
@@ -271,6 +275,67 @@ type GetDestinationBridgeTx struct {
 		KappaStatus *model.KappaStatus  "json:\"kappaStatus\" graphql:\"kappaStatus\""
 	} "json:\"response\" graphql:\"response\""
 }
+type GetPendingTransfers struct {
+	Response []*struct {
+		FromInfo *struct {
+			ChainID            *int     "json:\"chainID\" graphql:\"chainID\""
+			DestinationChainID *int     "json:\"destinationChainID\" graphql:\"destinationChainID\""
+			Address            *string  "json:\"address\" graphql:\"address\""
+			TxnHash            *string  "json:\"txnHash\" graphql:\"txnHash\""
+			Value              *string  "json:\"value\" graphql:\"value\""
+			FormattedValue     *float64 "json:\"formattedValue\" graphql:\"formattedValue\""
+			USDValue           *float64 "json:\"USDValue\" graphql:\"USDValue\""
+			TokenAddress       *string  "json:\"tokenAddress\" graphql:\"tokenAddress\""
+			TokenSymbol        *string  "json:\"tokenSymbol\" graphql:\"tokenSymbol\""
+			BlockNumber        *int     "json:\"blockNumber\" graphql:\"blockNumber\""
+			Time               *int     "json:\"time\" graphql:\"time\""
+			FormattedTime      *string  "json:\"formattedTime\" graphql:\"formattedTime\""
+			FormattedEventType *string  "json:\"formattedEventType\" graphql:\"formattedEventType\""
+			EventType          *int     "json:\"eventType\" graphql:\"eventType\""
+		} "json:\"fromInfo\" graphql:\"fromInfo\""
+		Kappa       *string                   "json:\"kappa\" graphql:\"kappa\""
+		BridgeType  *model.BridgeType         "json:\"bridgeType\" graphql:\"bridgeType\""
+		AgeSeconds  *int                      "json:\"ageSeconds\" graphql:\"ageSeconds\""
+		SLASeconds  *int                      "json:\"slaSeconds\" graphql:\"slaSeconds\""
+		LikelyCause *model.StuckTransferCause "json:\"likelyCause\" graphql:\"likelyCause\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetFeeStatistics struct {
+	Response []*struct {
+		Date            *string            "json:\"date\" graphql:\"date\""
+		ChainID         *int               "json:\"chainID\" graphql:\"chainID\""
+		Platform        *model.FeePlatform "json:\"platform\" graphql:\"platform\""
+		ContractAddress *string            "json:\"contractAddress\" graphql:\"contractAddress\""
+		Token           *string            "json:\"token\" graphql:\"token\""
+		TokenSymbol     *string            "json:\"tokenSymbol\" graphql:\"tokenSymbol\""
+		Count           *int               "json:\"count\" graphql:\"count\""
+		VolumeUsd       *float64           "json:\"volumeUsd\" graphql:\"volumeUsd\""
+		FeeUsd          *float64           "json:\"feeUsd\" graphql:\"feeUsd\""
+		ProtocolFeeUsd  *float64           "json:\"protocolFeeUsd\" graphql:\"protocolFeeUsd\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetRelayerStatistics struct {
+	Response []*struct {
+		Date       *string  "json:\"date\" graphql:\"date\""
+		ChainID    *int     "json:\"chainID\" graphql:\"chainID\""
+		Relayer    *string  "json:\"relayer\" graphql:\"relayer\""
+		Fills      *int     "json:\"fills\" graphql:\"fills\""
+		FillShare  *float64 "json:\"fillShare\" graphql:\"fillShare\""
+		VolumeUsd  *float64 "json:\"volumeUsd\" graphql:\"volumeUsd\""
+		RevenueUsd *float64 "json:\"revenueUsd\" graphql:\"revenueUsd\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetChainFlows struct {
+	Response []*struct {
+		ChainIDFrom             *int     "json:\"chainIDFrom\" graphql:\"chainIDFrom\""
+		ChainIDTo               *int     "json:\"chainIDTo\" graphql:\"chainIDTo\""
+		TokenSymbol             *string  "json:\"tokenSymbol\" graphql:\"tokenSymbol\""
+		Count                   *int     "json:\"count\" graphql:\"count\""
+		VolumeUsd               *float64 "json:\"volumeUsd\" graphql:\"volumeUsd\""
+		MedianCompletionSeconds *float64 "json:\"medianCompletionSeconds\" graphql:\"medianCompletionSeconds\""
+		P95CompletionSeconds    *float64 "json:\"p95CompletionSeconds\" graphql:\"p95CompletionSeconds\""
+	} "json:\"response\" graphql:\"response\""
+}
 
 const GetBridgeTransactionsDocument = `query GetBridgeTransactions ($chainIDTo: [Int], $chainIDFrom: [Int], $addressTo: String, $addressFrom: String, $maxAmount: Int, $minAmount: Int, $maxAmountUSD: Int, $minAmountUSD: Int, $startTime: Int, $endTime: Int, $txHash: String, $kappa: String, $pending: Boolean, $page: Int, $tokenAddressFrom: [String], $tokenAddressTo: [String], $useMv: Boolean) {
 	response: bridgeTransactions(chainIDTo: $chainIDTo, chainIDFrom: $chainIDFrom, addressTo: $addressTo, addressFrom: $addressFrom, maxAmount: $maxAmount, minAmount: $minAmount, maxAmountUsd: $maxAmountUSD, minAmountUsd: $minAmountUSD, startTime: $startTime, endTime: $endTime, txnHash: $txHash, kappa: $kappa, pending: $pending, page: $page, tokenAddressTo: $tokenAddressTo, tokenAddressFrom: $tokenAddressFrom, useMv: $useMv) {
@@ -754,3 +819,135 @@ func (c *Client) GetDestinationBridgeTx(ctx context.Context, chainID int, kappa
 
 	return &res, nil
 }
+
+const GetPendingTransfersDocument = `query GetPendingTransfers ($chainIDFrom: Int, $chainIDTo: Int, $bridgeType: BridgeType, $page: Int) {
+	response: pendingTransfers(chainIDFrom: $chainIDFrom, chainIDTo: $chainIDTo, bridgeType: $bridgeType, page: $page) {
+		fromInfo {
+			chainID
+			destinationChainID
+			address
+			txnHash
+			value
+			formattedValue
+			USDValue
+			tokenAddress
+			tokenSymbol
+			blockNumber
+			time
+			formattedTime
+			formattedEventType
+			eventType
+		}
+		kappa
+		bridgeType
+		ageSeconds
+		slaSeconds
+		likelyCause
+	}
+}
+`
+
+func (c *Client) GetPendingTransfers(ctx context.Context, chainIDFrom *int, chainIDTo *int, bridgeType *model.BridgeType, page *int, httpRequestOptions ...client.HTTPRequestOption) (*GetPendingTransfers, error) {
+	vars := map[string]interface{}{
+		"chainIDFrom": chainIDFrom,
+		"chainIDTo":   chainIDTo,
+		"bridgeType":  bridgeType,
+		"page":        page,
+	}
+
+	var res GetPendingTransfers
+	if err := c.Client.Post(ctx, "GetPendingTransfers", GetPendingTransfersDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetFeeStatisticsDocument = `query GetFeeStatistics ($chainID: Int, $platform: FeePlatform, $duration: Duration) {
+	response: feeStatistics(chainID: $chainID, platform: $platform, duration: $duration) {
+		date
+		chainID
+		platform
+		contractAddress
+		token
+		tokenSymbol
+		count
+		volumeUsd
+		feeUsd
+		protocolFeeUsd
+	}
+}
+`
+
+func (c *Client) GetFeeStatistics(ctx context.Context, chainID *int, platform *model.FeePlatform, duration *model.Duration, httpRequestOptions ...client.HTTPRequestOption) (*GetFeeStatistics, error) {
+	vars := map[string]interface{}{
+		"chainID":  chainID,
+		"platform": platform,
+		"duration": duration,
+	}
+
+	var res GetFeeStatistics
+	if err := c.Client.Post(ctx, "GetFeeStatistics", GetFeeStatisticsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetRelayerStatisticsDocument = `query GetRelayerStatistics ($chainID: Int, $relayer: String, $duration: Duration) {
+	response: relayerStatistics(chainID: $chainID, relayer: $relayer, duration: $duration) {
+		date
+		chainID
+		relayer
+		fills
+		fillShare
+		volumeUsd
+		revenueUsd
+	}
+}
+`
+
+func (c *Client) GetRelayerStatistics(ctx context.Context, chainID *int, relayer *string, duration *model.Duration, httpRequestOptions ...client.HTTPRequestOption) (*GetRelayerStatistics, error) {
+	vars := map[string]interface{}{
+		"chainID":  chainID,
+		"relayer":  relayer,
+		"duration": duration,
+	}
+
+	var res GetRelayerStatistics
+	if err := c.Client.Post(ctx, "GetRelayerStatistics", GetRelayerStatisticsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetChainFlowsDocument = `query GetChainFlows ($chainIDFrom: Int, $chainIDTo: Int, $tokenSymbol: String, $byToken: Boolean, $duration: Duration) {
+	response: chainFlows(chainIDFrom: $chainIDFrom, chainIDTo: $chainIDTo, tokenSymbol: $tokenSymbol, byToken: $byToken, duration: $duration) {
+		chainIDFrom
+		chainIDTo
+		tokenSymbol
+		count
+		volumeUsd
+		medianCompletionSeconds
+		p95CompletionSeconds
+	}
+}
+`
+
+func (c *Client) GetChainFlows(ctx context.Context, chainIDFrom *int, chainIDTo *int, tokenSymbol *string, byToken *bool, duration *model.Duration, httpRequestOptions ...client.HTTPRequestOption) (*GetChainFlows, error) {
+	vars := map[string]interface{}{
+		"chainIDFrom": chainIDFrom,
+		"chainIDTo":   chainIDTo,
+		"tokenSymbol": tokenSymbol,
+		"byToken":     byToken,
+		"duration":    duration,
+	}
+
+	var res GetChainFlows
+	if err := c.Client.Post(ctx, "GetChainFlows", GetChainFlowsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -14,6 +14,7 @@
 	"github.com/99designs/gqlgen/codegen/config"
 	clientConfig "github.com/Yamashou/gqlgenc/config"
 	"github.com/integralist/go-findroot/find"
+	"github.com/synapsecns/sanguine/services/explorer/consumer/parser"
 )
 
 func main() {
@@ -29,6 +30,12 @@ func main() {
 		log.Fatalf("Error: %s", err.Error())
 	}
 
+	err = writeParserSchemas(filepath.Join(root.Path, "services/explorer/graphql/server/graph/schema"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to write parser schemas", err.Error())
+		os.Exit(2)
+	}
+
 	cfg, err := config.LoadConfig(filepath.Join(root.Path, "services/explorer/graphql/gqlgen.yaml"))
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "failed to load config", err.Error())
@@ -54,3 +61,22 @@ func main() {
 		os.Exit(3)
 	}
 }
+
+// writeParserSchemas writes the graphql schema extension of each registered parser to the schema directory, so gqlgen
+// generates the resolvers of the extensions next to the built-in ones.
+func writeParserSchemas(schemaDir string) error {
+	for _, registration := range parser.Registrations() {
+		if registration.GraphQLSchema == "" {
+			continue
+		}
+
+		schema := fmt.Sprintf("# Code generated from the %s parser registration. DO NOT EDIT.\n\n%s", registration.ContractType, registration.GraphQLSchema)
+		//nolint: gosec
+		err := os.WriteFile(filepath.Join(schemaDir, fmt.Sprintf("parser_%s.graphql", registration.ContractType)), []byte(schema), 0644)
+		if err != nil {
+			return fmt.Errorf("could not write schema of %s parser: %w", registration.ContractType, err)
+		}
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@
 	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph"
 	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/interceptor"
 	resolvers "github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/resolver"
+	"github.com/synapsecns/sanguine/services/explorer/subscription"
 	"github.com/synapsecns/sanguine/services/explorer/types"
 	"time"
 )
@@ -31,19 +32,20 @@
 )
 
 // EnableGraphql enables the scribe graphql service.
-func EnableGraphql(engine *gin.Engine, consumerDB db.ConsumerDB, fetcher fetcher.ScribeFetcher, apiCache cache.Service, clients map[uint32]etherClient.EVM, parsers *types.ServerParsers, refs *types.ServerRefs, swapFilters map[string]*swap.SwapFlashLoanFilterer, config serverConfig.Config, handler metrics.Handler) {
+func EnableGraphql(engine *gin.Engine, consumerDB db.ConsumerDB, fetcher fetcher.ScribeFetcher, apiCache cache.Service, clients map[uint32]etherClient.EVM, parsers *types.ServerParsers, refs *types.ServerRefs, swapFilters map[string]*swap.SwapFlashLoanFilterer, config serverConfig.Config, hub *subscription.Hub, handler metrics.Handler) {
 	server := createServer(
 		resolvers.NewExecutableSchema(
 			resolvers.Config{Resolvers: &graph.Resolver{
-				DB:          consumerDB,
-				Fetcher:     fetcher,
-				Cache:       apiCache,
-				CacheMutex:  mapmutex.NewStringMapMutex(),
-				Clients:     clients,
-				Parsers:     parsers,
-				Refs:        refs,
-				SwapFilters: swapFilters,
-				Config:      config,
+				DB:            consumerDB,
+				Fetcher:       fetcher,
+				Cache:         apiCache,
+				CacheMutex:    mapmutex.NewStringMapMutex(),
+				Clients:       clients,
+				Parsers:       parsers,
+				Refs:          refs,
+				SwapFilters:   swapFilters,
+				Config:        config,
+				Subscriptions: hub,
 			}},
 		),
 	)
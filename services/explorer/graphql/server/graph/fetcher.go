@@ -447,7 +447,7 @@ func (r Resolver) parseSwapLog(ctx context.Context, swapLog ethTypes.Log, chainI
 	}
 
 	soldID := iFace.SoldId
-	address, err := r.DB.GetString(ctx, fmt.Sprintf("SELECT token_address FROM token_indices WHERE contract_address='%s' AND chain_id=%d AND token_index=%d", swapLog.Address.String(), chainID, soldID.Uint64()))
+	address, err := r.DB.GetString(ctx, sql.Format("SELECT token_address FROM token_indices WHERE contract_address = %s AND chain_id = %s AND token_index = %s", sql.Param(swapLog.Address.String()), sql.Param(chainID), sql.Param(soldID.Uint64())))
 	if err != nil {
 		return nil, fmt.Errorf("could not parse swap event: %w", err)
 	}
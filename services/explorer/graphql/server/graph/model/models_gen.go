@@ -68,6 +68,18 @@ type BridgeWatcherTx struct {
 	KappaStatus *KappaStatus  `json:"kappaStatus,omitempty"`
 }
 
+// ChainFlow is the flow of completed transfers from an origin to a destination chain. tokenSymbol is only set when flows
+// are split by token. Completion times are in seconds, from the origin to the destination block.
+type ChainFlow struct {
+	ChainIDFrom             *int     `json:"chainIDFrom,omitempty"`
+	ChainIDTo               *int     `json:"chainIDTo,omitempty"`
+	TokenSymbol             *string  `json:"tokenSymbol,omitempty"`
+	Count                   *int     `json:"count,omitempty"`
+	VolumeUsd               *float64 `json:"volumeUsd,omitempty"`
+	MedianCompletionSeconds *float64 `json:"medianCompletionSeconds,omitempty"`
+	P95CompletionSeconds    *float64 `json:"p95CompletionSeconds,omitempty"`
+}
+
 type ContractQuery struct {
 	ChainID int          `json:"chainID"`
 	Type    ContractType `json:"type"`
@@ -106,6 +118,20 @@ type DateResultByChain struct {
 	Total     *float64 `json:"total,omitempty"`
 }
 
+// FeeStatistic is the fees charged by a contract in a token on a day.
+type FeeStatistic struct {
+	Date            *string      `json:"date,omitempty"`
+	ChainID         *int         `json:"chainID,omitempty"`
+	Platform        *FeePlatform `json:"platform,omitempty"`
+	ContractAddress *string      `json:"contractAddress,omitempty"`
+	Token           *string      `json:"token,omitempty"`
+	TokenSymbol     *string      `json:"tokenSymbol,omitempty"`
+	Count           *int         `json:"count,omitempty"`
+	VolumeUsd       *float64     `json:"volumeUsd,omitempty"`
+	FeeUsd          *float64     `json:"feeUsd,omitempty"`
+	ProtocolFeeUsd  *float64     `json:"protocolFeeUsd,omitempty"`
+}
+
 type HeroType struct {
 	Recipient string `json:"recipient"`
 	HeroID    string `json:"heroID"`
@@ -169,6 +195,16 @@ type PartialMessageBusInfo struct {
 	RevertedReason       *string     `json:"revertedReason,omitempty"`
 }
 
+// PendingTransfer is an origin transaction that has no destination transaction after the sla of its chain pair.
+type PendingTransfer struct {
+	FromInfo    *PartialInfo        `json:"fromInfo,omitempty"`
+	Kappa       *string             `json:"kappa,omitempty"`
+	BridgeType  *BridgeType         `json:"bridgeType,omitempty"`
+	AgeSeconds  *int                `json:"ageSeconds,omitempty"`
+	SLASeconds  *int                `json:"slaSeconds,omitempty"`
+	LikelyCause *StuckTransferCause `json:"likelyCause,omitempty"`
+}
+
 type PetType struct {
 	Recipient string `json:"recipient"`
 	PetID     string `json:"petID"`
@@ -177,6 +213,18 @@ type PetType struct {
 
 func (PetType) IsMessageType() {}
 
+// RelayerStatistic is the RFQ fills of a relayer on a destination chain on a day. fillShare is the share of the day's
+// fills on the chain made by the relayer.
+type RelayerStatistic struct {
+	Date       *string  `json:"date,omitempty"`
+	ChainID    *int     `json:"chainID,omitempty"`
+	Relayer    *string  `json:"relayer,omitempty"`
+	Fills      *int     `json:"fills,omitempty"`
+	FillShare  *float64 `json:"fillShare,omitempty"`
+	VolumeUsd  *float64 `json:"volumeUsd,omitempty"`
+	RevenueUsd *float64 `json:"revenueUsd,omitempty"`
+}
+
 type TearType struct {
 	Recipient string `json:"recipient"`
 	Amount    string `json:"amount"`
@@ -197,6 +245,13 @@ type TransactionCountResult struct {
 	Count   *int `json:"count,omitempty"`
 }
 
+// TransferEvent is an origin, destination or refund event of a transfer, pushed by the transferEvents subscription.
+type TransferEvent struct {
+	Type     *TransferEventType `json:"type,omitempty"`
+	Kappa    *string            `json:"kappa,omitempty"`
+	BridgeTx *PartialInfo       `json:"bridgeTx,omitempty"`
+}
+
 type UnknownType struct {
 	Known bool `json:"known"`
 }
@@ -475,6 +530,52 @@ func (e Duration) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// FeePlatform is the platform that charged a fee.
+type FeePlatform string
+
+const (
+	FeePlatformBridge FeePlatform = "BRIDGE"
+	FeePlatformCctp   FeePlatform = "CCTP"
+	FeePlatformRfq    FeePlatform = "RFQ"
+	FeePlatformSwap   FeePlatform = "SWAP"
+)
+
+var AllFeePlatform = []FeePlatform{
+	FeePlatformBridge,
+	FeePlatformCctp,
+	FeePlatformRfq,
+	FeePlatformSwap,
+}
+
+func (e FeePlatform) IsValid() bool {
+	switch e {
+	case FeePlatformBridge, FeePlatformCctp, FeePlatformRfq, FeePlatformSwap:
+		return true
+	}
+	return false
+}
+
+func (e FeePlatform) String() string {
+	return string(e)
+}
+
+func (e *FeePlatform) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FeePlatform(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FeePlatform", str)
+	}
+	return nil
+}
+
+func (e FeePlatform) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type HistoricalResultType string
 
 const (
@@ -658,3 +759,95 @@ func (e *StatisticType) UnmarshalGQL(v interface{}) error {
 func (e StatisticType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// StuckTransferCause is the most likely reason a transfer has not been completed.
+type StuckTransferCause string
+
+const (
+	StuckTransferCauseDestinationNotIndexed StuckTransferCause = "DESTINATION_NOT_INDEXED"
+	StuckTransferCauseDestinationIndexerLag StuckTransferCause = "DESTINATION_INDEXER_LAG"
+	StuckTransferCauseAwaitingValidators    StuckTransferCause = "AWAITING_VALIDATORS"
+	StuckTransferCauseAwaitingAttestation   StuckTransferCause = "AWAITING_ATTESTATION"
+	StuckTransferCauseAwaitingRelayer       StuckTransferCause = "AWAITING_RELAYER"
+)
+
+var AllStuckTransferCause = []StuckTransferCause{
+	StuckTransferCauseDestinationNotIndexed,
+	StuckTransferCauseDestinationIndexerLag,
+	StuckTransferCauseAwaitingValidators,
+	StuckTransferCauseAwaitingAttestation,
+	StuckTransferCauseAwaitingRelayer,
+}
+
+func (e StuckTransferCause) IsValid() bool {
+	switch e {
+	case StuckTransferCauseDestinationNotIndexed, StuckTransferCauseDestinationIndexerLag, StuckTransferCauseAwaitingValidators, StuckTransferCauseAwaitingAttestation, StuckTransferCauseAwaitingRelayer:
+		return true
+	}
+	return false
+}
+
+func (e StuckTransferCause) String() string {
+	return string(e)
+}
+
+func (e *StuckTransferCause) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StuckTransferCause(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StuckTransferCause", str)
+	}
+	return nil
+}
+
+func (e StuckTransferCause) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// TransferEventType is the step of a transfer an event completes.
+type TransferEventType string
+
+const (
+	TransferEventTypeOriginSeen           TransferEventType = "ORIGIN_SEEN"
+	TransferEventTypeDestinationCompleted TransferEventType = "DESTINATION_COMPLETED"
+	TransferEventTypeRefunded             TransferEventType = "REFUNDED"
+)
+
+var AllTransferEventType = []TransferEventType{
+	TransferEventTypeOriginSeen,
+	TransferEventTypeDestinationCompleted,
+	TransferEventTypeRefunded,
+}
+
+func (e TransferEventType) IsValid() bool {
+	switch e {
+	case TransferEventTypeOriginSeen, TransferEventTypeDestinationCompleted, TransferEventTypeRefunded:
+		return true
+	}
+	return false
+}
+
+func (e TransferEventType) String() string {
+	return string(e)
+}
+
+func (e *TransferEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TransferEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TransferEventType", str)
+	}
+	return nil
+}
+
+func (e TransferEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
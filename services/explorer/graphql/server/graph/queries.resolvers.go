@@ -10,6 +10,7 @@
 	"sort"
 	"sync"
 
+	"github.com/synapsecns/sanguine/services/explorer/api/cache"
 	"github.com/synapsecns/sanguine/services/explorer/db/sql"
 	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
 	resolvers "github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/resolver"
@@ -98,8 +99,8 @@ func (r *queryResolver) AddressRanking(ctx context.Context, hours *int) ([]*mode
 	firstFilter := true
 	timeStampSpecifier := generateTimestampSpecifierSQL(&targetTime, sql.TimeStampFieldName, &firstFilter, "")
 	directionSpecifier := generateDirectionSpecifierSQL(true, &firstFilter, "")
-	compositeFilters := fmt.Sprintf("%s%s", timeStampSpecifier, directionSpecifier)
-	query := fmt.Sprintf(`SELECT %s AS address, COUNT(DISTINCT %s) AS Count FROM (%s) GROUP BY %s ORDER BY Count Desc`, sql.SenderFieldName, sql.TxHashFieldName, generateDeDepQuery(compositeFilters, nil, nil), sql.SenderFieldName)
+	compositeFilters := sql.Concat(timeStampSpecifier, directionSpecifier)
+	query := sql.Format(fmt.Sprintf(`SELECT %s AS address, COUNT(DISTINCT %s) AS Count FROM (%%s) GROUP BY %s ORDER BY Count Desc`, sql.SenderFieldName, sql.TxHashFieldName, sql.SenderFieldName), generateDeDepQuery(compositeFilters, nil, nil))
 	res, err := r.DB.GetAddressRanking(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get count by chain ID: %w", err)
@@ -111,7 +112,7 @@ func (r *queryResolver) AddressRanking(ctx context.Context, hours *int) ([]*mode
 // AmountStatistic is the resolver for the amountStatistic field.
 func (r *queryResolver) AmountStatistic(ctx context.Context, typeArg model.StatisticType, duration *model.Duration, platform *model.Platform, chainID *int, address *string, tokenAddress *string, useCache *bool, useMv *bool) (*model.ValueResult, error) {
 	if useCache != nil && *useCache {
-		res, err := r.getValueResultFromCache(fmt.Sprintf("amountStatistic, %s, %s, %s, %s, %s, %s", typeArg.String(), platform.String(), duration.String(), keyGenHandleNilInt(chainID), keyGenHandleNilString(address), keyGenHandleNilString(tokenAddress)))
+		res, err := r.getValueResultFromCache(ctx, cache.AmountStatisticKey(typeArg, platform, duration, chainID, address, tokenAddress))
 		if err == nil {
 			return res, nil
 		}
@@ -123,11 +124,8 @@ func (r *queryResolver) AmountStatistic(ctx context.Context, typeArg model.Stati
 	addressSpecifier := generateSingleSpecifierStringSQL(address, sql.SenderFieldName, &firstFilter, "")
 	chainIDSpecifier := generateSingleSpecifierI32SQL(chainID, sql.ChainIDFieldName, &firstFilter, "")
 
-	compositeFilters := fmt.Sprintf(
-		`%s%s%s`,
-		timestampSpecifier, addressSpecifier, chainIDSpecifier,
-	)
-	var finalSQL *string
+	compositeFilters := sql.Concat(timestampSpecifier, addressSpecifier, chainIDSpecifier)
+	var finalSQL *sql.Query
 	switch *platform {
 	case model.PlatformBridge:
 		finalSQL, err = GenerateAmountStatisticBridgeSQL(typeArg, address, chainID, tokenAddress)
@@ -159,7 +157,7 @@ func (r *queryResolver) AmountStatistic(ctx context.Context, typeArg model.Stati
 		output := model.ValueResult{
 			Value: value,
 		}
-		err = r.Cache.CacheResponse(fmt.Sprintf("amountStatistic, %s, %s, %s, %s, %s, %s", typeArg.String(), platform.String(), duration.String(), keyGenHandleNilInt(chainID), keyGenHandleNilString(address), keyGenHandleNilString(tokenAddress)), &output)
+		err = r.Cache.CacheResponse(ctx, cache.AmountStatisticKey(typeArg, platform, duration, chainID, address, tokenAddress), &output)
 		if err != nil {
 			return nil, fmt.Errorf("error caching results, %w", err)
 		}
@@ -179,7 +177,7 @@ func (r *queryResolver) AmountStatistic(ctx context.Context, typeArg model.Stati
 	output := model.ValueResult{
 		Value: &value,
 	}
-	err = r.Cache.CacheResponse(fmt.Sprintf("amountStatistic, %s, %s, %s, %s, %s, %s", typeArg.String(), platform.String(), duration.String(), keyGenHandleNilInt(chainID), keyGenHandleNilString(address), keyGenHandleNilString(tokenAddress)), &output)
+	err = r.Cache.CacheResponse(ctx, cache.AmountStatisticKey(typeArg, platform, duration, chainID, address, tokenAddress), &output)
 	if err != nil {
 		return nil, fmt.Errorf("error storing cache data, %w", err)
 	}
@@ -188,13 +186,13 @@ func (r *queryResolver) AmountStatistic(ctx context.Context, typeArg model.Stati
 
 // DailyStatisticsByChain is the resolver for the dailyStatisticsByChain field.
 func (r *queryResolver) DailyStatisticsByChain(ctx context.Context, chainID *int, typeArg *model.DailyStatisticType, platform *model.Platform, duration *model.Duration, useCache *bool, useMv *bool) ([]*model.DateResultByChain, error) {
-	cacheKey := fmt.Sprintf("dailyStatisticsByChain, %s, %s, %s, %s", keyGenHandleNilInt(chainID), typeArg.String(), duration.String(), platform.String())
+	cacheKey := cache.DailyStatisticsByChainKey(chainID, typeArg, duration, platform)
 
 	if useCache != nil && *useCache {
-		locker := r.CacheMutex.Lock(cacheKey)
+		locker := r.CacheMutex.Lock(cacheKey.String())
 		defer locker.Unlock()
 
-		res, err := r.getDateResultByChainFromCache(cacheKey)
+		res, err := r.getDateResultByChainFromCache(ctx, cacheKey)
 		if err == nil {
 			return res, nil
 		}
@@ -212,13 +210,10 @@ func (r *queryResolver) DailyStatisticsByChain(ctx context.Context, chainID *int
 	firstFilter := true
 	timestampSpecifier := GetDurationFilter(duration, &firstFilter, "")
 	chainIDSpecifier := generateSingleSpecifierI32SQL(chainID, sql.ChainIDFieldName, &firstFilter, "")
-	compositeFilters := fmt.Sprintf(
-		`%s%s`,
-		timestampSpecifier, chainIDSpecifier,
-	)
+	compositeFilters := sql.Concat(timestampSpecifier, chainIDSpecifier)
 
 	var res []*model.DateResultByChain
-	var query *string
+	var query *sql.Query
 	g, groupCtx := errgroup.WithContext(ctx)
 	switch *platform {
 	case model.PlatformBridge:
@@ -253,7 +248,7 @@ func (r *queryResolver) DailyStatisticsByChain(ctx context.Context, chainID *int
 	if err != nil {
 		return nil, fmt.Errorf("could not get daily data by chain: %w", err)
 	}
-	err = r.Cache.CacheResponse(fmt.Sprintf("dailyStatisticsByChain, %s, %s, %s, %s", keyGenHandleNilInt(chainID), typeArg.String(), duration.String(), platform.String()), res)
+	err = r.Cache.CacheResponse(ctx, cacheKey, res)
 	if err != nil {
 		return nil, fmt.Errorf("error cahcing response, %w", err)
 	}
@@ -290,12 +285,13 @@ func (r *queryResolver) RankedChainIDsByVolume(ctx context.Context, duration *mo
 
 // AddressData is the resolver for the addressData field.
 func (r *queryResolver) AddressData(ctx context.Context, address string) (*model.AddressData, error) {
-	bridgeQuery := fmt.Sprintf("SELECT toFloat64(sumKahan(famount_usd)) AS volumeTotal, toFloat64(sumKahan(tfee_amount_usd)) AS feeTotal, toInt64(uniq(fchain_id, ftx_hash)) AS txTotal FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id,fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", address)
-	swapQuery := fmt.Sprintf("SELECT toFloat64(sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1, arraySum(mapValues(amount_usd)), event_type = 9, arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id],0))) AS volumeTotal, toFloat64(sumKahan(arraySum(mapValues(fee_usd)))) AS feeTotal,  toInt64(uniq(chain_id, tx_hash)) AS txTotal FROM (SELECT * FROM swap_events where sender = '%s' LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash)", address)
-	rankingQuery := fmt.Sprintf("select rowNumber from (select sender, row_number() over (order by sumTotal desc ) as rowNumber from (select fsender as sender, sumKahan(famount_usd) as sumTotal from (SELECT * FROM mv_bridge_events where fsender != '' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where fsender != '' group by fsender)) where sender = '%s'", address)
-	firstTx := fmt.Sprintf("SELECT min(ftimestamp) AS earliestTime FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id,fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", address)
-	dailyDataQuery := fmt.Sprintf("SELECT coalesce(toString(date), toString(s.date)) AS date, toFloat64(coalesce(sumTotal, 0)) + toFloat64(coalesce(s.sumTotal, 0)) as count FROM (SELECT * FROM (SELECT %s, uniq(fchain_id, ftx_hash) AS sumTotal FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date order by date) b FULL OUTER JOIN (SELECT %s, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM swap_events WHERE sender = '%s' LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date) s ON b.date = s.date) SETTINGS join_use_nulls=1", toDateSelectMv, address, toDateSelect, address)
-	chainRankingQuery := fmt.Sprintf("SELECT row_number() over (order by VolumeUsd desc ) as Rank, tchain_id as ChainID, sumKahan(tamount_usd) AS VolumeUsd FROM (SELECT * FROM mv_bridge_events where fsender = '%s' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where ChainID > 0 group by ChainID", address)
+	addressParam := sql.Param(address)
+	bridgeQuery := sql.Format("SELECT toFloat64(sumKahan(famount_usd)) AS volumeTotal, toFloat64(sumKahan(tfee_amount_usd)) AS feeTotal, toInt64(uniq(fchain_id, ftx_hash)) AS txTotal FROM (SELECT * FROM mv_bridge_events where fsender = %s LIMIT 1 BY fchain_id,fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", addressParam)
+	swapQuery := sql.Format("SELECT toFloat64(sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1, arraySum(mapValues(amount_usd)), event_type = 9, arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id],0))) AS volumeTotal, toFloat64(sumKahan(arraySum(mapValues(fee_usd)))) AS feeTotal,  toInt64(uniq(chain_id, tx_hash)) AS txTotal FROM (SELECT * FROM swap_events where sender = %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash)", addressParam)
+	rankingQuery := sql.Format("select rowNumber from (select sender, row_number() over (order by sumTotal desc ) as rowNumber from (select fsender as sender, sumKahan(famount_usd) as sumTotal from (SELECT * FROM mv_bridge_events where fsender != '' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where fsender != '' group by fsender)) where sender = %s", addressParam)
+	firstTx := sql.Format("SELECT min(ftimestamp) AS earliestTime FROM (SELECT * FROM mv_bridge_events where fsender = %s LIMIT 1 BY fchain_id,fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", addressParam)
+	dailyDataQuery := sql.Format("SELECT coalesce(toString(date), toString(s.date)) AS date, toFloat64(coalesce(sumTotal, 0)) + toFloat64(coalesce(s.sumTotal, 0)) as count FROM (SELECT * FROM (SELECT %s, uniq(fchain_id, ftx_hash) AS sumTotal FROM (SELECT * FROM mv_bridge_events where fsender = %s LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date order by date) b FULL OUTER JOIN (SELECT %s, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM swap_events WHERE sender = %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date) s ON b.date = s.date) SETTINGS join_use_nulls=1", sql.Raw(toDateSelectMv), addressParam, sql.Raw(toDateSelect), addressParam)
+	chainRankingQuery := sql.Format("SELECT row_number() over (order by VolumeUsd desc ) as Rank, tchain_id as ChainID, sumKahan(tamount_usd) AS VolumeUsd FROM (SELECT * FROM mv_bridge_events where fsender = %s LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where ChainID > 0 group by ChainID", addressParam)
 	var bridgeVolume float64
 	var bridgeFees float64
 	var bridgeTxs int
@@ -388,8 +384,8 @@ func (r *queryResolver) Leaderboard(ctx context.Context, duration *model.Duratio
 	chainIDSpecifier := generateSingleSpecifierI32SQL(chainID, sql.ChainIDFieldName, &firstFilter, "f")
 	pageValue := sql.PageSize
 	pageOffset := (*page - 1) * sql.PageSize
-	filters := timestampSpecifier + chainIDSpecifier
-	leaderboardQuery := fmt.Sprintf("select row_number() over (order by VolumeUsd desc ) as Rank, * from (select fsender as Address, toFloat64(sumKahan(famount_usd)) as VolumeUsd,toFloat64(avg(famount_usd)) as AvgVolumeUsd, count(DISTINCT ftx_hash) as Txs,toFloat64(sumKahan(tfee_amount_usd)) as Fees from (SELECT * FROM mv_bridge_events where fsender != '' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where fsender != '' %s group by fsender) LIMIT %d OFFSET %d", filters, pageValue, pageOffset)
+	filters := sql.Concat(timestampSpecifier, chainIDSpecifier)
+	leaderboardQuery := sql.Format("select row_number() over (order by VolumeUsd desc ) as Rank, * from (select fsender as Address, toFloat64(sumKahan(famount_usd)) as VolumeUsd,toFloat64(avg(famount_usd)) as AvgVolumeUsd, count(DISTINCT ftx_hash) as Txs,toFloat64(sumKahan(tfee_amount_usd)) as Fees from (SELECT * FROM mv_bridge_events where fsender != '' LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) where fsender != '' %s group by fsender) LIMIT %s OFFSET %s", filters, sql.Param(pageValue), sql.Param(pageOffset))
 	leaderboardRes, err := r.DB.GetLeaderboard(ctx, leaderboardQuery)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get leaderboard %w", err)
@@ -434,8 +430,8 @@ func (r *queryResolver) GetDestinationBridgeTx(ctx context.Context, chainID int,
 
 // GetBlockHeight is the resolver for the getBlockHeight field.
 func (r *queryResolver) GetBlockHeight(ctx context.Context, contracts []*model.ContractQuery) ([]*model.BlockHeight, error) {
-	// Generate string for right side of IN clause
-	var contractString string
+	// Generate the tuples for right side of IN clause
+	var contractTuples []sql.Query
 	contractTypeMap := make(map[string]model.ContractType)
 	for i, contract := range contracts {
 		contractAddr, err := r.getContractAddressFromType(uint32(contract.ChainID), contract.Type)
@@ -443,14 +439,13 @@ func (r *queryResolver) GetBlockHeight(ctx context.Context, contracts []*model.C
 			return nil, fmt.Errorf("could not get contract address from type %s, %w", contract.Type, err)
 		}
 		contractTypeMap[contractAddr] = contract.Type
-		if i == 0 {
-			contractString += fmt.Sprintf("('%s', %d)", contractAddr, contract.ChainID)
-		} else {
-			contractString += fmt.Sprintf(", ('%s', %d)", contractAddr, contract.ChainID)
+		if i > 0 {
+			contractTuples = append(contractTuples, sql.Raw(", "))
 		}
+		contractTuples = append(contractTuples, sql.Format("(%s, %s)", sql.Param(contractAddr), sql.Param(contract.ChainID)))
 	}
 
-	query := fmt.Sprintf("SELECT contract_address, chain_id, block_number FROM last_blocks WHERE (contract_address, chain_id) IN (%s) ORDER BY block_number", contractString)
+	query := sql.Format("SELECT contract_address, chain_id, block_number FROM last_blocks WHERE (contract_address, chain_id) IN (%s) ORDER BY block_number", sql.Concat(contractTuples...))
 	results, err := r.DB.GetBlockHeights(ctx, query, contractTypeMap)
 	if err != nil {
 		return nil, fmt.Errorf("could not get block heights from database %w", err)
@@ -458,6 +453,46 @@ func (r *queryResolver) GetBlockHeight(ctx context.Context, contracts []*model.C
 	return results, nil
 }
 
+// PendingTransfers is the resolver for the pendingTransfers field.
+func (r *queryResolver) PendingTransfers(ctx context.Context, chainIDFrom *int, chainIDTo *int, bridgeType *model.BridgeType, page *int) ([]*model.PendingTransfer, error) {
+	results, err := r.GetPendingTransfers(ctx, chainIDFrom, chainIDTo, bridgeType, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending transfers: %w", err)
+	}
+
+	return results, nil
+}
+
+// FeeStatistics is the resolver for the feeStatistics field.
+func (r *queryResolver) FeeStatistics(ctx context.Context, chainID *int, platform *model.FeePlatform, duration *model.Duration) ([]*model.FeeStatistic, error) {
+	results, err := r.DB.GetFeeStatistics(ctx, generateFeeStatisticsQuery(chainID, platform, duration))
+	if err != nil {
+		return nil, fmt.Errorf("could not get fee statistics: %w", err)
+	}
+
+	return results, nil
+}
+
+// RelayerStatistics is the resolver for the relayerStatistics field.
+func (r *queryResolver) RelayerStatistics(ctx context.Context, chainID *int, relayer *string, duration *model.Duration) ([]*model.RelayerStatistic, error) {
+	results, err := r.DB.GetRelayerStatistics(ctx, generateRelayerStatisticsQuery(chainID, relayer, duration))
+	if err != nil {
+		return nil, fmt.Errorf("could not get relayer statistics: %w", err)
+	}
+
+	return results, nil
+}
+
+// ChainFlows is the resolver for the chainFlows field.
+func (r *queryResolver) ChainFlows(ctx context.Context, chainIDFrom *int, chainIDTo *int, tokenSymbol *string, byToken *bool, duration *model.Duration) ([]*model.ChainFlow, error) {
+	results, err := r.DB.GetChainFlows(ctx, generateChainFlowsQuery(chainIDFrom, chainIDTo, tokenSymbol, byToken != nil && *byToken, duration))
+	if err != nil {
+		return nil, fmt.Errorf("could not get chain flows: %w", err)
+	}
+
+	return results, nil
+}
+
 // Query returns resolvers.QueryResolver implementation.
 func (r *Resolver) Query() resolvers.QueryResolver { return &queryResolver{r} }
 
@@ -5,35 +5,38 @@
 	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/synapsecns/sanguine/services/explorer/contracts/user"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/synapsecns/sanguine/services/explorer/api/cache"
 	"github.com/synapsecns/sanguine/services/explorer/db/sql"
 	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+	"github.com/synapsecns/sanguine/services/explorer/stuck"
 	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
 )
 
 // nolint:unparam
-func generateDeDepQuery(filter string, page *int, offset *int) string {
+func generateDeDepQuery(filter sql.Query, page *int, offset *int) sql.Query {
 	if page != nil || offset != nil {
-		return fmt.Sprintf("SELECT * FROM bridge_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash LIMIT %d OFFSET %d", filter, *page, *offset)
+		return sql.Format("SELECT * FROM bridge_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash LIMIT %s OFFSET %s", filter, sql.Param(*page), sql.Param(*offset))
 	}
 
-	return fmt.Sprintf("SELECT * FROM bridge_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash", filter)
+	return sql.Format("SELECT * FROM bridge_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash", filter)
 }
 
-func generateDeDepQueryCTE(filter string, page *int, offset *int, in bool) string {
-	minTimestamp := " (SELECT min(timestamp) - 86400 FROM baseQuery) AS minTimestamp, (SELECT count(*) FROM baseQuery) AS rowCount"
+func generateDeDepQueryCTE(filter sql.Query, page *int, offset *int, in bool) sql.Query {
+	minTimestamp := sql.Raw(" (SELECT min(timestamp) - 86400 FROM baseQuery) AS minTimestamp, (SELECT count(*) FROM baseQuery) AS rowCount")
 	if in {
-		minTimestamp = " (SELECT min(timestamp) FROM baseQuery) AS minTimestamp, (SELECT count(*) FROM baseQuery) AS rowCount"
+		minTimestamp = sql.Raw(" (SELECT min(timestamp) FROM baseQuery) AS minTimestamp, (SELECT count(*) FROM baseQuery) AS rowCount")
 	}
 	if page != nil || offset != nil {
-		return fmt.Sprintf("WITH baseQuery AS (SELECT * FROM bridge_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash LIMIT %d OFFSET %d), %s, %s", filter, *page, *offset, minTimestamp, swapDeDup)
+		return sql.Format("WITH baseQuery AS (SELECT * FROM bridge_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash LIMIT %s OFFSET %s), %s, %s", filter, sql.Param(*page), sql.Param(*offset), minTimestamp, sql.Raw(swapDeDup))
 	}
-	return fmt.Sprintf("WITH baseQuery AS (SELECT * FROM bridge_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash), %s, %s", filter, minTimestamp, swapDeDup)
+	return sql.Format("WITH baseQuery AS (SELECT * FROM bridge_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash), %s, %s", filter, minTimestamp, sql.Raw(swapDeDup))
 }
 
 func (r *queryResolver) getDirectionIn(direction *model.Direction) bool {
@@ -82,452 +85,311 @@ func (r *queryResolver) mergeBridgeTransactions(origin []*model.BridgeTransactio
 	return results
 }
 
-// generateAddressSpecifierSQL generates a where function with an string.
-//
-// nolint:unparam
-func generateAddressSpecifierSQL(address *string, firstFilter *bool, tablePrefix string) string {
-	// if address != nil {
-	//	if *firstFilter {
-	//		*firstFilter = false
-	//
-	//		return fmt.Sprintf(" WHERE (%s%s = '%s' OR  %s%s = '%s')", tablePrefix, sql.RecipientFieldName, *address, tablePrefix, sql.SenderFieldName, *address)
-	//	}
-	//
-	//	return fmt.Sprintf(" AND (%s%s = '%s' OR %s%s = '%s')", tablePrefix, sql.RecipientFieldName, *address, tablePrefix, sql.SenderFieldName, *address)
-	//}
-	//
-	// return ""
-	if address != nil {
-		if *firstFilter {
-			*firstFilter = false
+// filterPrefix returns the keyword that joins the next filter to the previous ones.
+func filterPrefix(firstFilter *bool) string {
+	if *firstFilter {
+		*firstFilter = false
 
-			return fmt.Sprintf(" WHERE %s%s = '%s'", tablePrefix, sql.SenderFieldName, *address)
-		}
+		return " WHERE"
+	}
+
+	return " AND"
+}
 
-		return fmt.Sprintf(" AND %s%s = '%s'", tablePrefix, sql.SenderFieldName, *address)
+// filterPrefixMv returns the keyword that joins the next filter to the previous ones for filters that are grouped
+// by locale (origin or destination) in the materialized view.
+func filterPrefixMv(firstFilter *bool, firstInLocale *bool) string {
+	if *firstInLocale {
+		*firstFilter = false
+		*firstInLocale = false
+
+		return ""
 	}
 
-	return ""
+	return filterPrefix(firstFilter)
 }
 
-// generateAddressSpecifierSQL generates a where function with an string.
-//
-// nolint:unparam
-func generateAddressSpecifierSQLMv(address *string, firstFilter *bool, firstInLocale *bool, tablePrefix string) string {
-	// if address != nil {
-	//	if *firstFilter {
-	//		*firstFilter = false
-	//
-	//		return fmt.Sprintf(" WHERE (%s%s = '%s' OR  %s%s = '%s')", tablePrefix, sql.RecipientFieldName, *address, tablePrefix, sql.SenderFieldName, *address)
-	//	}
-	//
-	//	return fmt.Sprintf(" AND (%s%s = '%s' OR %s%s = '%s')", tablePrefix, sql.RecipientFieldName, *address, tablePrefix, sql.SenderFieldName, *address)
-	//}
-	//
-	// return ""
-	if address != nil {
-		if *firstInLocale {
-			*firstFilter = false
-			*firstInLocale = false
-			return fmt.Sprintf("  %s%s = '%s'", tablePrefix, sql.SenderFieldName, *address)
-		}
-		if *firstFilter {
-			*firstFilter = false
+// column creates the query fragment for a column with the given table prefix.
+func column(tablePrefix string, field string) sql.Query {
+	return sql.Raw(tablePrefix + field)
+}
 
-			return fmt.Sprintf(" WHERE %s%s = '%s'", tablePrefix, sql.SenderFieldName, *address)
-		}
+// comparisonOperator returns the operator for an equality specifier.
+func comparisonOperator(greaterThan bool) sql.Query {
+	if greaterThan {
+		return sql.Raw(">")
+	}
 
-		return fmt.Sprintf(" AND %s%s = '%s'", tablePrefix, sql.SenderFieldName, *address)
+	return sql.Raw("<")
+}
+
+// cctpEventType returns the event type used to filter cctp events.
+func cctpEventType(to bool) int {
+	// From explorer/types/bridge/eventtypes.go
+	if to {
+		return 11
 	}
 
-	return ""
+	return 10
 }
 
-func generateRecipientSpecifierSQL(address *string, firstFilter *bool, tablePrefix string) string {
-	// if address != nil {
-	//	if *firstFilter {
-	//		*firstFilter = false
-	//
-	//		return fmt.Sprintf(" WHERE (%s%s = '%s' OR  %s%s = '%s')", tablePrefix, sql.RecipientFieldName, *address, tablePrefix, sql.SenderFieldName, *address)
-	//	}
-	//
-	//	return fmt.Sprintf(" AND (%s%s = '%s' OR %s%s = '%s')", tablePrefix, sql.RecipientFieldName, *address, tablePrefix, sql.SenderFieldName, *address)
-	//}
-	//
-	// return ""
+// generateAddressSpecifierSQL generates a where function with an string.
+//
+// nolint:unparam
+func generateAddressSpecifierSQL(address *string, firstFilter *bool, tablePrefix string) sql.Query {
 	if address != nil {
-		if *firstFilter {
-			*firstFilter = false
+		return sql.Format("%s %s = %s", sql.Raw(filterPrefix(firstFilter)), column(tablePrefix, sql.SenderFieldName), sql.Param(*address))
+	}
 
-			return fmt.Sprintf(" WHERE %s%s = '%s'", tablePrefix, sql.RecipientFieldName, *address)
-		}
+	return sql.Query{}
+}
 
-		return fmt.Sprintf(" AND %s%s = '%s'", tablePrefix, sql.RecipientFieldName, *address)
+// generateAddressSpecifierSQL generates a where function with an string.
+//
+// nolint:unparam
+func generateAddressSpecifierSQLMv(address *string, firstFilter *bool, firstInLocale *bool, tablePrefix string) sql.Query {
+	if address != nil {
+		return sql.Format("%s %s = %s", sql.Raw(filterPrefixMv(firstFilter, firstInLocale)), column(tablePrefix, sql.SenderFieldName), sql.Param(*address))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
-func generateRecipientSpecifierSQLMv(address *string, firstFilter *bool, firstInLocale *bool, tablePrefix string) string {
-	// if address != nil {
-	//	if *firstFilter {
-	//		*firstFilter = false
-	//
-	//		return fmt.Sprintf(" WHERE (%s%s = '%s' OR  %s%s = '%s')", tablePrefix, sql.RecipientFieldName, *address, tablePrefix, sql.SenderFieldName, *address)
-	//	}
-	//
-	//	return fmt.Sprintf(" AND (%s%s = '%s' OR %s%s = '%s')", tablePrefix, sql.RecipientFieldName, *address, tablePrefix, sql.SenderFieldName, *address)
-	//}
-	//
-	// return ""
+func generateRecipientSpecifierSQL(address *string, firstFilter *bool, tablePrefix string) sql.Query {
 	if address != nil {
-		if *firstInLocale {
-			*firstFilter = false
-			*firstInLocale = false
-			return fmt.Sprintf(" %s%s = '%s'", tablePrefix, sql.RecipientFieldName, *address)
-		}
-		if *firstFilter {
-			*firstFilter = false
+		return sql.Format("%s %s = %s", sql.Raw(filterPrefix(firstFilter)), column(tablePrefix, sql.RecipientFieldName), sql.Param(*address))
+	}
 
-			return fmt.Sprintf(" WHERE %s%s = '%s'", tablePrefix, sql.RecipientFieldName, *address)
-		}
+	return sql.Query{}
+}
 
-		return fmt.Sprintf(" AND %s%s = '%s'", tablePrefix, sql.RecipientFieldName, *address)
+func generateRecipientSpecifierSQLMv(address *string, firstFilter *bool, firstInLocale *bool, tablePrefix string) sql.Query {
+	if address != nil {
+		return sql.Format("%s %s = %s", sql.Raw(filterPrefixMv(firstFilter, firstInLocale)), column(tablePrefix, sql.RecipientFieldName), sql.Param(*address))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
 // generateEqualitySpecifierSQL generates a where function with an equality.
 //
 // nolint:unparam
-func generateEqualitySpecifierSQL(value *int, field string, firstFilter *bool, tablePrefix string, greaterThan bool) string {
-	operator := "<"
-	if greaterThan {
-		operator = ">"
-	}
+func generateEqualitySpecifierSQL(value *int, field string, firstFilter *bool, tablePrefix string, greaterThan bool) sql.Query {
 	if value != nil {
-		if *firstFilter {
-			*firstFilter = false
-
-			return fmt.Sprintf(" WHERE %s%s %s %d", tablePrefix, field, operator, *value)
-		}
-
-		return fmt.Sprintf(" AND %s%s %s %d", tablePrefix, field, operator, *value)
+		return sql.Format("%s %s %s %s", sql.Raw(filterPrefix(firstFilter)), column(tablePrefix, field), comparisonOperator(greaterThan), sql.Param(*value))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
 // generateCCTPSpecifierSQLMv generates a where function with event type to filter only cctp events.
-func generateCCTPSpecifierSQL(onlyCctp *bool, to bool, field string, firstFilter *bool, tablePrefix string) string {
+func generateCCTPSpecifierSQL(onlyCctp *bool, to bool, field string, firstFilter *bool, tablePrefix string) sql.Query {
 	if onlyCctp != nil && *onlyCctp {
-		// From explorer/types/bridge/eventtypes.go
-		eventType := 10
-		if to {
-			eventType = 11
-		}
-
-		if *firstFilter {
-			*firstFilter = false
-
-			return fmt.Sprintf(" WHERE %s%s =  %d", tablePrefix, field, eventType)
-		}
-
-		return fmt.Sprintf(" AND %s%s = %d", tablePrefix, field, eventType)
+		return sql.Format("%s %s = %s", sql.Raw(filterPrefix(firstFilter)), column(tablePrefix, field), sql.Param(cctpEventType(to)))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
 // generateEqualitySpecifierSQL generates a where function with an equality.
 //
 // nolint:unparam
-func generateEqualitySpecifierSQLMv(value *int, field string, firstFilter *bool, firstInLocale *bool, tablePrefix string, greaterThan bool) string {
-	operator := "<"
-	if greaterThan {
-		operator = ">"
-	}
+func generateEqualitySpecifierSQLMv(value *int, field string, firstFilter *bool, firstInLocale *bool, tablePrefix string, greaterThan bool) sql.Query {
 	if value != nil {
-		if *firstInLocale {
-			*firstFilter = false
-			*firstInLocale = false
-			return fmt.Sprintf(" %s%s %s %d", tablePrefix, field, operator, *value)
-		}
-		if *firstFilter {
-			*firstFilter = false
-
-			return fmt.Sprintf(" WHERE %s%s %s %d", tablePrefix, field, operator, *value)
-		}
-
-		return fmt.Sprintf(" AND %s%s %s %d", tablePrefix, field, operator, *value)
+		return sql.Format("%s %s %s %s", sql.Raw(filterPrefixMv(firstFilter, firstInLocale)), column(tablePrefix, field), comparisonOperator(greaterThan), sql.Param(*value))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
 // generateDirectionSpecifierSQL generates a where function with a string.
 //
 // nolint:unparam
-func generateDirectionSpecifierSQL(in bool, firstFilter *bool, tablePrefix string) string {
+func generateDirectionSpecifierSQL(in bool, firstFilter *bool, tablePrefix string) sql.Query {
 	if in {
-		if *firstFilter {
-			*firstFilter = false
-
-			return fmt.Sprintf(" WHERE %s%s > 0", tablePrefix, sql.DestinationChainIDFieldName)
-		}
-
-		return fmt.Sprintf(" AND %s%s > 0", tablePrefix, sql.DestinationChainIDFieldName)
+		return sql.Format("%s %s > 0", sql.Raw(filterPrefix(firstFilter)), column(tablePrefix, sql.DestinationChainIDFieldName))
 	}
-	if *firstFilter {
-		*firstFilter = false
 
-		return fmt.Sprintf(" WHERE %s%s = 0", tablePrefix, sql.DestinationChainIDFieldName)
-	}
-
-	return fmt.Sprintf(" AND %s%s = 0", tablePrefix, sql.DestinationChainIDFieldName)
+	return sql.Format("%s %s = 0", sql.Raw(filterPrefix(firstFilter)), column(tablePrefix, sql.DestinationChainIDFieldName))
 }
 
 // generateSingleSpecifierI32SQL generates a where function with an uint32.
 //
 // nolint:unparam
-func generateSingleSpecifierI32SQL(value *int, field string, firstFilter *bool, tablePrefix string) string {
+func generateSingleSpecifierI32SQL(value *int, field string, firstFilter *bool, tablePrefix string) sql.Query {
 	if value != nil {
-		if *firstFilter {
-			*firstFilter = false
-
-			return fmt.Sprintf(" WHERE %s%s = %d", tablePrefix, field, *value)
-		}
-
-		return fmt.Sprintf(" AND %s%s = %d", tablePrefix, field, *value)
+		return sql.Format("%s %s = %s", sql.Raw(filterPrefix(firstFilter)), column(tablePrefix, field), sql.Param(*value))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
 // generateSingleSpecifierI32ArrSQL generates a where function with an uint32.
 //
 // nolint:unparam
-func generateSingleSpecifierI32ArrSQL(values []*int, field string, firstFilter *bool, tablePrefix string) string {
+func generateSingleSpecifierI32ArrSQL(values []*int, field string, firstFilter *bool, tablePrefix string) sql.Query {
 	if len(values) == 0 {
-		return ""
+		return sql.Query{}
 	}
-	var final string
+	var final sql.Query
 	if *firstFilter {
 		*firstFilter = false
-		final += whereString
+		final = sql.Raw(whereString)
 	}
 
 	for i := range values {
-		final += fmt.Sprintf(" %s%s = %d", tablePrefix, field, *values[i])
+		final = sql.Concat(final, sql.Format(" %s = %s", column(tablePrefix, field), sql.Param(*values[i])))
 		if i < len(values)-1 {
-			final += orString
+			final = sql.Concat(final, sql.Raw(orString))
 		}
 	}
 
-	return final + ")"
+	return sql.Concat(final, sql.Raw(")"))
 }
 
 // generateSingleSpecifierI32ArrSQL generates a where function with an uint32.
 //
 // nolint:unparam
-func generateSingleSpecifierI32ArrSQLMv(values []*int, field string, firstFilter *bool, firstInLocale *bool, tablePrefix string) string {
+func generateSingleSpecifierI32ArrSQLMv(values []*int, field string, firstFilter *bool, firstInLocale *bool, tablePrefix string) sql.Query {
 	if len(values) == 0 {
-		return ""
+		return sql.Query{}
 	}
-	var final string
+	var final sql.Query
 	if *firstInLocale {
 		*firstInLocale = false
 		*firstFilter = false
-		final += " ("
+		final = sql.Raw(" (")
 	} else if *firstFilter {
 		*firstFilter = false
-		final += whereString
+		final = sql.Raw(whereString)
 	}
 	for i := range values {
-		final += fmt.Sprintf(" %s%s = %d", tablePrefix, field, *values[i])
+		final = sql.Concat(final, sql.Format(" %s = %s", column(tablePrefix, field), sql.Param(*values[i])))
 		if i < len(values)-1 {
-			final += orString
+			final = sql.Concat(final, sql.Raw(orString))
 		}
 	}
 
-	return final + ")"
+	return sql.Concat(final, sql.Raw(")"))
 }
 
 // GenerateSingleSpecifierStringSQL generates a where function with a string.
 //
 // nolint:unparam
-func generateSingleSpecifierStringArrSQL(values []*string, field string, firstFilter *bool, tablePrefix string) string {
+func generateSingleSpecifierStringArrSQL(values []*string, field string, firstFilter *bool, tablePrefix string) sql.Query {
 	if len(values) == 0 {
-		return ""
+		return sql.Query{}
 	}
-	var final string
+	var final sql.Query
 	if *firstFilter {
 		*firstFilter = false
-		final += whereString
+		final = sql.Raw(whereString)
 	} else {
-		final += " AND ("
+		final = sql.Raw(" AND (")
 	}
 
 	for i := range values {
 		if values[i] != nil {
-			final += fmt.Sprintf(" %s%s = '%s'", tablePrefix, field, *values[i])
+			final = sql.Concat(final, sql.Format(" %s = %s", column(tablePrefix, field), sql.Param(*values[i])))
 			if i < len(values)-1 {
-				final += orString
+				final = sql.Concat(final, sql.Raw(orString))
 			}
 		}
 	}
 
-	return final + ")"
+	return sql.Concat(final, sql.Raw(")"))
 }
 
 // GenerateSingleSpecifierStringSQL generates a where function with a string.
 //
 // nolint:unparam
-func generateSingleSpecifierStringArrSQLMv(values []*string, field string, firstFilter *bool, firstInLocale *bool, tablePrefix string) string {
+func generateSingleSpecifierStringArrSQLMv(values []*string, field string, firstFilter *bool, firstInLocale *bool, tablePrefix string) sql.Query {
 	if len(values) == 0 {
-		return ""
+		return sql.Query{}
 	}
-	var final string
+	var final sql.Query
 	if *firstInLocale {
 		*firstInLocale = false
 		*firstFilter = false
-		final += " ("
+		final = sql.Raw(" (")
 	} else {
 		if *firstFilter {
 			*firstFilter = false
-			final += whereString
+			final = sql.Raw(whereString)
 		} else {
-			final += " AND ("
+			final = sql.Raw(" AND (")
 		}
 	}
 	for i := range values {
 		if values[i] != nil {
-			final += fmt.Sprintf(" %s%s = '%s'", tablePrefix, field, *values[i])
+			final = sql.Concat(final, sql.Format(" %s = %s", column(tablePrefix, field), sql.Param(*values[i])))
 			if i < len(values)-1 {
-				final += orString
+				final = sql.Concat(final, sql.Raw(orString))
 			}
 		}
 	}
 
-	return final + ")"
+	return sql.Concat(final, sql.Raw(")"))
 }
 
 // generateTimestampSpecifierSQL generates a where function with an uint64.
 //
 // nolint:unparam
-func generateTimestampSpecifierSQL(value *uint64, field string, firstFilter *bool, tablePrefix string) string {
+func generateTimestampSpecifierSQL(value *uint64, field string, firstFilter *bool, tablePrefix string) sql.Query {
 	if value != nil {
-		if *firstFilter {
-			*firstFilter = false
-
-			return fmt.Sprintf(" WHERE %s%s >= %d", tablePrefix, field, *value)
-		}
-
-		return fmt.Sprintf(" AND %s%s >= %d", tablePrefix, field, *value)
+		return sql.Format("%s %s >= %s", sql.Raw(filterPrefix(firstFilter)), column(tablePrefix, field), sql.Param(*value))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
 // GenerateSingleSpecifierStringSQL generates a where function with a string.
 //
 // nolint:unparam
-func generateSingleSpecifierStringSQL(value *string, field string, firstFilter *bool, tablePrefix string) string {
+func generateSingleSpecifierStringSQL(value *string, field string, firstFilter *bool, tablePrefix string) sql.Query {
 	if value != nil {
-		if *firstFilter {
-			*firstFilter = false
-
-			return fmt.Sprintf(" WHERE %s%s =  '%s'", tablePrefix, field, *value)
-		}
-
-		return fmt.Sprintf(" AND %s%s =  '%s'", tablePrefix, field, *value)
+		return sql.Format("%s %s = %s", sql.Raw(filterPrefix(firstFilter)), column(tablePrefix, field), sql.Param(*value))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
 // GenerateSingleSpecifierStringSQL generates a where function with a string.
 //
 // nolint:unparam
-func generateSingleSpecifierStringSQLMv(value *string, field string, firstFilter *bool, firstLocale *bool, tablePrefix string) string {
+func generateSingleSpecifierStringSQLMv(value *string, field string, firstFilter *bool, firstLocale *bool, tablePrefix string) sql.Query {
 	if value != nil {
-		if *firstLocale {
-			*firstFilter = false
-			*firstLocale = false
-			return fmt.Sprintf(" %s%s = '%s'", tablePrefix, field, *value)
-		}
-		if *firstFilter {
-			*firstFilter = false
-
-			return fmt.Sprintf(" WHERE %s%s = '%s'", tablePrefix, field, *value)
-		}
-
-		return fmt.Sprintf(" AND %s%s = '%s'", tablePrefix, field, *value)
+		return sql.Format("%s %s = %s", sql.Raw(filterPrefixMv(firstFilter, firstLocale)), column(tablePrefix, field), sql.Param(*value))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
 // generateKappaSpecifierSQL generates a where function with a string.
-func generateKappaSpecifierSQL(value *string, field string, firstFilter *bool, tablePrefix string) string {
+func generateKappaSpecifierSQL(value *string, field string, firstFilter *bool, tablePrefix string) sql.Query {
 	if value != nil {
-		if *firstFilter {
-			*firstFilter = false
-
-			return fmt.Sprintf(" WHERE %s%s = '%s'", tablePrefix, field, *value)
-		}
-
-		return fmt.Sprintf(" AND %s%s = '%s'", tablePrefix, field, *value)
+		return sql.Format("%s %s = %s", sql.Raw(filterPrefix(firstFilter)), column(tablePrefix, field), sql.Param(*value))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
 // generateKappaSpecifierSQL generates a where function with a string.
-func generateKappaSpecifierSQLMv(value *string, field string, firstFilter *bool, firstInLocale *bool, tablePrefix string) string {
+func generateKappaSpecifierSQLMv(value *string, field string, firstFilter *bool, firstInLocale *bool, tablePrefix string) sql.Query {
 	if value != nil {
-		if *firstInLocale {
-			*firstFilter = false
-			*firstInLocale = false
-			return fmt.Sprintf(" %s%s = '%s'", tablePrefix, field, *value)
-		}
-		if *firstFilter {
-			*firstFilter = false
-
-			return fmt.Sprintf(" WHERE %s%s = '%s'", tablePrefix, field, *value)
-		}
-
-		return fmt.Sprintf(" AND %s%s = '%s'", tablePrefix, field, *value)
+		return sql.Format("%s %s = %s", sql.Raw(filterPrefixMv(firstFilter, firstInLocale)), column(tablePrefix, field), sql.Param(*value))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
 // generateCCTPSpecifierSQLMv generates a where function with event type to filter only cctp events.
-func generateCCTPSpecifierSQLMv(onlyCctp *bool, to bool, field string, firstFilter *bool, firstInLocale *bool, tablePrefix string) string {
+func generateCCTPSpecifierSQLMv(onlyCctp *bool, to bool, field string, firstFilter *bool, firstInLocale *bool, tablePrefix string) sql.Query {
 	if onlyCctp != nil && *onlyCctp {
-		// From explorer/types/bridge/eventtypes.go
-		eventType := 10
-		if to {
-			eventType = 11
-		}
-
-		if *firstInLocale {
-			*firstFilter = false
-			*firstInLocale = false
-			return fmt.Sprintf(" %s%s = %d", tablePrefix, field, eventType)
-		}
-		if *firstFilter {
-			*firstFilter = false
-
-			return fmt.Sprintf(" WHERE %s%s =  %d", tablePrefix, field, eventType)
-		}
-
-		return fmt.Sprintf(" AND %s%s = %d", tablePrefix, field, eventType)
+		return sql.Format("%s %s = %s", sql.Raw(filterPrefixMv(firstFilter, firstInLocale)), column(tablePrefix, field), sql.Param(cctpEventType(to)))
 	}
 
-	return ""
+	return sql.Query{}
 }
 
 //// generateDestinationChainIDSpecifierSQL generates a where function with a string.
@@ -550,7 +412,7 @@ func generateCCTPSpecifierSQLMv(onlyCctp *bool, to bool, field string, firstFilt
 //}
 
 // generateBridgeEventCountQuery creates the query for bridge event count.
-func generateBridgeEventCountQuery(chainID *int, address *string, tokenAddress *string, directionIn bool, timestamp *uint64, isTokenCount bool) string {
+func generateBridgeEventCountQuery(chainID *int, address *string, tokenAddress *string, directionIn bool, timestamp *uint64, isTokenCount bool) sql.Query {
 	chainField := sql.ChainIDFieldName
 
 	firstFilter := true
@@ -560,17 +422,14 @@ func generateBridgeEventCountQuery(chainID *int, address *string, tokenAddress *
 	tokenAddressSpecifier := generateSingleSpecifierStringSQL(tokenAddress, sql.TokenFieldName, &firstFilter, "")
 	timestampSpecifier := generateTimestampSpecifierSQL(timestamp, sql.TimeStampFieldName, &firstFilter, "")
 
-	compositeFilters := fmt.Sprintf(
-		`%s%s%s%s%s`,
-		directionSpecifier, chainIDSpecifier, addressSpecifier, tokenAddressSpecifier, timestampSpecifier,
-	)
-	var query string
+	compositeFilters := sql.Concat(directionSpecifier, chainIDSpecifier, addressSpecifier, tokenAddressSpecifier, timestampSpecifier)
+	var query sql.Query
 	if isTokenCount {
-		query = fmt.Sprintf(`%s SELECT %s, %s AS TokenAddress, COUNT(DISTINCT (%s)) AS Count FROM (SELECT %s FROM %s %s) GROUP BY %s, %s ORDER BY Count Desc`,
-			generateDeDepQueryCTE(compositeFilters, nil, nil, true), sql.ChainIDFieldName, sql.TokenFieldName, sql.TxHashFieldName, singleSideCol, "baseQuery", singleSideJoinsCTE, sql.TokenFieldName, sql.ChainIDFieldName)
+		query = sql.Format(fmt.Sprintf(`%%s SELECT %s, %s AS TokenAddress, COUNT(DISTINCT (%s)) AS Count FROM (SELECT %%s FROM baseQuery %%s) GROUP BY %s, %s ORDER BY Count Desc`, sql.ChainIDFieldName, sql.TokenFieldName, sql.TxHashFieldName, sql.TokenFieldName, sql.ChainIDFieldName),
+			generateDeDepQueryCTE(compositeFilters, nil, nil, true), sql.Raw(singleSideCol), sql.Raw(singleSideJoinsCTE))
 	} else {
-		query = fmt.Sprintf(`%s SELECT %s, COUNT(DISTINCT (%s)) AS Count FROM (SELECT %s FROM %s %s) GROUP BY %s ORDER BY Count Desc`,
-			generateDeDepQueryCTE(compositeFilters, nil, nil, true), sql.ChainIDFieldName, sql.TxHashFieldName, singleSideCol, "baseQuery", singleSideJoinsCTE, sql.ChainIDFieldName)
+		query = sql.Format(fmt.Sprintf(`%%s SELECT %s, COUNT(DISTINCT (%s)) AS Count FROM (SELECT %%s FROM baseQuery %%s) GROUP BY %s ORDER BY Count Desc`, sql.ChainIDFieldName, sql.TxHashFieldName, sql.ChainIDFieldName),
+			generateDeDepQueryCTE(compositeFilters, nil, nil, true), sql.Raw(singleSideCol), sql.Raw(singleSideJoinsCTE))
 	}
 	return query
 }
@@ -699,7 +558,7 @@ func GetPartialInfoFromBridgeEventHybrid(bridgeEvent sql.HybridBridgeEvent, incl
 	return &bridgeTx, nil
 }
 
-func generateMessageBusQuery(chainID []*int, address *string, startTime *int, endTime *int, messageID *string, pending bool, reverted bool, txHash *string, page int) string {
+func generateMessageBusQuery(chainID []*int, address *string, startTime *int, endTime *int, messageID *string, pending bool, reverted bool, txHash *string, page int) sql.Query {
 	firstFilter := true
 
 	chainIDSpecifier := generateSingleSpecifierI32ArrSQL(chainID, sql.ChainIDFieldName, &firstFilter, "")
@@ -714,21 +573,21 @@ func generateMessageBusQuery(chainID []*int, address *string, startTime *int, en
 	if !pending {
 		operation = " != ''"
 	}
-	pendingSpecifier := fmt.Sprintf(" WHERE t.message_id %s", operation)
-	compositeFilters := chainIDSpecifier + minTimeSpecfier + maxTimeSpecfier + addressSpecifier + messageIDSpecifier + txHashSpecifier
-	pageValue := sql.PageSize
-	pageOffset := (page - 1) * sql.PageSize
+	pendingSpecifier := sql.Raw(fmt.Sprintf(" WHERE t.message_id %s", operation))
+	compositeFilters := sql.Concat(chainIDSpecifier, minTimeSpecfier, maxTimeSpecfier, addressSpecifier, messageIDSpecifier, txHashSpecifier)
+	pageValue := sql.Param(sql.PageSize)
+	pageOffset := sql.Param((page - 1) * sql.PageSize)
 
-	cte := fmt.Sprintf("WITH baseQuery AS (SELECT * FROM message_bus_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash), (SELECT min(timestamp) FROM baseQuery) AS minTimestamp", compositeFilters)
+	cte := sql.Format("WITH baseQuery AS (SELECT * FROM message_bus_events %s ORDER BY timestamp DESC, block_number DESC, event_index DESC, insert_time DESC LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash), (SELECT min(timestamp) FROM baseQuery) AS minTimestamp", compositeFilters)
 
-	finalQuery := fmt.Sprintf("%s SELECT * FROM (SELECT * FROM (SELECT * FROM %s WHERE %s = 1 ) f LEFT JOIN (SELECT * FROM (%s) WHERE %s = 0) t ON f.%s = t.%s %s)  LIMIT %d OFFSET %d", cte, "baseQuery", sql.EventTypeFieldName, baseMessageBus, sql.EventTypeFieldName, "message_id", "message_id", pendingSpecifier, pageValue, pageOffset)
+	finalQuery := sql.Format(fmt.Sprintf("%%s SELECT * FROM (SELECT * FROM (SELECT * FROM baseQuery WHERE %s = 1 ) f LEFT JOIN (SELECT * FROM (%%s) WHERE %s = 0) t ON f.message_id = t.message_id %%s)  LIMIT %%s OFFSET %%s", sql.EventTypeFieldName, sql.EventTypeFieldName), cte, sql.Raw(baseMessageBus), pendingSpecifier, pageValue, pageOffset)
 
 	if reverted {
-		finalQuery = fmt.Sprintf("%s SELECT * FROM  (SELECT * FROM (select * from (%s) WHERE %s = 1) f RIGHT OUTER JOIN (Select r.reverted_reason AS reverted_reason, j.reverted_reason AS rrr, * FROM (select * from %s WHERE event_type = 0 and status = 'Fail') j LEFT JOIN (select reverted_reason, tx_hash from (%s) WHERE %s = 2) r on j.tx_hash = r.tx_hash) t ON f.%s = t.%s)  LIMIT %d OFFSET %d", cte, baseMessageBus, sql.EventTypeFieldName, "baseQuery", baseMessageBus, sql.EventTypeFieldName, "message_id", "message_id", pageValue, pageOffset)
+		finalQuery = sql.Format(fmt.Sprintf("%%s SELECT * FROM  (SELECT * FROM (select * from (%%s) WHERE %s = 1) f RIGHT OUTER JOIN (Select r.reverted_reason AS reverted_reason, j.reverted_reason AS rrr, * FROM (select * from baseQuery WHERE event_type = 0 and status = 'Fail') j LEFT JOIN (select reverted_reason, tx_hash from (%%s) WHERE %s = 2) r on j.tx_hash = r.tx_hash) t ON f.message_id = t.message_id)  LIMIT %%s OFFSET %%s", sql.EventTypeFieldName, sql.EventTypeFieldName), cte, sql.Raw(baseMessageBus), sql.Raw(baseMessageBus), pageValue, pageOffset)
 	}
 	return finalQuery
 }
-func generateAllBridgeEventsQueryFromDestination(chainIDTo []*int, chainIDFrom []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, tokenAddressTo []*string, kappa *string, txHash *string, onlyCctp *bool, page int, in bool) string {
+func generateAllBridgeEventsQueryFromDestination(chainIDTo []*int, chainIDFrom []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, tokenAddressTo []*string, kappa *string, txHash *string, onlyCctp *bool, page int, in bool) sql.Query {
 	firstFilter := true
 	chainIDToFilter := generateSingleSpecifierI32ArrSQL(chainIDTo, sql.ChainIDFieldName, &firstFilter, "")
 	minTimeFilter := generateEqualitySpecifierSQL(startTime, sql.TimeStampFieldName, &firstFilter, "", true)
@@ -739,13 +598,13 @@ func generateAllBridgeEventsQueryFromDestination(chainIDTo []*int, chainIDFrom [
 	directionFilter := generateDirectionSpecifierSQL(in, &firstFilter, "")
 	cctpFilter := generateCCTPSpecifierSQL(onlyCctp, true, sql.EventTypeFieldName, &firstFilter, "")
 
-	toFilters := chainIDToFilter + minTimeFilter + maxTimeFilter + addressToFilter + kappaFilter + txHashFilter + directionFilter + cctpFilter
+	toFilters := sql.Concat(chainIDToFilter, minTimeFilter, maxTimeFilter, addressToFilter, kappaFilter, txHashFilter, directionFilter, cctpFilter)
 
 	firstFilter = false
 	chainIDFromFilter := generateSingleSpecifierI32ArrSQL(chainIDFrom, sql.ChainIDFieldName, &firstFilter, "")
 	addressFromFilter := generateAddressSpecifierSQL(addressFrom, &firstFilter, "")
 
-	fromFilters := chainIDFromFilter + addressFromFilter
+	fromFilters := sql.Concat(chainIDFromFilter, addressFromFilter)
 
 	firstFilter = true
 	minAmountFilter := generateEqualitySpecifierSQL(minAmount, "tamount", &firstFilter, "", true)
@@ -754,17 +613,17 @@ func generateAllBridgeEventsQueryFromDestination(chainIDTo []*int, chainIDFrom [
 	maxAmountFilterUsd := generateEqualitySpecifierSQL(maxAmountUsd, "famount_usd", &firstFilter, "", false)
 	tokenAddressToFilter := generateSingleSpecifierStringArrSQL(tokenAddressTo, "ttoken", &firstFilter, "")
 	tokenAddressFromFilter := generateSingleSpecifierStringArrSQL(tokenAddressFrom, "ftoken", &firstFilter, "")
-	postJoinFilters := minAmountFilter + minAmountFilterUsd + maxAmountFilter + maxAmountFilterUsd + tokenAddressToFilter + tokenAddressFromFilter
+	postJoinFilters := sql.Concat(minAmountFilter, minAmountFilterUsd, maxAmountFilter, maxAmountFilterUsd, tokenAddressToFilter, tokenAddressFromFilter)
 
 	pageValue := sql.PageSize
 	pageOffset := (page - 1) * sql.PageSize
-	if postJoinFilters == "" {
-		return fmt.Sprintf("%s SELECT %s FROM %s %s %s %s", generateDeDepQueryCTE(toFilters, &pageValue, &pageOffset, false), destToOriginCol, "baseQuery", destToOriginJoinsPt1, fromFilters, destToOriginJoinsPt2)
+	if postJoinFilters.IsEmpty() {
+		return sql.Format("%s SELECT %s FROM baseQuery %s %s %s", generateDeDepQueryCTE(toFilters, &pageValue, &pageOffset, false), sql.Raw(destToOriginCol), sql.Raw(destToOriginJoinsPt1), fromFilters, sql.Raw(destToOriginJoinsPt2))
 	}
-	return fmt.Sprintf("%s SELECT * FROM (SELECT %s FROM %s %s %s %s) %s LIMIT %d OFFSET %d", generateDeDepQueryCTE(toFilters, nil, nil, false), destToOriginCol, "baseQuery", destToOriginJoinsPt1, fromFilters, destToOriginJoinsPt2, postJoinFilters, pageValue, pageOffset)
+	return sql.Format("%s SELECT * FROM (SELECT %s FROM baseQuery %s %s %s) %s LIMIT %s OFFSET %s", generateDeDepQueryCTE(toFilters, nil, nil, false), sql.Raw(destToOriginCol), sql.Raw(destToOriginJoinsPt1), fromFilters, sql.Raw(destToOriginJoinsPt2), postJoinFilters, sql.Param(pageValue), sql.Param(pageOffset))
 }
 
-func generateAllBridgeEventsQueryFromDestinationMv(chainIDTo []*int, addressTo *string, minAmount *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressTo []*string, kappa *string, txHash *string, pending *bool, page int) string {
+func generateAllBridgeEventsQueryFromDestinationMv(chainIDTo []*int, addressTo *string, minAmount *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressTo []*string, kappa *string, txHash *string, pending *bool, page int) sql.Query {
 	firstFilter := true
 	chainIDToFilter := generateSingleSpecifierI32ArrSQL(chainIDTo, sql.ChainIDFieldName, &firstFilter, "t")
 	minTimeFilter := generateEqualitySpecifierSQL(startTime, sql.TimeStampFieldName, &firstFilter, "t", true)
@@ -788,31 +647,31 @@ func generateAllBridgeEventsQueryFromDestinationMv(chainIDTo []*int, addressTo *
 	// firstFilter = true
 	// minAmountFilter := generateEqualitySpecifierSQL(minAmount, "tamount", &firstFilter, "", true)
 	// minAmountFilterUsd := generateEqualitySpecifierSQL(minAmountUsd, "tamount_usd", &firstFilter, "", true)
-	pendingFilter := ""
+	var pendingFilter sql.Query
 	if pending != nil {
 		prefix := " AND "
 		if firstFilter {
 			prefix = " WHERE "
 		}
 		if *pending {
-			pendingFilter = prefix + "fdestination_kappa = ''"
+			pendingFilter = sql.Raw(prefix + "fdestination_kappa = ''")
 		} else {
-			pendingFilter = prefix + "fdestination_tkappa != ''"
+			pendingFilter = sql.Raw(prefix + "fdestination_tkappa != ''")
 		}
 	}
 
-	toFilters := chainIDToFilter + minTimeFilter + maxTimeFilter + addressToFilter + kappaFilter + txHashFilter + minAmountFilter + minAmountFilterUsd + tokenAddressToFilter + pendingFilter
+	toFilters := sql.Concat(chainIDToFilter, minTimeFilter, maxTimeFilter, addressToFilter, kappaFilter, txHashFilter, minAmountFilter, minAmountFilterUsd, tokenAddressToFilter, pendingFilter)
 
 	pageValue := sql.PageSize
 	pageOffset := (page - 1) * sql.PageSize
 
-	return fmt.Sprintf("SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash LIMIT %d OFFSET %d ", toFilters, pageValue, pageOffset)
+	return sql.Format("SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash LIMIT %s OFFSET %s ", toFilters, sql.Param(pageValue), sql.Param(pageOffset))
 }
 
 // generateAllBridgeEventsQueryFromOrigin gets all the filters for query from origin.
 //
 // nolint:dupl
-func generateAllBridgeEventsQueryFromOrigin(chainIDFrom []*int, chainIDTo []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, tokenAddressTo []*string, txHash *string, pending *bool, onlyCctp *bool, page int, in bool) string {
+func generateAllBridgeEventsQueryFromOrigin(chainIDFrom []*int, chainIDTo []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, tokenAddressTo []*string, txHash *string, pending *bool, onlyCctp *bool, page int, in bool) sql.Query {
 	firstFilter := true
 	chainIDFromFilter := generateSingleSpecifierI32ArrSQL(chainIDFrom, sql.ChainIDFieldName, &firstFilter, "")
 	minTimeFilter := generateEqualitySpecifierSQL(startTime, sql.TimeStampFieldName, &firstFilter, "", true)
@@ -821,13 +680,13 @@ func generateAllBridgeEventsQueryFromOrigin(chainIDFrom []*int, chainIDTo []*int
 	txHashFilter := generateSingleSpecifierStringSQL(txHash, sql.TxHashFieldName, &firstFilter, "")
 	directionFilter := generateDirectionSpecifierSQL(in, &firstFilter, "")
 	cctpFilter := generateCCTPSpecifierSQL(onlyCctp, false, sql.EventTypeFieldName, &firstFilter, "")
-	fromFilters := chainIDFromFilter + minTimeFilter + maxTimeFilter + addressFromFilter + txHashFilter + directionFilter + cctpFilter
+	fromFilters := sql.Concat(chainIDFromFilter, minTimeFilter, maxTimeFilter, addressFromFilter, txHashFilter, directionFilter, cctpFilter)
 
 	firstFilter = false
 	chainIDToFilter := generateSingleSpecifierI32ArrSQL(chainIDTo, sql.ChainIDFieldName, &firstFilter, "")
 	addressToFilter := generateAddressSpecifierSQL(addressTo, &firstFilter, "")
 
-	toFilters := chainIDToFilter + addressToFilter
+	toFilters := sql.Concat(chainIDToFilter, addressToFilter)
 
 	firstFilter = false
 	minAmountFilter := generateEqualitySpecifierSQL(minAmount, "tamount", &firstFilter, "", true)
@@ -841,21 +700,21 @@ func generateAllBridgeEventsQueryFromOrigin(chainIDFrom []*int, chainIDTo []*int
 	if pending != nil && !*pending {
 		operation = " != ''"
 	}
-	pendingFilter := fmt.Sprintf(" WHERE t%s %s", sql.KappaFieldName, operation)
-	postJoinFilters := minAmountFilter + minAmountFilterUsd + maxAmountFilter + maxAmountFilterUsd + tokenAddressToFilter + tokenAddressFromFilter
+	pendingFilter := sql.Raw(fmt.Sprintf(" WHERE t%s %s", sql.KappaFieldName, operation))
+	postJoinFilters := sql.Concat(minAmountFilter, minAmountFilterUsd, maxAmountFilter, maxAmountFilterUsd, tokenAddressToFilter, tokenAddressFromFilter)
 
 	pageValue := sql.PageSize
 	pageOffset := (page - 1) * sql.PageSize
-	if pending != nil && !*pending && postJoinFilters == "" {
-		return fmt.Sprintf("%s SELECT %s FROM %s %s %s %s", generateDeDepQueryCTE(fromFilters, &pageValue, &pageOffset, false), originToDestCol, "baseQuery", originToDestJoinsPt1, toFilters, originToDestJoinsPt2)
+	if pending != nil && !*pending && postJoinFilters.IsEmpty() {
+		return sql.Format("%s SELECT %s FROM baseQuery %s %s %s", generateDeDepQueryCTE(fromFilters, &pageValue, &pageOffset, false), sql.Raw(originToDestCol), sql.Raw(originToDestJoinsPt1), toFilters, sql.Raw(originToDestJoinsPt2))
 	}
-	return fmt.Sprintf("%s SELECT * FROM (SELECT %s FROM %s %s %s %s) %s LIMIT %d OFFSET %d", generateDeDepQueryCTE(fromFilters, nil, nil, false), originToDestCol, "baseQuery", originToDestJoinsPt1, toFilters, originToDestJoinsPt2, pendingFilter+postJoinFilters, pageValue, pageOffset)
+	return sql.Format("%s SELECT * FROM (SELECT %s FROM baseQuery %s %s %s) %s LIMIT %s OFFSET %s", generateDeDepQueryCTE(fromFilters, nil, nil, false), sql.Raw(originToDestCol), sql.Raw(originToDestJoinsPt1), toFilters, sql.Raw(originToDestJoinsPt2), sql.Concat(pendingFilter, postJoinFilters), sql.Param(pageValue), sql.Param(pageOffset))
 }
 
 // generateAllBridgeEventsQueryFromOriginMv gets all the filters for query from origin.
 //
 // nolint:dupl
-func generateAllBridgeEventsQueryFromOriginMv(chainIDFrom []*int, addressFrom *string, maxAmount *int, maxAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, txHash *string, kappa *string, pending *bool, page int) string {
+func generateAllBridgeEventsQueryFromOriginMv(chainIDFrom []*int, addressFrom *string, maxAmount *int, maxAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, txHash *string, kappa *string, pending *bool, page int) sql.Query {
 	firstFilter := true
 	chainIDFromFilter := generateSingleSpecifierI32ArrSQL(chainIDFrom, sql.ChainIDFieldName, &firstFilter, "f")
 	minTimeFilter := generateEqualitySpecifierSQL(startTime, sql.TimeStampFieldName, &firstFilter, "f", true)
@@ -873,25 +732,25 @@ func generateAllBridgeEventsQueryFromOriginMv(chainIDFrom []*int, addressFrom *s
 	// minAmountFilterUsd := generateEqualitySpecifierSQL(minAmountUsd, "tamount_usd", &firstFilter, "", true)
 	// tokenAddressToFilter := generateSingleSpecifierStringArrSQL(tokenAddressTo, "ttoken", &firstFilter, "")
 
-	pendingFilter := ""
+	var pendingFilter sql.Query
 	if pending != nil {
 		prefix := " AND "
 		if firstFilter {
 			prefix = " WHERE "
 		}
 		if *pending {
-			pendingFilter = prefix + "tkappa = ''"
+			pendingFilter = sql.Raw(prefix + "tkappa = ''")
 		} else {
-			pendingFilter = prefix + "tkappa != ''"
+			pendingFilter = sql.Raw(prefix + "tkappa != ''")
 		}
 	}
 
-	fromFilters := chainIDFromFilter + minTimeFilter + maxTimeFilter + addressFromFilter + txHashFilter + tokenAddressFromFilter + maxAmountFilter + maxAmountFilterUsd + pendingFilter + kappaFilter
+	fromFilters := sql.Concat(chainIDFromFilter, minTimeFilter, maxTimeFilter, addressFromFilter, txHashFilter, tokenAddressFromFilter, maxAmountFilter, maxAmountFilterUsd, pendingFilter, kappaFilter)
 	pageValue := sql.PageSize
 	pageOffset := (page - 1) * sql.PageSize
-	return fmt.Sprintf("SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash LIMIT %d OFFSET %d ", fromFilters, pageValue, pageOffset)
+	return sql.Format("SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash LIMIT %s OFFSET %s ", fromFilters, sql.Param(pageValue), sql.Param(pageOffset))
 }
-func generateAllBridgeEventsQueryMv(chainIDFrom []*int, chainIDTo []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, tokenAddressTo []*string, txHash *string, kappa *string, pending *bool, onlyCctp *bool, page int) string {
+func generateAllBridgeEventsQueryMv(chainIDFrom []*int, chainIDTo []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, tokenAddressFrom []*string, tokenAddressTo []*string, txHash *string, kappa *string, pending *bool, onlyCctp *bool, page int) sql.Query {
 	firstFilter := true
 	firstInLocale := true
 	chainIDFromFilter := generateSingleSpecifierI32ArrSQLMv(chainIDFrom, sql.ChainIDFieldName, &firstFilter, &firstInLocale, "f")
@@ -914,42 +773,43 @@ func generateAllBridgeEventsQueryMv(chainIDFrom []*int, chainIDTo []*int, addres
 	kappaToFilter := generateKappaSpecifierSQLMv(kappa, sql.KappaFieldName, &firstFilter, &firstInLocale, "t")
 	onlyCCTPToFilter := generateCCTPSpecifierSQLMv(onlyCctp, true, sql.EventTypeFieldName, &firstFilter, &firstInLocale, "t")
 
-	toFilters := chainIDFromFilter + addressFromFilter + txHashFromFilter + tokenAddressFromFilter + maxAmountFilter + maxAmountFilterUsd + kappaFromFilter + onlyCCTPFromFilter
-	fromFilters := chainIDToFilter + addressToFilter + txHashToFilter + tokenAddressToFilter + minAmountFilter + minAmountFilterUsd + kappaToFilter + onlyCCTPToFilter
+	toFilters := sql.Concat(chainIDFromFilter, addressFromFilter, txHashFromFilter, tokenAddressFromFilter, maxAmountFilter, maxAmountFilterUsd, kappaFromFilter, onlyCCTPFromFilter)
+	fromFilters := sql.Concat(chainIDToFilter, addressToFilter, txHashToFilter, tokenAddressToFilter, minAmountFilter, minAmountFilterUsd, kappaToFilter, onlyCCTPToFilter)
 
 	minTimeFilter := generateEqualitySpecifierSQL(startTime, sql.TimeStampFieldName, &firstFilter, "f", true)
 	maxTimeFilter := generateEqualitySpecifierSQL(endTime, sql.TimeStampFieldName, &firstFilter, "f", false)
+	timeFilters := sql.Concat(minTimeFilter, maxTimeFilter)
 
-	var allFilters string
+	var allFilters sql.Query
 	switch {
-	case fromFilters != "" && toFilters != "":
-		allFilters = fmt.Sprintf("WHERE ((%s) OR (%s)) %s", fromFilters, toFilters, minTimeFilter+maxTimeFilter)
-	case fromFilters != "" && toFilters == "":
-		allFilters = fmt.Sprintf("WHERE (%s) %s", fromFilters, minTimeFilter+maxTimeFilter)
-	case fromFilters == "" && toFilters != "":
-		allFilters = fmt.Sprintf("WHERE (%s) %s ", toFilters, minTimeFilter+maxTimeFilter)
+	case !fromFilters.IsEmpty() && !toFilters.IsEmpty():
+		allFilters = sql.Format("WHERE ((%s) OR (%s)) %s", fromFilters, toFilters, timeFilters)
+	case !fromFilters.IsEmpty() && toFilters.IsEmpty():
+		allFilters = sql.Format("WHERE (%s) %s", fromFilters, timeFilters)
+	case fromFilters.IsEmpty() && !toFilters.IsEmpty():
+		allFilters = sql.Format("WHERE (%s) %s ", toFilters, timeFilters)
 	default:
-		allFilters = minTimeFilter + maxTimeFilter
+		allFilters = timeFilters
 	}
 
-	var pendingFilter string
+	var pendingFilter sql.Query
 	if pending != nil {
 		if *pending {
-			pendingFilter = "WHERE tkappa = '' AND fdestination_chain_id != 121014925"
+			pendingFilter = sql.Raw("WHERE tkappa = '' AND fdestination_chain_id != 121014925")
 		} else {
-			pendingFilter = " WHERE tkappa != ''"
+			pendingFilter = sql.Raw(" WHERE tkappa != ''")
 		}
 	}
 	pageValue := sql.PageSize
 	pageOffset := (page - 1) * sql.PageSize
-	return fmt.Sprintf("SELECT * FROM(SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) %s LIMIT %d OFFSET %d SETTINGS memory_overcommit_ratio_denominator=4000, memory_usage_overcommit_max_wait_microseconds=500 ", allFilters, pendingFilter, pageValue, pageOffset)
+	return sql.Format("SELECT * FROM(SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) %s LIMIT %s OFFSET %s SETTINGS memory_overcommit_ratio_denominator=4000, memory_usage_overcommit_max_wait_microseconds=500 ", allFilters, pendingFilter, sql.Param(pageValue), sql.Param(pageOffset))
 }
 
 // nolint:cyclop
 func (r *queryResolver) GetBridgeTxsFromDestination(ctx context.Context, useMv *bool, chainIDFrom []*int, chainIDTo []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, txHash *string, kappa *string, tokenAddressFrom []*string, tokenAddressTo []*string, onlyCctp *bool, page *int, pending *bool) ([]*model.BridgeTransaction, error) {
 	var err error
 	var results []*model.BridgeTransaction
-	var query string
+	var query sql.Query
 	if useMv != nil && *useMv {
 		if chainIDTo == nil && addressTo == nil && minAmount == nil && minAmountUsd == nil && startTime == nil && endTime == nil && tokenAddressTo == nil && kappa == nil && txHash == nil {
 			return nil, nil
@@ -1041,6 +901,85 @@ func (r *queryResolver) GetBridgeTxs(ctx context.Context, chainIDFrom []*int, ch
 	return results, nil
 }
 
+// generateFeeStatisticsQuery generates the query for the fees charged per day, chain, platform, contract and token.
+func generateFeeStatisticsQuery(chainID *int, platform *model.FeePlatform, duration *model.Duration) sql.Query {
+	firstFilter := true
+	filters := sql.Concat(GetDurationFilter(duration, &firstFilter, ""), generateSingleSpecifierI32SQL(chainID, sql.ChainIDFieldName, &firstFilter, ""))
+	if platform != nil {
+		platformName := strings.ToLower(platform.String())
+		filters = sql.Concat(filters, generateSingleSpecifierStringSQL(&platformName, "platform", &firstFilter, ""))
+	}
+
+	return sql.Format("SELECT toString(toDate(toDateTime(timestamp))) AS Date, chain_id AS ChainID, upper(platform) AS Platform, contract_address AS ContractAddress, token AS Token, any(token_symbol) AS TokenSymbol, toInt64(count()) AS Count, sumKahan(amount_usd) AS VolumeUsd, sumKahan(fee_usd) AS FeeUsd, sumKahan(protocol_fee_usd) AS ProtocolFeeUsd FROM (SELECT * FROM fee_events FINAL %s) GROUP BY Date, ChainID, Platform, ContractAddress, Token ORDER BY Date DESC, FeeUsd DESC", filters)
+}
+
+// generateRelayerStatisticsQuery generates the query for the rfq fills and revenue per day, destination chain and
+// relayer. The fill share is computed over all relayers before the relayer filter is applied.
+func generateRelayerStatisticsQuery(chainID *int, relayer *string, duration *model.Duration) sql.Query {
+	firstFilter := false
+	filters := sql.Concat(GetDurationFilter(duration, &firstFilter, ""), generateSingleSpecifierI32SQL(chainID, sql.ChainIDFieldName, &firstFilter, ""))
+
+	relayerFilter := sql.Query{}
+	if relayer != nil {
+		relayerFilter = sql.Format(" WHERE lower(Relayer) = lower(%s)", sql.Param(*relayer))
+	}
+
+	return sql.Format("SELECT * FROM (SELECT Date, ChainID, Relayer, Fills, Fills / sum(Fills) OVER (PARTITION BY Date, ChainID) AS FillShare, VolumeUsd, RevenueUsd FROM (SELECT toString(toDate(toDateTime(timestamp))) AS Date, chain_id AS ChainID, relayer AS Relayer, toInt64(count()) AS Fills, sumKahan(amount_usd) AS VolumeUsd, sumKahan(revenue_usd) AS RevenueUsd FROM (SELECT * FROM fee_events FINAL WHERE platform = 'rfq' %s) GROUP BY Date, ChainID, Relayer))%s ORDER BY Date DESC, ChainID, Fills DESC", filters, relayerFilter)
+}
+
+// generateChainFlowsQuery generates the query for the completed transfers per origin chain, destination chain and,
+// if byToken is set, token symbol. Transfers are matched with their destination event by the bridge materialized view.
+func generateChainFlowsQuery(chainIDFrom *int, chainIDTo *int, tokenSymbol *string, byToken bool, duration *model.Duration) sql.Query {
+	firstFilter := false
+	filters := sql.Concat(
+		GetDurationFilter(duration, &firstFilter, "f"),
+		generateSingleSpecifierI32SQL(chainIDFrom, sql.ChainIDFieldName, &firstFilter, "f"),
+		generateSingleSpecifierI32SQL(chainIDTo, sql.ChainIDFieldName, &firstFilter, "t"),
+		generateSingleSpecifierStringSQL(tokenSymbol, "token_symbol", &firstFilter, "f"),
+	)
+
+	tokenColumn := sql.Raw("CAST(NULL AS Nullable(String))")
+	if byToken {
+		tokenColumn = sql.Raw("ftoken_symbol")
+	}
+
+	return sql.Format("SELECT fchain_id AS ChainIDFrom, tchain_id AS ChainIDTo, %s AS TokenSymbol, toInt64(count()) AS Count, sumKahan(coalesce(famount_usd, 0)) AS VolumeUsd, toFloat64(quantileExact(0.5)(CompletionSeconds)) AS MedianCompletionSeconds, toFloat64(quantileExact(0.95)(CompletionSeconds)) AS P95CompletionSeconds FROM (SELECT *, greatest(toInt64(ttimestamp) - toInt64(ftimestamp), 0) AS CompletionSeconds FROM mv_bridge_events WHERE tkappa != '' %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) GROUP BY ChainIDFrom, ChainIDTo, TokenSymbol ORDER BY VolumeUsd DESC", tokenColumn, filters)
+}
+
+// GetPendingTransfers gets the transfers that have no destination transaction after the sla of their chain pair.
+func (r *queryResolver) GetPendingTransfers(ctx context.Context, chainIDFrom *int, chainIDTo *int, bridgeType *model.BridgeType, page *int) ([]*model.PendingTransfer, error) {
+	filter := stuck.Filter{
+		OriginChainID:      chainIDFrom,
+		DestinationChainID: chainIDTo,
+		BridgeType:         bridgeType,
+	}
+	transfers, err := stuck.NewFinder(r.DB, r.Config).Find(ctx, filter, *page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stuck transfers: %w", err)
+	}
+
+	results := make([]*model.PendingTransfer, len(transfers))
+	for i := range transfers {
+		bridgeTx, err := GetPartialInfoFromBridgeEventHybrid(transfers[i].Event, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get partial info from bridge event: %w", err)
+		}
+
+		ageSeconds := int(transfers[i].Age.Seconds())
+		slaSeconds := int(transfers[i].SLA.Seconds())
+		results[i] = &model.PendingTransfer{
+			FromInfo:    bridgeTx.FromInfo,
+			Kappa:       bridgeTx.Kappa,
+			BridgeType:  &transfers[i].BridgeType,
+			AgeSeconds:  &ageSeconds,
+			SLASeconds:  &slaSeconds,
+			LikelyCause: &transfers[i].Cause,
+		}
+	}
+
+	return results, nil
+}
+
 // GetPartialInfoFromMessageBusEventHybrid returns the partial info from message bus event.
 //
 // nolint:cyclop
@@ -1161,39 +1100,39 @@ func keyGen(chainID string, kappa string) string {
 }
 
 // GenerateAmountStatisticBridgeSQL generate sql for the bridge platform.
-func GenerateAmountStatisticBridgeSQL(typeArg model.StatisticType, address *string, chainID *int, tokenAddress *string) (*string, error) {
+func GenerateAmountStatisticBridgeSQL(typeArg model.StatisticType, address *string, chainID *int, tokenAddress *string) (*sql.Query, error) {
 	var operation string
-	var finalSQL string
+	var finalSQL sql.Query
 	firstFilter2 := true
 	addressFilter := generateSingleSpecifierStringSQL(address, sql.SenderFieldName, &firstFilter2, "f")
 	chainIDFilter := generateSingleSpecifierI32SQL(chainID, sql.ChainIDFieldName, &firstFilter2, "f")
 	tokenAddressFilter := generateSingleSpecifierStringSQL(tokenAddress, sql.TokenFieldName, &firstFilter2, "f")
-	compositeFilters := addressFilter + chainIDFilter + tokenAddressFilter
+	compositeFilters := sql.Concat(addressFilter, chainIDFilter, tokenAddressFilter)
 	switch typeArg {
 	case model.StatisticTypeMeanVolumeUsd:
 		operation = fmt.Sprintf("AVG(f%s)", sql.AmountUSDFieldName)
-		finalSQL = fmt.Sprintf("SELECT %s from (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)  ", operation, compositeFilters)
+		finalSQL = sql.Format("SELECT %s from (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)  ", sql.Raw(operation), compositeFilters)
 	case model.StatisticTypeMedianVolumeUsd:
 		operation = fmt.Sprintf("median(f%s)", sql.AmountUSDFieldName)
-		finalSQL = fmt.Sprintf("SELECT %s FROM (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)  ", operation, compositeFilters)
+		finalSQL = sql.Format("SELECT %s FROM (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)  ", sql.Raw(operation), compositeFilters)
 	case model.StatisticTypeTotalVolumeUsd:
 		operation = fmt.Sprintf("sumKahan(f%s)", sql.AmountUSDFieldName)
-		finalSQL = fmt.Sprintf("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", operation, compositeFilters)
+		finalSQL = sql.Format("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", sql.Raw(operation), compositeFilters)
 	case model.StatisticTypeCountTransactions:
 		operation = fmt.Sprintf("uniq(f%s, f%s) AS res", sql.ChainIDFieldName, sql.TxHashFieldName)
-		finalSQL = fmt.Sprintf("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", operation, compositeFilters)
+		finalSQL = sql.Format("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", sql.Raw(operation), compositeFilters)
 	case model.StatisticTypeCountAddresses:
 		operation = fmt.Sprintf("uniq(f%s, f%s) AS res", sql.ChainIDFieldName, sql.SenderFieldName)
-		finalSQL = fmt.Sprintf("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", operation, compositeFilters)
+		finalSQL = sql.Format("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", sql.Raw(operation), compositeFilters)
 	case model.StatisticTypeMeanFeeUsd:
 		operation = fmt.Sprintf("AVG(%s)", "tfee_amount_usd")
-		finalSQL = fmt.Sprintf("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", operation, compositeFilters)
+		finalSQL = sql.Format("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", sql.Raw(operation), compositeFilters)
 	case model.StatisticTypeMedianFeeUsd:
 		operation = fmt.Sprintf("median(%s)", "tfee_amount_usd")
-		finalSQL = fmt.Sprintf("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", operation, compositeFilters)
+		finalSQL = sql.Format("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", sql.Raw(operation), compositeFilters)
 	case model.StatisticTypeTotalFeeUsd:
 		operation = fmt.Sprintf("sumKahan(%s)", "tfee_amount_usd")
-		finalSQL = fmt.Sprintf("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", operation, compositeFilters)
+		finalSQL = sql.Format("SELECT %s from ( select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash)", sql.Raw(operation), compositeFilters)
 
 	default:
 		return nil, fmt.Errorf("invalid statistic type: %s", typeArg)
@@ -1204,9 +1143,9 @@ func GenerateAmountStatisticBridgeSQL(typeArg model.StatisticType, address *stri
 // GenerateAmountStatisticSwapSQL generates sql to get statistics on the swap platform.
 //
 // nolint:cyclop
-func GenerateAmountStatisticSwapSQL(typeArg model.StatisticType, compositeFilters string, tokenAddress *string) (*string, error) {
+func GenerateAmountStatisticSwapSQL(typeArg model.StatisticType, compositeFilters sql.Query, tokenAddress *string) (*sql.Query, error) {
 	var operation string
-	var finalSQL string
+	var finalSQL sql.Query
 
 	switch typeArg {
 	case model.StatisticTypeMeanVolumeUsd:
@@ -1229,19 +1168,19 @@ func GenerateAmountStatisticSwapSQL(typeArg model.StatisticType, compositeFilter
 		return nil, fmt.Errorf("invalid statistic type: %s", typeArg)
 	}
 	if tokenAddress == nil {
-		finalSQL = fmt.Sprintf("SELECT %s FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash)", operation, compositeFilters)
+		finalSQL = sql.Format("SELECT %s FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash)", sql.Raw(operation), compositeFilters)
 	} else {
 		firstFilter := true
 		tokenAddressSpecifier := generateSingleSpecifierStringSQL(tokenAddress, sql.TokenFieldName, &firstFilter, "")
-		finalSQL = fmt.Sprintf("SELECT %s FROM (%s %s %s %s", operation, baseSwapWithTokenPt1, compositeFilters, baseSwapWithTokenPt2, tokenAddressSpecifier)
+		finalSQL = sql.Format("SELECT %s FROM (%s %s %s %s", sql.Raw(operation), sql.Raw(baseSwapWithTokenPt1), compositeFilters, sql.Raw(baseSwapWithTokenPt2), tokenAddressSpecifier)
 	}
 	return &finalSQL, nil
 }
 
 // GenerateAmountStatisticMessageBusSQL generates sql for getting stats on the message bus platform.
-func GenerateAmountStatisticMessageBusSQL(typeArg model.StatisticType, compositeFilters string) (*string, error) {
+func GenerateAmountStatisticMessageBusSQL(typeArg model.StatisticType, compositeFilters sql.Query) (*sql.Query, error) {
 	var operation string
-	var finalSQL string
+	var finalSQL sql.Query
 	switch typeArg {
 	case model.StatisticTypeMeanVolumeUsd:
 		return nil, fmt.Errorf("cannot calculate volume data for messagebus events")
@@ -1251,19 +1190,19 @@ func GenerateAmountStatisticMessageBusSQL(typeArg model.StatisticType, composite
 		return nil, fmt.Errorf("cannot calculate volume data for messagebus events")
 	case model.StatisticTypeCountTransactions:
 		operation = fmt.Sprintf("uniq(%s, %s) AS res", sql.ChainIDFieldName, sql.TxHashFieldName)
-		finalSQL = fmt.Sprintf("SELECT %s FROM (%s) %s", operation, baseMessageBus, compositeFilters)
+		finalSQL = sql.Format("SELECT %s FROM (%s) %s", sql.Raw(operation), sql.Raw(baseMessageBus), compositeFilters)
 	case model.StatisticTypeCountAddresses:
 		operation = fmt.Sprintf("uniq(%s, source_address) AS res", sql.ChainIDFieldName)
-		finalSQL = fmt.Sprintf("SELECT %s FROM (%s) %s", operation, baseMessageBus, compositeFilters)
+		finalSQL = sql.Format("SELECT %s FROM (%s) %s", sql.Raw(operation), sql.Raw(baseMessageBus), compositeFilters)
 	case model.StatisticTypeMeanFeeUsd:
 		operation = fmt.Sprintf("AVG(%s)", sql.FeeUSDFieldName)
-		finalSQL = fmt.Sprintf("SELECT %s FROM (%s) %s", operation, baseMessageBus, compositeFilters)
+		finalSQL = sql.Format("SELECT %s FROM (%s) %s", sql.Raw(operation), sql.Raw(baseMessageBus), compositeFilters)
 	case model.StatisticTypeMedianFeeUsd:
 		operation = fmt.Sprintf("median(%s)", sql.FeeUSDFieldName)
-		finalSQL = fmt.Sprintf("SELECT %s FROM (%s) %s", operation, baseMessageBus, compositeFilters)
+		finalSQL = sql.Format("SELECT %s FROM (%s) %s", sql.Raw(operation), sql.Raw(baseMessageBus), compositeFilters)
 	case model.StatisticTypeTotalFeeUsd:
 		operation = fmt.Sprintf("sumKahan(%s)", sql.FeeUSDFieldName)
-		finalSQL = fmt.Sprintf("SELECT %s FROM (%s) %s", operation, baseMessageBus, compositeFilters)
+		finalSQL = sql.Format("SELECT %s FROM (%s) %s", sql.Raw(operation), sql.Raw(baseMessageBus), compositeFilters)
 	default:
 		return nil, fmt.Errorf("invalid statistic type: %s", typeArg)
 	}
@@ -1271,25 +1210,25 @@ func GenerateAmountStatisticMessageBusSQL(typeArg model.StatisticType, composite
 }
 
 // GenerateRankedChainsByVolumeSQL generates sql for getting all chains ranked in order of volume.
-func GenerateRankedChainsByVolumeSQL(compositeFilters string, firstFilter *bool) string {
+func GenerateRankedChainsByVolumeSQL(compositeFilters sql.Query, firstFilter *bool) sql.Query {
 	directionSpecifier := generateDirectionSpecifierSQL(true, firstFilter, "")
-	return fmt.Sprintf("%s %s FULL OUTER JOIN (SELECT chain_id, sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1, arraySum(mapValues(amount_usd)), event_type = 9, arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id], 0)) as usdTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by chain_id) s ON b.pre_fchain_id = s.chain_id ORDER BY total DESC SETTINGS join_use_nulls = 1", generateDeDepQueryCTE(compositeFilters+directionSpecifier, nil, nil, true), rankedChainsBridgeVolume, compositeFilters)
+	return sql.Format("%s %s FULL OUTER JOIN (SELECT chain_id, sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1, arraySum(mapValues(amount_usd)), event_type = 9, arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id], 0)) as usdTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by chain_id) s ON b.pre_fchain_id = s.chain_id ORDER BY total DESC SETTINGS join_use_nulls = 1", generateDeDepQueryCTE(sql.Concat(compositeFilters, directionSpecifier), nil, nil, true), sql.Raw(rankedChainsBridgeVolume), compositeFilters)
 }
 
 // GenerateDailyStatisticByChainAllSQL generates sql for getting daily stats across all chains.
-func GenerateDailyStatisticByChainAllSQL(typeArg *model.DailyStatisticType, compositeFilters string, firstFilter *bool) (*string, error) {
-	var query string
+func GenerateDailyStatisticByChainAllSQL(typeArg *model.DailyStatisticType, compositeFilters sql.Query, firstFilter *bool) (*sql.Query, error) {
+	var query sql.Query
 	switch *typeArg {
 	case model.DailyStatisticTypeVolume:
 		directionSpecifier := generateDirectionSpecifierSQL(true, firstFilter, "")
-		query = fmt.Sprintf("%s %s FULL OUTER JOIN (SELECT %s, chain_id, sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1,    arraySum(mapValues(amount_usd)), event_type = 9,    arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id],    0) )     as usdTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.pre_fchain_id = s.chain_id) group by date order by date) SETTINGS join_use_nulls=1", generateDeDepQueryCTE(compositeFilters+directionSpecifier, nil, nil, true), dailyVolumeBridge, toDateSelect, compositeFilters)
+		query = sql.Format("%s %s FULL OUTER JOIN (SELECT %s, chain_id, sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1,    arraySum(mapValues(amount_usd)), event_type = 9,    arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id],    0) )     as usdTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.pre_fchain_id = s.chain_id) group by date order by date) SETTINGS join_use_nulls=1", generateDeDepQueryCTE(sql.Concat(compositeFilters, directionSpecifier), nil, nil, true), sql.Raw(dailyVolumeBridge), sql.Raw(toDateSelect), compositeFilters)
 	case model.DailyStatisticTypeFee:
-		query = fmt.Sprintf("%s FROM ( SELECT %s, chain_id, sumKahan(fee_usd) as sumTotal FROM (SELECT * FROM bridge_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) GROUP BY date, chain_id) b  FULL OUTER JOIN ( SELECT %s, chain_id, sumKahan(arraySum(mapValues(fee_usd))) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, sumKahan(fee_usd) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", dailyStatisticGenericSelect, toDateSelect, compositeFilters, toDateSelect, compositeFilters, toDateSelect, compositeFilters)
+		query = sql.Format("%s FROM ( SELECT %s, chain_id, sumKahan(fee_usd) as sumTotal FROM (SELECT * FROM bridge_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) GROUP BY date, chain_id) b  FULL OUTER JOIN ( SELECT %s, chain_id, sumKahan(arraySum(mapValues(fee_usd))) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, sumKahan(fee_usd) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", sql.Raw(dailyStatisticGenericSelect), sql.Raw(toDateSelect), compositeFilters, sql.Raw(toDateSelect), compositeFilters, sql.Raw(toDateSelect), compositeFilters)
 	case model.DailyStatisticTypeAddresses:
-		query = fmt.Sprintf("%s FROM ( SELECT %s, chain_id, uniq(chain_id, sender) as sumTotal FROM (SELECT * FROM bridge_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) GROUP BY date, chain_id) b  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, sender) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, source_address) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", dailyStatisticGenericSelect, toDateSelect, compositeFilters, toDateSelect, compositeFilters, toDateSelect, compositeFilters)
+		query = sql.Format("%s FROM ( SELECT %s, chain_id, uniq(chain_id, sender) as sumTotal FROM (SELECT * FROM bridge_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) GROUP BY date, chain_id) b  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, sender) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, source_address) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", sql.Raw(dailyStatisticGenericSelect), sql.Raw(toDateSelect), compositeFilters, sql.Raw(toDateSelect), compositeFilters, sql.Raw(toDateSelect), compositeFilters)
 	case model.DailyStatisticTypeTransactions:
 		directionSpecifier := generateDirectionSpecifierSQL(true, firstFilter, "")
-		query = fmt.Sprintf("%s FROM ( SELECT %s, chain_id, uniq(chain_id, tx_hash) as sumTotal FROM (SELECT * FROM bridge_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) GROUP BY date, chain_id) b  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", dailyStatisticGenericSelect, toDateSelect, compositeFilters+directionSpecifier, toDateSelect, compositeFilters, toDateSelect, compositeFilters)
+		query = sql.Format("%s FROM ( SELECT %s, chain_id, uniq(chain_id, tx_hash) as sumTotal FROM (SELECT * FROM bridge_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) GROUP BY date, chain_id) b  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", sql.Raw(dailyStatisticGenericSelect), sql.Raw(toDateSelect), sql.Concat(compositeFilters, directionSpecifier), sql.Raw(toDateSelect), compositeFilters, sql.Raw(toDateSelect), compositeFilters)
 	default:
 		return nil, fmt.Errorf("unsupported statistic type")
 	}
@@ -1299,19 +1238,19 @@ func GenerateDailyStatisticByChainAllSQL(typeArg *model.DailyStatisticType, comp
 // TODO make this more dynamic.
 
 // GenerateDailyStatisticByChainBridgeSQL generates sql for getting data for daily stats across the bridge platform.
-func GenerateDailyStatisticByChainBridgeSQL(typeArg *model.DailyStatisticType, compositeFilters string, firstFilter *bool) (*string, error) {
-	var query string
+func GenerateDailyStatisticByChainBridgeSQL(typeArg *model.DailyStatisticType, compositeFilters sql.Query, firstFilter *bool) (*sql.Query, error) {
+	var query sql.Query
 	switch *typeArg {
 	case model.DailyStatisticTypeVolume:
 		directionSpecifier := generateDirectionSpecifierSQL(true, firstFilter, "")
-		query = fmt.Sprintf("%s  %s sumKahan(amount_usd) AS sumTotal %s group by date, chain_id order by date, chain_id) group by date order by date )", generateDeDepQueryCTE(compositeFilters+directionSpecifier, nil, nil, true), dailyStatisticGenericSinglePlatform, dailyStatisticBridge)
+		query = sql.Format("%s  %s sumKahan(amount_usd) AS sumTotal %s group by date, chain_id order by date, chain_id) group by date order by date )", generateDeDepQueryCTE(sql.Concat(compositeFilters, directionSpecifier), nil, nil, true), sql.Raw(dailyStatisticGenericSinglePlatform), sql.Raw(dailyStatisticBridge))
 	case model.DailyStatisticTypeFee:
-		query = fmt.Sprintf("%s  %s sumKahan(fee_usd) AS sumTotal %s group by date, chain_id order by date, chain_id) group by date order by date )", generateDeDepQueryCTE(compositeFilters, nil, nil, true), dailyStatisticGenericSinglePlatform, dailyStatisticBridge)
+		query = sql.Format("%s  %s sumKahan(fee_usd) AS sumTotal %s group by date, chain_id order by date, chain_id) group by date order by date )", generateDeDepQueryCTE(compositeFilters, nil, nil, true), sql.Raw(dailyStatisticGenericSinglePlatform), sql.Raw(dailyStatisticBridge))
 	case model.DailyStatisticTypeAddresses:
-		query = fmt.Sprintf("%s  %s uniq(chain_id, sender) AS sumTotal %s group by date, chain_id order by date, chain_id) group by date order by date )", generateDeDepQueryCTE(compositeFilters, nil, nil, true), dailyStatisticGenericSinglePlatform, dailyStatisticBridge)
+		query = sql.Format("%s  %s uniq(chain_id, sender) AS sumTotal %s group by date, chain_id order by date, chain_id) group by date order by date )", generateDeDepQueryCTE(compositeFilters, nil, nil, true), sql.Raw(dailyStatisticGenericSinglePlatform), sql.Raw(dailyStatisticBridge))
 	case model.DailyStatisticTypeTransactions:
 		directionSpecifier := generateDirectionSpecifierSQL(true, firstFilter, "")
-		query = fmt.Sprintf("%s %s uniq(chain_id, tx_hash) AS sumTotal  %s group by date, chain_id order by date, chain_id) group by date order by date )", generateDeDepQueryCTE(compositeFilters+directionSpecifier, nil, nil, true), dailyStatisticGenericSinglePlatform, dailyStatisticBridge)
+		query = sql.Format("%s %s uniq(chain_id, tx_hash) AS sumTotal  %s group by date, chain_id order by date, chain_id) group by date order by date )", generateDeDepQueryCTE(sql.Concat(compositeFilters, directionSpecifier), nil, nil, true), sql.Raw(dailyStatisticGenericSinglePlatform), sql.Raw(dailyStatisticBridge))
 	default:
 		return nil, fmt.Errorf("unsupported statistic type")
 	}
@@ -1319,17 +1258,17 @@ func GenerateDailyStatisticByChainBridgeSQL(typeArg *model.DailyStatisticType, c
 }
 
 // GenerateDailyStatisticByChainSwapSQL generates sql for getting daily stats across the swap platform.
-func GenerateDailyStatisticByChainSwapSQL(typeArg *model.DailyStatisticType, compositeFilters string) (*string, error) {
-	var query string
+func GenerateDailyStatisticByChainSwapSQL(typeArg *model.DailyStatisticType, compositeFilters sql.Query) (*sql.Query, error) {
+	var query sql.Query
 	switch *typeArg {
 	case model.DailyStatisticTypeVolume:
-		query = fmt.Sprintf("%s sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1, arraySum(mapValues(amount_usd)), event_type = 9, arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id],0)) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", dailyStatisticGenericSinglePlatform, baseSwap, compositeFilters)
+		query = sql.Format("%s sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1, arraySum(mapValues(amount_usd)), event_type = 9, arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id],0)) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", sql.Raw(dailyStatisticGenericSinglePlatform), sql.Raw(baseSwap), compositeFilters)
 	case model.DailyStatisticTypeFee:
-		query = fmt.Sprintf("%s sumKahan(arraySum(mapValues(%s))) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", dailyStatisticGenericSinglePlatform, sql.FeeUSDFieldName, baseSwap, compositeFilters)
+		query = sql.Format("%s sumKahan(arraySum(mapValues(%s))) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", sql.Raw(dailyStatisticGenericSinglePlatform), sql.Raw(sql.FeeUSDFieldName), sql.Raw(baseSwap), compositeFilters)
 	case model.DailyStatisticTypeAddresses:
-		query = fmt.Sprintf("%s uniq(%s, %s) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", dailyStatisticGenericSinglePlatform, sql.ChainIDFieldName, sql.SenderFieldName, baseSwap, compositeFilters)
+		query = sql.Format("%s uniq(%s, %s) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", sql.Raw(dailyStatisticGenericSinglePlatform), sql.Raw(sql.ChainIDFieldName), sql.Raw(sql.SenderFieldName), sql.Raw(baseSwap), compositeFilters)
 	case model.DailyStatisticTypeTransactions:
-		query = fmt.Sprintf("%s uniq(%s, %s) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", dailyStatisticGenericSinglePlatform, sql.ChainIDFieldName, sql.TxHashFieldName, baseSwap, compositeFilters)
+		query = sql.Format("%s uniq(%s, %s) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", sql.Raw(dailyStatisticGenericSinglePlatform), sql.Raw(sql.ChainIDFieldName), sql.Raw(sql.TxHashFieldName), sql.Raw(baseSwap), compositeFilters)
 	default:
 		return nil, fmt.Errorf("unsupported statistic type")
 	}
@@ -1337,17 +1276,17 @@ func GenerateDailyStatisticByChainSwapSQL(typeArg *model.DailyStatisticType, com
 }
 
 // GenerateDailyStatisticByChainMessageBusSQL generates sql for getting daily stats across the message bus platform.
-func GenerateDailyStatisticByChainMessageBusSQL(typeArg *model.DailyStatisticType, compositeFilters string) (*string, error) {
-	var query string
+func GenerateDailyStatisticByChainMessageBusSQL(typeArg *model.DailyStatisticType, compositeFilters sql.Query) (*sql.Query, error) {
+	var query sql.Query
 	switch *typeArg {
 	case model.DailyStatisticTypeVolume:
 		return nil, fmt.Errorf("cannot calculate volume for messagebus")
 	case model.DailyStatisticTypeFee:
-		query = fmt.Sprintf("%s sumKahan(%s) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", dailyStatisticGenericSinglePlatform, sql.FeeUSDFieldName, baseMessageBus, compositeFilters)
+		query = sql.Format("%s sumKahan(%s) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", sql.Raw(dailyStatisticGenericSinglePlatform), sql.Raw(sql.FeeUSDFieldName), sql.Raw(baseMessageBus), compositeFilters)
 	case model.DailyStatisticTypeAddresses:
-		query = fmt.Sprintf("%s uniq(%s, %s) AS sumTotal FROM (%s)%s group by date, chain_id) group by date order by date)", dailyStatisticGenericSinglePlatform, sql.ChainIDFieldName, sql.SenderFieldName, baseMessageBus, compositeFilters)
+		query = sql.Format("%s uniq(%s, %s) AS sumTotal FROM (%s)%s group by date, chain_id) group by date order by date)", sql.Raw(dailyStatisticGenericSinglePlatform), sql.Raw(sql.ChainIDFieldName), sql.Raw(sql.SenderFieldName), sql.Raw(baseMessageBus), compositeFilters)
 	case model.DailyStatisticTypeTransactions:
-		query = fmt.Sprintf("%s uniq(%s, %s) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", dailyStatisticGenericSinglePlatform, sql.ChainIDFieldName, sql.TxHashFieldName, baseMessageBus, compositeFilters)
+		query = sql.Format("%s uniq(%s, %s) AS sumTotal FROM (%s) %s group by date, chain_id) group by date order by date)", sql.Raw(dailyStatisticGenericSinglePlatform), sql.Raw(sql.ChainIDFieldName), sql.Raw(sql.TxHashFieldName), sql.Raw(baseMessageBus), compositeFilters)
 	default:
 		return nil, fmt.Errorf("unsupported statistic type")
 	}
@@ -1368,57 +1307,37 @@ func (s SortMessageBusTxType) Len() int           { return len(s) }
 func (s SortMessageBusTxType) Less(i, j int) bool { return *s[i].FromInfo.Time > *s[j].FromInfo.Time }
 func (s SortMessageBusTxType) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 
-func keyGenHandleNilInt(item *int) string {
-	if item != nil {
-		return fmt.Sprintf("%d", *item)
+// Gets the value result from cache.
+func (r *queryResolver) getValueResultFromCache(ctx context.Context, key cache.Key) (*model.ValueResult, error) {
+	var res *model.ValueResult
+	ok, err := r.Cache.GetCache(ctx, key, &res)
+	if err != nil {
+		return nil, fmt.Errorf("could not get cached data: %w", err)
 	}
-	return ""
-}
-
-func keyGenHandleNilString(item *string) string {
-	if item != nil {
-		return *item
+	if !ok || res == nil {
+		return nil, fmt.Errorf("could not get cached data")
 	}
-	return ""
-}
 
-// Gets the value result from cache.
-func (r *queryResolver) getValueResultFromCache(key string) (*model.ValueResult, error) {
-	cacheResult := r.Cache.GetCache(key)
-	if cacheResult != nil {
-		rawCache, ok := cacheResult.(*interface{})
-		if !ok || rawCache == nil {
-			return nil, fmt.Errorf("type assertion error when converting to *interface{}, rawCache %v", rawCache)
-		}
-		res, ok := (*rawCache).(*model.ValueResult)
-		if !ok || res == nil {
-			return nil, fmt.Errorf("type assertion error when converting to *model.ValueResult, res %v", res)
-		}
-		return res, nil
-	}
-	return nil, fmt.Errorf("could not get cached data")
+	return res, nil
 }
 
-// Gets the value result from cache.
-func (r *queryResolver) getDateResultByChainFromCache(key string) ([]*model.DateResultByChain, error) {
-	cacheResult := r.Cache.GetCache(key)
-	if cacheResult != nil {
-		rawCache, ok := cacheResult.(*interface{})
-		if !ok || rawCache == nil {
-			return nil, fmt.Errorf("type assertion error when converting to *interface{}, rawCache %v", rawCache)
-		}
-		res, ok := (*rawCache).([]*model.DateResultByChain)
-		if !ok || res == nil {
-			return nil, fmt.Errorf("type assertion error when converting to []*model.DateResultByChain, res %v", res)
-		}
-		return res, nil
+// Gets the daily results by chain from cache.
+func (r *queryResolver) getDateResultByChainFromCache(ctx context.Context, key cache.Key) ([]*model.DateResultByChain, error) {
+	var res []*model.DateResultByChain
+	ok, err := r.Cache.GetCache(ctx, key, &res)
+	if err != nil {
+		return nil, fmt.Errorf("could not get cached data: %w", err)
 	}
-	return nil, fmt.Errorf("could not get cached data")
+	if !ok || res == nil {
+		return nil, fmt.Errorf("could not get cached data")
+	}
+
+	return res, nil
 }
 
 // GetDurationFilter creates a filter for the various time ranges for analysis.
-func GetDurationFilter(duration *model.Duration, firstFilter *bool, prefix string) string {
-	var timestampSpecifier string
+func GetDurationFilter(duration *model.Duration, firstFilter *bool, prefix string) sql.Query {
+	var timestampSpecifier sql.Query
 	switch *duration {
 	case model.DurationPastDay:
 		hours := 24
@@ -1441,19 +1360,19 @@ func GetDurationFilter(duration *model.Duration, firstFilter *bool, prefix strin
 		targetTime := GetTargetTime(&hours)
 		timestampSpecifier = generateTimestampSpecifierSQL(&targetTime, sql.TimeStampFieldName, firstFilter, prefix)
 	case model.DurationAllTime:
-		timestampSpecifier = ""
+		timestampSpecifier = sql.Query{}
 	}
 	return timestampSpecifier
 }
 
 // nolint:cyclop
-func (r *queryResolver) getAmountStatisticsAll(ctx context.Context, typeArg model.StatisticType, chainID *int, address *string, tokenAddress *string, compositeFilters string) (*string, error) {
+func (r *queryResolver) getAmountStatisticsAll(ctx context.Context, typeArg model.StatisticType, chainID *int, address *string, tokenAddress *string, compositeFilters sql.Query) (*string, error) {
 	if typeArg == model.StatisticTypeMedianVolumeUsd || typeArg == model.StatisticTypeMeanVolumeUsd || typeArg == model.StatisticTypeMedianFeeUsd || typeArg == model.StatisticTypeMeanFeeUsd {
 		return nil, fmt.Errorf("cannot calculate averages or medians across all platforms")
 	}
-	var bridgeFinalSQL *string
-	var swapFinalSQL *string
-	var messageBusFinalSQL *string
+	var bridgeFinalSQL *sql.Query
+	var swapFinalSQL *sql.Query
+	var messageBusFinalSQL *sql.Query
 	var err error
 	var bridgeSum float64
 	var swapSum float64
@@ -1515,30 +1434,21 @@ func (r *queryResolver) getDateResultByChainMv(ctx context.Context, chainID *int
 	firstFilter := true
 	timestampSpecifierMv := GetDurationFilter(duration, &firstFilter, "f")
 	chainIDSpecifierMv := generateSingleSpecifierI32SQL(chainID, sql.ChainIDFieldName, &firstFilter, "f")
-	compositeFiltersMv := fmt.Sprintf(
-		`%s%s`,
-		timestampSpecifierMv, chainIDSpecifierMv,
-	)
+	compositeFiltersMv := sql.Concat(timestampSpecifierMv, chainIDSpecifierMv)
 	firstFilter = true
 	timestampSpecifier := GetDurationFilter(duration, &firstFilter, "")
 	chainIDSpecifier := generateSingleSpecifierI32SQL(chainID, sql.ChainIDFieldName, &firstFilter, "")
-	compositeFilters := fmt.Sprintf(
-		`%s%s`,
-		timestampSpecifier, chainIDSpecifier,
-	)
+	compositeFilters := sql.Concat(timestampSpecifier, chainIDSpecifier)
 
 	var res []*model.DateResultByChain
-	var query *string
+	var query *sql.Query
 	g, groupCtx := errgroup.WithContext(ctx)
 	switch *platform {
 	case model.PlatformBridge:
 		// Change chainID filter to destination chainID as that's where fees are collected.
 		if *typeArg == model.DailyStatisticTypeFee {
 			chainIDSpecifierMv = generateSingleSpecifierI32SQL(chainID, sql.ChainIDFieldName, &firstFilter, "t")
-			compositeFiltersMv = fmt.Sprintf(
-				`%s%s`,
-				timestampSpecifierMv, chainIDSpecifierMv,
-			)
+			compositeFiltersMv = sql.Concat(timestampSpecifierMv, chainIDSpecifierMv)
 		}
 		query, err = GenerateDailyStatisticByChainBridgeSQLMv(typeArg, compositeFiltersMv)
 		if err != nil {
@@ -1571,7 +1481,7 @@ func (r *queryResolver) getDateResultByChainMv(ctx context.Context, chainID *int
 	if err != nil {
 		return nil, fmt.Errorf("could not get daily data by chain: %w", err)
 	}
-	err = r.Cache.CacheResponse(fmt.Sprintf("dailyStatisticsByChain, %s, %s, %s, %s", keyGenHandleNilInt(chainID), typeArg.String(), duration.String(), platform.String()), res)
+	err = r.Cache.CacheResponse(ctx, cache.DailyStatisticsByChainKey(chainID, typeArg, duration, platform), res)
 	if err != nil {
 		return nil, fmt.Errorf("error caching response, %w", err)
 	}
@@ -1579,17 +1489,17 @@ func (r *queryResolver) getDateResultByChainMv(ctx context.Context, chainID *int
 }
 
 // GenerateDailyStatisticByChainBridgeSQLMv generates sql for getting data for daily stats across the bridge platform.
-func GenerateDailyStatisticByChainBridgeSQLMv(typeArg *model.DailyStatisticType, compositeFilters string) (*string, error) {
-	var query string
+func GenerateDailyStatisticByChainBridgeSQLMv(typeArg *model.DailyStatisticType, compositeFilters sql.Query) (*sql.Query, error) {
+	var query sql.Query
 	switch *typeArg {
 	case model.DailyStatisticTypeVolume:
-		query = fmt.Sprintf("%s  sumKahan(famount_usd) AS sumTotal from (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date, chain_id) group by date order by date)  ", dailyStatisticGenericSinglePlatformMv, compositeFilters)
+		query = sql.Format("%s  sumKahan(famount_usd) AS sumTotal from (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date, chain_id) group by date order by date)  ", sql.Raw(dailyStatisticGenericSinglePlatformMv), compositeFilters)
 	case model.DailyStatisticTypeFee:
-		query = fmt.Sprintf("%s  sumKahan(tfee_amount_usd) AS sumTotal from (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date, chain_id) group by date order by date)  ", dailyStatisticGenericSinglePlatformMvFee, compositeFilters)
+		query = sql.Format("%s  sumKahan(tfee_amount_usd) AS sumTotal from (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date, chain_id) group by date order by date)  ", sql.Raw(dailyStatisticGenericSinglePlatformMvFee), compositeFilters)
 	case model.DailyStatisticTypeAddresses:
-		query = fmt.Sprintf("%s  uniq(fchain_id, fsender) AS sumTotal from (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date, chain_id) group by date order by date)  ", dailyStatisticGenericSinglePlatformMv, compositeFilters)
+		query = sql.Format("%s  uniq(fchain_id, fsender) AS sumTotal from (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date, chain_id) group by date order by date)  ", sql.Raw(dailyStatisticGenericSinglePlatformMv), compositeFilters)
 	case model.DailyStatisticTypeTransactions:
-		query = fmt.Sprintf("%s  uniq(fchain_id, ftx_hash) AS sumTotal from (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date, chain_id) group by date order by date) ", dailyStatisticGenericSinglePlatformMv, compositeFilters)
+		query = sql.Format("%s  uniq(fchain_id, ftx_hash) AS sumTotal from (select * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) group by date, chain_id) group by date order by date) ", sql.Raw(dailyStatisticGenericSinglePlatformMv), compositeFilters)
 	default:
 		return nil, fmt.Errorf("unsupported statistic type")
 	}
@@ -1597,17 +1507,17 @@ func GenerateDailyStatisticByChainBridgeSQLMv(typeArg *model.DailyStatisticType,
 }
 
 // GenerateDailyStatisticByChainAllSQLMv generates sql for getting daily stats across all chains.
-func GenerateDailyStatisticByChainAllSQLMv(typeArg *model.DailyStatisticType, compositeFilters string, compositeFiltersMv string) (*string, error) {
-	var query string
+func GenerateDailyStatisticByChainAllSQLMv(typeArg *model.DailyStatisticType, compositeFilters sql.Query, compositeFiltersMv sql.Query) (*sql.Query, error) {
+	var query sql.Query
 	switch *typeArg {
 	case model.DailyStatisticTypeVolume:
-		query = fmt.Sprintf("%s %s %s FULL OUTER JOIN (SELECT %s, chain_id, sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1,    arraySum(mapValues(amount_usd)), event_type = 9,    arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id],    0) )     as usdTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id) group by date order by date) SETTINGS join_use_nulls=1", dailyVolumeBridgeMvPt1, compositeFiltersMv, dailyVolumeBridgeMvPt2, toDateSelect, compositeFilters)
+		query = sql.Format("%s %s %s FULL OUTER JOIN (SELECT %s, chain_id, sumKahan(multiIf(event_type = 0, amount_usd[sold_id], event_type = 1,    arraySum(mapValues(amount_usd)), event_type = 9,    arraySum(mapValues(amount_usd)), event_type = 10, amount_usd[sold_id],    0) )     as usdTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id) group by date order by date) SETTINGS join_use_nulls=1", sql.Raw(dailyVolumeBridgeMvPt1), compositeFiltersMv, sql.Raw(dailyVolumeBridgeMvPt2), sql.Raw(toDateSelect), compositeFilters)
 	case model.DailyStatisticTypeFee: // destination chain fee used
-		query = fmt.Sprintf("%s FROM ( SELECT %s, tchain_id AS chain_id, sumKahan(tfee_amount_usd) as sumTotal FROM (SELECT * FROM mv_bridge_events %s LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) GROUP BY date, chain_id) b FULL OUTER JOIN ( SELECT %s, chain_id, sumKahan(arraySum(mapValues(fee_usd))) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, sumKahan(fee_usd) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", dailyStatisticGenericSelect, toDateSelectMv, compositeFiltersMv, toDateSelect, compositeFilters, toDateSelect, compositeFilters)
+		query = sql.Format("%s FROM ( SELECT %s, tchain_id AS chain_id, sumKahan(tfee_amount_usd) as sumTotal FROM (SELECT * FROM mv_bridge_events %s LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) GROUP BY date, chain_id) b FULL OUTER JOIN ( SELECT %s, chain_id, sumKahan(arraySum(mapValues(fee_usd))) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, sumKahan(fee_usd) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", sql.Raw(dailyStatisticGenericSelect), sql.Raw(toDateSelectMv), compositeFiltersMv, sql.Raw(toDateSelect), compositeFilters, sql.Raw(toDateSelect), compositeFilters)
 	case model.DailyStatisticTypeAddresses:
-		query = fmt.Sprintf("%s FROM ( SELECT %s, fchain_id AS chain_id, uniq(fchain_id, fsender) as sumTotal FROM (SELECT * FROM mv_bridge_events %s LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) GROUP BY date, chain_id) b FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, sender) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, source_address) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", dailyStatisticGenericSelect, toDateSelectMv, compositeFiltersMv, toDateSelect, compositeFilters, toDateSelect, compositeFilters)
+		query = sql.Format("%s FROM ( SELECT %s, fchain_id AS chain_id, uniq(fchain_id, fsender) as sumTotal FROM (SELECT * FROM mv_bridge_events %s LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) GROUP BY date, chain_id) b FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, sender) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, source_address) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", sql.Raw(dailyStatisticGenericSelect), sql.Raw(toDateSelectMv), compositeFiltersMv, sql.Raw(toDateSelect), compositeFilters, sql.Raw(toDateSelect), compositeFilters)
 	case model.DailyStatisticTypeTransactions:
-		query = fmt.Sprintf("%s FROM ( SELECT %s, fchain_id AS chain_id, uniq(fchain_id, ftx_hash) as sumTotal FROM (SELECT * FROM mv_bridge_events %s LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) GROUP BY date, chain_id) b FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", dailyStatisticGenericSelect, toDateSelectMv, compositeFiltersMv, toDateSelect, compositeFilters, toDateSelect, compositeFilters)
+		query = sql.Format("%s FROM ( SELECT %s, fchain_id AS chain_id, uniq(fchain_id, ftx_hash) as sumTotal FROM (SELECT * FROM mv_bridge_events %s LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) GROUP BY date, chain_id) b FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM swap_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) s ON b.date = s.date AND b.chain_id = s.chain_id  FULL OUTER JOIN ( SELECT %s, chain_id, uniq(chain_id, tx_hash) AS sumTotal FROM (SELECT * FROM message_bus_events %s LIMIT 1 BY chain_id, contract_address, event_type, block_number, event_index, tx_hash) group by date, chain_id ) m ON b.date = m.date AND b.chain_id = m.chain_id) group by date order by date ) SETTINGS join_use_nulls = 1", sql.Raw(dailyStatisticGenericSelect), sql.Raw(toDateSelectMv), compositeFiltersMv, sql.Raw(toDateSelect), compositeFilters, sql.Raw(toDateSelect), compositeFilters)
 	default:
 		return nil, fmt.Errorf("unsupported statistic type")
 	}
@@ -1633,7 +1543,7 @@ func UnsafeSetFallbackTime(ttf time.Duration) {
 // GetOriginBridgeTxBW gets an origin bridge tx.
 func (r *queryResolver) GetOriginBridgeTxBW(ctx context.Context, chainID int, txnHash string, eventType model.BridgeType) (*model.BridgeWatcherTx, error) {
 	txType := model.BridgeTxTypeOrigin
-	query := fmt.Sprintf("SELECT * FROM mv_bridge_events WHERE fchain_id = %d AND ftx_hash = '%s' ORDER BY insert_time desc LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash", chainID, txnHash)
+	query := sql.Format("SELECT * FROM mv_bridge_events WHERE fchain_id = %s AND ftx_hash = %s ORDER BY insert_time desc LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash", sql.Param(chainID), sql.Param(txnHash))
 
 	bwQueryCtx, cancel := context.WithTimeout(ctx, timeToFallback)
 	defer cancel()
@@ -1658,7 +1568,7 @@ func (r *queryResolver) GetDestinationBridgeTxBW(ctx context.Context, chainID in
 	bwQueryCtx, cancel := context.WithTimeout(ctx, timeToFallback)
 	defer cancel()
 
-	query := fmt.Sprintf("SELECT * FROM mv_bridge_events WHERE tchain_id = %d AND tkappa = '%s' ORDER BY insert_time desc LIMIT 1 BY tchain_id, tcontract_address, tevent_type, tblock_number, tevent_index, ttx_hash", chainID, kappa)
+	query := sql.Format("SELECT * FROM mv_bridge_events WHERE tchain_id = %s AND tkappa = %s ORDER BY insert_time desc LIMIT 1 BY tchain_id, tcontract_address, tevent_type, tblock_number, tevent_index, ttx_hash", sql.Param(chainID), sql.Param(kappa))
 	bridgeEventMV, err := r.DB.GetMVBridgeEvent(bwQueryCtx, query)
 
 	var bridgeTx model.PartialInfo
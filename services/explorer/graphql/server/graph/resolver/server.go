@@ -7,6 +7,7 @@
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -37,6 +38,7 @@ type Config struct {
 
 type ResolverRoot interface {
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -94,6 +96,16 @@ type ComplexityRoot struct {
 		Type        func(childComplexity int) int
 	}
 
+	ChainFlow struct {
+		ChainIDFrom             func(childComplexity int) int
+		ChainIDTo               func(childComplexity int) int
+		Count                   func(childComplexity int) int
+		MedianCompletionSeconds func(childComplexity int) int
+		P95CompletionSeconds    func(childComplexity int) int
+		TokenSymbol             func(childComplexity int) int
+		VolumeUsd               func(childComplexity int) int
+	}
+
 	DateResult struct {
 		Date  func(childComplexity int) int
 		Total func(childComplexity int) int
@@ -125,6 +137,19 @@ type ComplexityRoot struct {
 		Total     func(childComplexity int) int
 	}
 
+	FeeStatistic struct {
+		ChainID         func(childComplexity int) int
+		ContractAddress func(childComplexity int) int
+		Count           func(childComplexity int) int
+		Date            func(childComplexity int) int
+		FeeUsd          func(childComplexity int) int
+		Platform        func(childComplexity int) int
+		ProtocolFeeUsd  func(childComplexity int) int
+		Token           func(childComplexity int) int
+		TokenSymbol     func(childComplexity int) int
+		VolumeUsd       func(childComplexity int) int
+	}
+
 	HeroType struct {
 		HeroID    func(childComplexity int) int
 		Recipient func(childComplexity int) int
@@ -184,6 +209,15 @@ type ComplexityRoot struct {
 		TxnHash              func(childComplexity int) int
 	}
 
+	PendingTransfer struct {
+		AgeSeconds  func(childComplexity int) int
+		BridgeType  func(childComplexity int) int
+		FromInfo    func(childComplexity int) int
+		Kappa       func(childComplexity int) int
+		LikelyCause func(childComplexity int) int
+		SLASeconds  func(childComplexity int) int
+	}
+
 	PetType struct {
 		Name      func(childComplexity int) int
 		PetID     func(childComplexity int) int
@@ -195,15 +229,33 @@ type ComplexityRoot struct {
 		AddressRanking         func(childComplexity int, hours *int) int
 		AmountStatistic        func(childComplexity int, typeArg model.StatisticType, duration *model.Duration, platform *model.Platform, chainID *int, address *string, tokenAddress *string, useCache *bool, useMv *bool) int
 		BridgeTransactions     func(childComplexity int, chainIDFrom []*int, chainIDTo []*int, addressFrom *string, addressTo *string, maxAmount *int, minAmount *int, maxAmountUsd *int, minAmountUsd *int, startTime *int, endTime *int, txnHash *string, kappa *string, pending *bool, useMv *bool, page *int, tokenAddressFrom []*string, tokenAddressTo []*string, onlyCctp *bool) int
+		ChainFlows             func(childComplexity int, chainIDFrom *int, chainIDTo *int, tokenSymbol *string, byToken *bool, duration *model.Duration) int
 		CountByChainID         func(childComplexity int, chainID *int, address *string, direction *model.Direction, hours *int) int
 		CountByTokenAddress    func(childComplexity int, chainID *int, address *string, direction *model.Direction, hours *int) int
 		DailyStatisticsByChain func(childComplexity int, chainID *int, typeArg *model.DailyStatisticType, platform *model.Platform, duration *model.Duration, useCache *bool, useMv *bool) int
+		FeeStatistics          func(childComplexity int, chainID *int, platform *model.FeePlatform, duration *model.Duration) int
 		GetBlockHeight         func(childComplexity int, contracts []*model.ContractQuery) int
 		GetDestinationBridgeTx func(childComplexity int, chainID int, address string, kappa string, timestamp int, bridgeType model.BridgeType, historical *bool) int
 		GetOriginBridgeTx      func(childComplexity int, chainID int, txnHash string, bridgeType model.BridgeType) int
 		Leaderboard            func(childComplexity int, duration *model.Duration, chainID *int, useMv *bool, page *int) int
 		MessageBusTransactions func(childComplexity int, chainID []*int, contractAddress *string, startTime *int, endTime *int, txnHash *string, messageID *string, pending *bool, reverted *bool, page *int) int
+		PendingTransfers       func(childComplexity int, chainIDFrom *int, chainIDTo *int, bridgeType *model.BridgeType, page *int) int
 		RankedChainIDsByVolume func(childComplexity int, duration *model.Duration, useCache *bool) int
+		RelayerStatistics      func(childComplexity int, chainID *int, relayer *string, duration *model.Duration) int
+	}
+
+	RelayerStatistic struct {
+		ChainID    func(childComplexity int) int
+		Date       func(childComplexity int) int
+		FillShare  func(childComplexity int) int
+		Fills      func(childComplexity int) int
+		Relayer    func(childComplexity int) int
+		RevenueUsd func(childComplexity int) int
+		VolumeUsd  func(childComplexity int) int
+	}
+
+	Subscription struct {
+		TransferEvents func(childComplexity int, address *string, kappa *string) int
 	}
 
 	TearType struct {
@@ -222,6 +274,12 @@ type ComplexityRoot struct {
 		Count   func(childComplexity int) int
 	}
 
+	TransferEvent struct {
+		BridgeTx func(childComplexity int) int
+		Kappa    func(childComplexity int) int
+		Type     func(childComplexity int) int
+	}
+
 	UnknownType struct {
 		Known func(childComplexity int) int
 	}
@@ -250,6 +308,13 @@ type QueryResolver interface {
 	GetOriginBridgeTx(ctx context.Context, chainID int, txnHash string, bridgeType model.BridgeType) (*model.BridgeWatcherTx, error)
 	GetDestinationBridgeTx(ctx context.Context, chainID int, address string, kappa string, timestamp int, bridgeType model.BridgeType, historical *bool) (*model.BridgeWatcherTx, error)
 	GetBlockHeight(ctx context.Context, contracts []*model.ContractQuery) ([]*model.BlockHeight, error)
+	PendingTransfers(ctx context.Context, chainIDFrom *int, chainIDTo *int, bridgeType *model.BridgeType, page *int) ([]*model.PendingTransfer, error)
+	FeeStatistics(ctx context.Context, chainID *int, platform *model.FeePlatform, duration *model.Duration) ([]*model.FeeStatistic, error)
+	RelayerStatistics(ctx context.Context, chainID *int, relayer *string, duration *model.Duration) ([]*model.RelayerStatistic, error)
+	ChainFlows(ctx context.Context, chainIDFrom *int, chainIDTo *int, tokenSymbol *string, byToken *bool, duration *model.Duration) ([]*model.ChainFlow, error)
+}
+type SubscriptionResolver interface {
+	TransferEvents(ctx context.Context, address *string, kappa *string) (<-chan *model.TransferEvent, error)
 }
 
 type executableSchema struct {
@@ -477,6 +542,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.BridgeWatcherTx.Type(childComplexity), true
 
+	case "ChainFlow.chainIDFrom":
+		if e.complexity.ChainFlow.ChainIDFrom == nil {
+			break
+		}
+
+		return e.complexity.ChainFlow.ChainIDFrom(childComplexity), true
+
+	case "ChainFlow.chainIDTo":
+		if e.complexity.ChainFlow.ChainIDTo == nil {
+			break
+		}
+
+		return e.complexity.ChainFlow.ChainIDTo(childComplexity), true
+
+	case "ChainFlow.count":
+		if e.complexity.ChainFlow.Count == nil {
+			break
+		}
+
+		return e.complexity.ChainFlow.Count(childComplexity), true
+
+	case "ChainFlow.medianCompletionSeconds":
+		if e.complexity.ChainFlow.MedianCompletionSeconds == nil {
+			break
+		}
+
+		return e.complexity.ChainFlow.MedianCompletionSeconds(childComplexity), true
+
+	case "ChainFlow.p95CompletionSeconds":
+		if e.complexity.ChainFlow.P95CompletionSeconds == nil {
+			break
+		}
+
+		return e.complexity.ChainFlow.P95CompletionSeconds(childComplexity), true
+
+	case "ChainFlow.tokenSymbol":
+		if e.complexity.ChainFlow.TokenSymbol == nil {
+			break
+		}
+
+		return e.complexity.ChainFlow.TokenSymbol(childComplexity), true
+
+	case "ChainFlow.volumeUsd":
+		if e.complexity.ChainFlow.VolumeUsd == nil {
+			break
+		}
+
+		return e.complexity.ChainFlow.VolumeUsd(childComplexity), true
+
 	case "DateResult.date":
 		if e.complexity.DateResult.Date == nil {
 			break
@@ -652,6 +766,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DateResultByChain.Total(childComplexity), true
 
+	case "FeeStatistic.chainID":
+		if e.complexity.FeeStatistic.ChainID == nil {
+			break
+		}
+
+		return e.complexity.FeeStatistic.ChainID(childComplexity), true
+
+	case "FeeStatistic.contractAddress":
+		if e.complexity.FeeStatistic.ContractAddress == nil {
+			break
+		}
+
+		return e.complexity.FeeStatistic.ContractAddress(childComplexity), true
+
+	case "FeeStatistic.count":
+		if e.complexity.FeeStatistic.Count == nil {
+			break
+		}
+
+		return e.complexity.FeeStatistic.Count(childComplexity), true
+
+	case "FeeStatistic.date":
+		if e.complexity.FeeStatistic.Date == nil {
+			break
+		}
+
+		return e.complexity.FeeStatistic.Date(childComplexity), true
+
+	case "FeeStatistic.feeUsd":
+		if e.complexity.FeeStatistic.FeeUsd == nil {
+			break
+		}
+
+		return e.complexity.FeeStatistic.FeeUsd(childComplexity), true
+
+	case "FeeStatistic.platform":
+		if e.complexity.FeeStatistic.Platform == nil {
+			break
+		}
+
+		return e.complexity.FeeStatistic.Platform(childComplexity), true
+
+	case "FeeStatistic.protocolFeeUsd":
+		if e.complexity.FeeStatistic.ProtocolFeeUsd == nil {
+			break
+		}
+
+		return e.complexity.FeeStatistic.ProtocolFeeUsd(childComplexity), true
+
+	case "FeeStatistic.token":
+		if e.complexity.FeeStatistic.Token == nil {
+			break
+		}
+
+		return e.complexity.FeeStatistic.Token(childComplexity), true
+
+	case "FeeStatistic.tokenSymbol":
+		if e.complexity.FeeStatistic.TokenSymbol == nil {
+			break
+		}
+
+		return e.complexity.FeeStatistic.TokenSymbol(childComplexity), true
+
+	case "FeeStatistic.volumeUsd":
+		if e.complexity.FeeStatistic.VolumeUsd == nil {
+			break
+		}
+
+		return e.complexity.FeeStatistic.VolumeUsd(childComplexity), true
+
 	case "HeroType.heroID":
 		if e.complexity.HeroType.HeroID == nil {
 			break
@@ -939,6 +1123,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PartialMessageBusInfo.TxnHash(childComplexity), true
 
+	case "PendingTransfer.ageSeconds":
+		if e.complexity.PendingTransfer.AgeSeconds == nil {
+			break
+		}
+
+		return e.complexity.PendingTransfer.AgeSeconds(childComplexity), true
+
+	case "PendingTransfer.bridgeType":
+		if e.complexity.PendingTransfer.BridgeType == nil {
+			break
+		}
+
+		return e.complexity.PendingTransfer.BridgeType(childComplexity), true
+
+	case "PendingTransfer.fromInfo":
+		if e.complexity.PendingTransfer.FromInfo == nil {
+			break
+		}
+
+		return e.complexity.PendingTransfer.FromInfo(childComplexity), true
+
+	case "PendingTransfer.kappa":
+		if e.complexity.PendingTransfer.Kappa == nil {
+			break
+		}
+
+		return e.complexity.PendingTransfer.Kappa(childComplexity), true
+
+	case "PendingTransfer.likelyCause":
+		if e.complexity.PendingTransfer.LikelyCause == nil {
+			break
+		}
+
+		return e.complexity.PendingTransfer.LikelyCause(childComplexity), true
+
+	case "PendingTransfer.slaSeconds":
+		if e.complexity.PendingTransfer.SLASeconds == nil {
+			break
+		}
+
+		return e.complexity.PendingTransfer.SLASeconds(childComplexity), true
+
 	case "PetType.name":
 		if e.complexity.PetType.Name == nil {
 			break
@@ -1008,6 +1234,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.BridgeTransactions(childComplexity, args["chainIDFrom"].([]*int), args["chainIDTo"].([]*int), args["addressFrom"].(*string), args["addressTo"].(*string), args["maxAmount"].(*int), args["minAmount"].(*int), args["maxAmountUsd"].(*int), args["minAmountUsd"].(*int), args["startTime"].(*int), args["endTime"].(*int), args["txnHash"].(*string), args["kappa"].(*string), args["pending"].(*bool), args["useMv"].(*bool), args["page"].(*int), args["tokenAddressFrom"].([]*string), args["tokenAddressTo"].([]*string), args["onlyCCTP"].(*bool)), true
 
+	case "Query.chainFlows":
+		if e.complexity.Query.ChainFlows == nil {
+			break
+		}
+
+		args, err := ec.field_Query_chainFlows_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ChainFlows(childComplexity, args["chainIDFrom"].(*int), args["chainIDTo"].(*int), args["tokenSymbol"].(*string), args["byToken"].(*bool), args["duration"].(*model.Duration)), true
+
 	case "Query.countByChainId":
 		if e.complexity.Query.CountByChainID == nil {
 			break
@@ -1044,6 +1282,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.DailyStatisticsByChain(childComplexity, args["chainID"].(*int), args["type"].(*model.DailyStatisticType), args["platform"].(*model.Platform), args["duration"].(*model.Duration), args["useCache"].(*bool), args["useMv"].(*bool)), true
 
+	case "Query.feeStatistics":
+		if e.complexity.Query.FeeStatistics == nil {
+			break
+		}
+
+		args, err := ec.field_Query_feeStatistics_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FeeStatistics(childComplexity, args["chainID"].(*int), args["platform"].(*model.FeePlatform), args["duration"].(*model.Duration)), true
+
 	case "Query.getBlockHeight":
 		if e.complexity.Query.GetBlockHeight == nil {
 			break
@@ -1104,6 +1354,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.MessageBusTransactions(childComplexity, args["chainID"].([]*int), args["contractAddress"].(*string), args["startTime"].(*int), args["endTime"].(*int), args["txnHash"].(*string), args["messageID"].(*string), args["pending"].(*bool), args["reverted"].(*bool), args["page"].(*int)), true
 
+	case "Query.pendingTransfers":
+		if e.complexity.Query.PendingTransfers == nil {
+			break
+		}
+
+		args, err := ec.field_Query_pendingTransfers_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PendingTransfers(childComplexity, args["chainIDFrom"].(*int), args["chainIDTo"].(*int), args["bridgeType"].(*model.BridgeType), args["page"].(*int)), true
+
 	case "Query.rankedChainIDsByVolume":
 		if e.complexity.Query.RankedChainIDsByVolume == nil {
 			break
@@ -1116,6 +1378,79 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.RankedChainIDsByVolume(childComplexity, args["duration"].(*model.Duration), args["useCache"].(*bool)), true
 
+	case "Query.relayerStatistics":
+		if e.complexity.Query.RelayerStatistics == nil {
+			break
+		}
+
+		args, err := ec.field_Query_relayerStatistics_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RelayerStatistics(childComplexity, args["chainID"].(*int), args["relayer"].(*string), args["duration"].(*model.Duration)), true
+
+	case "RelayerStatistic.chainID":
+		if e.complexity.RelayerStatistic.ChainID == nil {
+			break
+		}
+
+		return e.complexity.RelayerStatistic.ChainID(childComplexity), true
+
+	case "RelayerStatistic.date":
+		if e.complexity.RelayerStatistic.Date == nil {
+			break
+		}
+
+		return e.complexity.RelayerStatistic.Date(childComplexity), true
+
+	case "RelayerStatistic.fillShare":
+		if e.complexity.RelayerStatistic.FillShare == nil {
+			break
+		}
+
+		return e.complexity.RelayerStatistic.FillShare(childComplexity), true
+
+	case "RelayerStatistic.fills":
+		if e.complexity.RelayerStatistic.Fills == nil {
+			break
+		}
+
+		return e.complexity.RelayerStatistic.Fills(childComplexity), true
+
+	case "RelayerStatistic.relayer":
+		if e.complexity.RelayerStatistic.Relayer == nil {
+			break
+		}
+
+		return e.complexity.RelayerStatistic.Relayer(childComplexity), true
+
+	case "RelayerStatistic.revenueUsd":
+		if e.complexity.RelayerStatistic.RevenueUsd == nil {
+			break
+		}
+
+		return e.complexity.RelayerStatistic.RevenueUsd(childComplexity), true
+
+	case "RelayerStatistic.volumeUsd":
+		if e.complexity.RelayerStatistic.VolumeUsd == nil {
+			break
+		}
+
+		return e.complexity.RelayerStatistic.VolumeUsd(childComplexity), true
+
+	case "Subscription.transferEvents":
+		if e.complexity.Subscription.TransferEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_transferEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.TransferEvents(childComplexity, args["address"].(*string), args["kappa"].(*string)), true
+
 	case "TearType.amount":
 		if e.complexity.TearType.Amount == nil {
 			break
@@ -1165,6 +1500,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TransactionCountResult.Count(childComplexity), true
 
+	case "TransferEvent.bridgeTx":
+		if e.complexity.TransferEvent.BridgeTx == nil {
+			break
+		}
+
+		return e.complexity.TransferEvent.BridgeTx(childComplexity), true
+
+	case "TransferEvent.kappa":
+		if e.complexity.TransferEvent.Kappa == nil {
+			break
+		}
+
+		return e.complexity.TransferEvent.Kappa(childComplexity), true
+
+	case "TransferEvent.type":
+		if e.complexity.TransferEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.TransferEvent.Type(childComplexity), true
+
 	case "UnknownType.known":
 		if e.complexity.UnknownType.Known == nil {
 			break
@@ -1236,6 +1592,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 
 			return &response
 		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
 
 	default:
 		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
@@ -1470,9 +1843,63 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
   getBlockHeight(
     contracts: [ContractQuery]
   ): [BlockHeight]
-}
 
 
+  """
+  Returns origin bridge, CCTP and RFQ transactions that have no destination transaction after the sla of their chain pair.
+  """
+  pendingTransfers(
+    chainIDFrom:  Int
+    chainIDTo:    Int
+    bridgeType:   BridgeType
+    page:         Int = 1
+  ): [PendingTransfer]
+
+
+  """
+  Returns the fees charged by bridge, CCTP, RFQ and swap contracts per day, chain, platform, contract and token.
+  Specifying no duration defaults to the last 30 days.
+  """
+  feeStatistics(
+    chainID:    Int
+    platform:   FeePlatform
+    duration:   Duration = PAST_MONTH
+  ): [FeeStatistic]
+
+  """
+  Returns the fills, fill share and revenue of RFQ relayers per day and destination chain.
+  Specifying no duration defaults to the last 30 days.
+  """
+  relayerStatistics(
+    chainID:    Int
+    relayer:    String
+    duration:   Duration = PAST_MONTH
+  ): [RelayerStatistic]
+
+  """
+  Returns the flow of completed transfers from each origin chain to each destination chain: the number of transfers,
+  their volume and their median and 95th percentile completion time. Flows are split by token if byToken is set.
+  Specifying no duration defaults to the last 30 days.
+  """
+  chainFlows(
+    chainIDFrom:  Int
+    chainIDTo:    Int
+    tokenSymbol:  String
+    byToken:      Boolean = false
+    duration:     Duration = PAST_MONTH
+  ): [ChainFlow]
+}
+`, BuiltIn: false},
+	{Name: "../schema/subscriptions.graphql", Input: `type Subscription {
+  """
+  Pushes the origin, destination and refund events of the transfers of an address, or of the transfer with a kappa
+  (the transaction id of RFQ transfers), as soon as they are indexed. At least one of address and kappa is required.
+  """
+  transferEvents(
+    address:  String
+    kappa:    String
+  ): TransferEvent!
+}
 `, BuiltIn: false},
 	{Name: "../schema/types.graphql", Input: `"""
 BridgeTransaction represents an entire bridge transaction, including both
@@ -1721,7 +2148,101 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
   blockNumber: Int
 }
 
+"""
+PendingTransfer is an origin transaction that has no destination transaction after the sla of its chain pair.
+"""
+type PendingTransfer {
+  fromInfo:     PartialInfo
+  kappa:        String
+  bridgeType:   BridgeType
+  ageSeconds:   Int
+  slaSeconds:   Int
+  likelyCause:  StuckTransferCause
+}
+
+"""
+StuckTransferCause is the most likely reason a transfer has not been completed.
+"""
+enum StuckTransferCause {
+  DESTINATION_NOT_INDEXED
+  DESTINATION_INDEXER_LAG
+  AWAITING_VALIDATORS
+  AWAITING_ATTESTATION
+  AWAITING_RELAYER
+}
+
+"""
+FeePlatform is the platform that charged a fee.
+"""
+enum FeePlatform {
+  BRIDGE
+  CCTP
+  RFQ
+  SWAP
+}
+
+"""
+FeeStatistic is the fees charged by a contract in a token on a day.
+"""
+type FeeStatistic {
+  date:             String
+  chainID:          Int
+  platform:         FeePlatform
+  contractAddress:  String
+  token:            String
+  tokenSymbol:      String
+  count:            Int
+  volumeUsd:        Float
+  feeUsd:           Float
+  protocolFeeUsd:   Float
+}
+
+"""
+RelayerStatistic is the RFQ fills of a relayer on a destination chain on a day. fillShare is the share of the day's
+fills on the chain made by the relayer.
+"""
+type RelayerStatistic {
+  date:         String
+  chainID:      Int
+  relayer:      String
+  fills:        Int
+  fillShare:    Float
+  volumeUsd:    Float
+  revenueUsd:   Float
+}
 
+"""
+ChainFlow is the flow of completed transfers from an origin to a destination chain. tokenSymbol is only set when flows
+are split by token. Completion times are in seconds, from the origin to the destination block.
+"""
+type ChainFlow {
+  chainIDFrom:                Int
+  chainIDTo:                  Int
+  tokenSymbol:                String
+  count:                      Int
+  volumeUsd:                  Float
+  medianCompletionSeconds:    Float
+  p95CompletionSeconds:       Float
+}
+
+
+"""
+TransferEvent is an origin, destination or refund event of a transfer, pushed by the transferEvents subscription.
+"""
+type TransferEvent {
+  type:     TransferEventType
+  kappa:    String
+  bridgeTx: PartialInfo
+}
+
+"""
+TransferEventType is the step of a transfer an event completes.
+"""
+enum TransferEventType {
+  ORIGIN_SEEN
+  DESTINATION_COMPLETED
+  REFUNDED
+}
 `, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
@@ -2021,12 +2542,63 @@ func (ec *executionContext) field_Query_bridgeTransactions_args(ctx context.Cont
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_countByChainId_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_chainFlows_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 *int
-	if tmp, ok := rawArgs["chainID"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chainID"))
+	if tmp, ok := rawArgs["chainIDFrom"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chainIDFrom"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["chainIDFrom"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["chainIDTo"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chainIDTo"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["chainIDTo"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["tokenSymbol"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tokenSymbol"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tokenSymbol"] = arg2
+	var arg3 *bool
+	if tmp, ok := rawArgs["byToken"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("byToken"))
+		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["byToken"] = arg3
+	var arg4 *model.Duration
+	if tmp, ok := rawArgs["duration"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("duration"))
+		arg4, err = ec.unmarshalODuration2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDuration(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["duration"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_countByChainId_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["chainID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chainID"))
 		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
@@ -2165,6 +2737,39 @@ func (ec *executionContext) field_Query_dailyStatisticsByChain_args(ctx context.
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_feeStatistics_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["chainID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chainID"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["chainID"] = arg0
+	var arg1 *model.FeePlatform
+	if tmp, ok := rawArgs["platform"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("platform"))
+		arg1, err = ec.unmarshalOFeePlatform2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐFeePlatform(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["platform"] = arg1
+	var arg2 *model.Duration
+	if tmp, ok := rawArgs["duration"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("duration"))
+		arg2, err = ec.unmarshalODuration2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDuration(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["duration"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_getBlockHeight_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2402,6 +3007,48 @@ func (ec *executionContext) field_Query_messageBusTransactions_args(ctx context.
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_pendingTransfers_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["chainIDFrom"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chainIDFrom"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["chainIDFrom"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["chainIDTo"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chainIDTo"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["chainIDTo"] = arg1
+	var arg2 *model.BridgeType
+	if tmp, ok := rawArgs["bridgeType"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bridgeType"))
+		arg2, err = ec.unmarshalOBridgeType2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeType(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["bridgeType"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["page"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["page"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_rankedChainIDsByVolume_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2426,6 +3073,63 @@ func (ec *executionContext) field_Query_rankedChainIDsByVolume_args(ctx context.
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_relayerStatistics_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["chainID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chainID"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["chainID"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["relayer"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("relayer"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["relayer"] = arg1
+	var arg2 *model.Duration
+	if tmp, ok := rawArgs["duration"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("duration"))
+		arg2, err = ec.unmarshalODuration2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDuration(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["duration"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_transferEvents_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["address"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["address"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["kappa"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kappa"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["kappa"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3798,8 +4502,8 @@ func (ec *executionContext) fieldContext_BridgeWatcherTx_kappaStatus(ctx context
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResult_date(ctx context.Context, field graphql.CollectedField, obj *model.DateResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResult_date(ctx, field)
+func (ec *executionContext) _ChainFlow_chainIDFrom(ctx context.Context, field graphql.CollectedField, obj *model.ChainFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChainFlow_chainIDFrom(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3812,7 +4516,7 @@ func (ec *executionContext) _DateResult_date(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Date, nil
+		return obj.ChainIDFrom, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3821,26 +4525,26 @@ func (ec *executionContext) _DateResult_date(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResult_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChainFlow_chainIDFrom(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DateResult",
+		Object:     "ChainFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResult_total(ctx context.Context, field graphql.CollectedField, obj *model.DateResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResult_total(ctx, field)
+func (ec *executionContext) _ChainFlow_chainIDTo(ctx context.Context, field graphql.CollectedField, obj *model.ChainFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChainFlow_chainIDTo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3853,7 +4557,7 @@ func (ec *executionContext) _DateResult_total(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Total, nil
+		return obj.ChainIDTo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3862,26 +4566,26 @@ func (ec *executionContext) _DateResult_total(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResult_total(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChainFlow_chainIDTo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DateResult",
+		Object:     "ChainFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_date(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_date(ctx, field)
+func (ec *executionContext) _ChainFlow_tokenSymbol(ctx context.Context, field graphql.CollectedField, obj *model.ChainFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChainFlow_tokenSymbol(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3894,7 +4598,7 @@ func (ec *executionContext) _DateResultByChain_date(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Date, nil
+		return obj.TokenSymbol, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3908,9 +4612,9 @@ func (ec *executionContext) _DateResultByChain_date(ctx context.Context, field g
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChainFlow_tokenSymbol(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DateResultByChain",
+		Object:     "ChainFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3921,8 +4625,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_date(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_ethereum(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_ethereum(ctx, field)
+func (ec *executionContext) _ChainFlow_count(ctx context.Context, field graphql.CollectedField, obj *model.ChainFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChainFlow_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3935,7 +4639,7 @@ func (ec *executionContext) _DateResultByChain_ethereum(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ethereum, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3944,26 +4648,26 @@ func (ec *executionContext) _DateResultByChain_ethereum(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_ethereum(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChainFlow_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DateResultByChain",
+		Object:     "ChainFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_optimism(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_optimism(ctx, field)
+func (ec *executionContext) _ChainFlow_volumeUsd(ctx context.Context, field graphql.CollectedField, obj *model.ChainFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChainFlow_volumeUsd(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3976,7 +4680,7 @@ func (ec *executionContext) _DateResultByChain_optimism(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Optimism, nil
+		return obj.VolumeUsd, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3990,9 +4694,9 @@ func (ec *executionContext) _DateResultByChain_optimism(ctx context.Context, fie
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_optimism(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChainFlow_volumeUsd(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DateResultByChain",
+		Object:     "ChainFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4003,8 +4707,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_optimism(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_cronos(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_cronos(ctx, field)
+func (ec *executionContext) _ChainFlow_medianCompletionSeconds(ctx context.Context, field graphql.CollectedField, obj *model.ChainFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChainFlow_medianCompletionSeconds(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4017,7 +4721,7 @@ func (ec *executionContext) _DateResultByChain_cronos(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cronos, nil
+		return obj.MedianCompletionSeconds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4031,9 +4735,9 @@ func (ec *executionContext) _DateResultByChain_cronos(ctx context.Context, field
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_cronos(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChainFlow_medianCompletionSeconds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DateResultByChain",
+		Object:     "ChainFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4044,8 +4748,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_cronos(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_bsc(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_bsc(ctx, field)
+func (ec *executionContext) _ChainFlow_p95CompletionSeconds(ctx context.Context, field graphql.CollectedField, obj *model.ChainFlow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ChainFlow_p95CompletionSeconds(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4058,7 +4762,7 @@ func (ec *executionContext) _DateResultByChain_bsc(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Bsc, nil
+		return obj.P95CompletionSeconds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4072,9 +4776,9 @@ func (ec *executionContext) _DateResultByChain_bsc(ctx context.Context, field gr
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_bsc(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChainFlow_p95CompletionSeconds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DateResultByChain",
+		Object:     "ChainFlow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4085,8 +4789,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_bsc(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_polygon(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_polygon(ctx, field)
+func (ec *executionContext) _DateResult_date(ctx context.Context, field graphql.CollectedField, obj *model.DateResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResult_date(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4099,7 +4803,7 @@ func (ec *executionContext) _DateResultByChain_polygon(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Polygon, nil
+		return obj.Date, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4108,26 +4812,26 @@ func (ec *executionContext) _DateResultByChain_polygon(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_polygon(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResult_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DateResultByChain",
+		Object:     "DateResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_fantom(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_fantom(ctx, field)
+func (ec *executionContext) _DateResult_total(ctx context.Context, field graphql.CollectedField, obj *model.DateResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResult_total(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4140,7 +4844,7 @@ func (ec *executionContext) _DateResultByChain_fantom(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fantom, nil
+		return obj.Total, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4154,9 +4858,9 @@ func (ec *executionContext) _DateResultByChain_fantom(ctx context.Context, field
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_fantom(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResult_total(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DateResultByChain",
+		Object:     "DateResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4167,8 +4871,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_fantom(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_boba(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_boba(ctx, field)
+func (ec *executionContext) _DateResultByChain_date(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_date(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4181,7 +4885,7 @@ func (ec *executionContext) _DateResultByChain_boba(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Boba, nil
+		return obj.Date, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4190,26 +4894,26 @@ func (ec *executionContext) _DateResultByChain_boba(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_boba(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_metis(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_metis(ctx, field)
+func (ec *executionContext) _DateResultByChain_ethereum(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_ethereum(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4222,7 +4926,7 @@ func (ec *executionContext) _DateResultByChain_metis(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Metis, nil
+		return obj.Ethereum, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4236,7 +4940,7 @@ func (ec *executionContext) _DateResultByChain_metis(ctx context.Context, field
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_metis(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_ethereum(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4249,8 +4953,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_metis(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_moonbeam(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_moonbeam(ctx, field)
+func (ec *executionContext) _DateResultByChain_optimism(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_optimism(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4263,7 +4967,7 @@ func (ec *executionContext) _DateResultByChain_moonbeam(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Moonbeam, nil
+		return obj.Optimism, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4277,7 +4981,7 @@ func (ec *executionContext) _DateResultByChain_moonbeam(ctx context.Context, fie
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_moonbeam(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_optimism(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4290,8 +4994,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_moonbeam(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_moonriver(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_moonriver(ctx, field)
+func (ec *executionContext) _DateResultByChain_cronos(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_cronos(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4304,7 +5008,7 @@ func (ec *executionContext) _DateResultByChain_moonriver(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Moonriver, nil
+		return obj.Cronos, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4318,7 +5022,7 @@ func (ec *executionContext) _DateResultByChain_moonriver(ctx context.Context, fi
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_moonriver(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_cronos(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4331,8 +5035,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_moonriver(ctx context
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_klaytn(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_klaytn(ctx, field)
+func (ec *executionContext) _DateResultByChain_bsc(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_bsc(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4345,7 +5049,7 @@ func (ec *executionContext) _DateResultByChain_klaytn(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Klaytn, nil
+		return obj.Bsc, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4359,7 +5063,7 @@ func (ec *executionContext) _DateResultByChain_klaytn(ctx context.Context, field
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_klaytn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_bsc(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4372,8 +5076,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_klaytn(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_arbitrum(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_arbitrum(ctx, field)
+func (ec *executionContext) _DateResultByChain_polygon(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_polygon(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4386,7 +5090,7 @@ func (ec *executionContext) _DateResultByChain_arbitrum(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Arbitrum, nil
+		return obj.Polygon, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4400,7 +5104,7 @@ func (ec *executionContext) _DateResultByChain_arbitrum(ctx context.Context, fie
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_arbitrum(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_polygon(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4413,8 +5117,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_arbitrum(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_avalanche(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_avalanche(ctx, field)
+func (ec *executionContext) _DateResultByChain_fantom(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_fantom(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4427,7 +5131,7 @@ func (ec *executionContext) _DateResultByChain_avalanche(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Avalanche, nil
+		return obj.Fantom, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4441,7 +5145,7 @@ func (ec *executionContext) _DateResultByChain_avalanche(ctx context.Context, fi
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_avalanche(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_fantom(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4454,8 +5158,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_avalanche(ctx context
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_dfk(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_dfk(ctx, field)
+func (ec *executionContext) _DateResultByChain_boba(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_boba(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4468,7 +5172,7 @@ func (ec *executionContext) _DateResultByChain_dfk(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Dfk, nil
+		return obj.Boba, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4482,7 +5186,7 @@ func (ec *executionContext) _DateResultByChain_dfk(ctx context.Context, field gr
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_dfk(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_boba(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4495,8 +5199,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_dfk(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_aurora(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_aurora(ctx, field)
+func (ec *executionContext) _DateResultByChain_metis(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_metis(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4509,7 +5213,7 @@ func (ec *executionContext) _DateResultByChain_aurora(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Aurora, nil
+		return obj.Metis, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4523,7 +5227,7 @@ func (ec *executionContext) _DateResultByChain_aurora(ctx context.Context, field
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_aurora(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_metis(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4536,8 +5240,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_aurora(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_harmony(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_harmony(ctx, field)
+func (ec *executionContext) _DateResultByChain_moonbeam(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_moonbeam(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4550,7 +5254,7 @@ func (ec *executionContext) _DateResultByChain_harmony(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Harmony, nil
+		return obj.Moonbeam, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4564,7 +5268,7 @@ func (ec *executionContext) _DateResultByChain_harmony(ctx context.Context, fiel
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_harmony(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_moonbeam(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4577,8 +5281,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_harmony(ctx context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_canto(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_canto(ctx, field)
+func (ec *executionContext) _DateResultByChain_moonriver(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_moonriver(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4591,7 +5295,7 @@ func (ec *executionContext) _DateResultByChain_canto(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Canto, nil
+		return obj.Moonriver, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4605,7 +5309,7 @@ func (ec *executionContext) _DateResultByChain_canto(ctx context.Context, field
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_canto(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_moonriver(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4618,8 +5322,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_canto(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_dogechain(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_dogechain(ctx, field)
+func (ec *executionContext) _DateResultByChain_klaytn(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_klaytn(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4632,7 +5336,7 @@ func (ec *executionContext) _DateResultByChain_dogechain(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Dogechain, nil
+		return obj.Klaytn, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4646,7 +5350,7 @@ func (ec *executionContext) _DateResultByChain_dogechain(ctx context.Context, fi
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_dogechain(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_klaytn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4659,8 +5363,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_dogechain(ctx context
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_base(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_base(ctx, field)
+func (ec *executionContext) _DateResultByChain_arbitrum(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_arbitrum(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4673,7 +5377,7 @@ func (ec *executionContext) _DateResultByChain_base(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Base, nil
+		return obj.Arbitrum, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4687,7 +5391,7 @@ func (ec *executionContext) _DateResultByChain_base(ctx context.Context, field g
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_base(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_arbitrum(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4700,8 +5404,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_base(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_blast(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_blast(ctx, field)
+func (ec *executionContext) _DateResultByChain_avalanche(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_avalanche(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4714,7 +5418,7 @@ func (ec *executionContext) _DateResultByChain_blast(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Blast, nil
+		return obj.Avalanche, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4728,7 +5432,7 @@ func (ec *executionContext) _DateResultByChain_blast(ctx context.Context, field
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_blast(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_avalanche(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4741,8 +5445,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_blast(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_scroll(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_scroll(ctx, field)
+func (ec *executionContext) _DateResultByChain_dfk(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_dfk(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4755,7 +5459,7 @@ func (ec *executionContext) _DateResultByChain_scroll(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Scroll, nil
+		return obj.Dfk, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4769,7 +5473,7 @@ func (ec *executionContext) _DateResultByChain_scroll(ctx context.Context, field
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_scroll(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_dfk(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4782,8 +5486,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_scroll(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _DateResultByChain_total(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DateResultByChain_total(ctx, field)
+func (ec *executionContext) _DateResultByChain_aurora(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_aurora(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4796,7 +5500,7 @@ func (ec *executionContext) _DateResultByChain_total(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Total, nil
+		return obj.Aurora, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4810,7 +5514,7 @@ func (ec *executionContext) _DateResultByChain_total(ctx context.Context, field
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DateResultByChain_total(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_aurora(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "DateResultByChain",
 		Field:      field,
@@ -4823,8 +5527,8 @@ func (ec *executionContext) fieldContext_DateResultByChain_total(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _HeroType_recipient(ctx context.Context, field graphql.CollectedField, obj *model.HeroType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HeroType_recipient(ctx, field)
+func (ec *executionContext) _DateResultByChain_harmony(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_harmony(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4837,38 +5541,35 @@ func (ec *executionContext) _HeroType_recipient(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Recipient, nil
+		return obj.Harmony, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HeroType_recipient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_harmony(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HeroType",
+		Object:     "DateResultByChain",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HeroType_heroID(ctx context.Context, field graphql.CollectedField, obj *model.HeroType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HeroType_heroID(ctx, field)
+func (ec *executionContext) _DateResultByChain_canto(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_canto(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4881,38 +5582,35 @@ func (ec *executionContext) _HeroType_heroID(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HeroID, nil
+		return obj.Canto, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HeroType_heroID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_canto(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HeroType",
+		Object:     "DateResultByChain",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HistoricalResult_total(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HistoricalResult_total(ctx, field)
+func (ec *executionContext) _DateResultByChain_dogechain(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_dogechain(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4925,7 +5623,7 @@ func (ec *executionContext) _HistoricalResult_total(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Total, nil
+		return obj.Dogechain, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4939,9 +5637,9 @@ func (ec *executionContext) _HistoricalResult_total(ctx context.Context, field g
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HistoricalResult_total(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_dogechain(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HistoricalResult",
+		Object:     "DateResultByChain",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4952,8 +5650,8 @@ func (ec *executionContext) fieldContext_HistoricalResult_total(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _HistoricalResult_dateResults(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HistoricalResult_dateResults(ctx, field)
+func (ec *executionContext) _DateResultByChain_base(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_base(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4966,7 +5664,7 @@ func (ec *executionContext) _HistoricalResult_dateResults(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DateResults, nil
+		return obj.Base, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4975,32 +5673,26 @@ func (ec *executionContext) _HistoricalResult_dateResults(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.DateResult)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalODateResult2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDateResult(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HistoricalResult_dateResults(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_base(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HistoricalResult",
+		Object:     "DateResultByChain",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "date":
-				return ec.fieldContext_DateResult_date(ctx, field)
-			case "total":
-				return ec.fieldContext_DateResult_total(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DateResult", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _HistoricalResult_type(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_HistoricalResult_type(ctx, field)
+func (ec *executionContext) _DateResultByChain_blast(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_blast(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5013,7 +5705,7 @@ func (ec *executionContext) _HistoricalResult_type(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Blast, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5022,26 +5714,26 @@ func (ec *executionContext) _HistoricalResult_type(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.HistoricalResultType)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOHistoricalResultType2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐHistoricalResultType(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_HistoricalResult_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_blast(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "HistoricalResult",
+		Object:     "DateResultByChain",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type HistoricalResultType does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Leaderboard_address(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Leaderboard_address(ctx, field)
+func (ec *executionContext) _DateResultByChain_scroll(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_scroll(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5054,7 +5746,7 @@ func (ec *executionContext) _Leaderboard_address(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.Scroll, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5063,26 +5755,26 @@ func (ec *executionContext) _Leaderboard_address(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Leaderboard_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_scroll(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Leaderboard",
+		Object:     "DateResultByChain",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Leaderboard_volumeUSD(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Leaderboard_volumeUSD(ctx, field)
+func (ec *executionContext) _DateResultByChain_total(ctx context.Context, field graphql.CollectedField, obj *model.DateResultByChain) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DateResultByChain_total(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5095,7 +5787,7 @@ func (ec *executionContext) _Leaderboard_volumeUSD(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.VolumeUsd, nil
+		return obj.Total, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5109,9 +5801,9 @@ func (ec *executionContext) _Leaderboard_volumeUSD(ctx context.Context, field gr
 	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Leaderboard_volumeUSD(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateResultByChain_total(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Leaderboard",
+		Object:     "DateResultByChain",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5122,8 +5814,8 @@ func (ec *executionContext) fieldContext_Leaderboard_volumeUSD(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _Leaderboard_fees(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Leaderboard_fees(ctx, field)
+func (ec *executionContext) _FeeStatistic_date(ctx context.Context, field graphql.CollectedField, obj *model.FeeStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeeStatistic_date(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5136,7 +5828,7 @@ func (ec *executionContext) _Leaderboard_fees(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fees, nil
+		return obj.Date, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5145,26 +5837,26 @@ func (ec *executionContext) _Leaderboard_fees(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Leaderboard_fees(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeeStatistic_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Leaderboard",
+		Object:     "FeeStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Leaderboard_txs(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Leaderboard_txs(ctx, field)
+func (ec *executionContext) _FeeStatistic_chainID(ctx context.Context, field graphql.CollectedField, obj *model.FeeStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeeStatistic_chainID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5177,7 +5869,7 @@ func (ec *executionContext) _Leaderboard_txs(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Txs, nil
+		return obj.ChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5191,9 +5883,9 @@ func (ec *executionContext) _Leaderboard_txs(ctx context.Context, field graphql.
 	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Leaderboard_txs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeeStatistic_chainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Leaderboard",
+		Object:     "FeeStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5204,8 +5896,8 @@ func (ec *executionContext) fieldContext_Leaderboard_txs(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Leaderboard_rank(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Leaderboard_rank(ctx, field)
+func (ec *executionContext) _FeeStatistic_platform(ctx context.Context, field graphql.CollectedField, obj *model.FeeStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeeStatistic_platform(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5218,7 +5910,7 @@ func (ec *executionContext) _Leaderboard_rank(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Rank, nil
+		return obj.Platform, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5227,26 +5919,26 @@ func (ec *executionContext) _Leaderboard_rank(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*model.FeePlatform)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOFeePlatform2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐFeePlatform(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Leaderboard_rank(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeeStatistic_platform(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Leaderboard",
+		Object:     "FeeStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type FeePlatform does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Leaderboard_avgVolumeUSD(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Leaderboard_avgVolumeUSD(ctx, field)
+func (ec *executionContext) _FeeStatistic_contractAddress(ctx context.Context, field graphql.CollectedField, obj *model.FeeStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeeStatistic_contractAddress(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5259,7 +5951,7 @@ func (ec *executionContext) _Leaderboard_avgVolumeUSD(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AvgVolumeUsd, nil
+		return obj.ContractAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5268,26 +5960,26 @@ func (ec *executionContext) _Leaderboard_avgVolumeUSD(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Leaderboard_avgVolumeUSD(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeeStatistic_contractAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Leaderboard",
+		Object:     "FeeStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MessageBusTransaction_fromInfo(ctx context.Context, field graphql.CollectedField, obj *model.MessageBusTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MessageBusTransaction_fromInfo(ctx, field)
+func (ec *executionContext) _FeeStatistic_token(ctx context.Context, field graphql.CollectedField, obj *model.FeeStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeeStatistic_token(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5300,7 +5992,7 @@ func (ec *executionContext) _MessageBusTransaction_fromInfo(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FromInfo, nil
+		return obj.Token, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5309,52 +6001,26 @@ func (ec *executionContext) _MessageBusTransaction_fromInfo(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.PartialMessageBusInfo)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOPartialMessageBusInfo2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPartialMessageBusInfo(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MessageBusTransaction_fromInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeeStatistic_token(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MessageBusTransaction",
+		Object:     "FeeStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "chainID":
-				return ec.fieldContext_PartialMessageBusInfo_chainID(ctx, field)
-			case "chainName":
-				return ec.fieldContext_PartialMessageBusInfo_chainName(ctx, field)
-			case "destinationChainID":
-				return ec.fieldContext_PartialMessageBusInfo_destinationChainID(ctx, field)
-			case "destinationChainName":
-				return ec.fieldContext_PartialMessageBusInfo_destinationChainName(ctx, field)
-			case "contractAddress":
-				return ec.fieldContext_PartialMessageBusInfo_contractAddress(ctx, field)
-			case "txnHash":
-				return ec.fieldContext_PartialMessageBusInfo_txnHash(ctx, field)
-			case "message":
-				return ec.fieldContext_PartialMessageBusInfo_message(ctx, field)
-			case "messageType":
-				return ec.fieldContext_PartialMessageBusInfo_messageType(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_PartialMessageBusInfo_blockNumber(ctx, field)
-			case "time":
-				return ec.fieldContext_PartialMessageBusInfo_time(ctx, field)
-			case "formattedTime":
-				return ec.fieldContext_PartialMessageBusInfo_formattedTime(ctx, field)
-			case "revertedReason":
-				return ec.fieldContext_PartialMessageBusInfo_revertedReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PartialMessageBusInfo", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MessageBusTransaction_toInfo(ctx context.Context, field graphql.CollectedField, obj *model.MessageBusTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MessageBusTransaction_toInfo(ctx, field)
+func (ec *executionContext) _FeeStatistic_tokenSymbol(ctx context.Context, field graphql.CollectedField, obj *model.FeeStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeeStatistic_tokenSymbol(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5367,7 +6033,7 @@ func (ec *executionContext) _MessageBusTransaction_toInfo(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ToInfo, nil
+		return obj.TokenSymbol, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5376,52 +6042,26 @@ func (ec *executionContext) _MessageBusTransaction_toInfo(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.PartialMessageBusInfo)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOPartialMessageBusInfo2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPartialMessageBusInfo(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MessageBusTransaction_toInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeeStatistic_tokenSymbol(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MessageBusTransaction",
+		Object:     "FeeStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "chainID":
-				return ec.fieldContext_PartialMessageBusInfo_chainID(ctx, field)
-			case "chainName":
-				return ec.fieldContext_PartialMessageBusInfo_chainName(ctx, field)
-			case "destinationChainID":
-				return ec.fieldContext_PartialMessageBusInfo_destinationChainID(ctx, field)
-			case "destinationChainName":
-				return ec.fieldContext_PartialMessageBusInfo_destinationChainName(ctx, field)
-			case "contractAddress":
-				return ec.fieldContext_PartialMessageBusInfo_contractAddress(ctx, field)
-			case "txnHash":
-				return ec.fieldContext_PartialMessageBusInfo_txnHash(ctx, field)
-			case "message":
-				return ec.fieldContext_PartialMessageBusInfo_message(ctx, field)
-			case "messageType":
-				return ec.fieldContext_PartialMessageBusInfo_messageType(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_PartialMessageBusInfo_blockNumber(ctx, field)
-			case "time":
-				return ec.fieldContext_PartialMessageBusInfo_time(ctx, field)
-			case "formattedTime":
-				return ec.fieldContext_PartialMessageBusInfo_formattedTime(ctx, field)
-			case "revertedReason":
-				return ec.fieldContext_PartialMessageBusInfo_revertedReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PartialMessageBusInfo", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MessageBusTransaction_pending(ctx context.Context, field graphql.CollectedField, obj *model.MessageBusTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MessageBusTransaction_pending(ctx, field)
+func (ec *executionContext) _FeeStatistic_count(ctx context.Context, field graphql.CollectedField, obj *model.FeeStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeeStatistic_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5434,7 +6074,7 @@ func (ec *executionContext) _MessageBusTransaction_pending(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pending, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5443,26 +6083,26 @@ func (ec *executionContext) _MessageBusTransaction_pending(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MessageBusTransaction_pending(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeeStatistic_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MessageBusTransaction",
+		Object:     "FeeStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MessageBusTransaction_messageID(ctx context.Context, field graphql.CollectedField, obj *model.MessageBusTransaction) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MessageBusTransaction_messageID(ctx, field)
+func (ec *executionContext) _FeeStatistic_volumeUsd(ctx context.Context, field graphql.CollectedField, obj *model.FeeStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeeStatistic_volumeUsd(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5475,7 +6115,7 @@ func (ec *executionContext) _MessageBusTransaction_messageID(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MessageID, nil
+		return obj.VolumeUsd, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5484,26 +6124,26 @@ func (ec *executionContext) _MessageBusTransaction_messageID(ctx context.Context
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MessageBusTransaction_messageID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeeStatistic_volumeUsd(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MessageBusTransaction",
+		Object:     "FeeStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_chainID(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_chainID(ctx, field)
+func (ec *executionContext) _FeeStatistic_feeUsd(ctx context.Context, field graphql.CollectedField, obj *model.FeeStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeeStatistic_feeUsd(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5516,7 +6156,7 @@ func (ec *executionContext) _PartialInfo_chainID(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ChainID, nil
+		return obj.FeeUsd, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5525,26 +6165,26 @@ func (ec *executionContext) _PartialInfo_chainID(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_chainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeeStatistic_feeUsd(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "FeeStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_destinationChainID(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_destinationChainID(ctx, field)
+func (ec *executionContext) _FeeStatistic_protocolFeeUsd(ctx context.Context, field graphql.CollectedField, obj *model.FeeStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FeeStatistic_protocolFeeUsd(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5557,7 +6197,7 @@ func (ec *executionContext) _PartialInfo_destinationChainID(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DestinationChainID, nil
+		return obj.ProtocolFeeUsd, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5566,26 +6206,26 @@ func (ec *executionContext) _PartialInfo_destinationChainID(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_destinationChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FeeStatistic_protocolFeeUsd(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "FeeStatistic",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_address(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_address(ctx, field)
+func (ec *executionContext) _HeroType_recipient(ctx context.Context, field graphql.CollectedField, obj *model.HeroType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HeroType_recipient(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5598,23 +6238,26 @@ func (ec *executionContext) _PartialInfo_address(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.Recipient, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HeroType_recipient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "HeroType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5625,8 +6268,8 @@ func (ec *executionContext) fieldContext_PartialInfo_address(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_txnHash(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_txnHash(ctx, field)
+func (ec *executionContext) _HeroType_heroID(ctx context.Context, field graphql.CollectedField, obj *model.HeroType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HeroType_heroID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5639,23 +6282,26 @@ func (ec *executionContext) _PartialInfo_txnHash(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxnHash, nil
+		return obj.HeroID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_txnHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HeroType_heroID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "HeroType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5666,8 +6312,8 @@ func (ec *executionContext) fieldContext_PartialInfo_txnHash(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_value(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_value(ctx, field)
+func (ec *executionContext) _HistoricalResult_total(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HistoricalResult_total(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5680,7 +6326,7 @@ func (ec *executionContext) _PartialInfo_value(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Total, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5689,26 +6335,26 @@ func (ec *executionContext) _PartialInfo_value(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HistoricalResult_total(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "HistoricalResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_formattedValue(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_formattedValue(ctx, field)
+func (ec *executionContext) _HistoricalResult_dateResults(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HistoricalResult_dateResults(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5721,7 +6367,7 @@ func (ec *executionContext) _PartialInfo_formattedValue(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FormattedValue, nil
+		return obj.DateResults, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5730,26 +6376,32 @@ func (ec *executionContext) _PartialInfo_formattedValue(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.([]*model.DateResult)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalODateResult2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDateResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_formattedValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HistoricalResult_dateResults(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "HistoricalResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "date":
+				return ec.fieldContext_DateResult_date(ctx, field)
+			case "total":
+				return ec.fieldContext_DateResult_total(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DateResult", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_USDValue(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_USDValue(ctx, field)
+func (ec *executionContext) _HistoricalResult_type(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_HistoricalResult_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5762,7 +6414,7 @@ func (ec *executionContext) _PartialInfo_USDValue(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.USDValue, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5771,26 +6423,26 @@ func (ec *executionContext) _PartialInfo_USDValue(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*float64)
+	res := resTmp.(*model.HistoricalResultType)
 	fc.Result = res
-	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+	return ec.marshalOHistoricalResultType2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐHistoricalResultType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_USDValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_HistoricalResult_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "HistoricalResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type HistoricalResultType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_tokenAddress(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_tokenAddress(ctx, field)
+func (ec *executionContext) _Leaderboard_address(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Leaderboard_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5803,7 +6455,7 @@ func (ec *executionContext) _PartialInfo_tokenAddress(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TokenAddress, nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5817,9 +6469,9 @@ func (ec *executionContext) _PartialInfo_tokenAddress(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_tokenAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Leaderboard_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "Leaderboard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5830,8 +6482,8 @@ func (ec *executionContext) fieldContext_PartialInfo_tokenAddress(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_tokenSymbol(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_tokenSymbol(ctx, field)
+func (ec *executionContext) _Leaderboard_volumeUSD(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Leaderboard_volumeUSD(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5844,7 +6496,7 @@ func (ec *executionContext) _PartialInfo_tokenSymbol(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TokenSymbol, nil
+		return obj.VolumeUsd, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5853,26 +6505,26 @@ func (ec *executionContext) _PartialInfo_tokenSymbol(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_tokenSymbol(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Leaderboard_volumeUSD(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "Leaderboard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_blockNumber(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_blockNumber(ctx, field)
+func (ec *executionContext) _Leaderboard_fees(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Leaderboard_fees(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5885,7 +6537,7 @@ func (ec *executionContext) _PartialInfo_blockNumber(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BlockNumber, nil
+		return obj.Fees, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5894,26 +6546,26 @@ func (ec *executionContext) _PartialInfo_blockNumber(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_blockNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Leaderboard_fees(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "Leaderboard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_time(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_time(ctx, field)
+func (ec *executionContext) _Leaderboard_txs(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Leaderboard_txs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5926,7 +6578,7 @@ func (ec *executionContext) _PartialInfo_time(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Time, nil
+		return obj.Txs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5940,9 +6592,9 @@ func (ec *executionContext) _PartialInfo_time(ctx context.Context, field graphql
 	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Leaderboard_txs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "Leaderboard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5953,8 +6605,8 @@ func (ec *executionContext) fieldContext_PartialInfo_time(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_formattedTime(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_formattedTime(ctx, field)
+func (ec *executionContext) _Leaderboard_rank(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Leaderboard_rank(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5967,7 +6619,7 @@ func (ec *executionContext) _PartialInfo_formattedTime(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FormattedTime, nil
+		return obj.Rank, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5976,26 +6628,26 @@ func (ec *executionContext) _PartialInfo_formattedTime(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_formattedTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Leaderboard_rank(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "Leaderboard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_formattedEventType(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_formattedEventType(ctx, field)
+func (ec *executionContext) _Leaderboard_avgVolumeUSD(ctx context.Context, field graphql.CollectedField, obj *model.Leaderboard) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Leaderboard_avgVolumeUSD(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6008,7 +6660,7 @@ func (ec *executionContext) _PartialInfo_formattedEventType(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FormattedEventType, nil
+		return obj.AvgVolumeUsd, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6017,26 +6669,26 @@ func (ec *executionContext) _PartialInfo_formattedEventType(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_formattedEventType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Leaderboard_avgVolumeUSD(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "Leaderboard",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialInfo_eventType(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialInfo_eventType(ctx, field)
+func (ec *executionContext) _MessageBusTransaction_fromInfo(ctx context.Context, field graphql.CollectedField, obj *model.MessageBusTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageBusTransaction_fromInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6049,7 +6701,7 @@ func (ec *executionContext) _PartialInfo_eventType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EventType, nil
+		return obj.FromInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6058,26 +6710,52 @@ func (ec *executionContext) _PartialInfo_eventType(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*model.PartialMessageBusInfo)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOPartialMessageBusInfo2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPartialMessageBusInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialInfo_eventType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageBusTransaction_fromInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialInfo",
+		Object:     "MessageBusTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "chainID":
+				return ec.fieldContext_PartialMessageBusInfo_chainID(ctx, field)
+			case "chainName":
+				return ec.fieldContext_PartialMessageBusInfo_chainName(ctx, field)
+			case "destinationChainID":
+				return ec.fieldContext_PartialMessageBusInfo_destinationChainID(ctx, field)
+			case "destinationChainName":
+				return ec.fieldContext_PartialMessageBusInfo_destinationChainName(ctx, field)
+			case "contractAddress":
+				return ec.fieldContext_PartialMessageBusInfo_contractAddress(ctx, field)
+			case "txnHash":
+				return ec.fieldContext_PartialMessageBusInfo_txnHash(ctx, field)
+			case "message":
+				return ec.fieldContext_PartialMessageBusInfo_message(ctx, field)
+			case "messageType":
+				return ec.fieldContext_PartialMessageBusInfo_messageType(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_PartialMessageBusInfo_blockNumber(ctx, field)
+			case "time":
+				return ec.fieldContext_PartialMessageBusInfo_time(ctx, field)
+			case "formattedTime":
+				return ec.fieldContext_PartialMessageBusInfo_formattedTime(ctx, field)
+			case "revertedReason":
+				return ec.fieldContext_PartialMessageBusInfo_revertedReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PartialMessageBusInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_chainID(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_chainID(ctx, field)
+func (ec *executionContext) _MessageBusTransaction_toInfo(ctx context.Context, field graphql.CollectedField, obj *model.MessageBusTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageBusTransaction_toInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6090,7 +6768,7 @@ func (ec *executionContext) _PartialMessageBusInfo_chainID(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ChainID, nil
+		return obj.ToInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6099,26 +6777,52 @@ func (ec *executionContext) _PartialMessageBusInfo_chainID(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*model.PartialMessageBusInfo)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOPartialMessageBusInfo2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPartialMessageBusInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_chainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageBusTransaction_toInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "MessageBusTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "chainID":
+				return ec.fieldContext_PartialMessageBusInfo_chainID(ctx, field)
+			case "chainName":
+				return ec.fieldContext_PartialMessageBusInfo_chainName(ctx, field)
+			case "destinationChainID":
+				return ec.fieldContext_PartialMessageBusInfo_destinationChainID(ctx, field)
+			case "destinationChainName":
+				return ec.fieldContext_PartialMessageBusInfo_destinationChainName(ctx, field)
+			case "contractAddress":
+				return ec.fieldContext_PartialMessageBusInfo_contractAddress(ctx, field)
+			case "txnHash":
+				return ec.fieldContext_PartialMessageBusInfo_txnHash(ctx, field)
+			case "message":
+				return ec.fieldContext_PartialMessageBusInfo_message(ctx, field)
+			case "messageType":
+				return ec.fieldContext_PartialMessageBusInfo_messageType(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_PartialMessageBusInfo_blockNumber(ctx, field)
+			case "time":
+				return ec.fieldContext_PartialMessageBusInfo_time(ctx, field)
+			case "formattedTime":
+				return ec.fieldContext_PartialMessageBusInfo_formattedTime(ctx, field)
+			case "revertedReason":
+				return ec.fieldContext_PartialMessageBusInfo_revertedReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PartialMessageBusInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_chainName(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_chainName(ctx, field)
+func (ec *executionContext) _MessageBusTransaction_pending(ctx context.Context, field graphql.CollectedField, obj *model.MessageBusTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageBusTransaction_pending(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6131,7 +6835,7 @@ func (ec *executionContext) _PartialMessageBusInfo_chainName(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ChainName, nil
+		return obj.Pending, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6140,26 +6844,26 @@ func (ec *executionContext) _PartialMessageBusInfo_chainName(ctx context.Context
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_chainName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageBusTransaction_pending(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "MessageBusTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_destinationChainID(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_destinationChainID(ctx, field)
+func (ec *executionContext) _MessageBusTransaction_messageID(ctx context.Context, field graphql.CollectedField, obj *model.MessageBusTransaction) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MessageBusTransaction_messageID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6172,7 +6876,7 @@ func (ec *executionContext) _PartialMessageBusInfo_destinationChainID(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DestinationChainID, nil
+		return obj.MessageID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6181,26 +6885,26 @@ func (ec *executionContext) _PartialMessageBusInfo_destinationChainID(ctx contex
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_destinationChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageBusTransaction_messageID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "MessageBusTransaction",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_destinationChainName(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_destinationChainName(ctx, field)
+func (ec *executionContext) _PartialInfo_chainID(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_chainID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6213,7 +6917,7 @@ func (ec *executionContext) _PartialMessageBusInfo_destinationChainName(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DestinationChainName, nil
+		return obj.ChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6222,26 +6926,26 @@ func (ec *executionContext) _PartialMessageBusInfo_destinationChainName(ctx cont
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_destinationChainName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_chainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_contractAddress(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_contractAddress(ctx, field)
+func (ec *executionContext) _PartialInfo_destinationChainID(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_destinationChainID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6254,7 +6958,7 @@ func (ec *executionContext) _PartialMessageBusInfo_contractAddress(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContractAddress, nil
+		return obj.DestinationChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6263,26 +6967,26 @@ func (ec *executionContext) _PartialMessageBusInfo_contractAddress(ctx context.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_contractAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_destinationChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_txnHash(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_txnHash(ctx, field)
+func (ec *executionContext) _PartialInfo_address(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_address(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6295,7 +6999,7 @@ func (ec *executionContext) _PartialMessageBusInfo_txnHash(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxnHash, nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6309,9 +7013,9 @@ func (ec *executionContext) _PartialMessageBusInfo_txnHash(ctx context.Context,
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_txnHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_address(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6322,8 +7026,8 @@ func (ec *executionContext) fieldContext_PartialMessageBusInfo_txnHash(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_message(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_message(ctx, field)
+func (ec *executionContext) _PartialInfo_txnHash(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_txnHash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6336,7 +7040,7 @@ func (ec *executionContext) _PartialMessageBusInfo_message(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.TxnHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6350,9 +7054,9 @@ func (ec *executionContext) _PartialMessageBusInfo_message(ctx context.Context,
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_txnHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6363,8 +7067,8 @@ func (ec *executionContext) fieldContext_PartialMessageBusInfo_message(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_messageType(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_messageType(ctx, field)
+func (ec *executionContext) _PartialInfo_value(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6377,7 +7081,7 @@ func (ec *executionContext) _PartialMessageBusInfo_messageType(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MessageType, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6386,26 +7090,26 @@ func (ec *executionContext) _PartialMessageBusInfo_messageType(ctx context.Conte
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(model.MessageType)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOMessageType2githubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageType(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_messageType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type MessageType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_blockNumber(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_blockNumber(ctx, field)
+func (ec *executionContext) _PartialInfo_formattedValue(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_formattedValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6418,7 +7122,7 @@ func (ec *executionContext) _PartialMessageBusInfo_blockNumber(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BlockNumber, nil
+		return obj.FormattedValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6427,26 +7131,26 @@ func (ec *executionContext) _PartialMessageBusInfo_blockNumber(ctx context.Conte
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_blockNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_formattedValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_time(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_time(ctx, field)
+func (ec *executionContext) _PartialInfo_USDValue(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_USDValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6459,7 +7163,7 @@ func (ec *executionContext) _PartialMessageBusInfo_time(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Time, nil
+		return obj.USDValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6468,26 +7172,26 @@ func (ec *executionContext) _PartialMessageBusInfo_time(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_USDValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_formattedTime(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_formattedTime(ctx, field)
+func (ec *executionContext) _PartialInfo_tokenAddress(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_tokenAddress(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6500,7 +7204,7 @@ func (ec *executionContext) _PartialMessageBusInfo_formattedTime(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FormattedTime, nil
+		return obj.TokenAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6514,9 +7218,9 @@ func (ec *executionContext) _PartialMessageBusInfo_formattedTime(ctx context.Con
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_formattedTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_tokenAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6527,8 +7231,8 @@ func (ec *executionContext) fieldContext_PartialMessageBusInfo_formattedTime(ctx
 	return fc, nil
 }
 
-func (ec *executionContext) _PartialMessageBusInfo_revertedReason(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PartialMessageBusInfo_revertedReason(ctx, field)
+func (ec *executionContext) _PartialInfo_tokenSymbol(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_tokenSymbol(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6541,7 +7245,7 @@ func (ec *executionContext) _PartialMessageBusInfo_revertedReason(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RevertedReason, nil
+		return obj.TokenSymbol, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6555,9 +7259,9 @@ func (ec *executionContext) _PartialMessageBusInfo_revertedReason(ctx context.Co
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PartialMessageBusInfo_revertedReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_tokenSymbol(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PartialMessageBusInfo",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6568,8 +7272,8 @@ func (ec *executionContext) fieldContext_PartialMessageBusInfo_revertedReason(ct
 	return fc, nil
 }
 
-func (ec *executionContext) _PetType_recipient(ctx context.Context, field graphql.CollectedField, obj *model.PetType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PetType_recipient(ctx, field)
+func (ec *executionContext) _PartialInfo_blockNumber(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_blockNumber(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6582,38 +7286,35 @@ func (ec *executionContext) _PetType_recipient(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Recipient, nil
+		return obj.BlockNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PetType_recipient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_blockNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PetType",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PetType_petID(ctx context.Context, field graphql.CollectedField, obj *model.PetType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PetType_petID(ctx, field)
+func (ec *executionContext) _PartialInfo_time(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_time(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6626,38 +7327,35 @@ func (ec *executionContext) _PetType_petID(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PetID, nil
+		return obj.Time, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PetType_petID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PetType",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PetType_name(ctx context.Context, field graphql.CollectedField, obj *model.PetType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PetType_name(ctx, field)
+func (ec *executionContext) _PartialInfo_formattedTime(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_formattedTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6670,26 +7368,23 @@ func (ec *executionContext) _PetType_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.FormattedTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PetType_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_formattedTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PetType",
+		Object:     "PartialInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6700,8 +7395,8 @@ func (ec *executionContext) fieldContext_PetType_name(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_bridgeTransactions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_bridgeTransactions(ctx, field)
+func (ec *executionContext) _PartialInfo_formattedEventType(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_formattedEventType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6714,7 +7409,7 @@ func (ec *executionContext) _Query_bridgeTransactions(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().BridgeTransactions(rctx, fc.Args["chainIDFrom"].([]*int), fc.Args["chainIDTo"].([]*int), fc.Args["addressFrom"].(*string), fc.Args["addressTo"].(*string), fc.Args["maxAmount"].(*int), fc.Args["minAmount"].(*int), fc.Args["maxAmountUsd"].(*int), fc.Args["minAmountUsd"].(*int), fc.Args["startTime"].(*int), fc.Args["endTime"].(*int), fc.Args["txnHash"].(*string), fc.Args["kappa"].(*string), fc.Args["pending"].(*bool), fc.Args["useMv"].(*bool), fc.Args["page"].(*int), fc.Args["tokenAddressFrom"].([]*string), fc.Args["tokenAddressTo"].([]*string), fc.Args["onlyCCTP"].(*bool))
+		return obj.FormattedEventType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6723,49 +7418,26 @@ func (ec *executionContext) _Query_bridgeTransactions(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.BridgeTransaction)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOBridgeTransaction2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeTransaction(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_bridgeTransactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_formattedEventType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PartialInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "fromInfo":
-				return ec.fieldContext_BridgeTransaction_fromInfo(ctx, field)
-			case "toInfo":
-				return ec.fieldContext_BridgeTransaction_toInfo(ctx, field)
-			case "kappa":
-				return ec.fieldContext_BridgeTransaction_kappa(ctx, field)
-			case "pending":
-				return ec.fieldContext_BridgeTransaction_pending(ctx, field)
-			case "swapSuccess":
-				return ec.fieldContext_BridgeTransaction_swapSuccess(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type BridgeTransaction", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_bridgeTransactions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_messageBusTransactions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_messageBusTransactions(ctx, field)
+func (ec *executionContext) _PartialInfo_eventType(ctx context.Context, field graphql.CollectedField, obj *model.PartialInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialInfo_eventType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6778,7 +7450,7 @@ func (ec *executionContext) _Query_messageBusTransactions(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().MessageBusTransactions(rctx, fc.Args["chainID"].([]*int), fc.Args["contractAddress"].(*string), fc.Args["startTime"].(*int), fc.Args["endTime"].(*int), fc.Args["txnHash"].(*string), fc.Args["messageID"].(*string), fc.Args["pending"].(*bool), fc.Args["reverted"].(*bool), fc.Args["page"].(*int))
+		return obj.EventType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6787,47 +7459,26 @@ func (ec *executionContext) _Query_messageBusTransactions(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.MessageBusTransaction)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOMessageBusTransaction2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageBusTransaction(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_messageBusTransactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialInfo_eventType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PartialInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "fromInfo":
-				return ec.fieldContext_MessageBusTransaction_fromInfo(ctx, field)
-			case "toInfo":
-				return ec.fieldContext_MessageBusTransaction_toInfo(ctx, field)
-			case "pending":
-				return ec.fieldContext_MessageBusTransaction_pending(ctx, field)
-			case "messageID":
-				return ec.fieldContext_MessageBusTransaction_messageID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MessageBusTransaction", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_messageBusTransactions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_countByChainId(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_countByChainId(ctx, field)
+func (ec *executionContext) _PartialMessageBusInfo_chainID(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_chainID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6840,7 +7491,7 @@ func (ec *executionContext) _Query_countByChainId(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CountByChainID(rctx, fc.Args["chainID"].(*int), fc.Args["address"].(*string), fc.Args["direction"].(*model.Direction), fc.Args["hours"].(*int))
+		return obj.ChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6849,43 +7500,26 @@ func (ec *executionContext) _Query_countByChainId(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TransactionCountResult)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOTransactionCountResult2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐTransactionCountResult(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_countByChainId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_chainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PartialMessageBusInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "chainID":
-				return ec.fieldContext_TransactionCountResult_chainID(ctx, field)
-			case "count":
-				return ec.fieldContext_TransactionCountResult_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TransactionCountResult", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_countByChainId_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_countByTokenAddress(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_countByTokenAddress(ctx, field)
+func (ec *executionContext) _PartialMessageBusInfo_chainName(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_chainName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6898,7 +7532,7 @@ func (ec *executionContext) _Query_countByTokenAddress(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CountByTokenAddress(rctx, fc.Args["chainID"].(*int), fc.Args["address"].(*string), fc.Args["direction"].(*model.Direction), fc.Args["hours"].(*int))
+		return obj.ChainName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6907,45 +7541,26 @@ func (ec *executionContext) _Query_countByTokenAddress(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TokenCountResult)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOTokenCountResult2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐTokenCountResult(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_countByTokenAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_chainName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PartialMessageBusInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "chainID":
-				return ec.fieldContext_TokenCountResult_chainID(ctx, field)
-			case "tokenAddress":
-				return ec.fieldContext_TokenCountResult_tokenAddress(ctx, field)
-			case "count":
-				return ec.fieldContext_TokenCountResult_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TokenCountResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_countByTokenAddress_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_addressRanking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_addressRanking(ctx, field)
+func (ec *executionContext) _PartialMessageBusInfo_destinationChainID(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_destinationChainID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6958,7 +7573,7 @@ func (ec *executionContext) _Query_addressRanking(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AddressRanking(rctx, fc.Args["hours"].(*int))
+		return obj.DestinationChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6967,25 +7582,1629 @@ func (ec *executionContext) _Query_addressRanking(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.AddressRanking)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOAddressRanking2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐAddressRanking(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_addressRanking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_destinationChainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PartialMessageBusInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PartialMessageBusInfo_destinationChainName(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_destinationChainName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DestinationChainName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_destinationChainName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PartialMessageBusInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PartialMessageBusInfo_contractAddress(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_contractAddress(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ContractAddress, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_contractAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PartialMessageBusInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PartialMessageBusInfo_txnHash(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_txnHash(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TxnHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_txnHash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PartialMessageBusInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PartialMessageBusInfo_message(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PartialMessageBusInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PartialMessageBusInfo_messageType(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_messageType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MessageType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(model.MessageType)
+	fc.Result = res
+	return ec.marshalOMessageType2githubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_messageType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PartialMessageBusInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type MessageType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PartialMessageBusInfo_blockNumber(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_blockNumber(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BlockNumber, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_blockNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PartialMessageBusInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PartialMessageBusInfo_time(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_time(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PartialMessageBusInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PartialMessageBusInfo_formattedTime(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_formattedTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FormattedTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_formattedTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PartialMessageBusInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PartialMessageBusInfo_revertedReason(ctx context.Context, field graphql.CollectedField, obj *model.PartialMessageBusInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PartialMessageBusInfo_revertedReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RevertedReason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PartialMessageBusInfo_revertedReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PartialMessageBusInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingTransfer_fromInfo(ctx context.Context, field graphql.CollectedField, obj *model.PendingTransfer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingTransfer_fromInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FromInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.PartialInfo)
+	fc.Result = res
+	return ec.marshalOPartialInfo2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPartialInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PendingTransfer_fromInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PendingTransfer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "chainID":
+				return ec.fieldContext_PartialInfo_chainID(ctx, field)
+			case "destinationChainID":
+				return ec.fieldContext_PartialInfo_destinationChainID(ctx, field)
+			case "address":
+				return ec.fieldContext_PartialInfo_address(ctx, field)
+			case "txnHash":
+				return ec.fieldContext_PartialInfo_txnHash(ctx, field)
+			case "value":
+				return ec.fieldContext_PartialInfo_value(ctx, field)
+			case "formattedValue":
+				return ec.fieldContext_PartialInfo_formattedValue(ctx, field)
+			case "USDValue":
+				return ec.fieldContext_PartialInfo_USDValue(ctx, field)
+			case "tokenAddress":
+				return ec.fieldContext_PartialInfo_tokenAddress(ctx, field)
+			case "tokenSymbol":
+				return ec.fieldContext_PartialInfo_tokenSymbol(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_PartialInfo_blockNumber(ctx, field)
+			case "time":
+				return ec.fieldContext_PartialInfo_time(ctx, field)
+			case "formattedTime":
+				return ec.fieldContext_PartialInfo_formattedTime(ctx, field)
+			case "formattedEventType":
+				return ec.fieldContext_PartialInfo_formattedEventType(ctx, field)
+			case "eventType":
+				return ec.fieldContext_PartialInfo_eventType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PartialInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingTransfer_kappa(ctx context.Context, field graphql.CollectedField, obj *model.PendingTransfer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingTransfer_kappa(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kappa, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PendingTransfer_kappa(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PendingTransfer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingTransfer_bridgeType(ctx context.Context, field graphql.CollectedField, obj *model.PendingTransfer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingTransfer_bridgeType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BridgeType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.BridgeType)
+	fc.Result = res
+	return ec.marshalOBridgeType2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PendingTransfer_bridgeType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PendingTransfer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BridgeType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingTransfer_ageSeconds(ctx context.Context, field graphql.CollectedField, obj *model.PendingTransfer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingTransfer_ageSeconds(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AgeSeconds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PendingTransfer_ageSeconds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PendingTransfer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingTransfer_slaSeconds(ctx context.Context, field graphql.CollectedField, obj *model.PendingTransfer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingTransfer_slaSeconds(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SLASeconds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PendingTransfer_slaSeconds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PendingTransfer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingTransfer_likelyCause(ctx context.Context, field graphql.CollectedField, obj *model.PendingTransfer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingTransfer_likelyCause(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LikelyCause, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.StuckTransferCause)
+	fc.Result = res
+	return ec.marshalOStuckTransferCause2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐStuckTransferCause(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PendingTransfer_likelyCause(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PendingTransfer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type StuckTransferCause does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PetType_recipient(ctx context.Context, field graphql.CollectedField, obj *model.PetType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PetType_recipient(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Recipient, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PetType_recipient(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PetType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PetType_petID(ctx context.Context, field graphql.CollectedField, obj *model.PetType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PetType_petID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PetID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PetType_petID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PetType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PetType_name(ctx context.Context, field graphql.CollectedField, obj *model.PetType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PetType_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PetType_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PetType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_bridgeTransactions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_bridgeTransactions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().BridgeTransactions(rctx, fc.Args["chainIDFrom"].([]*int), fc.Args["chainIDTo"].([]*int), fc.Args["addressFrom"].(*string), fc.Args["addressTo"].(*string), fc.Args["maxAmount"].(*int), fc.Args["minAmount"].(*int), fc.Args["maxAmountUsd"].(*int), fc.Args["minAmountUsd"].(*int), fc.Args["startTime"].(*int), fc.Args["endTime"].(*int), fc.Args["txnHash"].(*string), fc.Args["kappa"].(*string), fc.Args["pending"].(*bool), fc.Args["useMv"].(*bool), fc.Args["page"].(*int), fc.Args["tokenAddressFrom"].([]*string), fc.Args["tokenAddressTo"].([]*string), fc.Args["onlyCCTP"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.BridgeTransaction)
+	fc.Result = res
+	return ec.marshalOBridgeTransaction2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeTransaction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_bridgeTransactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "fromInfo":
+				return ec.fieldContext_BridgeTransaction_fromInfo(ctx, field)
+			case "toInfo":
+				return ec.fieldContext_BridgeTransaction_toInfo(ctx, field)
+			case "kappa":
+				return ec.fieldContext_BridgeTransaction_kappa(ctx, field)
+			case "pending":
+				return ec.fieldContext_BridgeTransaction_pending(ctx, field)
+			case "swapSuccess":
+				return ec.fieldContext_BridgeTransaction_swapSuccess(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BridgeTransaction", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_bridgeTransactions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_messageBusTransactions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_messageBusTransactions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().MessageBusTransactions(rctx, fc.Args["chainID"].([]*int), fc.Args["contractAddress"].(*string), fc.Args["startTime"].(*int), fc.Args["endTime"].(*int), fc.Args["txnHash"].(*string), fc.Args["messageID"].(*string), fc.Args["pending"].(*bool), fc.Args["reverted"].(*bool), fc.Args["page"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.MessageBusTransaction)
+	fc.Result = res
+	return ec.marshalOMessageBusTransaction2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐMessageBusTransaction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_messageBusTransactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "fromInfo":
+				return ec.fieldContext_MessageBusTransaction_fromInfo(ctx, field)
+			case "toInfo":
+				return ec.fieldContext_MessageBusTransaction_toInfo(ctx, field)
+			case "pending":
+				return ec.fieldContext_MessageBusTransaction_pending(ctx, field)
+			case "messageID":
+				return ec.fieldContext_MessageBusTransaction_messageID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MessageBusTransaction", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_messageBusTransactions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_countByChainId(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_countByChainId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CountByChainID(rctx, fc.Args["chainID"].(*int), fc.Args["address"].(*string), fc.Args["direction"].(*model.Direction), fc.Args["hours"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TransactionCountResult)
+	fc.Result = res
+	return ec.marshalOTransactionCountResult2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐTransactionCountResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_countByChainId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "chainID":
+				return ec.fieldContext_TransactionCountResult_chainID(ctx, field)
+			case "count":
+				return ec.fieldContext_TransactionCountResult_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TransactionCountResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_countByChainId_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_countByTokenAddress(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_countByTokenAddress(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CountByTokenAddress(rctx, fc.Args["chainID"].(*int), fc.Args["address"].(*string), fc.Args["direction"].(*model.Direction), fc.Args["hours"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TokenCountResult)
+	fc.Result = res
+	return ec.marshalOTokenCountResult2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐTokenCountResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_countByTokenAddress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "chainID":
+				return ec.fieldContext_TokenCountResult_chainID(ctx, field)
+			case "tokenAddress":
+				return ec.fieldContext_TokenCountResult_tokenAddress(ctx, field)
+			case "count":
+				return ec.fieldContext_TokenCountResult_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TokenCountResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_countByTokenAddress_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_addressRanking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_addressRanking(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AddressRanking(rctx, fc.Args["hours"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.AddressRanking)
+	fc.Result = res
+	return ec.marshalOAddressRanking2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐAddressRanking(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_addressRanking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_AddressRanking_address(ctx, field)
+			case "count":
+				return ec.fieldContext_AddressRanking_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AddressRanking", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_addressRanking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_amountStatistic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_amountStatistic(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AmountStatistic(rctx, fc.Args["type"].(model.StatisticType), fc.Args["duration"].(*model.Duration), fc.Args["platform"].(*model.Platform), fc.Args["chainID"].(*int), fc.Args["address"].(*string), fc.Args["tokenAddress"].(*string), fc.Args["useCache"].(*bool), fc.Args["useMv"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.ValueResult)
+	fc.Result = res
+	return ec.marshalOValueResult2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐValueResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_amountStatistic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "value":
+				return ec.fieldContext_ValueResult_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ValueResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_amountStatistic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_dailyStatisticsByChain(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_dailyStatisticsByChain(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DailyStatisticsByChain(rctx, fc.Args["chainID"].(*int), fc.Args["type"].(*model.DailyStatisticType), fc.Args["platform"].(*model.Platform), fc.Args["duration"].(*model.Duration), fc.Args["useCache"].(*bool), fc.Args["useMv"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.DateResultByChain)
+	fc.Result = res
+	return ec.marshalODateResultByChain2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDateResultByChain(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_dailyStatisticsByChain(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "date":
+				return ec.fieldContext_DateResultByChain_date(ctx, field)
+			case "ethereum":
+				return ec.fieldContext_DateResultByChain_ethereum(ctx, field)
+			case "optimism":
+				return ec.fieldContext_DateResultByChain_optimism(ctx, field)
+			case "cronos":
+				return ec.fieldContext_DateResultByChain_cronos(ctx, field)
+			case "bsc":
+				return ec.fieldContext_DateResultByChain_bsc(ctx, field)
+			case "polygon":
+				return ec.fieldContext_DateResultByChain_polygon(ctx, field)
+			case "fantom":
+				return ec.fieldContext_DateResultByChain_fantom(ctx, field)
+			case "boba":
+				return ec.fieldContext_DateResultByChain_boba(ctx, field)
+			case "metis":
+				return ec.fieldContext_DateResultByChain_metis(ctx, field)
+			case "moonbeam":
+				return ec.fieldContext_DateResultByChain_moonbeam(ctx, field)
+			case "moonriver":
+				return ec.fieldContext_DateResultByChain_moonriver(ctx, field)
+			case "klaytn":
+				return ec.fieldContext_DateResultByChain_klaytn(ctx, field)
+			case "arbitrum":
+				return ec.fieldContext_DateResultByChain_arbitrum(ctx, field)
+			case "avalanche":
+				return ec.fieldContext_DateResultByChain_avalanche(ctx, field)
+			case "dfk":
+				return ec.fieldContext_DateResultByChain_dfk(ctx, field)
+			case "aurora":
+				return ec.fieldContext_DateResultByChain_aurora(ctx, field)
+			case "harmony":
+				return ec.fieldContext_DateResultByChain_harmony(ctx, field)
+			case "canto":
+				return ec.fieldContext_DateResultByChain_canto(ctx, field)
+			case "dogechain":
+				return ec.fieldContext_DateResultByChain_dogechain(ctx, field)
+			case "base":
+				return ec.fieldContext_DateResultByChain_base(ctx, field)
+			case "blast":
+				return ec.fieldContext_DateResultByChain_blast(ctx, field)
+			case "scroll":
+				return ec.fieldContext_DateResultByChain_scroll(ctx, field)
+			case "total":
+				return ec.fieldContext_DateResultByChain_total(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DateResultByChain", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_dailyStatisticsByChain_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_rankedChainIDsByVolume(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_rankedChainIDsByVolume(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().RankedChainIDsByVolume(rctx, fc.Args["duration"].(*model.Duration), fc.Args["useCache"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.VolumeByChainID)
+	fc.Result = res
+	return ec.marshalOVolumeByChainID2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐVolumeByChainID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_rankedChainIDsByVolume(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "chainID":
+				return ec.fieldContext_VolumeByChainID_chainID(ctx, field)
+			case "total":
+				return ec.fieldContext_VolumeByChainID_total(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VolumeByChainID", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_rankedChainIDsByVolume_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_addressData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_addressData(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AddressData(rctx, fc.Args["address"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.AddressData)
+	fc.Result = res
+	return ec.marshalOAddressData2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐAddressData(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_addressData(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "bridgeVolume":
+				return ec.fieldContext_AddressData_bridgeVolume(ctx, field)
+			case "bridgeFees":
+				return ec.fieldContext_AddressData_bridgeFees(ctx, field)
+			case "bridgeTxs":
+				return ec.fieldContext_AddressData_bridgeTxs(ctx, field)
+			case "swapVolume":
+				return ec.fieldContext_AddressData_swapVolume(ctx, field)
+			case "swapFees":
+				return ec.fieldContext_AddressData_swapFees(ctx, field)
+			case "swapTxs":
+				return ec.fieldContext_AddressData_swapTxs(ctx, field)
+			case "rank":
+				return ec.fieldContext_AddressData_rank(ctx, field)
+			case "earliestTx":
+				return ec.fieldContext_AddressData_earliestTx(ctx, field)
+			case "chainRanking":
+				return ec.fieldContext_AddressData_chainRanking(ctx, field)
+			case "dailyData":
+				return ec.fieldContext_AddressData_dailyData(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AddressData", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_addressData_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_leaderboard(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_leaderboard(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Leaderboard(rctx, fc.Args["duration"].(*model.Duration), fc.Args["chainID"].(*int), fc.Args["useMv"].(*bool), fc.Args["page"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Leaderboard)
+	fc.Result = res
+	return ec.marshalOLeaderboard2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐLeaderboard(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_leaderboard(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_Leaderboard_address(ctx, field)
+			case "volumeUSD":
+				return ec.fieldContext_Leaderboard_volumeUSD(ctx, field)
+			case "fees":
+				return ec.fieldContext_Leaderboard_fees(ctx, field)
+			case "txs":
+				return ec.fieldContext_Leaderboard_txs(ctx, field)
+			case "rank":
+				return ec.fieldContext_Leaderboard_rank(ctx, field)
+			case "avgVolumeUSD":
+				return ec.fieldContext_Leaderboard_avgVolumeUSD(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Leaderboard", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_leaderboard_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getOriginBridgeTx(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getOriginBridgeTx(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetOriginBridgeTx(rctx, fc.Args["chainID"].(int), fc.Args["txnHash"].(string), fc.Args["bridgeType"].(model.BridgeType))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.BridgeWatcherTx)
+	fc.Result = res
+	return ec.marshalOBridgeWatcherTx2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeWatcherTx(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getOriginBridgeTx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "bridgeTx":
+				return ec.fieldContext_BridgeWatcherTx_bridgeTx(ctx, field)
+			case "pending":
+				return ec.fieldContext_BridgeWatcherTx_pending(ctx, field)
+			case "type":
+				return ec.fieldContext_BridgeWatcherTx_type(ctx, field)
+			case "kappa":
+				return ec.fieldContext_BridgeWatcherTx_kappa(ctx, field)
+			case "kappaStatus":
+				return ec.fieldContext_BridgeWatcherTx_kappaStatus(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BridgeWatcherTx", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getOriginBridgeTx_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDestinationBridgeTx(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getDestinationBridgeTx(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetDestinationBridgeTx(rctx, fc.Args["chainID"].(int), fc.Args["address"].(string), fc.Args["kappa"].(string), fc.Args["timestamp"].(int), fc.Args["bridgeType"].(model.BridgeType), fc.Args["historical"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.BridgeWatcherTx)
+	fc.Result = res
+	return ec.marshalOBridgeWatcherTx2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeWatcherTx(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getDestinationBridgeTx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "bridgeTx":
+				return ec.fieldContext_BridgeWatcherTx_bridgeTx(ctx, field)
+			case "pending":
+				return ec.fieldContext_BridgeWatcherTx_pending(ctx, field)
+			case "type":
+				return ec.fieldContext_BridgeWatcherTx_type(ctx, field)
+			case "kappa":
+				return ec.fieldContext_BridgeWatcherTx_kappa(ctx, field)
+			case "kappaStatus":
+				return ec.fieldContext_BridgeWatcherTx_kappaStatus(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BridgeWatcherTx", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDestinationBridgeTx_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getBlockHeight(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getBlockHeight(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetBlockHeight(rctx, fc.Args["contracts"].([]*model.ContractQuery))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.BlockHeight)
+	fc.Result = res
+	return ec.marshalOBlockHeight2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBlockHeight(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getBlockHeight(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "address":
-				return ec.fieldContext_AddressRanking_address(ctx, field)
-			case "count":
-				return ec.fieldContext_AddressRanking_count(ctx, field)
+			case "chainID":
+				return ec.fieldContext_BlockHeight_chainID(ctx, field)
+			case "type":
+				return ec.fieldContext_BlockHeight_type(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_BlockHeight_blockNumber(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AddressRanking", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type BlockHeight", field.Name)
 		},
 	}
 	defer func() {
@@ -6995,15 +9214,15 @@ func (ec *executionContext) fieldContext_Query_addressRanking(ctx context.Contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_addressRanking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_getBlockHeight_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_amountStatistic(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_amountStatistic(ctx, field)
+func (ec *executionContext) _Query_pendingTransfers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_pendingTransfers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7016,7 +9235,7 @@ func (ec *executionContext) _Query_amountStatistic(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AmountStatistic(rctx, fc.Args["type"].(model.StatisticType), fc.Args["duration"].(*model.Duration), fc.Args["platform"].(*model.Platform), fc.Args["chainID"].(*int), fc.Args["address"].(*string), fc.Args["tokenAddress"].(*string), fc.Args["useCache"].(*bool), fc.Args["useMv"].(*bool))
+		return ec.resolvers.Query().PendingTransfers(rctx, fc.Args["chainIDFrom"].(*int), fc.Args["chainIDTo"].(*int), fc.Args["bridgeType"].(*model.BridgeType), fc.Args["page"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7025,12 +9244,12 @@ func (ec *executionContext) _Query_amountStatistic(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.ValueResult)
+	res := resTmp.([]*model.PendingTransfer)
 	fc.Result = res
-	return ec.marshalOValueResult2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐValueResult(ctx, field.Selections, res)
+	return ec.marshalOPendingTransfer2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPendingTransfer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_amountStatistic(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_pendingTransfers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -7038,10 +9257,20 @@ func (ec *executionContext) fieldContext_Query_amountStatistic(ctx context.Conte
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "value":
-				return ec.fieldContext_ValueResult_value(ctx, field)
+			case "fromInfo":
+				return ec.fieldContext_PendingTransfer_fromInfo(ctx, field)
+			case "kappa":
+				return ec.fieldContext_PendingTransfer_kappa(ctx, field)
+			case "bridgeType":
+				return ec.fieldContext_PendingTransfer_bridgeType(ctx, field)
+			case "ageSeconds":
+				return ec.fieldContext_PendingTransfer_ageSeconds(ctx, field)
+			case "slaSeconds":
+				return ec.fieldContext_PendingTransfer_slaSeconds(ctx, field)
+			case "likelyCause":
+				return ec.fieldContext_PendingTransfer_likelyCause(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ValueResult", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PendingTransfer", field.Name)
 		},
 	}
 	defer func() {
@@ -7051,15 +9280,15 @@ func (ec *executionContext) fieldContext_Query_amountStatistic(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_amountStatistic_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_pendingTransfers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_dailyStatisticsByChain(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_dailyStatisticsByChain(ctx, field)
+func (ec *executionContext) _Query_feeStatistics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_feeStatistics(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7072,7 +9301,7 @@ func (ec *executionContext) _Query_dailyStatisticsByChain(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().DailyStatisticsByChain(rctx, fc.Args["chainID"].(*int), fc.Args["type"].(*model.DailyStatisticType), fc.Args["platform"].(*model.Platform), fc.Args["duration"].(*model.Duration), fc.Args["useCache"].(*bool), fc.Args["useMv"].(*bool))
+		return ec.resolvers.Query().FeeStatistics(rctx, fc.Args["chainID"].(*int), fc.Args["platform"].(*model.FeePlatform), fc.Args["duration"].(*model.Duration))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7081,12 +9310,12 @@ func (ec *executionContext) _Query_dailyStatisticsByChain(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.DateResultByChain)
+	res := resTmp.([]*model.FeeStatistic)
 	fc.Result = res
-	return ec.marshalODateResultByChain2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐDateResultByChain(ctx, field.Selections, res)
+	return ec.marshalOFeeStatistic2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐFeeStatistic(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_dailyStatisticsByChain(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_feeStatistics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -7095,53 +9324,237 @@ func (ec *executionContext) fieldContext_Query_dailyStatisticsByChain(ctx contex
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "date":
-				return ec.fieldContext_DateResultByChain_date(ctx, field)
-			case "ethereum":
-				return ec.fieldContext_DateResultByChain_ethereum(ctx, field)
-			case "optimism":
-				return ec.fieldContext_DateResultByChain_optimism(ctx, field)
-			case "cronos":
-				return ec.fieldContext_DateResultByChain_cronos(ctx, field)
-			case "bsc":
-				return ec.fieldContext_DateResultByChain_bsc(ctx, field)
-			case "polygon":
-				return ec.fieldContext_DateResultByChain_polygon(ctx, field)
-			case "fantom":
-				return ec.fieldContext_DateResultByChain_fantom(ctx, field)
-			case "boba":
-				return ec.fieldContext_DateResultByChain_boba(ctx, field)
-			case "metis":
-				return ec.fieldContext_DateResultByChain_metis(ctx, field)
-			case "moonbeam":
-				return ec.fieldContext_DateResultByChain_moonbeam(ctx, field)
-			case "moonriver":
-				return ec.fieldContext_DateResultByChain_moonriver(ctx, field)
-			case "klaytn":
-				return ec.fieldContext_DateResultByChain_klaytn(ctx, field)
-			case "arbitrum":
-				return ec.fieldContext_DateResultByChain_arbitrum(ctx, field)
-			case "avalanche":
-				return ec.fieldContext_DateResultByChain_avalanche(ctx, field)
-			case "dfk":
-				return ec.fieldContext_DateResultByChain_dfk(ctx, field)
-			case "aurora":
-				return ec.fieldContext_DateResultByChain_aurora(ctx, field)
-			case "harmony":
-				return ec.fieldContext_DateResultByChain_harmony(ctx, field)
-			case "canto":
-				return ec.fieldContext_DateResultByChain_canto(ctx, field)
-			case "dogechain":
-				return ec.fieldContext_DateResultByChain_dogechain(ctx, field)
-			case "base":
-				return ec.fieldContext_DateResultByChain_base(ctx, field)
-			case "blast":
-				return ec.fieldContext_DateResultByChain_blast(ctx, field)
-			case "scroll":
-				return ec.fieldContext_DateResultByChain_scroll(ctx, field)
-			case "total":
-				return ec.fieldContext_DateResultByChain_total(ctx, field)
+				return ec.fieldContext_FeeStatistic_date(ctx, field)
+			case "chainID":
+				return ec.fieldContext_FeeStatistic_chainID(ctx, field)
+			case "platform":
+				return ec.fieldContext_FeeStatistic_platform(ctx, field)
+			case "contractAddress":
+				return ec.fieldContext_FeeStatistic_contractAddress(ctx, field)
+			case "token":
+				return ec.fieldContext_FeeStatistic_token(ctx, field)
+			case "tokenSymbol":
+				return ec.fieldContext_FeeStatistic_tokenSymbol(ctx, field)
+			case "count":
+				return ec.fieldContext_FeeStatistic_count(ctx, field)
+			case "volumeUsd":
+				return ec.fieldContext_FeeStatistic_volumeUsd(ctx, field)
+			case "feeUsd":
+				return ec.fieldContext_FeeStatistic_feeUsd(ctx, field)
+			case "protocolFeeUsd":
+				return ec.fieldContext_FeeStatistic_protocolFeeUsd(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FeeStatistic", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_feeStatistics_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_relayerStatistics(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_relayerStatistics(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().RelayerStatistics(rctx, fc.Args["chainID"].(*int), fc.Args["relayer"].(*string), fc.Args["duration"].(*model.Duration))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RelayerStatistic)
+	fc.Result = res
+	return ec.marshalORelayerStatistic2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐRelayerStatistic(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_relayerStatistics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "date":
+				return ec.fieldContext_RelayerStatistic_date(ctx, field)
+			case "chainID":
+				return ec.fieldContext_RelayerStatistic_chainID(ctx, field)
+			case "relayer":
+				return ec.fieldContext_RelayerStatistic_relayer(ctx, field)
+			case "fills":
+				return ec.fieldContext_RelayerStatistic_fills(ctx, field)
+			case "fillShare":
+				return ec.fieldContext_RelayerStatistic_fillShare(ctx, field)
+			case "volumeUsd":
+				return ec.fieldContext_RelayerStatistic_volumeUsd(ctx, field)
+			case "revenueUsd":
+				return ec.fieldContext_RelayerStatistic_revenueUsd(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelayerStatistic", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_relayerStatistics_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_chainFlows(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_chainFlows(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ChainFlows(rctx, fc.Args["chainIDFrom"].(*int), fc.Args["chainIDTo"].(*int), fc.Args["tokenSymbol"].(*string), fc.Args["byToken"].(*bool), fc.Args["duration"].(*model.Duration))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ChainFlow)
+	fc.Result = res
+	return ec.marshalOChainFlow2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐChainFlow(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_chainFlows(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "chainIDFrom":
+				return ec.fieldContext_ChainFlow_chainIDFrom(ctx, field)
+			case "chainIDTo":
+				return ec.fieldContext_ChainFlow_chainIDTo(ctx, field)
+			case "tokenSymbol":
+				return ec.fieldContext_ChainFlow_tokenSymbol(ctx, field)
+			case "count":
+				return ec.fieldContext_ChainFlow_count(ctx, field)
+			case "volumeUsd":
+				return ec.fieldContext_ChainFlow_volumeUsd(ctx, field)
+			case "medianCompletionSeconds":
+				return ec.fieldContext_ChainFlow_medianCompletionSeconds(ctx, field)
+			case "p95CompletionSeconds":
+				return ec.fieldContext_ChainFlow_p95CompletionSeconds(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChainFlow", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_chainFlows_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DateResultByChain", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
 	defer func() {
@@ -7151,15 +9564,15 @@ func (ec *executionContext) fieldContext_Query_dailyStatisticsByChain(ctx contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_dailyStatisticsByChain_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_rankedChainIDsByVolume(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_rankedChainIDsByVolume(ctx, field)
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7172,7 +9585,7 @@ func (ec *executionContext) _Query_rankedChainIDsByVolume(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().RankedChainIDsByVolume(rctx, fc.Args["duration"].(*model.Duration), fc.Args["useCache"].(*bool))
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7181,43 +9594,40 @@ func (ec *executionContext) _Query_rankedChainIDsByVolume(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.VolumeByChainID)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalOVolumeByChainID2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐVolumeByChainID(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_rankedChainIDsByVolume(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "chainID":
-				return ec.fieldContext_VolumeByChainID_chainID(ctx, field)
-			case "total":
-				return ec.fieldContext_VolumeByChainID_total(ctx, field)
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type VolumeByChainID", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_rankedChainIDsByVolume_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_addressData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_addressData(ctx, field)
+func (ec *executionContext) _RelayerStatistic_date(ctx context.Context, field graphql.CollectedField, obj *model.RelayerStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelayerStatistic_date(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7230,7 +9640,7 @@ func (ec *executionContext) _Query_addressData(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AddressData(rctx, fc.Args["address"].(string))
+		return obj.Date, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7239,59 +9649,26 @@ func (ec *executionContext) _Query_addressData(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.AddressData)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOAddressData2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐAddressData(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_addressData(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelayerStatistic_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RelayerStatistic",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "bridgeVolume":
-				return ec.fieldContext_AddressData_bridgeVolume(ctx, field)
-			case "bridgeFees":
-				return ec.fieldContext_AddressData_bridgeFees(ctx, field)
-			case "bridgeTxs":
-				return ec.fieldContext_AddressData_bridgeTxs(ctx, field)
-			case "swapVolume":
-				return ec.fieldContext_AddressData_swapVolume(ctx, field)
-			case "swapFees":
-				return ec.fieldContext_AddressData_swapFees(ctx, field)
-			case "swapTxs":
-				return ec.fieldContext_AddressData_swapTxs(ctx, field)
-			case "rank":
-				return ec.fieldContext_AddressData_rank(ctx, field)
-			case "earliestTx":
-				return ec.fieldContext_AddressData_earliestTx(ctx, field)
-			case "chainRanking":
-				return ec.fieldContext_AddressData_chainRanking(ctx, field)
-			case "dailyData":
-				return ec.fieldContext_AddressData_dailyData(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AddressData", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_addressData_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_leaderboard(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_leaderboard(ctx, field)
+func (ec *executionContext) _RelayerStatistic_chainID(ctx context.Context, field graphql.CollectedField, obj *model.RelayerStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelayerStatistic_chainID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7304,7 +9681,7 @@ func (ec *executionContext) _Query_leaderboard(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Leaderboard(rctx, fc.Args["duration"].(*model.Duration), fc.Args["chainID"].(*int), fc.Args["useMv"].(*bool), fc.Args["page"].(*int))
+		return obj.ChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7313,51 +9690,26 @@ func (ec *executionContext) _Query_leaderboard(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Leaderboard)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOLeaderboard2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐLeaderboard(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_leaderboard(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelayerStatistic_chainID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RelayerStatistic",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "address":
-				return ec.fieldContext_Leaderboard_address(ctx, field)
-			case "volumeUSD":
-				return ec.fieldContext_Leaderboard_volumeUSD(ctx, field)
-			case "fees":
-				return ec.fieldContext_Leaderboard_fees(ctx, field)
-			case "txs":
-				return ec.fieldContext_Leaderboard_txs(ctx, field)
-			case "rank":
-				return ec.fieldContext_Leaderboard_rank(ctx, field)
-			case "avgVolumeUSD":
-				return ec.fieldContext_Leaderboard_avgVolumeUSD(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Leaderboard", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_leaderboard_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getOriginBridgeTx(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getOriginBridgeTx(ctx, field)
+func (ec *executionContext) _RelayerStatistic_relayer(ctx context.Context, field graphql.CollectedField, obj *model.RelayerStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelayerStatistic_relayer(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7370,7 +9722,7 @@ func (ec *executionContext) _Query_getOriginBridgeTx(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetOriginBridgeTx(rctx, fc.Args["chainID"].(int), fc.Args["txnHash"].(string), fc.Args["bridgeType"].(model.BridgeType))
+		return obj.Relayer, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7379,49 +9731,26 @@ func (ec *executionContext) _Query_getOriginBridgeTx(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.BridgeWatcherTx)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOBridgeWatcherTx2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeWatcherTx(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getOriginBridgeTx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelayerStatistic_relayer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RelayerStatistic",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "bridgeTx":
-				return ec.fieldContext_BridgeWatcherTx_bridgeTx(ctx, field)
-			case "pending":
-				return ec.fieldContext_BridgeWatcherTx_pending(ctx, field)
-			case "type":
-				return ec.fieldContext_BridgeWatcherTx_type(ctx, field)
-			case "kappa":
-				return ec.fieldContext_BridgeWatcherTx_kappa(ctx, field)
-			case "kappaStatus":
-				return ec.fieldContext_BridgeWatcherTx_kappaStatus(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type BridgeWatcherTx", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getOriginBridgeTx_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDestinationBridgeTx(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getDestinationBridgeTx(ctx, field)
+func (ec *executionContext) _RelayerStatistic_fills(ctx context.Context, field graphql.CollectedField, obj *model.RelayerStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelayerStatistic_fills(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7434,7 +9763,7 @@ func (ec *executionContext) _Query_getDestinationBridgeTx(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetDestinationBridgeTx(rctx, fc.Args["chainID"].(int), fc.Args["address"].(string), fc.Args["kappa"].(string), fc.Args["timestamp"].(int), fc.Args["bridgeType"].(model.BridgeType), fc.Args["historical"].(*bool))
+		return obj.Fills, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7443,49 +9772,67 @@ func (ec *executionContext) _Query_getDestinationBridgeTx(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.BridgeWatcherTx)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOBridgeWatcherTx2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeWatcherTx(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getDestinationBridgeTx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelayerStatistic_fills(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RelayerStatistic",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "bridgeTx":
-				return ec.fieldContext_BridgeWatcherTx_bridgeTx(ctx, field)
-			case "pending":
-				return ec.fieldContext_BridgeWatcherTx_pending(ctx, field)
-			case "type":
-				return ec.fieldContext_BridgeWatcherTx_type(ctx, field)
-			case "kappa":
-				return ec.fieldContext_BridgeWatcherTx_kappa(ctx, field)
-			case "kappaStatus":
-				return ec.fieldContext_BridgeWatcherTx_kappaStatus(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type BridgeWatcherTx", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelayerStatistic_fillShare(ctx context.Context, field graphql.CollectedField, obj *model.RelayerStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelayerStatistic_fillShare(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDestinationBridgeTx_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FillShare, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RelayerStatistic_fillShare(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelayerStatistic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getBlockHeight(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getBlockHeight(ctx, field)
+func (ec *executionContext) _RelayerStatistic_volumeUsd(ctx context.Context, field graphql.CollectedField, obj *model.RelayerStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelayerStatistic_volumeUsd(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7498,7 +9845,7 @@ func (ec *executionContext) _Query_getBlockHeight(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetBlockHeight(rctx, fc.Args["contracts"].([]*model.ContractQuery))
+		return obj.VolumeUsd, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7507,45 +9854,26 @@ func (ec *executionContext) _Query_getBlockHeight(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.BlockHeight)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalOBlockHeight2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBlockHeight(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getBlockHeight(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelayerStatistic_volumeUsd(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RelayerStatistic",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "chainID":
-				return ec.fieldContext_BlockHeight_chainID(ctx, field)
-			case "type":
-				return ec.fieldContext_BlockHeight_type(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_BlockHeight_blockNumber(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type BlockHeight", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getBlockHeight_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _RelayerStatistic_revenueUsd(ctx context.Context, field graphql.CollectedField, obj *model.RelayerStatistic) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelayerStatistic_revenueUsd(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7558,7 +9886,7 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.RevenueUsd, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7567,109 +9895,98 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelayerStatistic_revenueUsd(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RelayerStatistic",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _Subscription_transferEvents(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_transferEvents(ctx, field)
 	if err != nil {
-		return graphql.Null
+		return nil
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			ret = nil
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return ec.resolvers.Subscription().TransferEvents(rctx, fc.Args["address"].(*string), fc.Args["kappa"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
+		return nil
 	}
 	if resTmp == nil {
-		return graphql.Null
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.TransferEvent):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNTransferEvent2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐTransferEvent(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
 	}
-	res := resTmp.(*introspection.Schema)
-	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_transferEvents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Subscription",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+			case "type":
+				return ec.fieldContext_TransferEvent_type(ctx, field)
+			case "kappa":
+				return ec.fieldContext_TransferEvent_kappa(ctx, field)
+			case "bridgeTx":
+				return ec.fieldContext_TransferEvent_bridgeTx(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TransferEvent", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_transferEvents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
@@ -7966,6 +10283,159 @@ func (ec *executionContext) fieldContext_TransactionCountResult_count(ctx contex
 	return fc, nil
 }
 
+func (ec *executionContext) _TransferEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.TransferEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TransferEvent_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TransferEventType)
+	fc.Result = res
+	return ec.marshalOTransferEventType2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐTransferEventType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TransferEvent_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransferEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TransferEventType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransferEvent_kappa(ctx context.Context, field graphql.CollectedField, obj *model.TransferEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TransferEvent_kappa(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kappa, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TransferEvent_kappa(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransferEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TransferEvent_bridgeTx(ctx context.Context, field graphql.CollectedField, obj *model.TransferEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TransferEvent_bridgeTx(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BridgeTx, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.PartialInfo)
+	fc.Result = res
+	return ec.marshalOPartialInfo2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPartialInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TransferEvent_bridgeTx(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TransferEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "chainID":
+				return ec.fieldContext_PartialInfo_chainID(ctx, field)
+			case "destinationChainID":
+				return ec.fieldContext_PartialInfo_destinationChainID(ctx, field)
+			case "address":
+				return ec.fieldContext_PartialInfo_address(ctx, field)
+			case "txnHash":
+				return ec.fieldContext_PartialInfo_txnHash(ctx, field)
+			case "value":
+				return ec.fieldContext_PartialInfo_value(ctx, field)
+			case "formattedValue":
+				return ec.fieldContext_PartialInfo_formattedValue(ctx, field)
+			case "USDValue":
+				return ec.fieldContext_PartialInfo_USDValue(ctx, field)
+			case "tokenAddress":
+				return ec.fieldContext_PartialInfo_tokenAddress(ctx, field)
+			case "tokenSymbol":
+				return ec.fieldContext_PartialInfo_tokenSymbol(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_PartialInfo_blockNumber(ctx, field)
+			case "time":
+				return ec.fieldContext_PartialInfo_time(ctx, field)
+			case "formattedTime":
+				return ec.fieldContext_PartialInfo_formattedTime(ctx, field)
+			case "formattedEventType":
+				return ec.fieldContext_PartialInfo_formattedEventType(ctx, field)
+			case "eventType":
+				return ec.fieldContext_PartialInfo_eventType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PartialInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _UnknownType_known(ctx context.Context, field graphql.CollectedField, obj *model.UnknownType) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_UnknownType_known(ctx, field)
 	if err != nil {
@@ -10287,6 +12757,54 @@ func (ec *executionContext) _BridgeWatcherTx(ctx context.Context, sel ast.Select
 	return out
 }
 
+var chainFlowImplementors = []string{"ChainFlow"}
+
+func (ec *executionContext) _ChainFlow(ctx context.Context, sel ast.SelectionSet, obj *model.ChainFlow) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chainFlowImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChainFlow")
+		case "chainIDFrom":
+			out.Values[i] = ec._ChainFlow_chainIDFrom(ctx, field, obj)
+		case "chainIDTo":
+			out.Values[i] = ec._ChainFlow_chainIDTo(ctx, field, obj)
+		case "tokenSymbol":
+			out.Values[i] = ec._ChainFlow_tokenSymbol(ctx, field, obj)
+		case "count":
+			out.Values[i] = ec._ChainFlow_count(ctx, field, obj)
+		case "volumeUsd":
+			out.Values[i] = ec._ChainFlow_volumeUsd(ctx, field, obj)
+		case "medianCompletionSeconds":
+			out.Values[i] = ec._ChainFlow_medianCompletionSeconds(ctx, field, obj)
+		case "p95CompletionSeconds":
+			out.Values[i] = ec._ChainFlow_p95CompletionSeconds(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var dateResultImplementors = []string{"DateResult"}
 
 func (ec *executionContext) _DateResult(ctx context.Context, sel ast.SelectionSet, obj *model.DateResult) graphql.Marshaler {
@@ -10405,6 +12923,60 @@ func (ec *executionContext) _DateResultByChain(ctx context.Context, sel ast.Sele
 	return out
 }
 
+var feeStatisticImplementors = []string{"FeeStatistic"}
+
+func (ec *executionContext) _FeeStatistic(ctx context.Context, sel ast.SelectionSet, obj *model.FeeStatistic) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, feeStatisticImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FeeStatistic")
+		case "date":
+			out.Values[i] = ec._FeeStatistic_date(ctx, field, obj)
+		case "chainID":
+			out.Values[i] = ec._FeeStatistic_chainID(ctx, field, obj)
+		case "platform":
+			out.Values[i] = ec._FeeStatistic_platform(ctx, field, obj)
+		case "contractAddress":
+			out.Values[i] = ec._FeeStatistic_contractAddress(ctx, field, obj)
+		case "token":
+			out.Values[i] = ec._FeeStatistic_token(ctx, field, obj)
+		case "tokenSymbol":
+			out.Values[i] = ec._FeeStatistic_tokenSymbol(ctx, field, obj)
+		case "count":
+			out.Values[i] = ec._FeeStatistic_count(ctx, field, obj)
+		case "volumeUsd":
+			out.Values[i] = ec._FeeStatistic_volumeUsd(ctx, field, obj)
+		case "feeUsd":
+			out.Values[i] = ec._FeeStatistic_feeUsd(ctx, field, obj)
+		case "protocolFeeUsd":
+			out.Values[i] = ec._FeeStatistic_protocolFeeUsd(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var heroTypeImplementors = []string{"HeroType", "MessageType"}
 
 func (ec *executionContext) _HeroType(ctx context.Context, sel ast.SelectionSet, obj *model.HeroType) graphql.Marshaler {
@@ -10697,6 +13269,52 @@ func (ec *executionContext) _PartialMessageBusInfo(ctx context.Context, sel ast.
 	return out
 }
 
+var pendingTransferImplementors = []string{"PendingTransfer"}
+
+func (ec *executionContext) _PendingTransfer(ctx context.Context, sel ast.SelectionSet, obj *model.PendingTransfer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pendingTransferImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PendingTransfer")
+		case "fromInfo":
+			out.Values[i] = ec._PendingTransfer_fromInfo(ctx, field, obj)
+		case "kappa":
+			out.Values[i] = ec._PendingTransfer_kappa(ctx, field, obj)
+		case "bridgeType":
+			out.Values[i] = ec._PendingTransfer_bridgeType(ctx, field, obj)
+		case "ageSeconds":
+			out.Values[i] = ec._PendingTransfer_ageSeconds(ctx, field, obj)
+		case "slaSeconds":
+			out.Values[i] = ec._PendingTransfer_slaSeconds(ctx, field, obj)
+		case "likelyCause":
+			out.Values[i] = ec._PendingTransfer_likelyCause(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var petTypeImplementors = []string{"PetType", "MessageType"}
 
 func (ec *executionContext) _PetType(ctx context.Context, sel ast.SelectionSet, obj *model.PetType) graphql.Marshaler {
@@ -11011,6 +13629,82 @@ func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "pendingTransfers":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_pendingTransfers(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "feeStatistics":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_feeStatistics(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "relayerStatistics":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_relayerStatistics(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "chainFlows":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_chainFlows(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "__type":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
@@ -11043,6 +13737,74 @@ func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 	return out
 }
 
+var relayerStatisticImplementors = []string{"RelayerStatistic"}
+
+func (ec *executionContext) _RelayerStatistic(ctx context.Context, sel ast.SelectionSet, obj *model.RelayerStatistic) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relayerStatisticImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RelayerStatistic")
+		case "date":
+			out.Values[i] = ec._RelayerStatistic_date(ctx, field, obj)
+		case "chainID":
+			out.Values[i] = ec._RelayerStatistic_chainID(ctx, field, obj)
+		case "relayer":
+			out.Values[i] = ec._RelayerStatistic_relayer(ctx, field, obj)
+		case "fills":
+			out.Values[i] = ec._RelayerStatistic_fills(ctx, field, obj)
+		case "fillShare":
+			out.Values[i] = ec._RelayerStatistic_fillShare(ctx, field, obj)
+		case "volumeUsd":
+			out.Values[i] = ec._RelayerStatistic_volumeUsd(ctx, field, obj)
+		case "revenueUsd":
+			out.Values[i] = ec._RelayerStatistic_revenueUsd(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "transferEvents":
+		return ec._Subscription_transferEvents(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var tearTypeImplementors = []string{"TearType", "MessageType"}
 
 func (ec *executionContext) _TearType(ctx context.Context, sel ast.SelectionSet, obj *model.TearType) graphql.Marshaler {
@@ -11099,11 +13861,49 @@ func (ec *executionContext) _TokenCountResult(ctx context.Context, sel ast.Selec
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("TokenCountResult")
 		case "chainID":
-			out.Values[i] = ec._TokenCountResult_chainID(ctx, field, obj)
-		case "tokenAddress":
-			out.Values[i] = ec._TokenCountResult_tokenAddress(ctx, field, obj)
+			out.Values[i] = ec._TokenCountResult_chainID(ctx, field, obj)
+		case "tokenAddress":
+			out.Values[i] = ec._TokenCountResult_tokenAddress(ctx, field, obj)
+		case "count":
+			out.Values[i] = ec._TokenCountResult_count(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var transactionCountResultImplementors = []string{"TransactionCountResult"}
+
+func (ec *executionContext) _TransactionCountResult(ctx context.Context, sel ast.SelectionSet, obj *model.TransactionCountResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, transactionCountResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TransactionCountResult")
+		case "chainID":
+			out.Values[i] = ec._TransactionCountResult_chainID(ctx, field, obj)
 		case "count":
-			out.Values[i] = ec._TokenCountResult_count(ctx, field, obj)
+			out.Values[i] = ec._TransactionCountResult_count(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -11127,21 +13927,23 @@ func (ec *executionContext) _TokenCountResult(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var transactionCountResultImplementors = []string{"TransactionCountResult"}
+var transferEventImplementors = []string{"TransferEvent"}
 
-func (ec *executionContext) _TransactionCountResult(ctx context.Context, sel ast.SelectionSet, obj *model.TransactionCountResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, transactionCountResultImplementors)
+func (ec *executionContext) _TransferEvent(ctx context.Context, sel ast.SelectionSet, obj *model.TransferEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, transferEventImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TransactionCountResult")
-		case "chainID":
-			out.Values[i] = ec._TransactionCountResult_chainID(ctx, field, obj)
-		case "count":
-			out.Values[i] = ec._TransactionCountResult_count(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TransferEvent")
+		case "type":
+			out.Values[i] = ec._TransferEvent_type(ctx, field, obj)
+		case "kappa":
+			out.Values[i] = ec._TransferEvent_kappa(ctx, field, obj)
+		case "bridgeTx":
+			out.Values[i] = ec._TransferEvent_bridgeTx(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -11679,6 +14481,20 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) marshalNTransferEvent2githubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐTransferEvent(ctx context.Context, sel ast.SelectionSet, v model.TransferEvent) graphql.Marshaler {
+	return ec._TransferEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNTransferEvent2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐTransferEvent(ctx context.Context, sel ast.SelectionSet, v *model.TransferEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TransferEvent(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -12221,6 +15037,22 @@ func (ec *executionContext) marshalOBridgeTxType2ᚖgithubᚗcomᚋsynapsecnsᚋ
 	return v
 }
 
+func (ec *executionContext) unmarshalOBridgeType2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeType(ctx context.Context, v interface{}) (*model.BridgeType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.BridgeType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBridgeType2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeType(ctx context.Context, sel ast.SelectionSet, v *model.BridgeType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOBridgeWatcherTx2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐBridgeWatcherTx(ctx context.Context, sel ast.SelectionSet, v *model.BridgeWatcherTx) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -12228,6 +15060,54 @@ func (ec *executionContext) marshalOBridgeWatcherTx2ᚖgithubᚗcomᚋsynapsecns
 	return ec._BridgeWatcherTx(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOChainFlow2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐChainFlow(ctx context.Context, sel ast.SelectionSet, v []*model.ChainFlow) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOChainFlow2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐChainFlow(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalOChainFlow2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐChainFlow(ctx context.Context, sel ast.SelectionSet, v *model.ChainFlow) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ChainFlow(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOContractQuery2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐContractQuery(ctx context.Context, v interface{}) ([]*model.ContractQuery, error) {
 	if v == nil {
 		return nil, nil
@@ -12416,6 +15296,70 @@ func (ec *executionContext) marshalODuration2ᚖgithubᚗcomᚋsynapsecnsᚋsang
 	return v
 }
 
+func (ec *executionContext) unmarshalOFeePlatform2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐFeePlatform(ctx context.Context, v interface{}) (*model.FeePlatform, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.FeePlatform)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFeePlatform2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐFeePlatform(ctx context.Context, sel ast.SelectionSet, v *model.FeePlatform) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOFeeStatistic2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐFeeStatistic(ctx context.Context, sel ast.SelectionSet, v []*model.FeeStatistic) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOFeeStatistic2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐFeeStatistic(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalOFeeStatistic2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐFeeStatistic(ctx context.Context, sel ast.SelectionSet, v *model.FeeStatistic) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._FeeStatistic(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
 	if v == nil {
 		return nil, nil
@@ -12629,6 +15573,54 @@ func (ec *executionContext) marshalOPartialMessageBusInfo2ᚖgithubᚗcomᚋsyna
 	return ec._PartialMessageBusInfo(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOPendingTransfer2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPendingTransfer(ctx context.Context, sel ast.SelectionSet, v []*model.PendingTransfer) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOPendingTransfer2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPendingTransfer(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalOPendingTransfer2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPendingTransfer(ctx context.Context, sel ast.SelectionSet, v *model.PendingTransfer) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PendingTransfer(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOPlatform2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐPlatform(ctx context.Context, v interface{}) (*model.Platform, error) {
 	if v == nil {
 		return nil, nil
@@ -12645,6 +15637,54 @@ func (ec *executionContext) marshalOPlatform2ᚖgithubᚗcomᚋsynapsecnsᚋsang
 	return v
 }
 
+func (ec *executionContext) marshalORelayerStatistic2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐRelayerStatistic(ctx context.Context, sel ast.SelectionSet, v []*model.RelayerStatistic) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalORelayerStatistic2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐRelayerStatistic(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalORelayerStatistic2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐRelayerStatistic(ctx context.Context, sel ast.SelectionSet, v *model.RelayerStatistic) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RelayerStatistic(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
 	if v == nil {
 		return nil, nil
@@ -12731,6 +15771,22 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) unmarshalOStuckTransferCause2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐStuckTransferCause(ctx context.Context, v interface{}) (*model.StuckTransferCause, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.StuckTransferCause)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOStuckTransferCause2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐStuckTransferCause(ctx context.Context, sel ast.SelectionSet, v *model.StuckTransferCause) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOTokenCountResult2ᚕᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐTokenCountResult(ctx context.Context, sel ast.SelectionSet, v []*model.TokenCountResult) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -12827,6 +15883,22 @@ func (ec *executionContext) marshalOTransactionCountResult2ᚖgithubᚗcomᚋsyn
 	return ec._TransactionCountResult(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOTransferEventType2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐTransferEventType(ctx context.Context, v interface{}) (*model.TransferEventType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.TransferEventType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTransferEventType2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐTransferEventType(ctx context.Context, sel ast.SelectionSet, v *model.TransferEventType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOValueResult2ᚖgithubᚗcomᚋsynapsecnsᚋsanguineᚋservicesᚋexplorerᚋgraphqlᚋserverᚋgraphᚋmodelᚐValueResult(ctx context.Context, sel ast.SelectionSet, v *model.ValueResult) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -0,0 +1,37 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.36
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+	resolvers "github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/resolver"
+	"github.com/synapsecns/sanguine/services/explorer/subscription"
+)
+
+// TransferEvents is the resolver for the transferEvents field.
+func (r *subscriptionResolver) TransferEvents(ctx context.Context, address *string, kappa *string) (<-chan *model.TransferEvent, error) {
+	filter := subscription.Filter{}
+	if address != nil {
+		filter.Address = *address
+	}
+	if kappa != nil {
+		filter.Kappa = *kappa
+	}
+
+	events, err := r.Subscriptions.Subscribe(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("could not subscribe to transfer events: %w", err)
+	}
+
+	return events, nil
+}
+
+// Subscription returns resolvers.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() resolvers.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
@@ -18,6 +18,7 @@
 	"github.com/synapsecns/sanguine/services/explorer/consumer/parser"
 	"github.com/synapsecns/sanguine/services/explorer/consumer/parser/tokendata"
 	"github.com/synapsecns/sanguine/services/explorer/contracts/bridgeconfig"
+	"github.com/synapsecns/sanguine/services/explorer/contracts/swap"
 	"github.com/synapsecns/sanguine/services/explorer/db"
 	"github.com/synapsecns/sanguine/services/explorer/static"
 	"golang.org/x/sync/errgroup"
@@ -41,32 +42,9 @@ type ExplorerBackfiller struct {
 // nolint:gocognit
 func NewExplorerBackfiller(consumerDB db.ConsumerDB, config indexerConfig.Config, clients map[uint32]bind.ContractBackend, handler metrics.Handler) (*ExplorerBackfiller, error) {
 	chainBackfillers := make(map[uint32]*backfill.ChainBackfiller)
-	httpClient := http.Client{
-		Timeout: 10 * time.Second,
-		Transport: &http.Transport{
-			ResponseHeaderTimeout: 10 * time.Second,
-		},
-	}
-	fetcher := fetcherpkg.NewFetcher(gqlClient.NewClient(&httpClient, config.ScribeURL), handler)
-	bridgeConfigRef, err := bridgeconfig.NewBridgeConfigRef(common.HexToAddress(config.BridgeConfigAddress), clients[config.BridgeConfigChainID])
-	if err != nil || bridgeConfigRef == nil {
-		return nil, fmt.Errorf("could not create bridge config ScribeFetcher: %w", err)
-	}
-	priceDataService, err := tokenprice.NewPriceDataService()
+	fetcher, tokenDataService, priceDataService, err := newServices(consumerDB, config, clients, handler)
 	if err != nil {
-		return nil, fmt.Errorf("could not create price data service: %w", err)
-	}
-	newConfigFetcher, err := fetcherpkg.NewBridgeConfigFetcher(common.HexToAddress(config.BridgeConfigAddress), bridgeConfigRef)
-	if err != nil || newConfigFetcher == nil {
-		return nil, fmt.Errorf("could not get bridge abi: %w", err)
-	}
-	tokenSymbolToIDs, err := parser.ParseYaml(static.GetTokenSymbolToTokenIDConfig())
-	if err != nil {
-		return nil, fmt.Errorf("could not open yaml file: %w", err)
-	}
-	tokenDataService, err := tokendata.NewTokenDataService(newConfigFetcher, tokenSymbolToIDs)
-	if err != nil {
-		return nil, fmt.Errorf("could not create token data service: %w", err)
+		return nil, err
 	}
 
 	// Initialize each chain backfiller.
@@ -120,77 +98,175 @@ func (e ExplorerBackfiller) Backfill(ctx context.Context, livefill bool) error {
 	return nil
 }
 
-// nolint gocognit,cyclop
-func getChainBackfiller(consumerDB db.ConsumerDB, chainConfig indexerConfig.ChainConfig, fetcher fetcherpkg.ScribeFetcher, client bind.ContractBackend, tokenDataService tokendata.Service, priceDataService tokenprice.Service) (*backfill.ChainBackfiller, error) {
-	var err error
-	var bridgeParser *parser.BridgeParser
-	var messageBusParser *parser.MessageBusParser
-	var cctpParser *parser.CCTPParser
-	var swapService fetcherpkg.SwapService
-	var cctpService fetcherpkg.CCTPService
-	var rfqParser *parser.RFQParser
-	var rfqService fetcherpkg.RFQService
-
-	swapParsers := make(map[common.Address]*parser.SwapParser)
-
-	for i := range chainConfig.Contracts {
-		switch chainConfig.Contracts[i].ContractType {
-		case "bridge":
-			bridgeParser, err = parser.NewBridgeParser(consumerDB, common.HexToAddress(chainConfig.Contracts[i].Address), tokenDataService, fetcher, priceDataService, false)
-			if err != nil || bridgeParser == nil {
-				return nil, fmt.Errorf("could not create bridge parser: %w", err)
-			}
-		case "swap":
-			swapService, err = fetcherpkg.NewSwapFetcher(common.HexToAddress(chainConfig.Contracts[i].Address), client, false)
-			if err != nil || swapService == nil {
-				return nil, fmt.Errorf("could not create swapService: %w", err)
-			}
-			swapParser, err := parser.NewSwapParser(consumerDB, common.HexToAddress(chainConfig.Contracts[i].Address), false, fetcher, swapService, tokenDataService, priceDataService)
-			if err != nil || swapParser == nil {
-				return nil, fmt.Errorf("could not create swap parser: %w", err)
-			}
+// NewReparser creates a reparser for the contract of the given type on a chain. The contract address is only
+// needed when the chain has several contracts of that type (e.g. swaps).
+func NewReparser(consumerDB db.ConsumerDB, config indexerConfig.Config, clients map[uint32]bind.ContractBackend, handler metrics.Handler, chainID uint32, contractType string, contractAddress string) (*backfill.Reparser, error) {
+	var chainConfig *indexerConfig.ChainConfig
+	for i := range config.Chains {
+		if config.Chains[i].ChainID == chainID {
+			chainConfig = &config.Chains[i]
+		}
+	}
+	if chainConfig == nil {
+		return nil, fmt.Errorf("chain %d is not in the config", chainID)
+	}
 
-			swapParsers[common.HexToAddress(chainConfig.Contracts[i].Address)] = swapParser
-		case "metaswap":
-			if swapService == nil {
-				swapService, err := fetcherpkg.NewSwapFetcher(common.HexToAddress(chainConfig.Contracts[i].Address), client, true)
-				if err != nil || swapService == nil {
-					return nil, fmt.Errorf("could not create swapService: %w", err)
-				}
-			}
-			swapParser, err := parser.NewSwapParser(consumerDB, common.HexToAddress(chainConfig.Contracts[i].Address), true, fetcher, swapService, tokenDataService, priceDataService)
-			if err != nil || swapParser == nil {
-				return nil, fmt.Errorf("could not create swap parser: %w", err)
-			}
+	var contracts []indexerConfig.ContractConfig
+	for _, contract := range chainConfig.Contracts {
+		if contract.ContractType != contractType {
+			continue
+		}
+		if contractAddress != "" && common.HexToAddress(contract.Address) != common.HexToAddress(contractAddress) {
+			continue
+		}
+		contracts = append(contracts, contract)
+	}
+	switch {
+	case len(contracts) == 0:
+		return nil, fmt.Errorf("no %s contract on chain %d in the config", contractType, chainID)
+	case len(contracts) > 1:
+		return nil, fmt.Errorf("several %s contracts on chain %d in the config, the contract address is required", contractType, chainID)
+	}
 
-			swapParsers[common.HexToAddress(chainConfig.Contracts[i].Address)] = swapParser
-		case "messagebus":
-			messageBusParser, err = parser.NewMessageBusParser(consumerDB, common.HexToAddress(chainConfig.Contracts[i].Address), fetcher, priceDataService)
-			if err != nil || messageBusParser == nil {
-				return nil, fmt.Errorf("could not create message bus parser: %w", err)
-			}
-		case "cctp":
-			cctpService, err = fetcherpkg.NewCCTPFetcher(common.HexToAddress(chainConfig.Contracts[i].Address), client)
-			if err != nil || cctpService == nil {
-				return nil, fmt.Errorf("could not create cctpService: %w", err)
+	fetcher, tokenDataService, priceDataService, err := newServices(consumerDB, config, clients, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	// only create the parser of the reparsed contract.
+	reparseConfig := *chainConfig
+	reparseConfig.Contracts = contracts
+
+	capture := backfill.NewCaptureDB(consumerDB)
+	chainBackfiller, err := getChainBackfiller(capture, reparseConfig, fetcher, clients[chainID], tokenDataService, priceDataService)
+	if err != nil {
+		return nil, fmt.Errorf("could not get chain backfiller: %w", err)
+	}
+
+	return backfill.NewReparser(consumerDB, capture, chainBackfiller, contracts[0]), nil
+}
+
+// newServices creates the scribe fetcher and the token data and price services shared by the chain backfillers.
+func newServices(consumerDB db.ConsumerDB, config indexerConfig.Config, clients map[uint32]bind.ContractBackend, handler metrics.Handler) (fetcherpkg.ScribeFetcher, tokendata.Service, tokenprice.Service, error) {
+	httpClient := http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			ResponseHeaderTimeout: 10 * time.Second,
+		},
+	}
+	fetcher := fetcherpkg.NewFetcher(gqlClient.NewClient(&httpClient, config.ScribeURL), handler, fetcherpkg.WithConsumerToken(config.ScribeConsumerToken))
+	bridgeConfigRef, err := bridgeconfig.NewBridgeConfigRef(common.HexToAddress(config.BridgeConfigAddress), clients[config.BridgeConfigChainID])
+	if err != nil || bridgeConfigRef == nil {
+		return nil, nil, nil, fmt.Errorf("could not create bridge config ScribeFetcher: %w", err)
+	}
+	priceDataService, err := newPriceService(consumerDB, config.Prices, clients, &httpClient)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not create price data service: %w", err)
+	}
+	newConfigFetcher, err := fetcherpkg.NewBridgeConfigFetcher(common.HexToAddress(config.BridgeConfigAddress), bridgeConfigRef)
+	if err != nil || newConfigFetcher == nil {
+		return nil, nil, nil, fmt.Errorf("could not get bridge abi: %w", err)
+	}
+	tokenSymbolToIDs, err := parser.ParseYaml(static.GetTokenSymbolToTokenIDConfig())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not open yaml file: %w", err)
+	}
+	tokenDataService, err := tokendata.NewTokenDataService(newConfigFetcher, tokenSymbolToIDs)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not create token data service: %w", err)
+	}
+
+	return fetcher, tokenDataService, priceDataService, nil
+}
+
+// newPriceService creates the historical price service if it is enabled, otherwise the in-memory price service.
+func newPriceService(consumerDB db.ConsumerDB, config indexerConfig.PricesConfig, clients map[uint32]bind.ContractBackend, httpClient *http.Client) (tokenprice.Service, error) {
+	// the in-memory service prices the quote tokens of pools.
+	memoryService, err := tokenprice.NewPriceDataService()
+	if err != nil {
+		return nil, fmt.Errorf("could not create in-memory price service: %w", err)
+	}
+	if !config.Enabled {
+		return memoryService, nil
+	}
+
+	granularity, err := config.GetGranularity()
+	if err != nil {
+		return nil, fmt.Errorf("could not get price granularity: %w", err)
+	}
+
+	var providers []tokenprice.Provider
+	for _, name := range config.GetProviders() {
+		switch name {
+		case "defillama":
+			providers = append(providers, tokenprice.NewDefiLlamaProvider(tokenprice.DefiLlamaURL, httpClient))
+		case "coingecko":
+			baseURL := tokenprice.CoinGeckoURL
+			if config.CoinGeckoAPIKey != "" {
+				baseURL = tokenprice.CoinGeckoProURL
 			}
-			cctpParser, err = parser.NewCCTPParser(consumerDB, common.HexToAddress(chainConfig.Contracts[i].Address), fetcher, cctpService, tokenDataService, priceDataService, false)
-			if err != nil || cctpParser == nil {
-				return nil, fmt.Errorf("could not create message bus parser: %w", err)
+			providers = append(providers, tokenprice.NewCoinGeckoProvider(baseURL, config.CoinGeckoAPIKey, httpClient))
+		default:
+			return nil, fmt.Errorf("unknown price provider %s", name)
+		}
+	}
+
+	if len(config.Pools) > 0 {
+		quotes := make(map[string]tokenprice.PoolQuote, len(config.Pools))
+		for _, pool := range config.Pools {
+			client, ok := clients[pool.ChainID]
+			if !ok {
+				return nil, fmt.Errorf("no client for chain %d of the pool of %s", pool.ChainID, pool.CoinGeckoID)
 			}
-		case "rfq":
-			rfqService, err = fetcherpkg.NewRFQFetcher(common.HexToAddress(chainConfig.Contracts[i].Address), client)
-			if err != nil || rfqService == nil {
-				return nil, fmt.Errorf("could not create rfqService: %w", err)
+			swapRef, err := swap.NewSwapRef(common.HexToAddress(pool.Address), client)
+			if err != nil {
+				return nil, fmt.Errorf("could not create swap ref for the pool of %s: %w", pool.CoinGeckoID, err)
 			}
-			rfqParser, err = parser.NewRFQParser(consumerDB, common.HexToAddress(chainConfig.Contracts[i].Address), fetcher, rfqService, tokenDataService, priceDataService, false)
-			if err != nil || rfqParser == nil {
-				return nil, fmt.Errorf("could not create message bus parser: %w", err)
+
+			quotes[pool.CoinGeckoID] = tokenprice.PoolQuote{
+				Quoter:             swapRef,
+				TokenIndex:         pool.TokenIndex,
+				TokenDecimals:      pool.TokenDecimals,
+				QuoteTokenIndex:    pool.QuoteTokenIndex,
+				QuoteTokenDecimals: pool.QuoteTokenDecimals,
+				QuoteCoinGeckoID:   pool.QuoteCoinGeckoID,
 			}
 		}
+		providers = append(providers, tokenprice.NewPoolProvider(quotes, memoryService))
+	}
+
+	historicalService, err := tokenprice.NewHistoricalService(consumerDB, granularity, providers...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create historical price service: %w", err)
 	}
 
-	chainBackfiller := backfill.NewChainBackfiller(consumerDB, bridgeParser, swapParsers, messageBusParser, cctpParser, rfqParser, fetcher, chainConfig)
+	return historicalService, nil
+}
+
+// getChainBackfiller creates the backfiller of a chain with the parser registered for the type of each contract.
+func getChainBackfiller(consumerDB db.ConsumerDB, chainConfig indexerConfig.ChainConfig, fetcher fetcherpkg.ScribeFetcher, client bind.ContractBackend, tokenDataService tokendata.Service, priceDataService tokenprice.Service) (*backfill.ChainBackfiller, error) {
+	deps := parser.Dependencies{
+		ConsumerDB:       consumerDB,
+		Fetcher:          fetcher,
+		Client:           client,
+		TokenDataService: tokenDataService,
+		PriceDataService: priceDataService,
+	}
+
+	parsers := make(map[common.Address]parser.Parser)
+	for _, contract := range chainConfig.Contracts {
+		registration, ok := parser.GetRegistration(contract.ContractType)
+		if !ok {
+			return nil, fmt.Errorf("no parser registered for contract type %s", contract.ContractType)
+		}
+
+		eventParser, err := registration.NewParser(deps, common.HexToAddress(contract.Address))
+		if err != nil {
+			return nil, fmt.Errorf("could not create %s parser for %s: %w", contract.ContractType, contract.Address, err)
+		}
+
+		parsers[common.HexToAddress(contract.Address)] = eventParser
+	}
 
-	return chainBackfiller, nil
+	return backfill.NewChainBackfillerWithParsers(consumerDB, parsers, fetcher, chainConfig), nil
 }
@@ -12,6 +12,12 @@
 //go:embed tokenSymbolToTokenID.yaml
 var tokenSymbolToTokenIDMap []byte
 
+//go:embed chainIDs.yaml
+var chainIDsMap []byte
+
+//go:embed chainExplorers.yaml
+var chainExplorersMap []byte
+
 // GetTokenIDToCoingekoConfig returns the tokenID yaml files.
 func GetTokenIDToCoingekoConfig() []byte {
 	return tokenIDToCoingeckoMap
@@ -26,3 +32,13 @@ func GetTokenSymbolToCoingeckoConfig() []byte {
 func GetTokenSymbolToTokenIDConfig() []byte {
 	return tokenSymbolToTokenIDMap
 }
+
+// GetChainIDsConfig returns the chain ID to chain name yaml file.
+func GetChainIDsConfig() []byte {
+	return chainIDsMap
+}
+
+// GetChainExplorersConfig returns the chain ID to block explorer url yaml file.
+func GetChainExplorersConfig() []byte {
+	return chainExplorersMap
+}
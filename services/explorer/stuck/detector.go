@@ -0,0 +1,267 @@
+package stuck
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/synapsecns/sanguine/core/metrics"
+	serverConfig "github.com/synapsecns/sanguine/services/explorer/config/server"
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var logger = log.Logger("explorer-stuck")
+
+const (
+	meterName      = "github.com/synapsecns/sanguine/services/explorer/stuck"
+	stuckName      = "stuck_transfers"
+	newStuckName   = "new_stuck_transfers"
+	originChainID  = "origin_chain_id"
+	destChainID    = "destination_chain_id"
+	bridgeTypeName = "bridge_type"
+	// maxPages bounds the number of pages read by a single run of the detector.
+	maxPages = 50
+)
+
+// pairKey identifies the chain pair and bridge type of a transfer for metrics.
+type pairKey struct {
+	originChainID      uint32
+	destinationChainID uint32
+	bridgeType         string
+}
+
+// Detector periodically looks for stuck transfers, records them as metrics and posts newly stuck transfers to a
+// webhook.
+type Detector struct {
+	// finder finds the stuck transfers.
+	finder *Finder
+	// cfg is the stuck transfers config.
+	cfg serverConfig.StuckTransfersConfig
+	// client is the http client used for webhooks.
+	client *http.Client
+	// handler is the metrics handler.
+	handler metrics.Handler
+	// newStuckCounter counts the transfers that became stuck.
+	newStuckCounter metric.Int64Counter
+	// mux protects counts and alerted.
+	mux sync.Mutex
+	// counts is the number of stuck transfers found by the last run.
+	counts map[pairKey]int64
+	// alerted holds the stuck transfers that were already reported.
+	alerted map[string]bool
+}
+
+// NewDetector creates a new detector and registers its metrics.
+func NewDetector(consumerDB db.ConsumerDBReader, cfg serverConfig.Config, client *http.Client, handler metrics.Handler) (*Detector, error) {
+	d := &Detector{
+		finder:  NewFinder(consumerDB, cfg),
+		cfg:     cfg.StuckTransfers,
+		client:  client,
+		handler: handler,
+		counts:  make(map[pairKey]int64),
+		alerted: make(map[string]bool),
+	}
+
+	meter := handler.Meter(meterName)
+	stuckGauge, err := meter.Int64ObservableGauge(stuckName)
+	if err != nil {
+		return nil, fmt.Errorf("could not create stuck transfers gauge: %w", err)
+	}
+
+	d.newStuckCounter, err = meter.Int64Counter(newStuckName)
+	if err != nil {
+		return nil, fmt.Errorf("could not create new stuck transfers counter: %w", err)
+	}
+
+	if _, err := meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		d.mux.Lock()
+		defer d.mux.Unlock()
+
+		for key, count := range d.counts {
+			o.ObserveInt64(stuckGauge, count, metric.WithAttributes(key.attributes()...))
+		}
+
+		return nil
+	}, stuckGauge); err != nil {
+		return nil, fmt.Errorf("could not register callback for stuck transfers gauge: %w", err)
+	}
+
+	return d, nil
+}
+
+// Start runs the detector until the context is canceled.
+func (d *Detector) Start(ctx context.Context) error {
+	ticker := time.NewTicker(d.cfg.GetCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		err := d.check(ctx)
+		if err != nil {
+			logger.Warnf("could not check stuck transfers: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// check runs the detector once.
+func (d *Detector) check(parentCtx context.Context) (err error) {
+	ctx, span := d.handler.Tracer().Start(parentCtx, "check_stuck_transfers")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	var transfers []Transfer
+	for page := 1; page <= maxPages; page++ {
+		pageTransfers, err := d.finder.Find(ctx, Filter{}, page)
+		if err != nil {
+			return err
+		}
+
+		transfers = append(transfers, pageTransfers...)
+		if len(pageTransfers) < sql.PageSize {
+			break
+		}
+	}
+
+	counts := make(map[pairKey]int64)
+	stuck := make(map[string]bool, len(transfers))
+	var newlyStuck []Transfer
+	for _, transfer := range transfers {
+		counts[newPairKey(transfer)]++
+
+		id := transferID(transfer)
+		stuck[id] = true
+		if !d.isAlerted(id) {
+			newlyStuck = append(newlyStuck, transfer)
+		}
+	}
+
+	d.mux.Lock()
+	d.counts = counts
+	d.mux.Unlock()
+
+	// on failure the transfers are not marked as alerted so the webhook is retried on the next run.
+	if len(newlyStuck) > 0 && d.cfg.WebhookURL != "" {
+		err = d.postWebhook(ctx, newlyStuck)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, transfer := range newlyStuck {
+		d.newStuckCounter.Add(ctx, 1, metric.WithAttributes(newPairKey(transfer).attributes()...))
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	// only keep the transfers that are still stuck, resolved transfers are alerted again if they get stuck again.
+	d.alerted = stuck
+
+	return nil
+}
+
+func (d *Detector) isAlerted(id string) bool {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	return d.alerted[id]
+}
+
+// webhookPayload is the body posted to the webhook.
+type webhookPayload struct {
+	// Text is a summary of the stuck transfers, it is shown by chat webhooks.
+	Text string `json:"text"`
+	// Transfers are the newly stuck transfers.
+	Transfers []webhookTransfer `json:"transfers"`
+}
+
+// webhookTransfer is a stuck transfer posted to the webhook.
+type webhookTransfer struct {
+	OriginChainID      uint32 `json:"origin_chain_id"`
+	DestinationChainID uint32 `json:"destination_chain_id"`
+	TxHash             string `json:"tx_hash"`
+	Kappa              string `json:"kappa"`
+	BridgeType         string `json:"bridge_type"`
+	AgeSeconds         int64  `json:"age_seconds"`
+	SLASeconds         int64  `json:"sla_seconds"`
+	LikelyCause        string `json:"likely_cause"`
+}
+
+func (d *Detector) postWebhook(ctx context.Context, transfers []Transfer) error {
+	payload := webhookPayload{
+		Text:      fmt.Sprintf("%d bridge transfers are stuck", len(transfers)),
+		Transfers: make([]webhookTransfer, len(transfers)),
+	}
+	for i, transfer := range transfers {
+		payload.Transfers[i] = webhookTransfer{
+			OriginChainID:      transfer.Event.FChainID,
+			DestinationChainID: transfer.DestinationChainID(),
+			TxHash:             transfer.Event.FTxHash,
+			Kappa:              transfer.Event.FDestinationKappa,
+			BridgeType:         transfer.BridgeType.String(),
+			AgeSeconds:         int64(transfer.Age.Seconds()),
+			SLASeconds:         int64(transfer.SLA.Seconds()),
+			LikelyCause:        transfer.Cause.String(),
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.cfg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not post webhook: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// transferID gets the unique id of a transfer.
+func transferID(transfer Transfer) string {
+	return fmt.Sprintf("%d-%s-%d", transfer.Event.FChainID, transfer.Event.FTxHash, transfer.Event.FEventIndex)
+}
+
+func newPairKey(transfer Transfer) pairKey {
+	return pairKey{
+		originChainID:      transfer.Event.FChainID,
+		destinationChainID: transfer.DestinationChainID(),
+		bridgeType:         transfer.BridgeType.String(),
+	}
+}
+
+func (k pairKey) attributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int(originChainID, int(k.originChainID)),
+		attribute.Int(destChainID, int(k.destinationChainID)),
+		attribute.String(bridgeTypeName, k.bridgeType),
+	}
+}
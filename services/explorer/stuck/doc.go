@@ -0,0 +1,3 @@
+// Package stuck finds bridge transfers that were not completed on the destination chain within the sla of their
+// chain pair and alerts on them.
+package stuck
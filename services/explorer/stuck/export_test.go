@@ -0,0 +1,21 @@
+package stuck
+
+import (
+	"context"
+	"time"
+)
+
+// SetClock sets the clock used to compute the age of transfers.
+func (f *Finder) SetClock(now func() time.Time) {
+	f.now = now
+}
+
+// SetClock sets the clock used to compute the age of transfers.
+func (d *Detector) SetClock(now func() time.Time) {
+	d.finder.now = now
+}
+
+// Check runs the detector once.
+func (d *Detector) Check(ctx context.Context) error {
+	return d.check(ctx)
+}
@@ -0,0 +1,204 @@
+package stuck
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	serverConfig "github.com/synapsecns/sanguine/services/explorer/config/server"
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
+)
+
+// excludedDestinationChainID is skipped by the pending filter of the bridge transactions query as well.
+const excludedDestinationChainID = 121014925
+
+// Transfer is an origin transaction that has no destination transaction after the sla of its chain pair.
+type Transfer struct {
+	// Event is the origin event of the transfer.
+	Event sql.HybridBridgeEvent
+	// BridgeType is the type of bridge the transfer was sent through.
+	BridgeType model.BridgeType
+	// Age is the time since the origin transaction.
+	Age time.Duration
+	// SLA is the sla of the chain pair of the transfer.
+	SLA time.Duration
+	// Cause is the most likely reason the transfer has not been completed.
+	Cause model.StuckTransferCause
+}
+
+// DestinationChainID gets the destination chain id of the transfer.
+func (t Transfer) DestinationChainID() uint32 {
+	if t.Event.FDestinationChainID == nil {
+		return 0
+	}
+
+	return uint32(t.Event.FDestinationChainID.Uint64())
+}
+
+// Filter narrows down the transfers returned by the finder. Nil fields are ignored.
+type Filter struct {
+	// OriginChainID is the chain id of the origin chain.
+	OriginChainID *int
+	// DestinationChainID is the chain id of the destination chain.
+	DestinationChainID *int
+	// BridgeType is the type of bridge the transfer was sent through.
+	BridgeType *model.BridgeType
+}
+
+// Finder finds stuck transfers.
+type Finder struct {
+	// db is the consumer db reader.
+	db db.ConsumerDBReader
+	// cfg is the server config.
+	cfg serverConfig.Config
+	// now is the clock used to compute the age of transfers.
+	now func() time.Time
+}
+
+// NewFinder creates a new finder.
+func NewFinder(consumerDB db.ConsumerDBReader, cfg serverConfig.Config) *Finder {
+	return &Finder{
+		db:  consumerDB,
+		cfg: cfg,
+		now: time.Now,
+	}
+}
+
+// Find finds a page of stuck transfers, ordered from the most recent to the oldest.
+func (f *Finder) Find(ctx context.Context, filter Filter, page int) ([]Transfer, error) {
+	now := f.now()
+
+	events, err := f.db.GetAllBridgeEvents(ctx, generateStuckTransfersQuery(f.cfg.StuckTransfers, filter, now, page))
+	if err != nil {
+		return nil, fmt.Errorf("could not get stuck transfers: %w", err)
+	}
+
+	latestDestinations := make(map[uint32]uint64)
+	transfers := make([]Transfer, 0, len(events))
+	for i := range events {
+		transfer := Transfer{
+			Event:      events[i],
+			BridgeType: bridgeType(events[i].FEventType),
+		}
+		transfer.SLA = f.cfg.StuckTransfers.GetSLA(events[i].FChainID, transfer.DestinationChainID())
+		if events[i].FTimeStamp != nil {
+			transfer.Age = now.Sub(time.Unix(int64(*events[i].FTimeStamp), 0))
+		}
+
+		transfer.Cause, err = f.likelyCause(ctx, transfer, latestDestinations)
+		if err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, nil
+}
+
+// likelyCause guesses why a transfer has not been completed. latestDestinations caches the timestamp of the latest
+// destination event indexed on each chain.
+func (f *Finder) likelyCause(ctx context.Context, transfer Transfer, latestDestinations map[uint32]uint64) (model.StuckTransferCause, error) {
+	destinationChainID := transfer.DestinationChainID()
+	if _, ok := f.cfg.Chains[destinationChainID]; !ok {
+		return model.StuckTransferCauseDestinationNotIndexed, nil
+	}
+
+	latest, ok := latestDestinations[destinationChainID]
+	if !ok {
+		var err error
+		latest, err = f.db.GetUint64(ctx, generateLatestDestinationQuery(destinationChainID))
+		if err != nil {
+			return "", fmt.Errorf("could not get latest destination event for chain %d: %w", destinationChainID, err)
+		}
+		latestDestinations[destinationChainID] = latest
+	}
+
+	// nothing was indexed on the destination since the transfer was sent, so the transfer may be complete already.
+	if transfer.Event.FTimeStamp != nil && latest < *transfer.Event.FTimeStamp {
+		return model.StuckTransferCauseDestinationIndexerLag, nil
+	}
+
+	switch transfer.BridgeType {
+	case model.BridgeTypeCctp:
+		return model.StuckTransferCauseAwaitingAttestation, nil
+	case model.BridgeTypeRfq:
+		return model.StuckTransferCauseAwaitingRelayer, nil
+	default:
+		return model.StuckTransferCauseAwaitingValidators, nil
+	}
+}
+
+// bridgeType gets the type of bridge from the event type of the origin event.
+func bridgeType(eventType uint8) model.BridgeType {
+	switch eventType {
+	case bridge.CircleRequestSentEvent.Int():
+		return model.BridgeTypeCctp
+	case bridge.BridgeRequestedEvent.Int():
+		return model.BridgeTypeRfq
+	default:
+		return model.BridgeTypeBridge
+	}
+}
+
+// eventTypesList creates the list of an IN clause over the event types.
+func eventTypesList(eventTypes []bridge.EventType) sql.Query {
+	list := sql.Raw("(")
+	for i := range eventTypes {
+		if i > 0 {
+			list = sql.Concat(list, sql.Raw(", "))
+		}
+		list = sql.Concat(list, sql.Param(eventTypes[i].Int()))
+	}
+
+	return sql.Concat(list, sql.Raw(")"))
+}
+
+// generateSLAFilter generates the filter keeping transfers older than the sla of their chain pair.
+func generateSLAFilter(cfg serverConfig.StuckTransfersConfig, now time.Time) sql.Query {
+	defaultSLA := sql.Param(int64(cfg.GetDefaultSLA().Seconds()))
+	if len(cfg.SLAs) == 0 {
+		return sql.Format("ftimestamp + %s <= %s", defaultSLA, sql.Param(now.Unix()))
+	}
+
+	sla := sql.Raw("multiIf(")
+	for _, pair := range cfg.SLAs {
+		sla = sql.Concat(sla, sql.Format("fchain_id = %s AND fdestination_chain_id = %s, %s, ", sql.Param(pair.OriginChainID), sql.Param(pair.DestinationChainID), sql.Param(pair.SLA)))
+	}
+	sla = sql.Concat(sla, defaultSLA, sql.Raw(")"))
+
+	return sql.Format("ftimestamp + %s <= %s", sla, sql.Param(now.Unix()))
+}
+
+// generateStuckTransfersQuery generates the query for a page of stuck transfers.
+func generateStuckTransfersQuery(cfg serverConfig.StuckTransfersConfig, filter Filter, now time.Time, page int) sql.Query {
+	filters := sql.Format(" WHERE fevent_type IN %s AND fdestination_chain_id != %s AND ftimestamp >= %s AND %s",
+		eventTypesList(bridge.OriginEventTypes()), sql.Param(excludedDestinationChainID), sql.Param(now.Add(-cfg.GetLookback()).Unix()), generateSLAFilter(cfg, now))
+	if filter.OriginChainID != nil {
+		filters = sql.Concat(filters, sql.Format(" AND fchain_id = %s", sql.Param(*filter.OriginChainID)))
+	}
+	if filter.DestinationChainID != nil {
+		filters = sql.Concat(filters, sql.Format(" AND fdestination_chain_id = %s", sql.Param(*filter.DestinationChainID)))
+	}
+	if filter.BridgeType != nil {
+		var eventTypes []bridge.EventType
+		for _, eventType := range bridge.OriginEventTypes() {
+			if bridgeType(eventType.Int()) == *filter.BridgeType {
+				eventTypes = append(eventTypes, eventType)
+			}
+		}
+		filters = sql.Concat(filters, sql.Format(" AND fevent_type IN %s", eventTypesList(eventTypes)))
+	}
+
+	return sql.Format("SELECT * FROM (SELECT * FROM mv_bridge_events %s ORDER BY ftimestamp DESC, fblock_number DESC, fevent_index DESC, insert_time DESC LIMIT 1 BY fchain_id, fcontract_address, fevent_type, fblock_number, fevent_index, ftx_hash) WHERE tkappa = '' ORDER BY ftimestamp DESC LIMIT %s OFFSET %s",
+		filters, sql.Param(sql.PageSize), sql.Param((page-1)*sql.PageSize))
+}
+
+// generateLatestDestinationQuery generates the query for the timestamp of the latest destination event on a chain.
+func generateLatestDestinationQuery(chainID uint32) sql.Query {
+	return sql.Format("SELECT toInt64(max(timestamp)) FROM bridge_events WHERE chain_id = %s AND event_type IN %s",
+		sql.Param(chainID), eventTypesList(bridge.DestinationEventTypes()))
+}
@@ -0,0 +1,161 @@
+package stuck_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/core/metrics"
+	serverConfig "github.com/synapsecns/sanguine/services/explorer/config/server"
+	"github.com/synapsecns/sanguine/services/explorer/db/mocks"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+	"github.com/synapsecns/sanguine/services/explorer/stuck"
+	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
+)
+
+var now = time.Unix(1_700_000_000, 0)
+
+func newConfig() serverConfig.Config {
+	return serverConfig.Config{
+		Chains: map[uint32]serverConfig.ChainConfig{
+			1:     {ChainID: 1},
+			42161: {ChainID: 42161},
+		},
+		StuckTransfers: serverConfig.StuckTransfersConfig{
+			DefaultSLA: 3600,
+			SLAs: []serverConfig.ChainPairSLA{
+				{OriginChainID: 1, DestinationChainID: 42161, SLA: 1800},
+			},
+		},
+	}
+}
+
+func newEvent(chainID uint32, destinationChainID int64, eventType bridge.EventType, txHash string, age time.Duration) sql.HybridBridgeEvent {
+	timestamp := uint64(now.Add(-age).Unix())
+	return sql.HybridBridgeEvent{
+		FChainID:            chainID,
+		FDestinationChainID: big.NewInt(destinationChainID),
+		FEventType:          eventType.Int(),
+		FTxHash:             txHash,
+		FDestinationKappa:   txHash + "-kappa",
+		FTimeStamp:          &timestamp,
+	}
+}
+
+func TestFindLikelyCause(t *testing.T) {
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("GetAllBridgeEvents", mock.Anything, mock.Anything).Return([]sql.HybridBridgeEvent{
+		newEvent(1, 42161, bridge.DepositEvent, "0x1", 2*time.Hour),
+		newEvent(1, 42161, bridge.CircleRequestSentEvent, "0x2", 2*time.Hour),
+		newEvent(42161, 1, bridge.BridgeRequestedEvent, "0x3", 2*time.Hour),
+		newEvent(42161, 10, bridge.RedeemEvent, "0x4", 2*time.Hour),
+	}, nil)
+	// chain 42161 indexed a destination event an hour ago, chain 1 three hours ago.
+	consumerDB.On("GetUint64", mock.Anything, mock.MatchedBy(func(query sql.Query) bool {
+		_, args := query.Build()
+		return args[0] == uint32(42161)
+	})).Return(uint64(now.Add(-time.Hour).Unix()), nil)
+	consumerDB.On("GetUint64", mock.Anything, mock.Anything).Return(uint64(now.Add(-3*time.Hour).Unix()), nil)
+
+	finder := stuck.NewFinder(consumerDB, newConfig())
+	finder.SetClock(func() time.Time { return now })
+
+	transfers, err := finder.Find(context.Background(), stuck.Filter{}, 1)
+	require.NoError(t, err)
+	require.Len(t, transfers, 4)
+
+	assert.Equal(t, model.BridgeTypeBridge, transfers[0].BridgeType)
+	assert.Equal(t, model.StuckTransferCauseAwaitingValidators, transfers[0].Cause)
+	assert.Equal(t, 30*time.Minute, transfers[0].SLA)
+	assert.Equal(t, 2*time.Hour, transfers[0].Age)
+
+	assert.Equal(t, model.BridgeTypeCctp, transfers[1].BridgeType)
+	assert.Equal(t, model.StuckTransferCauseAwaitingAttestation, transfers[1].Cause)
+
+	assert.Equal(t, model.BridgeTypeRfq, transfers[2].BridgeType)
+	assert.Equal(t, model.StuckTransferCauseDestinationIndexerLag, transfers[2].Cause)
+	assert.Equal(t, time.Hour, transfers[2].SLA)
+
+	assert.Equal(t, model.StuckTransferCauseDestinationNotIndexed, transfers[3].Cause)
+
+	// the latest destination event is only read once per chain.
+	consumerDB.AssertNumberOfCalls(t, "GetUint64", 2)
+}
+
+func TestFindQuery(t *testing.T) {
+	consumerDB := new(mocks.ConsumerDB)
+	var query sql.Query
+	consumerDB.On("GetAllBridgeEvents", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		query = args.Get(1).(sql.Query)
+	}).Return(nil, nil)
+
+	finder := stuck.NewFinder(consumerDB, newConfig())
+	finder.SetClock(func() time.Time { return now })
+
+	originChainID := 1
+	bridgeType := model.BridgeTypeCctp
+	_, err := finder.Find(context.Background(), stuck.Filter{OriginChainID: &originChainID, BridgeType: &bridgeType}, 2)
+	require.NoError(t, err)
+
+	text, args := query.Build()
+	assert.Contains(t, text, "multiIf(fchain_id = ? AND fdestination_chain_id = ?, ?, ?)")
+	assert.Contains(t, text, "AND fchain_id = ?")
+	assert.Contains(t, text, "tkappa = ''")
+	assert.Contains(t, args, now.Unix())
+	assert.Contains(t, args, now.Add(-7*24*time.Hour).Unix())
+	// the cctp filter and the page offset.
+	assert.Contains(t, args, bridge.CircleRequestSentEvent.Int())
+	assert.Equal(t, sql.PageSize, args[len(args)-1])
+}
+
+func TestDetectorWebhook(t *testing.T) {
+	var received []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		received = append(received, payload)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	events := []sql.HybridBridgeEvent{newEvent(1, 42161, bridge.DepositEvent, "0x1", 2*time.Hour)}
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("GetAllBridgeEvents", mock.Anything, mock.Anything).Return(func(context.Context, sql.Query) []sql.HybridBridgeEvent {
+		return events
+	}, nil)
+	consumerDB.On("GetUint64", mock.Anything, mock.Anything).Return(uint64(now.Unix()), nil)
+
+	cfg := newConfig()
+	cfg.StuckTransfers.WebhookURL = server.URL
+	detector, err := stuck.NewDetector(consumerDB, cfg, server.Client(), metrics.NewNullHandler())
+	require.NoError(t, err)
+	detector.SetClock(func() time.Time { return now })
+
+	require.NoError(t, detector.Check(context.Background()))
+	require.Len(t, received, 1)
+	transfers, ok := received[0]["transfers"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, transfers, 1)
+	assert.Equal(t, "0x1", transfers[0].(map[string]interface{})["tx_hash"])
+	assert.Equal(t, model.StuckTransferCauseAwaitingValidators.String(), transfers[0].(map[string]interface{})["likely_cause"])
+
+	// a transfer is only reported once while it stays stuck.
+	require.NoError(t, detector.Check(context.Background()))
+	require.Len(t, received, 1)
+
+	events = append(events, newEvent(1, 42161, bridge.DepositEvent, "0x2", 2*time.Hour))
+	require.NoError(t, detector.Check(context.Background()))
+	require.Len(t, received, 2)
+	transfers, ok = received[1]["transfers"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, transfers, 1)
+	assert.Equal(t, "0x2", transfers[0].(map[string]interface{})["tx_hash"])
+}
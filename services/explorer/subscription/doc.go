@@ -0,0 +1,4 @@
+// Package subscription pushes the origin, destination and refund events of transfers to graphql subscribers. A single
+// hub reads the newly stored events of every subscribed address and kappa at once and fans them out to subscribers,
+// so the number of database reads does not grow with the number of subscribers.
+package subscription
@@ -0,0 +1,8 @@
+package subscription
+
+import "context"
+
+// Read reads the new events of the subscriptions once.
+func (h *Hub) Read(ctx context.Context) error {
+	return h.read(ctx)
+}
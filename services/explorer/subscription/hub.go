@@ -0,0 +1,327 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-log"
+	serverConfig "github.com/synapsecns/sanguine/services/explorer/config/server"
+	"github.com/synapsecns/sanguine/services/explorer/db"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
+	rfqTypes "github.com/synapsecns/sanguine/services/explorer/types/fastbridge"
+)
+
+var logger = log.Logger("explorer-subscription")
+
+// Filter selects the transfers pushed to a subscriber. At least one field is required.
+type Filter struct {
+	// Address is the sender or recipient of the transfers.
+	Address string
+	// Kappa is the kappa of a transfer, or the transaction id of a rfq transfer.
+	Kappa string
+}
+
+// normalize formats the filter like the stored addresses and kappas.
+func (f Filter) normalize() Filter {
+	return Filter{
+		Address: strings.ToLower(f.Address),
+		Kappa:   strings.TrimPrefix(strings.ToLower(f.Kappa), "0x"),
+	}
+}
+
+// subscriber is a subscription to the events of a filter.
+type subscriber struct {
+	// filter is the normalized filter of the subscription.
+	filter Filter
+	// events receives the events of the filter.
+	events chan *model.TransferEvent
+}
+
+// Hub reads the newly stored transfer events of every subscription at once and pushes them to the subscribers. The
+// events of a contract are read up to the last block the backfiller stored for it, which it only stores once every
+// event below it is stored, so each read picks up exactly where the previous one stopped.
+type Hub struct {
+	// db is the consumer db reader.
+	db db.ConsumerDBReader
+	// cfg is the subscriptions config.
+	cfg serverConfig.SubscriptionsConfig
+	// mux protects every field below.
+	mux sync.Mutex
+	// nextID is the id of the next subscriber.
+	nextID uint64
+	// byAddress holds the subscribers of each address.
+	byAddress map[string]map[uint64]*subscriber
+	// byKappa holds the subscribers of each kappa.
+	byKappa map[string]map[uint64]*subscriber
+	// cursors holds the last block read of each contract, by chain id and lowercase contract address.
+	cursors map[string]uint64
+}
+
+// NewHub creates a new hub.
+func NewHub(consumerDB db.ConsumerDBReader, cfg serverConfig.SubscriptionsConfig) *Hub {
+	return &Hub{
+		db:        consumerDB,
+		cfg:       cfg,
+		byAddress: make(map[string]map[uint64]*subscriber),
+		byKappa:   make(map[string]map[uint64]*subscriber),
+		cursors:   make(map[string]uint64),
+	}
+}
+
+// Subscribe subscribes to the events of the transfers matching a filter. The channel is closed when the context is
+// canceled.
+func (h *Hub) Subscribe(ctx context.Context, filter Filter) (<-chan *model.TransferEvent, error) {
+	filter = filter.normalize()
+	if filter.Address == "" && filter.Kappa == "" {
+		return nil, fmt.Errorf("an address or a kappa is required")
+	}
+
+	h.mux.Lock()
+	id := h.nextID
+	h.nextID++
+	sub := &subscriber{
+		filter: filter,
+		events: make(chan *model.TransferEvent, h.cfg.GetBufferSize()),
+	}
+	addSubscriber(h.byAddress, filter.Address, id, sub)
+	addSubscriber(h.byKappa, filter.Kappa, id, sub)
+	h.mux.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.unsubscribe(id, sub)
+	}()
+
+	return sub.events, nil
+}
+
+// unsubscribe removes a subscriber and closes its channel.
+func (h *Hub) unsubscribe(id uint64, sub *subscriber) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	removeSubscriber(h.byAddress, sub.filter.Address, id)
+	removeSubscriber(h.byKappa, sub.filter.Kappa, id)
+	close(sub.events)
+}
+
+// Start reads the new events of the subscriptions until the context is canceled.
+func (h *Hub) Start(ctx context.Context) error {
+	ticker := time.NewTicker(h.cfg.GetPollInterval())
+	defer ticker.Stop()
+
+	for {
+		err := h.read(ctx)
+		if err != nil {
+			logger.Warnf("could not read transfer events: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// read reads the events stored since the last read and pushes them to their subscribers. Contracts are read from the
+// first read they are seen at, earlier events are not pushed.
+func (h *Hub) read(ctx context.Context) error {
+	lastBlocks, err := h.db.GetContractLastStoredBlocks(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get last stored blocks: %w", err)
+	}
+
+	h.mux.Lock()
+	var ranges []sql.ContractBlockRange
+	for _, lastBlock := range lastBlocks {
+		key := cursorKey(lastBlock.ChainID, lastBlock.ContractAddress)
+		cursor, ok := h.cursors[key]
+		if !ok {
+			h.cursors[key] = lastBlock.BlockNumber
+			continue
+		}
+		if lastBlock.BlockNumber > cursor {
+			ranges = append(ranges, sql.ContractBlockRange{
+				ChainID:         lastBlock.ChainID,
+				ContractAddress: lastBlock.ContractAddress,
+				After:           cursor,
+				Until:           lastBlock.BlockNumber,
+			})
+		}
+	}
+	addresses := keys(h.byAddress)
+	kappas := keys(h.byKappa)
+	if len(ranges) == 0 || len(addresses) == 0 && len(kappas) == 0 {
+		h.advance(ranges)
+		h.mux.Unlock()
+		return nil
+	}
+	h.mux.Unlock()
+
+	// the cursors are only moved once the ranges are read, so a failed read is retried.
+	activities, err := h.db.GetTransferActivity(ctx, ranges, addresses, kappas)
+	if err != nil {
+		return fmt.Errorf("could not get transfer activity: %w", err)
+	}
+
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	h.advance(ranges)
+	for _, activity := range activities {
+		event := toTransferEvent(activity)
+		if event == nil {
+			continue
+		}
+		h.dispatch(activity, event)
+	}
+
+	return nil
+}
+
+// advance moves the cursors to the end of the ranges read. It must be called with the mutex held.
+func (h *Hub) advance(ranges []sql.ContractBlockRange) {
+	for _, blockRange := range ranges {
+		h.cursors[cursorKey(blockRange.ChainID, blockRange.ContractAddress)] = blockRange.Until
+	}
+}
+
+// cursorKey is the key of the cursor of a contract.
+func cursorKey(chainID uint32, contractAddress string) string {
+	return fmt.Sprintf("%d/%s", chainID, strings.ToLower(contractAddress))
+}
+
+// dispatch pushes an event to the subscribers of its addresses and kappa. It must be called with the mutex held.
+func (h *Hub) dispatch(activity sql.TransferActivity, event *model.TransferEvent) {
+	matched := make(map[uint64]*subscriber)
+	for _, address := range []string{activity.Sender, activity.Recipient} {
+		for id, sub := range h.byAddress[strings.ToLower(address)] {
+			matched[id] = sub
+		}
+	}
+	for id, sub := range h.byKappa[strings.TrimPrefix(strings.ToLower(activity.Kappa), "0x")] {
+		matched[id] = sub
+	}
+
+	for _, sub := range matched {
+		select {
+		case sub.events <- event:
+		default:
+			logger.Warnf("dropped transfer event %s for a subscriber that is not keeping up", activity.TxHash)
+		}
+	}
+}
+
+// toTransferEvent converts a transfer activity to a graphql transfer event. It returns nil for events that are not part
+// of a transfer.
+func toTransferEvent(activity sql.TransferActivity) *model.TransferEvent {
+	var eventType model.TransferEventType
+	var formattedEventType string
+	switch {
+	case activity.Platform == "rfq" && activity.EventType == rfqTypes.BridgeDepositRefundedEvent.Int():
+		eventType = model.TransferEventTypeRefunded
+		formattedEventType = rfqTypes.BridgeDepositRefundedEvent.String()
+	case activity.Platform == "bridge" && isEventType(activity.EventType, bridge.OriginEventTypes()):
+		eventType = model.TransferEventTypeOriginSeen
+		formattedEventType = bridge.GetEventType(activity.EventType)
+	case activity.Platform == "bridge" && isEventType(activity.EventType, bridge.DestinationEventTypes()):
+		eventType = model.TransferEventTypeDestinationCompleted
+		formattedEventType = bridge.GetEventType(activity.EventType)
+	default:
+		return nil
+	}
+
+	chainID := int(activity.ChainID)
+	destinationChainID := chainID
+	if eventType == model.TransferEventTypeOriginSeen {
+		destinationChainID = int(activity.DestinationChainID)
+	}
+	address := activity.Recipient
+	if address == "" || activity.EventType == bridge.CircleRequestSentEvent.Int() && eventType == model.TransferEventTypeOriginSeen {
+		address = activity.Sender
+	}
+	blockNumber := int(activity.BlockNumber)
+	timestamp := int(activity.TimeStamp)
+	formattedTime := time.Unix(int64(activity.TimeStamp), 0).String()
+	rawEventType := int(activity.EventType)
+
+	return &model.TransferEvent{
+		Type:  &eventType,
+		Kappa: &activity.Kappa,
+		BridgeTx: &model.PartialInfo{
+			ChainID:            &chainID,
+			DestinationChainID: &destinationChainID,
+			Address:            &address,
+			TxnHash:            &activity.TxHash,
+			Value:              &activity.Amount,
+			FormattedValue:     formatValue(activity.Amount, activity.TokenDecimal),
+			USDValue:           &activity.AmountUSD,
+			TokenAddress:       &activity.Token,
+			TokenSymbol:        &activity.TokenSymbol,
+			BlockNumber:        &blockNumber,
+			Time:               &timestamp,
+			FormattedTime:      &formattedTime,
+			FormattedEventType: &formattedEventType,
+			EventType:          &rawEventType,
+		},
+	}
+}
+
+// formatValue adjusts an amount by the decimals of its token.
+func formatValue(amount string, decimals uint8) *float64 {
+	value, ok := new(big.Float).SetString(amount)
+	if !ok {
+		return nil
+	}
+	decimalMultiplier := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	formatted, err := strconv.ParseFloat(new(big.Float).Quo(value, decimalMultiplier).Text('f', 4), 64)
+	if err != nil {
+		return nil
+	}
+
+	return &formatted
+}
+
+func isEventType(eventType uint8, eventTypes []bridge.EventType) bool {
+	for _, candidate := range eventTypes {
+		if candidate.Int() == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func addSubscriber(index map[string]map[uint64]*subscriber, key string, id uint64, sub *subscriber) {
+	if key == "" {
+		return
+	}
+	if index[key] == nil {
+		index[key] = make(map[uint64]*subscriber)
+	}
+	index[key][id] = sub
+}
+
+func removeSubscriber(index map[string]map[uint64]*subscriber, key string, id uint64) {
+	delete(index[key], id)
+	if len(index[key]) == 0 {
+		delete(index, key)
+	}
+}
+
+func keys(index map[string]map[uint64]*subscriber) []string {
+	res := make([]string, 0, len(index))
+	for key := range index {
+		res = append(res, key)
+	}
+
+	return res
+}
@@ -0,0 +1,186 @@
+package subscription_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	serverConfig "github.com/synapsecns/sanguine/services/explorer/config/server"
+	"github.com/synapsecns/sanguine/services/explorer/db/mocks"
+	"github.com/synapsecns/sanguine/services/explorer/db/sql"
+	"github.com/synapsecns/sanguine/services/explorer/graphql/server/graph/model"
+	"github.com/synapsecns/sanguine/services/explorer/subscription"
+	"github.com/synapsecns/sanguine/services/explorer/types/bridge"
+	"github.com/synapsecns/sanguine/services/explorer/types/fastbridge"
+)
+
+const (
+	sender    = "0x00000000000000000000000000000000000000aa"
+	recipient = "0x00000000000000000000000000000000000000bb"
+	kappa     = "1234abcd"
+	contract  = "0x00000000000000000000000000000000000000Cc"
+)
+
+var (
+	deposit = sql.TransferActivity{
+		Platform:           "bridge",
+		ChainID:            1,
+		DestinationChainID: 42161,
+		EventType:          bridge.DepositEvent.Int(),
+		TxHash:             "0x1",
+		Sender:             sender,
+		Recipient:          recipient,
+		Kappa:              kappa,
+		Amount:             "1500000",
+		TokenDecimal:       6,
+	}
+	withdraw = sql.TransferActivity{
+		Platform:     "bridge",
+		ChainID:      42161,
+		EventType:    bridge.WithdrawEvent.Int(),
+		TxHash:       "0x2",
+		Recipient:    recipient,
+		Kappa:        kappa,
+		Amount:       "1500000",
+		TokenDecimal: 6,
+	}
+	refund = sql.TransferActivity{
+		Platform:  "rfq",
+		ChainID:   10,
+		EventType: fastbridge.BridgeDepositRefundedEvent.Int(),
+		TxHash:    "0x3",
+		Recipient: sender,
+		Kappa:     "5678",
+		Amount:    "2000000",
+	}
+)
+
+// lastBlocks mocks the last stored block of the contract on each chain.
+func lastBlocks(blockNumber uint64) []sql.LastBlock {
+	var res []sql.LastBlock
+	for _, chainID := range []uint32{1, 10, 42161} {
+		res = append(res, sql.LastBlock{ChainID: chainID, ContractAddress: contract, BlockNumber: blockNumber})
+	}
+	return res
+}
+
+// blockRanges are the ranges read after the last blocks move from one block to another.
+func blockRanges(after, until uint64) []sql.ContractBlockRange {
+	var res []sql.ContractBlockRange
+	for _, lastBlock := range lastBlocks(until) {
+		res = append(res, sql.ContractBlockRange{ChainID: lastBlock.ChainID, ContractAddress: contract, After: after, Until: until})
+	}
+	return res
+}
+
+func receive(t *testing.T, events <-chan *model.TransferEvent) []*model.TransferEvent {
+	t.Helper()
+	var res []*model.TransferEvent
+	for {
+		select {
+		case event := <-events:
+			res = append(res, event)
+		default:
+			return res
+		}
+	}
+}
+
+func TestHubFansOutEvents(t *testing.T) {
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("GetContractLastStoredBlocks", mock.Anything).Return(lastBlocks(10), nil).Once()
+	consumerDB.On("GetContractLastStoredBlocks", mock.Anything).Return(lastBlocks(12), nil).Once()
+	consumerDB.On("GetTransferActivity", mock.Anything, blockRanges(10, 12), mock.Anything, mock.Anything).
+		Return([]sql.TransferActivity{deposit, withdraw, refund}, nil).Once()
+
+	hub := subscription.NewHub(consumerDB, serverConfig.SubscriptionsConfig{})
+	ctx := context.Background()
+
+	byRecipient, err := hub.Subscribe(ctx, subscription.Filter{Address: "0x00000000000000000000000000000000000000BB"})
+	require.NoError(t, err)
+	bySender, err := hub.Subscribe(ctx, subscription.Filter{Address: sender})
+	require.NoError(t, err)
+	byKappa, err := hub.Subscribe(ctx, subscription.Filter{Kappa: "0x" + kappa})
+	require.NoError(t, err)
+
+	// the first read only sets the cursors.
+	require.NoError(t, hub.Read(ctx))
+	consumerDB.AssertNotCalled(t, "GetTransferActivity", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	require.NoError(t, hub.Read(ctx))
+	// every subscription is served by a single read.
+	consumerDB.AssertNumberOfCalls(t, "GetTransferActivity", 1)
+
+	recipientEvents := receive(t, byRecipient)
+	require.Len(t, recipientEvents, 2)
+	assert.Equal(t, model.TransferEventTypeOriginSeen, *recipientEvents[0].Type)
+	assert.Equal(t, 42161, *recipientEvents[0].BridgeTx.DestinationChainID)
+	assert.Equal(t, 1.5, *recipientEvents[0].BridgeTx.FormattedValue)
+	assert.Equal(t, model.TransferEventTypeDestinationCompleted, *recipientEvents[1].Type)
+
+	senderEvents := receive(t, bySender)
+	require.Len(t, senderEvents, 2)
+	assert.Equal(t, "0x1", *senderEvents[0].BridgeTx.TxnHash)
+	assert.Equal(t, model.TransferEventTypeRefunded, *senderEvents[1].Type)
+	assert.Equal(t, fastbridge.BridgeDepositRefundedEvent.String(), *senderEvents[1].BridgeTx.FormattedEventType)
+
+	assert.Len(t, receive(t, byKappa), 2)
+}
+
+func TestHubReadsEachBlockOnce(t *testing.T) {
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("GetContractLastStoredBlocks", mock.Anything).Return(lastBlocks(10), nil).Once()
+	consumerDB.On("GetContractLastStoredBlocks", mock.Anything).Return(lastBlocks(12), nil).Twice()
+	consumerDB.On("GetContractLastStoredBlocks", mock.Anything).Return(lastBlocks(15), nil)
+	consumerDB.On("GetTransferActivity", mock.Anything, blockRanges(10, 12), mock.Anything, mock.Anything).
+		Return(nil, errors.New("read failed")).Once()
+	consumerDB.On("GetTransferActivity", mock.Anything, blockRanges(10, 12), mock.Anything, mock.Anything).
+		Return([]sql.TransferActivity{deposit}, nil).Once()
+	consumerDB.On("GetTransferActivity", mock.Anything, blockRanges(12, 15), mock.Anything, mock.Anything).
+		Return(nil, nil).Once()
+
+	hub := subscription.NewHub(consumerDB, serverConfig.SubscriptionsConfig{})
+	ctx := context.Background()
+
+	events, err := hub.Subscribe(ctx, subscription.Filter{Kappa: kappa})
+	require.NoError(t, err)
+
+	require.NoError(t, hub.Read(ctx))
+	// a failed read is retried from the same block.
+	require.Error(t, hub.Read(ctx))
+	require.NoError(t, hub.Read(ctx))
+	require.NoError(t, hub.Read(ctx))
+	assert.Len(t, receive(t, events), 1)
+	consumerDB.AssertExpectations(t)
+}
+
+func TestHubUnsubscribes(t *testing.T) {
+	consumerDB := new(mocks.ConsumerDB)
+	consumerDB.On("GetContractLastStoredBlocks", mock.Anything).Return(lastBlocks(10), nil).Once()
+	consumerDB.On("GetContractLastStoredBlocks", mock.Anything).Return(lastBlocks(12), nil).Once()
+	hub := subscription.NewHub(consumerDB, serverConfig.SubscriptionsConfig{})
+
+	_, err := hub.Subscribe(context.Background(), subscription.Filter{})
+	require.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := hub.Subscribe(ctx, subscription.Filter{Address: sender})
+	require.NoError(t, err)
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not closed")
+	}
+
+	// without subscribers the events are not read.
+	require.NoError(t, hub.Read(context.Background()))
+	require.NoError(t, hub.Read(context.Background()))
+	consumerDB.AssertNotCalled(t, "GetTransferActivity", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
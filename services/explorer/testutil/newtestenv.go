@@ -26,6 +26,9 @@
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+// ConsumerToken is the consumer token of the test Scribe server.
+const ConsumerToken = "consumer-token"
+
 // NewTestEnvDB sets up the test env with a database.
 func NewTestEnvDB(ctx context.Context, t *testing.T, handler metrics.Handler) (db db.ConsumerDB, eventDB scribedb.EventDB, gqlClient *client.Client, logIndex atomic.Int64, cleanup func(), testBackend backends.SimulatedTestBackend, deployManager *DeployManager) {
 	t.Helper()
@@ -42,9 +45,10 @@ func NewTestEnvDB(ctx context.Context, t *testing.T, handler metrics.Handler) (d
 
 	go func() {
 		assert.Nil(t, api.Start(ctx, api.Config{
-			Port:     uint16(freePort),
-			Database: "sqlite",
-			Path:     dbPath,
+			Port:          uint16(freePort),
+			Database:      "sqlite",
+			Path:          dbPath,
+			ConsumerToken: ConsumerToken,
 		}, handler))
 	}()
 
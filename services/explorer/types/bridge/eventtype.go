@@ -51,6 +51,18 @@ func AllEventTypes() []EventType {
 		BridgeRequestedEvent, BridgeRelayedEvent}
 }
 
+// OriginEventTypes is a list of the event types emitted on the origin chain of a transfer.
+func OriginEventTypes() []EventType {
+	return []EventType{DepositEvent, RedeemEvent, DepositAndSwapEvent, RedeemAndSwapEvent, RedeemAndRemoveEvent,
+		RedeemV2Event, CircleRequestSentEvent, BridgeRequestedEvent}
+}
+
+// DestinationEventTypes is a list of the event types emitted on the destination chain of a transfer.
+func DestinationEventTypes() []EventType {
+	return []EventType{WithdrawEvent, MintEvent, MintAndSwapEvent, WithdrawAndRemoveEvent,
+		CircleRequestFulfilledEvent, BridgeRelayedEvent}
+}
+
 // GetEventType gets the str/clear text event type from EventType.
 //
 // nolint:cyclop
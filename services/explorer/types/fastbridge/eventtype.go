@@ -10,11 +10,13 @@
 	BridgeRequestedEvent EventType = iota
 	// BridgeRelayedEvent is emitted when a RFQ request is relayed to the destination chain.
 	BridgeRelayedEvent
+	// BridgeDepositRefundedEvent is emitted when the deposit of a RFQ request is refunded on the origin chain.
+	BridgeDepositRefundedEvent
 )
 
 // AllEventTypes is a list of the event types.
 func AllEventTypes() []EventType {
-	return []EventType{BridgeRequestedEvent, BridgeRelayedEvent}
+	return []EventType{BridgeRequestedEvent, BridgeRelayedEvent, BridgeDepositRefundedEvent}
 }
 
 // Int gets the int value of the event type.
@@ -10,11 +10,12 @@ func _() {
 	var x [1]struct{}
 	_ = x[BridgeRequestedEvent-0]
 	_ = x[BridgeRelayedEvent-1]
+	_ = x[BridgeDepositRefundedEvent-2]
 }
 
-const _EventType_name = "BridgeRequestedEventBridgeRelayedEvent"
+const _EventType_name = "BridgeRequestedEventBridgeRelayedEventBridgeDepositRefundedEvent"
 
-var _EventType_index = [...]uint8{0, 20, 38}
+var _EventType_index = [...]uint8{0, 20, 38, 64}
 
 func (i EventType) String() string {
 	if i >= EventType(len(_EventType_index)-1) {
@@ -100,7 +100,7 @@ func NewQuoterManager(config relconfig.Config, metricsHandler metrics.Handler, i
 
 	var ss client.ScreenerClient
 	if config.ScreenerAPIUrl != "" {
-		ss, err = client.NewClient(metricsHandler, config.ScreenerAPIUrl)
+		ss, err = client.NewClient(metricsHandler, config.ScreenerAPIUrl, client.WithCaller("rfq-relayer"))
 		if err != nil {
 			return nil, fmt.Errorf("error creating screener client: %w", err)
 		}
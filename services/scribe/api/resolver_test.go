@@ -3,6 +3,7 @@
 import (
 	"github.com/synapsecns/sanguine/services/scribe/graphql"
 	"math/big"
+	"net/http"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/ethereum/go-ethereum/common"
@@ -97,3 +98,35 @@ func (g APISuite) TestLogResolvers() {
 	Nil(g.T(), err)
 	Equal(g.T(), *parsedLog, log)
 }
+
+func (g APISuite) TestUpdateConsumerCursor() {
+	chainID := int(gofakeit.Uint32()/2 + 1)
+	withToken := func(token string) func(req *http.Request) {
+		return func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+
+	// consumers without the token are refused
+	_, err := g.gqlClient.UpdateConsumerCursor(g.GetTestContext(), "explorer", chainID, 10)
+	NotNil(g.T(), err)
+	_, err = g.gqlClient.UpdateConsumerCursor(g.GetTestContext(), "explorer", chainID, 10, withToken("wrong-token"))
+	NotNil(g.T(), err)
+
+	// negative values are rejected
+	_, err = g.gqlClient.UpdateConsumerCursor(g.GetTestContext(), "explorer", chainID, -1, withToken(testConsumerToken))
+	NotNil(g.T(), err)
+	_, err = g.gqlClient.UpdateConsumerCursor(g.GetTestContext(), "explorer", -chainID, 10, withToken(testConsumerToken))
+	NotNil(g.T(), err)
+
+	cursor, err := g.gqlClient.UpdateConsumerCursor(g.GetTestContext(), "explorer", chainID, 10, withToken(testConsumerToken))
+	Nil(g.T(), err)
+	Equal(g.T(), "explorer", cursor.Response.Consumer)
+	Equal(g.T(), chainID, cursor.Response.ChainID)
+	Equal(g.T(), 10, cursor.Response.BlockNumber)
+
+	cursors, err := g.db.RetrieveConsumerCursors(g.GetTestContext(), uint32(chainID))
+	Nil(g.T(), err)
+	Len(g.T(), cursors, 1)
+	Equal(g.T(), uint64(10), cursors[0].BlockNumber)
+}
@@ -12,6 +12,7 @@
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/services/scribe/db"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/mysql"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/postgres"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/sqlite"
 	gqlServer "github.com/synapsecns/sanguine/services/scribe/graphql/server"
 	"github.com/synapsecns/sanguine/services/scribe/grpc/server"
@@ -38,6 +39,8 @@ type Config struct {
 	OmniRPCURL string
 	// SkipMigrations skips the database migrations.
 	SkipMigrations bool
+	// ConsumerToken is the bearer token consumers move their cursors with. Cursors can't be moved when it is empty.
+	ConsumerToken string
 }
 
 var logger = log.Logger("scribe-api")
@@ -55,7 +58,7 @@ func Start(ctx context.Context, cfg Config, handler metrics.Handler) error {
 	}
 
 	router.Use(handler.Gin())
-	gqlServer.EnableGraphql(router, eventDB, cfg.OmniRPCURL, handler)
+	gqlServer.EnableGraphql(router, eventDB, cfg.OmniRPCURL, cfg.ConsumerToken, handler)
 	grpcServer, err := server.SetupGRPCServer(ctx, router, eventDB, handler)
 	if err != nil {
 		return fmt.Errorf("could not create grpc server: %w", err)
@@ -156,6 +159,13 @@ func InitDB(ctx context.Context, databaseType string, path string, metrics metri
 		}
 
 		return mysqlStore, nil
+	case databaseType == "postgres":
+		postgresStore, err := postgres.NewPostgresStore(ctx, path, metrics, skipMigrations)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create postgres store: %w", err)
+		}
+
+		return postgresStore, nil
 	default:
 		return nil, fmt.Errorf("invalid databaseType type: %s", databaseType)
 	}
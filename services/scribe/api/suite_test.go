@@ -28,6 +28,9 @@
 	"go.uber.org/atomic"
 )
 
+// testConsumerToken is the token consumers use to report their cursors.
+const testConsumerToken = "consumer-token"
+
 // APISuite defines the basic test suite.
 type APISuite struct {
 	*testsuite.TestSuite
@@ -94,6 +97,7 @@ func (g *APISuite) SetupTest() {
 			Path:           g.dbPath,
 			OmniRPCURL:     "https://rpc.omnirpc.io/confirmations/1/rpc",
 			SkipMigrations: true,
+			ConsumerToken:  testConsumerToken,
 		}, g.metrics))
 	}()
 
@@ -0,0 +1,316 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lmittmann/w3/module/debug"
+	"github.com/lmittmann/w3/w3types"
+)
+
+const (
+	// DebugTraceTransaction traces transactions one at a time with the geth callTracer.
+	DebugTraceTransaction = "debug_traceTransaction"
+	// TraceBlock traces a whole block with the parity/openethereum style tracer.
+	TraceBlock = "trace_block"
+	// debugTraceBlockByNumber traces a whole block with the geth callTracer. It is used to trace the blocks of chains
+	// traced with debug_traceTransaction.
+	debugTraceBlockByNumber = "debug_traceBlockByNumber"
+)
+
+// CallFrame is a single call made while executing a transaction.
+type CallFrame struct {
+	// TraceAddress is the position of the call in the call tree, the top level call has an empty trace address.
+	TraceAddress []int
+	// CallType is the type of the call, e.g. call, delegatecall, staticcall, create.
+	CallType string
+	// From is the caller.
+	From common.Address
+	// To is the callee.
+	To common.Address
+	// Value is the native value sent with the call.
+	Value *big.Int
+	// Gas is the gas provided to the call.
+	Gas uint64
+	// GasUsed is the gas used by the call.
+	GasUsed uint64
+	// Input is the call data.
+	Input []byte
+	// Error is the error the call reverted with, if any.
+	Error string
+}
+
+// GetCallFrames gets the flattened call tree of a transaction using the given trace method.
+func GetCallFrames(ctx context.Context, backend ScribeBackend, traceMethod string, txHash common.Hash, blockNumber uint64) ([]CallFrame, error) {
+	switch traceMethod {
+	case "", DebugTraceTransaction:
+		var callTrace debug.CallTrace
+		if err := backend.BatchWithContext(ctx, debug.CallTraceTx(txHash, nil).Returns(&callTrace)); err != nil {
+			return nil, fmt.Errorf("could not trace tx %s: %w", txHash.String(), err)
+		}
+
+		return flattenCallTrace(&callTrace, []int{}), nil
+	case TraceBlock:
+		blockFrames, err := GetBlockCallFrames(ctx, backend, blockNumber)
+		if err != nil {
+			return nil, err
+		}
+		return blockFrames[txHash], nil
+	default:
+		return nil, fmt.Errorf("unsupported trace method %s", traceMethod)
+	}
+}
+
+// GetBlockCallFrames traces a whole block with trace_block, returning the call frames of each transaction by tx hash.
+func GetBlockCallFrames(ctx context.Context, backend ScribeBackend, blockNumber uint64) (map[common.Hash][]CallFrame, error) {
+	var traces []parityTrace
+	if err := backend.BatchWithContext(ctx, &traceBlockCaller{blockNumber: blockNumber, returns: &traces}); err != nil {
+		return nil, fmt.Errorf("could not trace block %d: %w", blockNumber, err)
+	}
+
+	blockFrames := make(map[common.Hash][]CallFrame)
+	for _, trace := range traces {
+		// block rewards are traced without a transaction.
+		if trace.TransactionHash == nil {
+			continue
+		}
+		blockFrames[*trace.TransactionHash] = append(blockFrames[*trace.TransactionHash], trace.toCallFrame())
+	}
+	return blockFrames, nil
+}
+
+// GetBlockTxCallFrames traces every transaction of a block using the given trace method, returning the call frames of
+// each transaction by tx hash. Chains traced with debug_traceTransaction are traced with debug_traceBlockByNumber, which
+// runs the same callTracer over the whole block.
+func GetBlockTxCallFrames(ctx context.Context, backend ScribeBackend, traceMethod string, blockNumber uint64) (map[common.Hash][]CallFrame, error) {
+	switch traceMethod {
+	case "", DebugTraceTransaction:
+		// older clients do not return the tx hash of the traces, which are in the order of the block's txs.
+		var txTraces []debugTxTrace
+		var block blockTxHashes
+		err := backend.BatchWithContext(ctx,
+			&debugTraceBlockCaller{blockNumber: blockNumber, returns: &txTraces},
+			&blockTxHashesCaller{blockNumber: blockNumber, returns: &block},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not trace block %d: %w", blockNumber, err)
+		}
+		if len(txTraces) != len(block.Transactions) {
+			return nil, fmt.Errorf("could not trace block %d: got %d traces for %d txs", blockNumber, len(txTraces), len(block.Transactions))
+		}
+
+		blockFrames := make(map[common.Hash][]CallFrame, len(txTraces))
+		for i, txTrace := range txTraces {
+			txHash := block.Transactions[i]
+			if txTrace.Result == nil {
+				return nil, fmt.Errorf("could not trace tx %s: %s", txHash.String(), txTrace.Error)
+			}
+			blockFrames[txHash] = flattenCallTrace(txTrace.Result, []int{})
+		}
+		return blockFrames, nil
+	case TraceBlock:
+		return GetBlockCallFrames(ctx, backend, blockNumber)
+	default:
+		return nil, fmt.Errorf("unsupported trace method %s", traceMethod)
+	}
+}
+
+// flattenCallTrace flattens a call trace tree depth first, assigning trace addresses as it goes.
+func flattenCallTrace(callTrace *debug.CallTrace, traceAddress []int) []CallFrame {
+	frames := []CallFrame{{
+		TraceAddress: traceAddress,
+		CallType:     strings.ToLower(callTrace.Type),
+		From:         callTrace.From,
+		To:           callTrace.To,
+		Value:        callTrace.Value,
+		Gas:          callTrace.Gas,
+		GasUsed:      callTrace.GasUsed,
+		Input:        callTrace.Input,
+		Error:        callTrace.Error,
+	}}
+
+	for i, call := range callTrace.Calls {
+		if call == nil {
+			continue
+		}
+		childAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		frames = append(frames, flattenCallTrace(call, append(childAddress, i))...)
+	}
+
+	return frames
+}
+
+// parityTrace is a single trace returned by trace_block.
+type parityTrace struct {
+	Action struct {
+		CallType string          `json:"callType"`
+		From     common.Address  `json:"from"`
+		To       *common.Address `json:"to"`
+		Gas      hexutil.Uint64  `json:"gas"`
+		Input    hexutil.Bytes   `json:"input"`
+		Init     hexutil.Bytes   `json:"init"`
+		Value    *hexutil.Big    `json:"value"`
+		// Address, RefundAddress and Balance are set instead of from, to and value by suicide traces.
+		Address       *common.Address `json:"address"`
+		RefundAddress *common.Address `json:"refundAddress"`
+		Balance       *hexutil.Big    `json:"balance"`
+	} `json:"action"`
+	Result *struct {
+		GasUsed hexutil.Uint64  `json:"gasUsed"`
+		Address *common.Address `json:"address"`
+	} `json:"result"`
+	Error           string       `json:"error"`
+	TraceAddress    []int        `json:"traceAddress"`
+	TransactionHash *common.Hash `json:"transactionHash"`
+	Type            string       `json:"type"`
+}
+
+func (p parityTrace) toCallFrame() CallFrame {
+	frame := CallFrame{
+		TraceAddress: p.TraceAddress,
+		CallType:     p.Action.CallType,
+		From:         p.Action.From,
+		Gas:          uint64(p.Action.Gas),
+		Input:        p.Action.Input,
+		Error:        p.Error,
+	}
+	if frame.TraceAddress == nil {
+		frame.TraceAddress = []int{}
+	}
+	if frame.CallType == "" {
+		frame.CallType = p.Type
+	}
+	if p.Action.To != nil {
+		frame.To = *p.Action.To
+	}
+	if p.Action.Value != nil {
+		frame.Value = p.Action.Value.ToInt()
+	}
+	if p.Result != nil {
+		frame.GasUsed = uint64(p.Result.GasUsed)
+		if p.Result.Address != nil {
+			frame.To = *p.Result.Address
+		}
+	}
+	switch p.Type {
+	case "create":
+		frame.Input = p.Action.Init
+	case "suicide":
+		// the destroyed contract sends its balance to the refund address.
+		if p.Action.Address != nil {
+			frame.From = *p.Action.Address
+		}
+		if p.Action.RefundAddress != nil {
+			frame.To = *p.Action.RefundAddress
+		}
+		if p.Action.Balance != nil {
+			frame.Value = p.Action.Balance.ToInt()
+		}
+	}
+
+	return frame
+}
+
+// traceBlockCaller is a w3 caller for trace_block, which w3 does not ship.
+type traceBlockCaller struct {
+	blockNumber uint64
+	returns     *[]parityTrace
+}
+
+// CreateRequest creates the trace_block batch element.
+func (t *traceBlockCaller) CreateRequest() (rpc.BatchElem, error) {
+	return rpc.BatchElem{
+		Method: TraceBlock,
+		Args:   []any{hexutil.EncodeUint64(t.blockNumber)},
+		Result: t.returns,
+	}, nil
+}
+
+// HandleResponse handles the trace_block batch element.
+func (t *traceBlockCaller) HandleResponse(elem rpc.BatchElem) error {
+	if elem.Error != nil {
+		return elem.Error
+	}
+	if t.returns == nil {
+		return errors.New("trace_block returned no result")
+	}
+	return nil
+}
+
+var _ w3types.Caller = &traceBlockCaller{}
+
+// debugTxTrace is the trace of a single transaction returned by debug_traceBlockByNumber.
+type debugTxTrace struct {
+	TxHash *common.Hash     `json:"txHash"`
+	Result *debug.CallTrace `json:"result"`
+	Error  string           `json:"error"`
+}
+
+// debugTraceBlockCaller is a w3 caller for debug_traceBlockByNumber with the callTracer, which w3 does not ship.
+type debugTraceBlockCaller struct {
+	blockNumber uint64
+	returns     *[]debugTxTrace
+}
+
+// CreateRequest creates the debug_traceBlockByNumber batch element.
+func (d *debugTraceBlockCaller) CreateRequest() (rpc.BatchElem, error) {
+	return rpc.BatchElem{
+		Method: debugTraceBlockByNumber,
+		Args:   []any{hexutil.EncodeUint64(d.blockNumber), map[string]string{"tracer": "callTracer"}},
+		Result: d.returns,
+	}, nil
+}
+
+// HandleResponse handles the debug_traceBlockByNumber batch element.
+func (d *debugTraceBlockCaller) HandleResponse(elem rpc.BatchElem) error {
+	if elem.Error != nil {
+		return elem.Error
+	}
+	if d.returns == nil {
+		return errors.New("debug_traceBlockByNumber returned no result")
+	}
+	return nil
+}
+
+var _ w3types.Caller = &debugTraceBlockCaller{}
+
+// blockTxHashes is a block returned by eth_getBlockByNumber without the full txs.
+type blockTxHashes struct {
+	Transactions []common.Hash `json:"transactions"`
+}
+
+// blockTxHashesCaller is a w3 caller for the tx hashes of a block. w3 only gets blocks with their full txs, which
+// cannot be decoded for every tx type.
+type blockTxHashesCaller struct {
+	blockNumber uint64
+	returns     *blockTxHashes
+}
+
+// CreateRequest creates the eth_getBlockByNumber batch element.
+func (b *blockTxHashesCaller) CreateRequest() (rpc.BatchElem, error) {
+	return rpc.BatchElem{
+		Method: "eth_getBlockByNumber",
+		Args:   []any{hexutil.EncodeUint64(b.blockNumber), false},
+		Result: b.returns,
+	}, nil
+}
+
+// HandleResponse handles the eth_getBlockByNumber batch element.
+func (b *blockTxHashesCaller) HandleResponse(elem rpc.BatchElem) error {
+	if elem.Error != nil {
+		return elem.Error
+	}
+	if b.returns == nil {
+		return errors.New("eth_getBlockByNumber returned no result")
+	}
+	return nil
+}
+
+var _ w3types.Caller = &blockTxHashesCaller{}
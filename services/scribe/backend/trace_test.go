@@ -0,0 +1,94 @@
+package backend_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/backend"
+	"github.com/synapsecns/sanguine/services/scribe/testutil"
+)
+
+var (
+	eoa    = common.HexToAddress("0xe0")
+	router = common.HexToAddress("0xaa")
+	impl   = common.HexToAddress("0xbb")
+	token  = common.HexToAddress("0xcc")
+)
+
+// normalizeValues replaces the values of frames with big ints equal to them, since zero values decode with a non nil abs.
+func normalizeValues(frames []backend.CallFrame) []backend.CallFrame {
+	for i := range frames {
+		if frames[i].Value != nil {
+			frames[i].Value, _ = new(big.Int).SetString(frames[i].Value.String(), 10)
+		}
+	}
+	return frames
+}
+
+func TestCallTraceFrames(t *testing.T) {
+	txHash := common.HexToHash("0xabc")
+	traceBackend := testutil.NewTraceBackend(t, map[common.Hash]json.RawMessage{txHash: testutil.CallTraceFixture}, nil)
+
+	frames, err := backend.GetCallFrames(context.Background(), traceBackend, backend.DebugTraceTransaction, txHash, 0)
+	Nil(t, err)
+
+	// the call tree is flattened depth first.
+	Equal(t, normalizeValues([]backend.CallFrame{
+		{TraceAddress: []int{}, CallType: "call", From: eoa, To: router, Value: big.NewInt(0), Gas: 200000, GasUsed: 120000, Input: hexutil.MustDecode("0x12345678")},
+		{TraceAddress: []int{0}, CallType: "delegatecall", From: router, To: impl, Gas: 10000, GasUsed: 5000, Input: hexutil.MustDecode("0xabcdef01")},
+		{TraceAddress: []int{0, 0}, CallType: "staticcall", From: router, To: token, Gas: 1000, GasUsed: 500, Input: hexutil.MustDecode("0x70a08231")},
+		{TraceAddress: []int{1}, CallType: "call", From: router, To: token, Value: big.NewInt(1e18), Gas: 10000, GasUsed: 10000, Input: hexutil.MustDecode("0xa9059cbb"), Error: "execution reverted"},
+	}), normalizeValues(frames))
+}
+
+func TestTraceBlockFrames(t *testing.T) {
+	traceBackend := testutil.NewTraceBackend(t, nil, map[uint64]json.RawMessage{testutil.TraceBlockNumber: testutil.TraceBlockFixture})
+
+	blockFrames, err := backend.GetBlockCallFrames(context.Background(), traceBackend, testutil.TraceBlockNumber)
+	Nil(t, err)
+
+	// the block reward has no tx, so only the frames of the two txes are returned.
+	Len(t, blockFrames, 2)
+	Equal(t, normalizeValues([]backend.CallFrame{
+		{TraceAddress: []int{}, CallType: "call", From: eoa, To: router, Value: big.NewInt(0), Gas: 200000, GasUsed: 120000, Input: hexutil.MustDecode("0x12345678")},
+		{TraceAddress: []int{0}, CallType: "call", From: router, To: token, Value: big.NewInt(1e18), Gas: 10000, GasUsed: 5000, Input: hexutil.MustDecode("0xa9059cbb")},
+		{TraceAddress: []int{1}, CallType: "create", From: router, To: common.HexToAddress("0xdd"), Value: big.NewInt(0), Gas: 30000, GasUsed: 20000, Input: hexutil.MustDecode("0x6080604052")},
+	}), normalizeValues(blockFrames[testutil.TraceBlockTxA]))
+	Equal(t, normalizeValues([]backend.CallFrame{
+		{TraceAddress: []int{}, CallType: "call", From: common.HexToAddress("0xe1"), To: router, Value: big.NewInt(0), Gas: 200000, GasUsed: 21000, Input: hexutil.MustDecode("0x87654321")},
+		{TraceAddress: []int{0}, CallType: "staticcall", From: router, To: token, Value: big.NewInt(0), Gas: 1000, Input: hexutil.MustDecode("0x70a08231"), Error: "Reverted"},
+		// the destroyed contract sends its balance to the refund address.
+		{TraceAddress: []int{1}, CallType: "suicide", From: common.HexToAddress("0xdd"), To: router, Value: big.NewInt(1e16)},
+	}), normalizeValues(blockFrames[testutil.TraceBlockTxB]))
+
+	// the frames of a single tx are taken from the block trace.
+	frames, err := backend.GetCallFrames(context.Background(), traceBackend, backend.TraceBlock, testutil.TraceBlockTxB, testutil.TraceBlockNumber)
+	Nil(t, err)
+	Equal(t, blockFrames[testutil.TraceBlockTxB], normalizeValues(frames))
+}
+
+func TestBlockTxCallFrames(t *testing.T) {
+	txA := common.HexToHash("0xabc")
+	txB := common.HexToHash("0xdef")
+	traceBackend := testutil.NewTraceBackend(t, map[common.Hash]json.RawMessage{txA: testutil.CallTraceFixture, txB: testutil.CallTraceFixture}, map[uint64]json.RawMessage{testutil.TraceBlockNumber: testutil.TraceBlockFixture})
+
+	// debug_traceTransaction chains trace the block with debug_traceBlockByNumber.
+	blockFrames, err := backend.GetBlockTxCallFrames(context.Background(), traceBackend, backend.DebugTraceTransaction, 1)
+	Nil(t, err)
+	Len(t, blockFrames, 2)
+	txFrames, err := backend.GetCallFrames(context.Background(), traceBackend, backend.DebugTraceTransaction, txA, 1)
+	Nil(t, err)
+	Equal(t, normalizeValues(txFrames), normalizeValues(blockFrames[txA]))
+	Equal(t, normalizeValues(txFrames), normalizeValues(blockFrames[txB]))
+
+	// trace_block chains trace the block with trace_block.
+	blockFrames, err = backend.GetBlockTxCallFrames(context.Background(), traceBackend, backend.TraceBlock, testutil.TraceBlockNumber)
+	Nil(t, err)
+	Len(t, blockFrames, 2)
+	Equal(t, int64(2), traceBackend.BlockTraceCount())
+}
@@ -5,11 +5,13 @@
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/services/scribe/backend"
 	"github.com/synapsecns/sanguine/services/scribe/service"
+	"context"
 	// used to embed markdown.
 	_ "embed"
 	"fmt"
 
 	markdown "github.com/MichaelMure/go-term-markdown"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/consul/sdk/freeport"
 	"github.com/jftuga/termsize"
 	"github.com/synapsecns/sanguine/core"
@@ -50,7 +52,7 @@
 
 var dbFlag = &cli.StringFlag{
 	Name:     "db",
-	Usage:    "--db <sqlite> or <mysql>",
+	Usage:    "--db <sqlite>, <mysql> or <postgres>",
 	Value:    "sqlite",
 	Required: true,
 }
@@ -112,7 +114,7 @@ func createScribeParameters(c *cli.Context) (eventDB db.EventDB, clients map[uin
 var serverCommand = &cli.Command{
 	Name:        "server",
 	Description: "starts a graphql server",
-	Flags:       []cli.Flag{portFlag, dbFlag, pathFlag, omniRPCFlag, skipMigrationServerFlag},
+	Flags:       []cli.Flag{portFlag, dbFlag, pathFlag, omniRPCFlag, skipMigrationServerFlag, consumerTokenFlag},
 	Action: func(c *cli.Context) error {
 		err := api.Start(c.Context, api.Config{
 			Port:           uint16(c.Uint(portFlag.Name)),
@@ -120,6 +122,7 @@ func createScribeParameters(c *cli.Context) (eventDB db.EventDB, clients map[uin
 			Path:           c.String(pathFlag.Name),
 			OmniRPCURL:     c.String(omniRPCFlag.Name),
 			SkipMigrations: c.Bool(skipMigrationServerFlag.Name),
+			ConsumerToken:  c.String(consumerTokenFlag.Name),
 		}, metrics.Get())
 		if err != nil {
 			return fmt.Errorf("could not start server: %w", err)
@@ -129,12 +132,141 @@ func createScribeParameters(c *cli.Context) (eventDB db.EventDB, clients map[uin
 	},
 }
 
+var chainIDFlag = &cli.UintFlag{
+	Name:     "chain-id",
+	Usage:    "--chain-id 1",
+	Required: true,
+}
+
+var addressFlag = &cli.StringFlag{
+	Name:  "address",
+	Usage: "--address <contract address>, verifies all contracts on the chain if unset",
+}
+
+var startBlockFlag = &cli.Uint64Flag{
+	Name:  "start",
+	Usage: "--start <block number>, defaults to the contract's start block",
+}
+
+var endBlockFlag = &cli.Uint64Flag{
+	Name:  "end",
+	Usage: "--end <block number>, defaults to the contract's last indexed block",
+}
+
+var repairFlag = &cli.BoolFlag{
+	Name:  "repair",
+	Usage: "--repair re-indexes block ranges that do not match the chain",
+	Value: false,
+}
+
+var verifyCommand = &cli.Command{
+	Name:        "verify",
+	Description: "verifies stored logs for a contract match the chain and optionally repairs broken ranges",
+	Flags:       []cli.Flag{configFlag, dbFlag, pathFlag, chainIDFlag, addressFlag, startBlockFlag, endBlockFlag, repairFlag},
+	Action: func(c *cli.Context) error {
+		eventDB, clients, scribeConfig, err := createScribeParameters(c)
+		if err != nil {
+			return err
+		}
+
+		chainID := uint32(c.Uint(chainIDFlag.Name))
+		var chainConfig *config.ChainConfig
+		for i := range scribeConfig.Chains {
+			if scribeConfig.Chains[i].ChainID == chainID {
+				chainConfig = &scribeConfig.Chains[i]
+			}
+		}
+		if chainConfig == nil {
+			return fmt.Errorf("chain %d is not in the config", chainID)
+		}
+
+		verifier, err := service.NewVerifier(eventDB, clients[chainID], *chainConfig, metrics.Get())
+		if err != nil {
+			return fmt.Errorf("could not create verifier: %w", err)
+		}
+
+		for _, contract := range chainConfig.Contracts {
+			if c.IsSet(addressFlag.Name) && common.HexToAddress(contract.Address) != common.HexToAddress(c.String(addressFlag.Name)) {
+				continue
+			}
+
+			err = verifyContract(c, eventDB, verifier, chainID, contract)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+// verifyContract verifies a single contract, printing the discrepancies found and repairing them if the repair flag is set.
+func verifyContract(c *cli.Context, eventDB db.EventDB, verifier *service.Verifier, chainID uint32, contract config.ContractConfig) error {
+	contractAddress := common.HexToAddress(contract.Address)
+
+	startBlock := contract.StartBlock
+	if c.IsSet(startBlockFlag.Name) {
+		startBlock = c.Uint64(startBlockFlag.Name)
+	}
+
+	endBlock := c.Uint64(endBlockFlag.Name)
+	if !c.IsSet(endBlockFlag.Name) {
+		lastIndexed, err := eventDB.RetrieveLastIndexed(c.Context, contractAddress, chainID, false)
+		if err != nil {
+			return fmt.Errorf("could not get last indexed for %s: %w", contractAddress, err)
+		}
+		endBlock = lastIndexed
+	}
+
+	// each window is printed and repaired as soon as it is verified.
+	report, err := verifier.Verify(c.Context, contractAddress, startBlock, endBlock, func(ctx context.Context, report *service.VerificationReport) error {
+		return reportWindow(ctx, verifier, report, c.Bool(repairFlag.Name))
+	})
+	if err != nil {
+		return fmt.Errorf("could not verify %s: %w", contractAddress, err)
+	}
+
+	fmt.Printf("chain %d contract %s blocks %d-%d: %d logs on chain, %d logs stored, %d blocks mismatched\n",
+		chainID, contractAddress, startBlock, endBlock, report.ChainLogCount, report.DBLogCount, len(report.Discrepancies))
+	return nil
+}
+
+// reportWindow prints the discrepancies of a verified window and repairs them if repair is set.
+func reportWindow(ctx context.Context, verifier *service.Verifier, report *service.VerificationReport, repair bool) error {
+	fmt.Printf("  blocks %d-%d: %d logs on chain, %d logs stored, %d blocks mismatched\n",
+		report.StartBlock, report.EndBlock, report.ChainLogCount, report.DBLogCount, len(report.Discrepancies))
+	for _, discrepancy := range report.Discrepancies {
+		fmt.Printf("    block %d: %d logs on chain (%s), %d logs stored (%s), %d stale block hashes\n",
+			discrepancy.BlockNumber, discrepancy.ChainLogCount, discrepancy.ChainHash, discrepancy.DBLogCount, discrepancy.DBHash, len(discrepancy.StaleBlockHashes))
+	}
+
+	if report.IsValid() || !repair {
+		return nil
+	}
+
+	for _, brokenRange := range report.BrokenRanges() {
+		fmt.Printf("    re-indexing blocks %d-%d\n", brokenRange.StartBlock, brokenRange.EndBlock)
+	}
+
+	err := verifier.Repair(ctx, report)
+	if err != nil {
+		return fmt.Errorf("could not repair blocks %d to %d: %w", report.StartBlock, report.EndBlock, err)
+	}
+	return nil
+}
+
 var omniRPCFlag = &cli.StringFlag{
 	Name:     "omnirpc",
 	Usage:    "--omnirpc https://omnirpc.url",
 	Required: true,
 }
 
+var consumerTokenFlag = &cli.StringFlag{
+	Name:    "consumer-token",
+	Usage:   "--consumer-token <token>, the bearer token consumers move their cursors with",
+	EnvVars: []string{"SCRIBE_CONSUMER_TOKEN"},
+}
+
 var skipMigrationFlag = &cli.BoolFlag{
 	Name:  "skip-migrations",
 	Usage: "--skip-migrations",
@@ -32,6 +32,32 @@ type ChainConfig struct {
 	LivefillRange uint64 `yaml:"livefill_range"`
 	// LivefillFlushInterval is how long to wait before flushing the livefill indexer db (in seconds)
 	LivefillFlushInterval uint64 `yaml:"livefill_flush_interval"`
+	// IndexBlockHeaders stores the full block header of every block in the indexed range.
+	IndexBlockHeaders bool `yaml:"index_block_headers"`
+	// IndexTraces stores the internal calls of every transaction in the indexed range that touch a configured contract.
+	IndexTraces bool `yaml:"index_traces"`
+	// TraceMethod is the rpc method used to fetch traces, either debug_traceTransaction (default, blocks are traced with debug_traceBlockByNumber) or trace_block.
+	TraceMethod string `yaml:"trace_method"`
+	// Retention is the data retention policy for the chain. If unset, data is never pruned.
+	Retention *RetentionConfig `yaml:"retention"`
+	// PruneInterval is how often to run the pruner (in seconds).
+	PruneInterval uint64 `yaml:"prune_interval"`
+	// ConsumerCursorTTL is how long a consumer cursor is considered live after its last update (in seconds).
+	// The pruner never prunes past a live consumer cursor.
+	ConsumerCursorTTL uint64 `yaml:"consumer_cursor_ttl"`
+}
+
+// HasRetention returns true if the chain or any of its contracts has a retention policy.
+func (c ChainConfig) HasRetention() bool {
+	if c.Retention != nil {
+		return true
+	}
+	for _, contract := range c.Contracts {
+		if contract.Retention != nil {
+			return true
+		}
+	}
+	return false
 }
 
 // ChainConfigs contains an array of ChainConfigs.
@@ -63,6 +89,14 @@ func (c ChainConfig) IsValid() (ok bool, err error) {
 	if c.ChainID == 0 {
 		return false, fmt.Errorf("%w: chain ID cannot be 0", ErrInvalidChainID)
 	}
+	if c.IndexTraces && c.TraceMethod != "" && c.TraceMethod != "debug_traceTransaction" && c.TraceMethod != "trace_block" {
+		return false, fmt.Errorf("%w: %s", ErrUnsupportedTraceMethod, c.TraceMethod)
+	}
+	if c.Retention != nil {
+		if ok, err = c.Retention.IsValid(); !ok {
+			return false, err
+		}
+	}
 	if ok, err = c.Contracts.IsValid(); !ok {
 		return false, err
 	}
@@ -17,6 +17,8 @@ type ContractConfig struct {
 	EndBlock uint64 `yaml:"end_block"`
 	// RefreshRate is the rate at which the contract is refreshed.
 	RefreshRate uint64 `yaml:"refresh_rate"`
+	// Retention overrides the chain retention for the logs of this contract.
+	Retention *RetentionConfig `yaml:"retention"`
 }
 
 // ContractConfigs contains a list of ContractConfigs.
@@ -53,5 +55,13 @@ func (c ContractConfig) IsValid() (ok bool, err error) {
 	if len(c.Address) != (common.AddressLength*2)+2 {
 		return false, fmt.Errorf("address not correct length: %w", ErrAddressLength)
 	}
+	if c.Retention != nil {
+		if c.Retention.PruneReceiptsAndTxs {
+			return false, fmt.Errorf("contract %s: %w", c.Address, ErrContractReceiptRetention)
+		}
+		if ok, err = c.Retention.IsValid(); !ok {
+			return false, err
+		}
+	}
 	return true, nil
 }
@@ -6,7 +6,7 @@
 type DBConfig struct {
 	// Type of the database to use for sql.
 	Type string `toml:"Type"`
-	// ConnString is the connection string used for mysql
+	// ConnString is the connection string used for mysql and postgres
 	ConnString string `toml:"ConnString"`
 }
 
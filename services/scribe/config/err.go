@@ -16,3 +16,12 @@
 
 // ErrAddressLength indicates that an invalid address length is found.
 var ErrAddressLength = errors.New("invalid address length")
+
+// ErrUnsupportedTraceMethod indicates that the trace method is not supported.
+var ErrUnsupportedTraceMethod = errors.New("unsupported trace method")
+
+// ErrInvalidTopic indicates that a topic is not a valid hash.
+var ErrInvalidTopic = errors.New("invalid topic")
+
+// ErrContractReceiptRetention indicates that receipt and tx pruning was set on a contract instead of a chain.
+var ErrContractReceiptRetention = errors.New("prune_receipts_and_txs can only be set on a chain")
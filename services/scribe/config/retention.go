@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RetentionConfig defines how long indexed data is kept for. Set on a chain it applies to every contract on the chain,
+// set on a contract it overrides the chain retention for that contract's logs.
+type RetentionConfig struct {
+	// KeepBlocks is the number of blocks behind the last confirmed block to keep. 0 keeps every block.
+	KeepBlocks uint64 `yaml:"keep_blocks"`
+	// KeepDays is the number of days of data to keep, based on stored block times. 0 keeps every day.
+	// If both KeepBlocks and KeepDays are set, data is kept as long as either rule keeps it.
+	KeepDays uint64 `yaml:"keep_days"`
+	// KeepTopics, if set, prunes confirmed logs whose first topic is not in the list.
+	KeepTopics []string `yaml:"keep_topics"`
+	// PruneReceiptsAndTxs drops receipts and txs once they are confirmed, keeping only logs. Only valid on a chain.
+	PruneReceiptsAndTxs bool `yaml:"prune_receipts_and_txs"`
+}
+
+// IsValid validates the retention config.
+func (r RetentionConfig) IsValid() (ok bool, err error) {
+	for _, topic := range r.KeepTopics {
+		if len(common.FromHex(topic)) != common.HashLength {
+			return false, fmt.Errorf("%w: keep topic %s", ErrInvalidTopic, topic)
+		}
+	}
+
+	return true, nil
+}
+
+// KeepTopicHashes returns the topics to keep as hashes.
+func (r RetentionConfig) KeepTopicHashes() []common.Hash {
+	topics := make([]common.Hash, len(r.KeepTopics))
+	for i, topic := range r.KeepTopics {
+		topics[i] = common.HexToHash(topic)
+	}
+	return topics
+}
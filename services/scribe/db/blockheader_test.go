@@ -0,0 +1,59 @@
+package db_test
+
+import (
+	"math/big"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+func (t *DBSuite) TestStoreRetrieveBlockHeader() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+
+		headers := make([]*types.Header, 5)
+		for i := range headers {
+			headers[i] = &types.Header{
+				ParentHash: common.BigToHash(big.NewInt(gofakeit.Int64())),
+				Coinbase:   common.BigToAddress(big.NewInt(gofakeit.Int64())),
+				Number:     big.NewInt(int64(i)),
+				GasLimit:   gofakeit.Uint64(),
+				GasUsed:    gofakeit.Uint64(),
+				Time:       uint64(gofakeit.Uint32()),
+			}
+			// leave the first header as a pre-london header.
+			if i > 0 {
+				headers[i].BaseFee = big.NewInt(int64(gofakeit.Uint32()))
+			}
+			Nil(t.T(), testDB.StoreBlockHeader(t.GetTestContext(), chainID, headers[i]))
+		}
+
+		// Storing a header twice is a no-op.
+		Nil(t.T(), testDB.StoreBlockHeader(t.GetTestContext(), chainID, headers[1]))
+
+		for _, header := range headers {
+			retrievedHeader, err := testDB.RetrieveBlockHeader(t.GetTestContext(), chainID, header.Number.Uint64())
+			Nil(t.T(), err)
+			Equal(t.T(), chainID, retrievedHeader.ChainID)
+			Equal(t.T(), header.Hash(), retrievedHeader.BlockHash)
+			Equal(t.T(), header.ParentHash, retrievedHeader.ParentHash)
+			Equal(t.T(), header.Coinbase, retrievedHeader.Miner)
+			Equal(t.T(), header.GasLimit, retrievedHeader.GasLimit)
+			Equal(t.T(), header.GasUsed, retrievedHeader.GasUsed)
+			Equal(t.T(), header.BaseFee, retrievedHeader.BaseFee)
+			Equal(t.T(), header.Time, retrievedHeader.Timestamp)
+		}
+
+		_, err := testDB.RetrieveBlockHeader(t.GetTestContext(), chainID+1, 0)
+		ErrorIs(t.T(), err, db.ErrNotFound)
+
+		retrievedHeaders, err := testDB.RetrieveBlockHeadersInRange(t.GetTestContext(), chainID, 1, 3, 1)
+		Nil(t.T(), err)
+		Len(t.T(), retrievedHeaders, 3)
+		Equal(t.T(), uint64(3), retrievedHeaders[0].BlockNumber)
+		Equal(t.T(), uint64(1), retrievedHeaders[2].BlockNumber)
+	})
+}
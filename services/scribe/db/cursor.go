@@ -0,0 +1,52 @@
+package db
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidCursor indicates that a cursor could not be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is a position in a result set ordered by block number, tx index, and log index.
+// Result sets that are not made of logs leave Index at 0.
+type Cursor struct {
+	BlockNumber uint64
+	TxIndex     uint64
+	Index       uint64
+}
+
+// Encode encodes the cursor into an opaque string.
+func (c Cursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d:%d", c.BlockNumber, c.TxIndex, c.Index)))
+}
+
+// DecodeCursor decodes an opaque cursor string produced by Cursor.Encode.
+func DecodeCursor(cursor string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, cursor)
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, cursor)
+	}
+
+	positions := make([]uint64, len(parts))
+	for i, part := range parts {
+		positions[i], err = strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, cursor)
+		}
+	}
+
+	return &Cursor{
+		BlockNumber: positions[0],
+		TxIndex:     positions[1],
+		Index:       positions[2],
+	}, nil
+}
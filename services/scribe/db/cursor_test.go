@@ -0,0 +1,94 @@
+package db_test
+
+import (
+	"math/big"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+func (t *DBSuite) TestCursorEncodeDecode() {
+	cursor := db.Cursor{BlockNumber: gofakeit.Uint64(), TxIndex: gofakeit.Uint64(), Index: gofakeit.Uint64()}
+	decoded, err := db.DecodeCursor(cursor.Encode())
+	Nil(t.T(), err)
+	Equal(t.T(), cursor, *decoded)
+
+	_, err = db.DecodeCursor("not a cursor")
+	ErrorIs(t.T(), err, db.ErrInvalidCursor)
+}
+
+func (t *DBSuite) TestRetrieveLogsAfterCursor() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		contractAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+
+		// Store 3 logs in each of 5 blocks.
+		for blockNumber := uint64(1); blockNumber <= 5; blockNumber++ {
+			for txIndex := uint(0); txIndex < 3; txIndex++ {
+				log := t.buildLog(contractAddress, blockNumber)
+				log.TxIndex = txIndex
+				log.Index = txIndex
+				Nil(t.T(), testDB.StoreLogs(t.GetTestContext(), chainID, log))
+			}
+		}
+
+		logFilter := db.LogFilter{ChainID: chainID, ContractAddress: contractAddress.String()}
+		var cursor *db.Cursor
+		var seen []db.Cursor
+		for {
+			logs, err := testDB.RetrieveLogsAfterCursor(t.GetTestContext(), logFilter, 2, 5, cursor, 4)
+			Nil(t.T(), err)
+			if len(logs) == 0 {
+				break
+			}
+			for _, log := range logs {
+				seen = append(seen, db.Cursor{BlockNumber: log.BlockNumber, TxIndex: uint64(log.TxIndex), Index: uint64(log.Index)})
+			}
+			cursor = &seen[len(seen)-1]
+		}
+
+		// Every log in the range is seen exactly once, in order.
+		Len(t.T(), seen, 12)
+		for i := range seen {
+			Equal(t.T(), uint64(2+i/3), seen[i].BlockNumber)
+			Equal(t.T(), uint64(i%3), seen[i].Index)
+		}
+	})
+}
+
+func (t *DBSuite) TestRetrieveLogAggregates() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		contractA := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+		contractB := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+		topic := common.BigToHash(big.NewInt(gofakeit.Int64()))
+
+		for blockNumber := uint64(1); blockNumber <= 10; blockNumber++ {
+			log := t.buildLog(contractA, blockNumber)
+			log.Topics[0] = topic
+			Nil(t.T(), testDB.StoreLogs(t.GetTestContext(), chainID, log))
+		}
+		for blockNumber := uint64(4); blockNumber <= 6; blockNumber++ {
+			log := t.buildLog(contractB, blockNumber)
+			log.Topics[0] = topic
+			Nil(t.T(), testDB.StoreLogs(t.GetTestContext(), chainID, log))
+		}
+
+		aggregates, err := testDB.RetrieveLogAggregates(t.GetTestContext(), db.LogFilter{ChainID: chainID, Topic: topic.String()}, 3, 8)
+		Nil(t.T(), err)
+		Len(t.T(), aggregates, 2)
+		for _, aggregate := range aggregates {
+			Equal(t.T(), topic, *aggregate.Topic)
+			switch aggregate.ContractAddress {
+			case contractA:
+				Equal(t.T(), db.LogAggregate{ContractAddress: contractA, Topic: &topic, Count: 6, FirstBlock: 3, LastBlock: 8}, aggregate)
+			case contractB:
+				Equal(t.T(), db.LogAggregate{ContractAddress: contractB, Topic: &topic, Count: 3, FirstBlock: 4, LastBlock: 6}, aggregate)
+			default:
+				t.T().Errorf("unexpected contract %s", aggregate.ContractAddress)
+			}
+		}
+	})
+}
@@ -62,7 +62,7 @@ func (s Store) StoreLogsAtHead(ctx context.Context, chainID uint32, logs ...type
 	}
 
 	dbTx := s.DB().WithContext(ctx)
-	if s.db.Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.db.Dialector.Name() != dbcommon.Mysql.String() {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns: []clause.Column{
 				{Name: ContractAddressFieldName}, {Name: ChainIDFieldName}, {Name: TxHashFieldName}, {Name: BlockIndexFieldName},
@@ -85,7 +85,7 @@ func (s Store) StoreLogsAtHead(ctx context.Context, chainID uint32, logs ...type
 // StoreReceiptAtHead stores a receipt.
 func (s Store) StoreReceiptAtHead(ctx context.Context, chainID uint32, receipt types.Receipt) error {
 	dbTx := s.DB().WithContext(ctx)
-	if s.DB().Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.DB().Dialector.Name() != dbcommon.Mysql.String() {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: TxHashFieldName}, {Name: ChainIDFieldName}},
 			DoNothing: true,
@@ -126,7 +126,7 @@ func (s Store) StoreEthTxAtHead(ctx context.Context, tx *types.Transaction, chai
 		return fmt.Errorf("could not marshall tx to binary: %w", err)
 	}
 	dbTx := s.DB().WithContext(ctx)
-	if s.DB().Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.DB().Dialector.Name() != dbcommon.Mysql.String() {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: TxHashFieldName}, {Name: ChainIDFieldName}},
 			DoNothing: true,
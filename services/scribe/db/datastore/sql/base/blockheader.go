@@ -0,0 +1,120 @@
+package base
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StoreBlockHeader stores a block header for a chain.
+func (s Store) StoreBlockHeader(ctx context.Context, chainID uint32, header *types.Header) error {
+	var baseFee sql.NullString
+	if header.BaseFee != nil {
+		baseFee = sql.NullString{String: header.BaseFee.String(), Valid: true}
+	}
+
+	dbTx := s.DB().WithContext(ctx)
+	if s.DB().Dialector.Name() != dbcommon.Mysql.String() {
+		dbTx = dbTx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: ChainIDFieldName}, {Name: BlockNumberFieldName}},
+			DoNothing: true,
+		})
+	} else {
+		dbTx = dbTx.Clauses(clause.Insert{
+			Modifier: "IGNORE",
+		})
+	}
+	dbTx = dbTx.Create(&BlockHeader{
+		ChainID:     chainID,
+		BlockNumber: header.Number.Uint64(),
+		BlockHash:   header.Hash().String(),
+		ParentHash:  header.ParentHash.String(),
+		Miner:       header.Coinbase.String(),
+		GasLimit:    header.GasLimit,
+		GasUsed:     header.GasUsed,
+		BaseFee:     baseFee,
+		Timestamp:   header.Time,
+	})
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store block header: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// RetrieveBlockHeader retrieves a block header for a chain and block number.
+func (s Store) RetrieveBlockHeader(ctx context.Context, chainID uint32, blockNumber uint64) (*db.BlockHeader, error) {
+	var blockHeader BlockHeader
+	dbTx := s.DB().WithContext(ctx).
+		Model(&BlockHeader{}).
+		Where(&BlockHeader{
+			ChainID:     chainID,
+			BlockNumber: blockNumber,
+		}).
+		First(&blockHeader)
+	if dbTx.Error != nil {
+		if errors.Is(dbTx.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("could not find block header for block %d: %w", blockNumber, db.ErrNotFound)
+		}
+		return nil, fmt.Errorf("could not retrieve block header: %w", dbTx.Error)
+	}
+
+	header := buildBlockHeaderFromDBBlockHeader(blockHeader)
+	return &header, nil
+}
+
+// RetrieveBlockHeadersInRange retrieves block headers for a chain within a range given a page.
+func (s Store) RetrieveBlockHeadersInRange(ctx context.Context, chainID uint32, startBlock, endBlock uint64, page int) ([]db.BlockHeader, error) {
+	if page < 1 {
+		page = 1
+	}
+	var dbBlockHeaders []BlockHeader
+	rangeQuery := fmt.Sprintf("%s BETWEEN ? AND ?", BlockNumberFieldName)
+	dbTx := s.DB().WithContext(ctx).
+		Model(&BlockHeader{}).
+		Where(&BlockHeader{
+			ChainID: chainID,
+		}).
+		Where(rangeQuery, startBlock, endBlock).
+		Order(fmt.Sprintf("%s desc", BlockNumberFieldName)).
+		Offset((page - 1) * PageSize).
+		Limit(PageSize).
+		Find(&dbBlockHeaders)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve block headers: %w", dbTx.Error)
+	}
+
+	blockHeaders := make([]db.BlockHeader, len(dbBlockHeaders))
+	for i := range dbBlockHeaders {
+		blockHeaders[i] = buildBlockHeaderFromDBBlockHeader(dbBlockHeaders[i])
+	}
+	return blockHeaders, nil
+}
+
+func buildBlockHeaderFromDBBlockHeader(dbBlockHeader BlockHeader) db.BlockHeader {
+	var baseFee *big.Int
+	if dbBlockHeader.BaseFee.Valid {
+		baseFee, _ = new(big.Int).SetString(dbBlockHeader.BaseFee.String, 10)
+	}
+
+	return db.BlockHeader{
+		ChainID:     dbBlockHeader.ChainID,
+		BlockNumber: dbBlockHeader.BlockNumber,
+		BlockHash:   common.HexToHash(dbBlockHeader.BlockHash),
+		ParentHash:  common.HexToHash(dbBlockHeader.ParentHash),
+		Miner:       common.HexToAddress(dbBlockHeader.Miner),
+		GasLimit:    dbBlockHeader.GasLimit,
+		GasUsed:     dbBlockHeader.GasUsed,
+		BaseFee:     baseFee,
+		Timestamp:   dbBlockHeader.Timestamp,
+	}
+}
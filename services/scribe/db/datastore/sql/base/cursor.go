@@ -0,0 +1,155 @@
+package base
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+// MaxCursorLimit is the maximum amount of entries returned for a single cursor query.
+var MaxCursorLimit = 1000
+
+// cursorLimit bounds the limit of a cursor query, defaulting to the page size.
+func cursorLimit(limit int) int {
+	if limit < 1 {
+		return PageSize
+	}
+	if limit > MaxCursorLimit {
+		return MaxCursorLimit
+	}
+	return limit
+}
+
+// RetrieveLogsAfterCursor retrieves up to limit logs that match a filter and are within a range, in ascending order after a cursor.
+func (s Store) RetrieveLogsAfterCursor(ctx context.Context, logFilter db.LogFilter, startBlock, endBlock uint64, after *db.Cursor, limit int) ([]*types.Log, error) {
+	var dbLogs []Log
+	queryFilter := logFilterToQuery(logFilter)
+	rangeQuery := fmt.Sprintf("%s BETWEEN ? AND ?", BlockNumberFieldName)
+	dbTx := s.DB().WithContext(ctx).
+		Model(&Log{}).
+		Where(&queryFilter).
+		Where(rangeQuery, startBlock, endBlock)
+	if after != nil {
+		dbTx = dbTx.Where(
+			fmt.Sprintf("(%[1]s > ? OR (%[1]s = ? AND tx_index > ?) OR (%[1]s = ? AND tx_index = ? AND %[2]s > ?))", BlockNumberFieldName, BlockIndexFieldName),
+			after.BlockNumber, after.BlockNumber, after.TxIndex, after.BlockNumber, after.TxIndex, after.Index,
+		)
+	}
+	dbTx = dbTx.
+		Order(fmt.Sprintf("%s asc, tx_index asc, %s asc", BlockNumberFieldName, BlockIndexFieldName)).
+		Limit(cursorLimit(limit)).
+		Find(&dbLogs)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve logs: %w", dbTx.Error)
+	}
+
+	return buildLogsFromDBLogs(dbLogs), nil
+}
+
+// RetrieveReceiptsAfterCursor retrieves up to limit receipts that match a filter and are within a range, in ascending order after a cursor.
+func (s Store) RetrieveReceiptsAfterCursor(ctx context.Context, receiptFilter db.ReceiptFilter, startBlock, endBlock uint64, after *db.Cursor, limit int) ([]types.Receipt, error) {
+	var dbReceipts []Receipt
+	query := receiptFilterToQuery(receiptFilter)
+	rangeQuery := fmt.Sprintf("%s BETWEEN ? AND ?", BlockNumberFieldName)
+	dbTx := s.DB().WithContext(ctx).
+		Model(&Receipt{}).
+		Where(&query).
+		Where(rangeQuery, startBlock, endBlock)
+	if after != nil {
+		dbTx = dbTx.Where(
+			fmt.Sprintf("(%[1]s > ? OR (%[1]s = ? AND %[2]s > ?))", BlockNumberFieldName, TransactionIndexFieldName),
+			after.BlockNumber, after.BlockNumber, after.TxIndex,
+		)
+	}
+	dbTx = dbTx.
+		Order(fmt.Sprintf("%s asc, %s asc", BlockNumberFieldName, TransactionIndexFieldName)).
+		Limit(cursorLimit(limit)).
+		Find(&dbReceipts)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve receipts: %w", dbTx.Error)
+	}
+
+	receipts, err := s.buildReceiptsFromDBReceipts(ctx, dbReceipts, receiptFilter.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not build receipts from db receipts: %w", err)
+	}
+
+	return receipts, nil
+}
+
+// RetrieveEthTxsAfterCursor retrieves up to limit eth txs that match a filter and are within a range, in ascending order after a cursor.
+func (s Store) RetrieveEthTxsAfterCursor(ctx context.Context, ethTxFilter db.EthTxFilter, startBlock, endBlock uint64, after *db.Cursor, limit int) ([]db.TxWithBlockNumber, error) {
+	var dbEthTxs []EthTx
+	query := ethTxFilterToQuery(ethTxFilter)
+	rangeQuery := fmt.Sprintf("%s BETWEEN ? AND ?", BlockNumberFieldName)
+	dbTx := s.DB().WithContext(ctx).
+		Model(&EthTx{}).
+		Where(&query).
+		Where(rangeQuery, startBlock, endBlock)
+	if after != nil {
+		dbTx = dbTx.Where(
+			fmt.Sprintf("(%[1]s > ? OR (%[1]s = ? AND %[2]s > ?))", BlockNumberFieldName, TransactionIndexFieldName),
+			after.BlockNumber, after.BlockNumber, after.TxIndex,
+		)
+	}
+	dbTx = dbTx.
+		Order(fmt.Sprintf("%s asc, %s asc", BlockNumberFieldName, TransactionIndexFieldName)).
+		Limit(cursorLimit(limit)).
+		Find(&dbEthTxs)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve eth txs: %w", dbTx.Error)
+	}
+
+	ethTxs, err := buildEthTxsFromDBEthTxs(dbEthTxs)
+	if err != nil {
+		return nil, fmt.Errorf("could not build eth txs: %w", err)
+	}
+
+	return ethTxs, nil
+}
+
+// logAggregate is a row of the log aggregate query.
+type logAggregate struct {
+	ContractAddress string
+	PrimaryTopic    sql.NullString
+	LogCount        int64
+	FirstBlock      uint64
+	LastBlock       uint64
+}
+
+// RetrieveLogAggregates retrieves log counts and first/last seen blocks per contract and primary topic within a range.
+func (s Store) RetrieveLogAggregates(ctx context.Context, logFilter db.LogFilter, startBlock, endBlock uint64) ([]db.LogAggregate, error) {
+	var rows []logAggregate
+	queryFilter := logFilterToQuery(logFilter)
+	rangeQuery := fmt.Sprintf("%s BETWEEN ? AND ?", BlockNumberFieldName)
+	dbTx := s.DB().WithContext(ctx).
+		Model(&Log{}).
+		Where(&queryFilter).
+		Where(rangeQuery, startBlock, endBlock).
+		Select(fmt.Sprintf("%[1]s AS contract_address, primary_topic AS primary_topic, COUNT(*) AS log_count, MIN(%[2]s) AS first_block, MAX(%[2]s) AS last_block", ContractAddressFieldName, BlockNumberFieldName)).
+		Group(fmt.Sprintf("%s, primary_topic", ContractAddressFieldName)).
+		Order(fmt.Sprintf("%s asc, primary_topic asc", ContractAddressFieldName)).
+		Scan(&rows)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve log aggregates: %w", dbTx.Error)
+	}
+
+	aggregates := make([]db.LogAggregate, len(rows))
+	for i, row := range rows {
+		aggregates[i] = db.LogAggregate{
+			ContractAddress: common.HexToAddress(row.ContractAddress),
+			Count:           row.LogCount,
+			FirstBlock:      row.FirstBlock,
+			LastBlock:       row.LastBlock,
+		}
+		if row.PrimaryTopic.Valid {
+			topic := common.HexToHash(row.PrimaryTopic.String)
+			aggregates[i].Topic = &topic
+		}
+	}
+	return aggregates, nil
+}
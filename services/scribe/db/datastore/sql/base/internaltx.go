@@ -0,0 +1,143 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"gorm.io/gorm/clause"
+)
+
+// StoreInternalTxs stores internal transactions for a chain.
+func (s Store) StoreInternalTxs(ctx context.Context, chainID uint32, internalTxs ...db.InternalTx) error {
+	if len(internalTxs) == 0 {
+		return nil
+	}
+
+	storeInternalTxs := make([]InternalTx, len(internalTxs))
+	for i, internalTx := range internalTxs {
+		value := "0"
+		if internalTx.Value != nil {
+			value = internalTx.Value.String()
+		}
+
+		storeInternalTxs[i] = InternalTx{
+			ChainID:      chainID,
+			TxHash:       internalTx.TxHash.String(),
+			TraceAddress: traceAddressToString(internalTx.TraceAddress),
+			BlockNumber:  internalTx.BlockNumber,
+			BlockHash:    internalTx.BlockHash.String(),
+			CallType:     internalTx.CallType,
+			FromAddress:  internalTx.From.String(),
+			ToAddress:    internalTx.To.String(),
+			Value:        value,
+			Gas:          internalTx.Gas,
+			GasUsed:      internalTx.GasUsed,
+			Input:        internalTx.Input,
+			Error:        internalTx.Error,
+		}
+	}
+
+	dbTx := s.DB().WithContext(ctx)
+	if s.DB().Dialector.Name() != dbcommon.Mysql.String() {
+		dbTx = dbTx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: ChainIDFieldName}, {Name: TxHashFieldName}, {Name: "trace_address"}},
+			DoNothing: true,
+		}).CreateInBatches(&storeInternalTxs, 10)
+	} else {
+		dbTx = dbTx.Clauses(clause.Insert{
+			Modifier: "IGNORE",
+		}).Create(&storeInternalTxs)
+	}
+
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store internal txs: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// RetrieveInternalTxsWithFilter retrieves internal transactions with a filter given a page.
+func (s Store) RetrieveInternalTxsWithFilter(ctx context.Context, internalTxFilter db.InternalTxFilter, page int) ([]db.InternalTx, error) {
+	if page < 1 {
+		page = 1
+	}
+	var dbInternalTxs []InternalTx
+	dbTx := s.DB().WithContext(ctx).
+		Model(&InternalTx{}).
+		Where(&InternalTx{
+			ChainID:     internalTxFilter.ChainID,
+			TxHash:      internalTxFilter.TxHash,
+			BlockNumber: internalTxFilter.BlockNumber,
+			FromAddress: internalTxFilter.From,
+			ToAddress:   internalTxFilter.To,
+		}).
+		Order(fmt.Sprintf("%s desc, %s desc, trace_address asc", BlockNumberFieldName, TxHashFieldName)).
+		Offset((page - 1) * PageSize).
+		Limit(PageSize).
+		Find(&dbInternalTxs)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve internal txs: %w", dbTx.Error)
+	}
+
+	internalTxs := make([]db.InternalTx, len(dbInternalTxs))
+	for i, dbInternalTx := range dbInternalTxs {
+		traceAddress, err := traceAddressFromString(dbInternalTx.TraceAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		value, ok := new(big.Int).SetString(dbInternalTx.Value, 10)
+		if !ok {
+			return nil, fmt.Errorf("could not parse value %s of internal tx %s", dbInternalTx.Value, dbInternalTx.TxHash)
+		}
+
+		internalTxs[i] = db.InternalTx{
+			TxHash:       common.HexToHash(dbInternalTx.TxHash),
+			BlockNumber:  dbInternalTx.BlockNumber,
+			BlockHash:    common.HexToHash(dbInternalTx.BlockHash),
+			TraceAddress: traceAddress,
+			CallType:     dbInternalTx.CallType,
+			From:         common.HexToAddress(dbInternalTx.FromAddress),
+			To:           common.HexToAddress(dbInternalTx.ToAddress),
+			Value:        value,
+			Gas:          dbInternalTx.Gas,
+			GasUsed:      dbInternalTx.GasUsed,
+			Input:        dbInternalTx.Input,
+			Error:        dbInternalTx.Error,
+		}
+	}
+	return internalTxs, nil
+}
+
+// traceAddressToString converts a trace address to its stored form, e.g. [0 1] -> "0,1".
+func traceAddressToString(traceAddress []int) string {
+	parts := make([]string, len(traceAddress))
+	for i, position := range traceAddress {
+		parts[i] = strconv.Itoa(position)
+	}
+	return strings.Join(parts, ",")
+}
+
+// traceAddressFromString parses a stored trace address.
+func traceAddressFromString(traceAddress string) ([]int, error) {
+	if traceAddress == "" {
+		return []int{}, nil
+	}
+
+	parts := strings.Split(traceAddress, ",")
+	positions := make([]int, len(parts))
+	for i, part := range parts {
+		position, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse trace address %s: %w", traceAddress, err)
+		}
+		positions[i] = position
+	}
+	return positions, nil
+}
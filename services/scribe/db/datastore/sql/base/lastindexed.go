@@ -37,23 +37,24 @@ func (s Store) StoreLastIndexed(parentCtx context.Context, contractAddress commo
 		Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: ContractAddressFieldName}, {Name: ChainIDFieldName}},
 			DoUpdates: clause.AssignmentColumns([]string{BlockNumberFieldName}),
+			// columns are qualified with the table, since postgres rejects them as ambiguous with excluded.
 			Where: clause.Where{
 				Exprs: []clause.Expression{
 					clause.And(
 						clause.Where{
 							Exprs: []clause.Expression{
 								clause.Eq{
-									Column: clause.Column{Name: ContractAddressFieldName},
+									Column: clause.Column{Table: clause.CurrentTable, Name: ContractAddressFieldName},
 									Value:  address,
 								},
 								clause.Eq{
-									Column: clause.Column{Name: ChainIDFieldName},
+									Column: clause.Column{Table: clause.CurrentTable, Name: ChainIDFieldName},
 									Value:  chainID,
 								},
 							},
 						},
 						clause.Lt{
-							Column: clause.Column{Name: BlockNumberFieldName},
+							Column: clause.Column{Table: clause.CurrentTable, Name: BlockNumberFieldName},
 							Value:  blockNumber,
 						},
 					),
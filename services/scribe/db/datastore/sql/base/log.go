@@ -60,7 +60,7 @@ func (s Store) StoreLogs(ctx context.Context, chainID uint32, logs ...types.Log)
 	}
 
 	dbTx := s.DB().WithContext(ctx)
-	if s.db.Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.db.Dialector.Name() != dbcommon.Mysql.String() {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns: []clause.Column{
 				{Name: ContractAddressFieldName}, {Name: ChainIDFieldName}, {Name: TxHashFieldName}, {Name: BlockIndexFieldName},
@@ -110,6 +110,11 @@ func (s Store) DeleteLogsForBlockHash(ctx context.Context, blockHash common.Hash
 // logFilterToQuery takes in a LogFilter and converts it to a database-type Log.
 // This is used to query with `WHERE` based on the filter.
 func logFilterToQuery(logFilter db.LogFilter) Log {
+	var primaryTopic sql.NullString
+	if logFilter.Topic != "" {
+		primaryTopic = sql.NullString{String: common.HexToHash(logFilter.Topic).String(), Valid: true}
+	}
+
 	return Log{
 		ContractAddress: logFilter.ContractAddress,
 		ChainID:         logFilter.ChainID,
@@ -119,6 +124,7 @@ func logFilterToQuery(logFilter db.LogFilter) Log {
 		BlockHash:       logFilter.BlockHash,
 		BlockIndex:      logFilter.Index,
 		Confirmed:       logFilter.Confirmed,
+		PrimaryTopic:    primaryTopic,
 	}
 }
 
@@ -2,6 +2,7 @@
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/synapsecns/sanguine/core/dbcommon"
 	"gorm.io/gorm"
@@ -266,3 +267,67 @@ type EthTxAtHead struct {
 	// InsertTime is the time at which this tx was inserted
 	InsertTime uint64 `gorm:"column:insert_time"`
 }
+
+// BlockHeader contains the indexed fields of a block header.
+type BlockHeader struct {
+	// ChainID is the chain id of the block
+	ChainID uint32 `gorm:"column:chain_id;primaryKey"`
+	// BlockNumber is the block number
+	BlockNumber uint64 `gorm:"column:block_number;primaryKey"`
+	// BlockHash is the hash of the block
+	BlockHash string `gorm:"column:block_hash;index:idx_block_header_hash,priority:1,sort:desc"`
+	// ParentHash is the hash of the parent block
+	ParentHash string `gorm:"column:parent_hash"`
+	// Miner is the address that received the block rewards
+	Miner string `gorm:"column:miner"`
+	// GasLimit is the gas limit of the block
+	GasLimit uint64 `gorm:"column:gas_limit"`
+	// GasUsed is the total gas used by all transactions in the block
+	GasUsed uint64 `gorm:"column:gas_used"`
+	// BaseFee is the base fee of the block in wei, null for blocks before london
+	BaseFee sql.NullString `gorm:"column:base_fee"`
+	// Timestamp is the timestamp of the block
+	Timestamp uint64 `gorm:"column:timestamp"`
+}
+
+// InternalTx contains a call made during the execution of a transaction.
+type InternalTx struct {
+	// ChainID is the chain id of the transaction
+	ChainID uint32 `gorm:"column:chain_id;primaryKey"`
+	// TxHash is the hash of the transaction
+	TxHash string `gorm:"column:tx_hash;primaryKey"`
+	// TraceAddress is the comma separated position of the call in the call tree
+	TraceAddress string `gorm:"column:trace_address;primaryKey"`
+	// BlockNumber is the block in which the transaction was included
+	BlockNumber uint64 `gorm:"column:block_number;index:idx_internal_tx_block_number,priority:1,sort:desc"`
+	// BlockHash is the hash of the block in which the transaction was included
+	BlockHash string `gorm:"column:block_hash"`
+	// CallType is the type of the call
+	CallType string `gorm:"column:call_type"`
+	// FromAddress is the caller
+	FromAddress string `gorm:"column:from_address;index:idx_internal_tx_from,priority:1"`
+	// ToAddress is the callee
+	ToAddress string `gorm:"column:to_address;index:idx_internal_tx_to,priority:1"`
+	// Value is the native value transferred in wei
+	Value string `gorm:"column:value"`
+	// Gas is the gas provided to the call
+	Gas uint64 `gorm:"column:gas"`
+	// GasUsed is the gas used by the call
+	GasUsed uint64 `gorm:"column:gas_used"`
+	// Input is the call data
+	Input []byte `gorm:"column:input"`
+	// Error is the error the call reverted with
+	Error string `gorm:"column:error"`
+}
+
+// ConsumerCursor stores the last block a downstream consumer has processed for a chain.
+type ConsumerCursor struct {
+	// Consumer is the name of the consumer
+	Consumer string `gorm:"column:consumer;primaryKey"`
+	// ChainID is the chain id of the cursor
+	ChainID uint32 `gorm:"column:chain_id;primaryKey"`
+	// BlockNumber is the last block number processed by the consumer
+	BlockNumber uint64 `gorm:"column:block_number"`
+	// UpdatedAt is when the cursor was last moved
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
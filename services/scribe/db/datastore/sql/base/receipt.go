@@ -17,7 +17,7 @@
 // StoreReceipt stores a receipt.
 func (s Store) StoreReceipt(ctx context.Context, chainID uint32, receipt types.Receipt) error {
 	dbTx := s.DB().WithContext(ctx)
-	if s.DB().Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.DB().Dialector.Name() != dbcommon.Mysql.String() {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: TxHashFieldName}, {Name: ChainIDFieldName}},
 			DoNothing: true,
@@ -0,0 +1,176 @@
+package base
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StoreConsumerCursor stores the last block number a downstream consumer has processed for a chain.
+func (s Store) StoreConsumerCursor(ctx context.Context, consumer string, chainID uint32, blockNumber uint64) error {
+	dbTx := s.DB().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "consumer"}, {Name: ChainIDFieldName}},
+			DoUpdates: clause.AssignmentColumns([]string{BlockNumberFieldName, "updated_at"}),
+		}).
+		Create(&ConsumerCursor{
+			Consumer:    consumer,
+			ChainID:     chainID,
+			BlockNumber: blockNumber,
+			UpdatedAt:   time.Now(),
+		})
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store consumer cursor: %w", dbTx.Error)
+	}
+
+	return nil
+}
+
+// RetrieveConsumerCursors retrieves the cursors of all consumers of a chain.
+func (s Store) RetrieveConsumerCursors(ctx context.Context, chainID uint32) ([]db.ConsumerCursor, error) {
+	var dbCursors []ConsumerCursor
+	dbTx := s.DB().WithContext(ctx).
+		Model(&ConsumerCursor{}).
+		Where(&ConsumerCursor{
+			ChainID: chainID,
+		}).
+		Find(&dbCursors)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not retrieve consumer cursors: %w", dbTx.Error)
+	}
+
+	cursors := make([]db.ConsumerCursor, len(dbCursors))
+	for i, dbCursor := range dbCursors {
+		cursors[i] = db.ConsumerCursor{
+			Consumer:    dbCursor.Consumer,
+			ChainID:     dbCursor.ChainID,
+			BlockNumber: dbCursor.BlockNumber,
+			UpdatedAt:   dbCursor.UpdatedAt,
+		}
+	}
+	return cursors, nil
+}
+
+// RetrieveLastBlockBeforeTime retrieves the last block number with a stored block time before a timestamp.
+// 0 is returned if there is no such block.
+func (s Store) RetrieveLastBlockBeforeTime(ctx context.Context, chainID uint32, timestamp uint64) (uint64, error) {
+	var blockNumber uint64
+	dbTx := s.DB().WithContext(ctx).
+		Model(&BlockTime{}).
+		Where(&BlockTime{
+			ChainID: chainID,
+		}).
+		Where("timestamp < ?", timestamp).
+		Select(fmt.Sprintf("COALESCE(MAX(%s), 0)", BlockNumberFieldName)).
+		Scan(&blockNumber)
+	if dbTx.Error != nil {
+		return 0, fmt.Errorf("could not retrieve last block before time: %w", dbTx.Error)
+	}
+	return blockNumber, nil
+}
+
+// pruneBatchSize is the number of blocks deleted by a single statement when pruning, so a prune does not hold a lock
+// over the whole range.
+const pruneBatchSize = 1000
+
+// pruneInBatches deletes the rows of a model matching the scope below a block number, pruneBatchSize blocks at a time.
+// Each batch starts at the lowest remaining block so gaps in the stored range are skipped.
+func (s Store) pruneInBatches(ctx context.Context, model interface{}, scope func(tx *gorm.DB) *gorm.DB, beforeBlock uint64) (int64, error) {
+	var deleted int64
+	startBlock := uint64(0)
+	for {
+		var firstBlock sql.NullInt64
+		dbTx := scope(s.DB().WithContext(ctx).Model(model)).
+			Where(fmt.Sprintf("%s >= ? AND %s < ?", BlockNumberFieldName, BlockNumberFieldName), startBlock, beforeBlock).
+			Select(fmt.Sprintf("MIN(%s)", BlockNumberFieldName)).
+			Scan(&firstBlock)
+		if dbTx.Error != nil {
+			return deleted, fmt.Errorf("could not get first block to prune: %w", dbTx.Error)
+		}
+		if !firstBlock.Valid {
+			return deleted, nil
+		}
+
+		startBlock = uint64(firstBlock.Int64)
+		endBlock := startBlock + pruneBatchSize
+		if endBlock > beforeBlock {
+			endBlock = beforeBlock
+		}
+		dbTx = scope(s.DB().WithContext(ctx)).
+			Where(fmt.Sprintf("%s >= ? AND %s < ?", BlockNumberFieldName, BlockNumberFieldName), startBlock, endBlock).
+			Delete(model)
+		if dbTx.Error != nil {
+			return deleted, fmt.Errorf("could not prune blocks %d to %d: %w", startBlock, endBlock, dbTx.Error)
+		}
+		deleted += dbTx.RowsAffected
+		startBlock = endBlock
+	}
+}
+
+// PruneLogs deletes the logs of a contract below a block number. If keepTopics is set, only logs
+// whose primary topic is not in keepTopics are deleted.
+func (s Store) PruneLogs(ctx context.Context, chainID uint32, contractAddress common.Address, beforeBlock uint64, keepTopics []common.Hash) (int64, error) {
+	topics := make([]string, len(keepTopics))
+	for i, topic := range keepTopics {
+		topics[i] = topic.String()
+	}
+
+	deleted, err := s.pruneInBatches(ctx, &Log{}, func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Where(&Log{
+			ChainID:         chainID,
+			ContractAddress: contractAddress.String(),
+		})
+		if len(topics) > 0 {
+			tx = tx.Where("(primary_topic IS NULL OR primary_topic NOT IN ?)", topics)
+		}
+		return tx
+	}, beforeBlock)
+	if err != nil {
+		return deleted, fmt.Errorf("could not prune logs: %w", err)
+	}
+	return deleted, nil
+}
+
+// PruneReceiptsAndTxs deletes receipts and txs below a block number.
+func (s Store) PruneReceiptsAndTxs(ctx context.Context, chainID uint32, beforeBlock uint64) (int64, error) {
+	receipts, err := s.pruneInBatches(ctx, &Receipt{}, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(&Receipt{ChainID: chainID})
+	}, beforeBlock)
+	if err != nil {
+		return receipts, fmt.Errorf("could not prune receipts: %w", err)
+	}
+
+	ethTxs, err := s.pruneInBatches(ctx, &EthTx{}, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(&EthTx{ChainID: chainID})
+	}, beforeBlock)
+	if err != nil {
+		return receipts + ethTxs, fmt.Errorf("could not prune eth txs: %w", err)
+	}
+
+	return receipts + ethTxs, nil
+}
+
+// PruneBlockData deletes block headers and internal txs below a block number.
+func (s Store) PruneBlockData(ctx context.Context, chainID uint32, beforeBlock uint64) (int64, error) {
+	headers, err := s.pruneInBatches(ctx, &BlockHeader{}, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(&BlockHeader{ChainID: chainID})
+	}, beforeBlock)
+	if err != nil {
+		return headers, fmt.Errorf("could not prune block headers: %w", err)
+	}
+
+	internalTxs, err := s.pruneInBatches(ctx, &InternalTx{}, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where(&InternalTx{ChainID: chainID})
+	}, beforeBlock)
+	if err != nil {
+		return headers + internalTxs, fmt.Errorf("could not prune internal txs: %w", err)
+	}
+
+	return headers + internalTxs, nil
+}
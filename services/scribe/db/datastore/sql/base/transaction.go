@@ -19,7 +19,7 @@ func (s Store) StoreEthTx(ctx context.Context, tx *types.Transaction, chainID ui
 		return fmt.Errorf("could not marshall tx to binary: %w", err)
 	}
 	dbTx := s.DB().WithContext(ctx)
-	if s.DB().Dialector.Name() == dbcommon.Sqlite.String() {
+	if s.DB().Dialector.Name() != dbcommon.Mysql.String() {
 		dbTx = dbTx.Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: TxHashFieldName}, {Name: ChainIDFieldName}},
 			DoNothing: true,
@@ -163,7 +163,7 @@ func buildEthTxsFromDBEthTxs(dbEthTxs []EthTx) ([]db.TxWithBlockNumber, error) {
 		if err := ethTx.UnmarshalBinary(dbEthTx.RawTx); err != nil {
 			return []db.TxWithBlockNumber{}, fmt.Errorf("could not unmarshall eth tx: %w", err)
 		}
-		ethTxs = append(ethTxs, db.TxWithBlockNumber{Tx: ethTx, BlockNumber: dbEthTx.BlockNumber})
+		ethTxs = append(ethTxs, db.TxWithBlockNumber{Tx: ethTx, BlockNumber: dbEthTx.BlockNumber, TransactionIndex: dbEthTx.TransactionIndex})
 	}
 
 	return ethTxs, nil
@@ -0,0 +1,2 @@
+// Package postgres implements the postgres package
+package postgres
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/base"
+	scribeLogger "github.com/synapsecns/sanguine/services/scribe/logger"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// Store is the postgres store. It extends the base store for postgres specific queries.
+type Store struct {
+	*base.Store
+}
+
+// MaxIdleConns is exported here for testing. Tests execute too slowly with a reconnect each time.
+var MaxIdleConns = 1048
+
+// MaxOpenConns is exported here for testing. Tests execute too slowly with a reconnect each time.
+var MaxOpenConns = 1048
+
+// NamingStrategy is exported here for testing.
+var NamingStrategy = schema.NamingStrategy{
+	TablePrefix: "v3_",
+}
+
+// NewPostgresStore creates a new postgres store for a given data store.
+func NewPostgresStore(parentCtx context.Context, dbURL string, handler metrics.Handler, skipMigrations bool) (_ *Store, err error) {
+	logger.Debug("creating postgres store")
+	scribeLogger.ReportScribeState(0, 0, nil, scribeLogger.CreatingSQLStore)
+	ctx, span := handler.Tracer().Start(parentCtx, "start-postgres")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	gdb, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{
+		Logger:                 gormLogger.Default.LogMode(gormLogger.Silent),
+		FullSaveAssociations:   true,
+		NamingStrategy:         NamingStrategy,
+		NowFunc:                time.Now,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create postgres connection: %w", err)
+	}
+
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		return nil, fmt.Errorf("could not get sql db: %w", err)
+	}
+
+	sqlDB.SetMaxIdleConns(MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(30 * time.Minute)
+	sqlDB.SetMaxOpenConns(MaxOpenConns)
+
+	handler.AddGormCallbacks(gdb)
+
+	if !skipMigrations {
+		// postgres supports transactional ddl, so a failed migration leaves no partial schema behind.
+		err = gdb.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			//nolint: wrapcheck
+			return tx.AutoMigrate(base.GetAllModels()...)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not migrate on postgres: %w", err)
+		}
+	}
+
+	return &Store{base.NewStore(gdb, handler)}, nil
+}
@@ -2,6 +2,9 @@
 
 import (
 	"context"
+	"math/big"
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 )
@@ -47,6 +50,21 @@ type EventDBWriter interface {
 
 	// StoreBlockTime stores a block time for a chain.
 	StoreBlockTime(ctx context.Context, chainID uint32, blockNumber, timestamp uint64) error
+
+	// StoreBlockHeader stores a block header for a chain.
+	StoreBlockHeader(ctx context.Context, chainID uint32, header *types.Header) error
+	// StoreInternalTxs stores internal transactions (call traces) for a chain.
+	StoreInternalTxs(ctx context.Context, chainID uint32, internalTxs ...InternalTx) error
+
+	// StoreConsumerCursor stores the last block number a downstream consumer has processed for a chain.
+	StoreConsumerCursor(ctx context.Context, consumer string, chainID uint32, blockNumber uint64) error
+	// PruneLogs deletes the logs of a contract below a block number. If keepTopics is set, only logs
+	// whose primary topic is not in keepTopics are deleted.
+	PruneLogs(ctx context.Context, chainID uint32, contractAddress common.Address, beforeBlock uint64, keepTopics []common.Hash) (int64, error)
+	// PruneReceiptsAndTxs deletes receipts and txs below a block number.
+	PruneReceiptsAndTxs(ctx context.Context, chainID uint32, beforeBlock uint64) (int64, error)
+	// PruneBlockData deletes block headers and internal txs below a block number.
+	PruneBlockData(ctx context.Context, chainID uint32, beforeBlock uint64) (int64, error)
 }
 
 // EventDBReader is an interface for reading events from a database.
@@ -102,6 +120,27 @@ type EventDBReader interface {
 
 	// FlushFromHeadTables flushes unconfirmed logs, receipts, and txs from the head.
 	FlushFromHeadTables(ctx context.Context, time int64) error
+
+	// RetrieveBlockHeader retrieves a block header for a chain and block number.
+	RetrieveBlockHeader(ctx context.Context, chainID uint32, blockNumber uint64) (*BlockHeader, error)
+	// RetrieveBlockHeadersInRange retrieves block headers for a chain within a range given a page.
+	RetrieveBlockHeadersInRange(ctx context.Context, chainID uint32, startBlock, endBlock uint64, page int) ([]BlockHeader, error)
+	// RetrieveInternalTxsWithFilter retrieves internal transactions with a filter given a page.
+	RetrieveInternalTxsWithFilter(ctx context.Context, internalTxFilter InternalTxFilter, page int) ([]InternalTx, error)
+
+	// RetrieveConsumerCursors retrieves the cursors of all consumers of a chain.
+	RetrieveConsumerCursors(ctx context.Context, chainID uint32) ([]ConsumerCursor, error)
+	// RetrieveLastBlockBeforeTime retrieves the last block number with a stored block time before a timestamp.
+	RetrieveLastBlockBeforeTime(ctx context.Context, chainID uint32, timestamp uint64) (uint64, error)
+
+	// RetrieveLogsAfterCursor retrieves up to limit logs that match a filter and are within a range, in ascending order after a cursor.
+	RetrieveLogsAfterCursor(ctx context.Context, logFilter LogFilter, startBlock, endBlock uint64, after *Cursor, limit int) ([]*types.Log, error)
+	// RetrieveReceiptsAfterCursor retrieves up to limit receipts that match a filter and are within a range, in ascending order after a cursor.
+	RetrieveReceiptsAfterCursor(ctx context.Context, receiptFilter ReceiptFilter, startBlock, endBlock uint64, after *Cursor, limit int) ([]types.Receipt, error)
+	// RetrieveEthTxsAfterCursor retrieves up to limit eth txs that match a filter and are within a range, in ascending order after a cursor.
+	RetrieveEthTxsAfterCursor(ctx context.Context, ethTxFilter EthTxFilter, startBlock, endBlock uint64, after *Cursor, limit int) ([]TxWithBlockNumber, error)
+	// RetrieveLogAggregates retrieves log counts and first/last seen blocks per contract and primary topic within a range.
+	RetrieveLogAggregates(ctx context.Context, logFilter LogFilter, startBlock, endBlock uint64) ([]LogAggregate, error)
 }
 
 // EventDB stores events.
@@ -114,6 +153,67 @@ type EventDB interface {
 
 // TxWithBlockNumber is a transaction with a block number and is used for specifically for batching data in explorer.
 type TxWithBlockNumber struct {
-	Tx          types.Transaction
+	Tx               types.Transaction
+	BlockNumber      uint64
+	TransactionIndex uint64
+}
+
+// BlockHeader is the subset of a block header indexed by scribe.
+type BlockHeader struct {
+	ChainID     uint32
 	BlockNumber uint64
+	BlockHash   common.Hash
+	ParentHash  common.Hash
+	Miner       common.Address
+	GasLimit    uint64
+	GasUsed     uint64
+	// BaseFee is nil for blocks before london.
+	BaseFee   *big.Int
+	Timestamp uint64
+}
+
+// InternalTx is a call made during the execution of a transaction, taken from its call trace.
+type InternalTx struct {
+	TxHash      common.Hash
+	BlockNumber uint64
+	BlockHash   common.Hash
+	// TraceAddress is the position of the call in the call tree, e.g. [0 1] is the second call made by the first call.
+	TraceAddress []int
+	// CallType is the type of call, e.g. CALL, DELEGATECALL or CREATE.
+	CallType string
+	From     common.Address
+	To       common.Address
+	// Value is the native value transferred by the call.
+	Value   *big.Int
+	Gas     uint64
+	GasUsed uint64
+	Input   []byte
+	// Error is the error the call reverted with, if any.
+	Error string
+}
+
+// ConsumerCursor is the position of a downstream consumer on a chain.
+type ConsumerCursor struct {
+	// Consumer is the name of the consumer.
+	Consumer string
+	// ChainID is the chain the cursor is on.
+	ChainID uint32
+	// BlockNumber is the last block number the consumer has processed.
+	BlockNumber uint64
+	// UpdatedAt is when the cursor was last moved.
+	UpdatedAt time.Time
+}
+
+// LogAggregate is the number of logs of a contract and primary topic within a block range.
+type LogAggregate struct {
+	// ContractAddress is the contract that emitted the logs.
+	ContractAddress common.Address
+	// Topic is the primary topic of the logs, nil for anonymous logs.
+	Topic *common.Hash
+	// Count is the number of logs.
+	Count int64
+	// FirstBlock is the first block the logs were seen in.
+	FirstBlock uint64
+	// LastBlock is the last block the logs were seen in.
+	LastBlock uint64
 }
@@ -1,5 +1,7 @@
 package db
 
+import "github.com/ethereum/go-ethereum/common"
+
 // BuildLogFilter builds a log filter from nullable parameters.
 func BuildLogFilter(contractAddress *string, blockNumber *int, txHash *string, txIndex *int, blockHash *string, index *int, confirmed *bool) LogFilter {
 	logFilter := LogFilter{}
@@ -68,3 +70,21 @@ func BuildEthTxFilter(txHash *string, blockNumber *int, blockHash *string, confi
 	}
 	return ethTxFilter
 }
+
+// BuildInternalTxFilter builds an internal tx filter from nullable parameters.
+func BuildInternalTxFilter(txHash *string, blockNumber *int, from *string, to *string) InternalTxFilter {
+	internalTxFilter := InternalTxFilter{}
+	if txHash != nil {
+		internalTxFilter.TxHash = *txHash
+	}
+	if blockNumber != nil {
+		internalTxFilter.BlockNumber = uint64(*blockNumber)
+	}
+	if from != nil {
+		internalTxFilter.From = common.HexToAddress(*from).String()
+	}
+	if to != nil {
+		internalTxFilter.To = common.HexToAddress(*to).String()
+	}
+	return internalTxFilter
+}
@@ -10,6 +10,8 @@ type LogFilter struct {
 	BlockHash       string
 	Index           uint64
 	Confirmed       bool
+	// Topic is the primary topic (topics[0]) of the log.
+	Topic string
 }
 
 // ReceiptFilter is a filter to use when querying the database for receipts.
@@ -31,3 +33,12 @@ type EthTxFilter struct {
 	BlockNumber uint64
 	Confirmed   bool
 }
+
+// InternalTxFilter is a filter to use when querying the database for internal transactions.
+type InternalTxFilter struct {
+	ChainID     uint32
+	TxHash      string
+	BlockNumber uint64
+	From        string
+	To          string
+}
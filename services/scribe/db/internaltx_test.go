@@ -0,0 +1,71 @@
+package db_test
+
+import (
+	"math/big"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+func (t *DBSuite) TestStoreRetrieveInternalTxs() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		txHash := common.BigToHash(big.NewInt(gofakeit.Int64()))
+		blockHash := common.BigToHash(big.NewInt(gofakeit.Int64()))
+		contract := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+		recipient := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+
+		internalTxs := []db.InternalTx{
+			{
+				TxHash:       txHash,
+				BlockNumber:  10,
+				BlockHash:    blockHash,
+				TraceAddress: []int{0},
+				CallType:     "call",
+				From:         contract,
+				To:           recipient,
+				Value:        big.NewInt(params.Ether),
+				Gas:          2300,
+				GasUsed:      0,
+				Input:        common.Hex2Bytes("a9059cbb"),
+			},
+			{
+				TxHash:       txHash,
+				BlockNumber:  10,
+				BlockHash:    blockHash,
+				TraceAddress: []int{0, 1},
+				CallType:     "delegatecall",
+				From:         recipient,
+				To:           contract,
+				Value:        big.NewInt(0),
+				Gas:          50000,
+				GasUsed:      21000,
+				Input:        common.Hex2Bytes("deadbeef"),
+				Error:        "execution reverted",
+			},
+		}
+
+		Nil(t.T(), testDB.StoreInternalTxs(t.GetTestContext(), chainID, internalTxs...))
+		// Storing the same internal txs twice is a no-op.
+		Nil(t.T(), testDB.StoreInternalTxs(t.GetTestContext(), chainID, internalTxs...))
+
+		txHashString := txHash.String()
+		filter := db.BuildInternalTxFilter(&txHashString, nil, nil, nil)
+		filter.ChainID = chainID
+		retrievedTxs, err := testDB.RetrieveInternalTxsWithFilter(t.GetTestContext(), filter, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), internalTxs, retrievedTxs)
+
+		// Filter by the native value recipient.
+		recipientString := recipient.Hex()
+		filter = db.BuildInternalTxFilter(nil, nil, nil, &recipientString)
+		filter.ChainID = chainID
+		retrievedTxs, err = testDB.RetrieveInternalTxsWithFilter(t.GetTestContext(), filter, 1)
+		Nil(t.T(), err)
+		Len(t.T(), retrievedTxs, 1)
+		Equal(t.T(), internalTxs[0].Value, retrievedTxs[0].Value)
+	})
+}
@@ -103,6 +103,115 @@ func (_m *EventDB) FlushFromHeadTables(ctx context.Context, time int64) error {
 	return r0
 }
 
+// PruneBlockData provides a mock function with given fields: ctx, chainID, beforeBlock
+func (_m *EventDB) PruneBlockData(ctx context.Context, chainID uint32, beforeBlock uint64) (int64, error) {
+	ret := _m.Called(ctx, chainID, beforeBlock)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) int64); ok {
+		r0 = rf(ctx, chainID, beforeBlock)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64) error); ok {
+		r1 = rf(ctx, chainID, beforeBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PruneLogs provides a mock function with given fields: ctx, chainID, contractAddress, beforeBlock, keepTopics
+func (_m *EventDB) PruneLogs(ctx context.Context, chainID uint32, contractAddress common.Address, beforeBlock uint64, keepTopics []common.Hash) (int64, error) {
+	ret := _m.Called(ctx, chainID, contractAddress, beforeBlock, keepTopics)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, common.Address, uint64, []common.Hash) int64); ok {
+		r0 = rf(ctx, chainID, contractAddress, beforeBlock, keepTopics)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, common.Address, uint64, []common.Hash) error); ok {
+		r1 = rf(ctx, chainID, contractAddress, beforeBlock, keepTopics)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PruneReceiptsAndTxs provides a mock function with given fields: ctx, chainID, beforeBlock
+func (_m *EventDB) PruneReceiptsAndTxs(ctx context.Context, chainID uint32, beforeBlock uint64) (int64, error) {
+	ret := _m.Called(ctx, chainID, beforeBlock)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) int64); ok {
+		r0 = rf(ctx, chainID, beforeBlock)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64) error); ok {
+		r1 = rf(ctx, chainID, beforeBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrieveBlockHeader provides a mock function with given fields: ctx, chainID, blockNumber
+func (_m *EventDB) RetrieveBlockHeader(ctx context.Context, chainID uint32, blockNumber uint64) (*db.BlockHeader, error) {
+	ret := _m.Called(ctx, chainID, blockNumber)
+
+	var r0 *db.BlockHeader
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) *db.BlockHeader); ok {
+		r0 = rf(ctx, chainID, blockNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*db.BlockHeader)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64) error); ok {
+		r1 = rf(ctx, chainID, blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrieveBlockHeadersInRange provides a mock function with given fields: ctx, chainID, startBlock, endBlock, page
+func (_m *EventDB) RetrieveBlockHeadersInRange(ctx context.Context, chainID uint32, startBlock uint64, endBlock uint64, page int) ([]db.BlockHeader, error) {
+	ret := _m.Called(ctx, chainID, startBlock, endBlock, page)
+
+	var r0 []db.BlockHeader
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64, int) []db.BlockHeader); ok {
+		r0 = rf(ctx, chainID, startBlock, endBlock, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.BlockHeader)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64, uint64, int) error); ok {
+		r1 = rf(ctx, chainID, startBlock, endBlock, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveBlockTime provides a mock function with given fields: ctx, chainID, blockNumber
 func (_m *EventDB) RetrieveBlockTime(ctx context.Context, chainID uint32, blockNumber uint64) (uint64, error) {
 	ret := _m.Called(ctx, chainID, blockNumber)
@@ -145,6 +254,52 @@ func (_m *EventDB) RetrieveBlockTimesCountForChain(ctx context.Context, chainID
 	return r0, r1
 }
 
+// RetrieveConsumerCursors provides a mock function with given fields: ctx, chainID
+func (_m *EventDB) RetrieveConsumerCursors(ctx context.Context, chainID uint32) ([]db.ConsumerCursor, error) {
+	ret := _m.Called(ctx, chainID)
+
+	var r0 []db.ConsumerCursor
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) []db.ConsumerCursor); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.ConsumerCursor)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrieveEthTxsAfterCursor provides a mock function with given fields: ctx, ethTxFilter, startBlock, endBlock, after, limit
+func (_m *EventDB) RetrieveEthTxsAfterCursor(ctx context.Context, ethTxFilter db.EthTxFilter, startBlock uint64, endBlock uint64, after *db.Cursor, limit int) ([]db.TxWithBlockNumber, error) {
+	ret := _m.Called(ctx, ethTxFilter, startBlock, endBlock, after, limit)
+
+	var r0 []db.TxWithBlockNumber
+	if rf, ok := ret.Get(0).(func(context.Context, db.EthTxFilter, uint64, uint64, *db.Cursor, int) []db.TxWithBlockNumber); ok {
+		r0 = rf(ctx, ethTxFilter, startBlock, endBlock, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.TxWithBlockNumber)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, db.EthTxFilter, uint64, uint64, *db.Cursor, int) error); ok {
+		r1 = rf(ctx, ethTxFilter, startBlock, endBlock, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveEthTxsInRange provides a mock function with given fields: ctx, ethTxFilter, startBlock, endBlock, page
 func (_m *EventDB) RetrieveEthTxsInRange(ctx context.Context, ethTxFilter db.EthTxFilter, startBlock uint64, endBlock uint64, page int) ([]db.TxWithBlockNumber, error) {
 	ret := _m.Called(ctx, ethTxFilter, startBlock, endBlock, page)
@@ -212,6 +367,50 @@ func (_m *EventDB) RetrieveFirstBlockStored(ctx context.Context, chainID uint32)
 	return r0, r1
 }
 
+// RetrieveInternalTxsWithFilter provides a mock function with given fields: ctx, internalTxFilter, page
+func (_m *EventDB) RetrieveInternalTxsWithFilter(ctx context.Context, internalTxFilter db.InternalTxFilter, page int) ([]db.InternalTx, error) {
+	ret := _m.Called(ctx, internalTxFilter, page)
+
+	var r0 []db.InternalTx
+	if rf, ok := ret.Get(0).(func(context.Context, db.InternalTxFilter, int) []db.InternalTx); ok {
+		r0 = rf(ctx, internalTxFilter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.InternalTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, db.InternalTxFilter, int) error); ok {
+		r1 = rf(ctx, internalTxFilter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetrieveLastBlockBeforeTime provides a mock function with given fields: ctx, chainID, timestamp
+func (_m *EventDB) RetrieveLastBlockBeforeTime(ctx context.Context, chainID uint32, timestamp uint64) (uint64, error) {
+	ret := _m.Called(ctx, chainID, timestamp)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) uint64); ok {
+		r0 = rf(ctx, chainID, timestamp)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64) error); ok {
+		r1 = rf(ctx, chainID, timestamp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveLastBlockStored provides a mock function with given fields: ctx, chainID
 func (_m *EventDB) RetrieveLastBlockStored(ctx context.Context, chainID uint32) (uint64, error) {
 	ret := _m.Called(ctx, chainID)
@@ -298,6 +497,29 @@ func (_m *EventDB) RetrieveLastIndexedMultiple(ctx context.Context, contractAddr
 	return r0, r1
 }
 
+// RetrieveLogAggregates provides a mock function with given fields: ctx, logFilter, startBlock, endBlock
+func (_m *EventDB) RetrieveLogAggregates(ctx context.Context, logFilter db.LogFilter, startBlock uint64, endBlock uint64) ([]db.LogAggregate, error) {
+	ret := _m.Called(ctx, logFilter, startBlock, endBlock)
+
+	var r0 []db.LogAggregate
+	if rf, ok := ret.Get(0).(func(context.Context, db.LogFilter, uint64, uint64) []db.LogAggregate); ok {
+		r0 = rf(ctx, logFilter, startBlock, endBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.LogAggregate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, db.LogFilter, uint64, uint64) error); ok {
+		r1 = rf(ctx, logFilter, startBlock, endBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveLogCountForContract provides a mock function with given fields: ctx, contractAddress, chainID
 func (_m *EventDB) RetrieveLogCountForContract(ctx context.Context, contractAddress common.Address, chainID uint32) (int64, error) {
 	ret := _m.Called(ctx, contractAddress, chainID)
@@ -319,6 +541,29 @@ func (_m *EventDB) RetrieveLogCountForContract(ctx context.Context, contractAddr
 	return r0, r1
 }
 
+// RetrieveLogsAfterCursor provides a mock function with given fields: ctx, logFilter, startBlock, endBlock, after, limit
+func (_m *EventDB) RetrieveLogsAfterCursor(ctx context.Context, logFilter db.LogFilter, startBlock uint64, endBlock uint64, after *db.Cursor, limit int) ([]*types.Log, error) {
+	ret := _m.Called(ctx, logFilter, startBlock, endBlock, after, limit)
+
+	var r0 []*types.Log
+	if rf, ok := ret.Get(0).(func(context.Context, db.LogFilter, uint64, uint64, *db.Cursor, int) []*types.Log); ok {
+		r0 = rf(ctx, logFilter, startBlock, endBlock, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, db.LogFilter, uint64, uint64, *db.Cursor, int) error); ok {
+		r1 = rf(ctx, logFilter, startBlock, endBlock, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveLogsFromHeadRangeQuery provides a mock function with given fields: ctx, logFilter, startBlock, endBlock, page
 func (_m *EventDB) RetrieveLogsFromHeadRangeQuery(ctx context.Context, logFilter db.LogFilter, startBlock uint64, endBlock uint64, page int) ([]*types.Log, error) {
 	ret := _m.Called(ctx, logFilter, startBlock, endBlock, page)
@@ -432,6 +677,29 @@ func (_m *EventDB) RetrieveReceiptCountForChain(ctx context.Context, chainID uin
 	return r0, r1
 }
 
+// RetrieveReceiptsAfterCursor provides a mock function with given fields: ctx, receiptFilter, startBlock, endBlock, after, limit
+func (_m *EventDB) RetrieveReceiptsAfterCursor(ctx context.Context, receiptFilter db.ReceiptFilter, startBlock uint64, endBlock uint64, after *db.Cursor, limit int) ([]types.Receipt, error) {
+	ret := _m.Called(ctx, receiptFilter, startBlock, endBlock, after, limit)
+
+	var r0 []types.Receipt
+	if rf, ok := ret.Get(0).(func(context.Context, db.ReceiptFilter, uint64, uint64, *db.Cursor, int) []types.Receipt); ok {
+		r0 = rf(ctx, receiptFilter, startBlock, endBlock, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Receipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, db.ReceiptFilter, uint64, uint64, *db.Cursor, int) error); ok {
+		r1 = rf(ctx, receiptFilter, startBlock, endBlock, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RetrieveReceiptsFromHeadRangeQuery provides a mock function with given fields: ctx, receiptFilter, startBlock, endBlock, page
 func (_m *EventDB) RetrieveReceiptsFromHeadRangeQuery(ctx context.Context, receiptFilter db.ReceiptFilter, startBlock uint64, endBlock uint64, page int) ([]types.Receipt, error) {
 	ret := _m.Called(ctx, receiptFilter, startBlock, endBlock, page)
@@ -547,6 +815,20 @@ func (_m *EventDB) RetrieveUnconfirmedEthTxsFromHeadRangeQuery(ctx context.Conte
 	return r0, r1
 }
 
+// StoreBlockHeader provides a mock function with given fields: ctx, chainID, header
+func (_m *EventDB) StoreBlockHeader(ctx context.Context, chainID uint32, header *types.Header) error {
+	ret := _m.Called(ctx, chainID, header)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, *types.Header) error); ok {
+		r0 = rf(ctx, chainID, header)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StoreBlockTime provides a mock function with given fields: ctx, chainID, blockNumber, timestamp
 func (_m *EventDB) StoreBlockTime(ctx context.Context, chainID uint32, blockNumber uint64, timestamp uint64) error {
 	ret := _m.Called(ctx, chainID, blockNumber, timestamp)
@@ -561,6 +843,20 @@ func (_m *EventDB) StoreBlockTime(ctx context.Context, chainID uint32, blockNumb
 	return r0
 }
 
+// StoreConsumerCursor provides a mock function with given fields: ctx, consumer, chainID, blockNumber
+func (_m *EventDB) StoreConsumerCursor(ctx context.Context, consumer string, chainID uint32, blockNumber uint64) error {
+	ret := _m.Called(ctx, consumer, chainID, blockNumber)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint32, uint64) error); ok {
+		r0 = rf(ctx, consumer, chainID, blockNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StoreEthTx provides a mock function with given fields: ctx, tx, chainID, blockHash, blockNumber, transactionIndex
 func (_m *EventDB) StoreEthTx(ctx context.Context, tx *types.Transaction, chainID uint32, blockHash common.Hash, blockNumber uint64, transactionIndex uint64) error {
 	ret := _m.Called(ctx, tx, chainID, blockHash, blockNumber, transactionIndex)
@@ -589,6 +885,27 @@ func (_m *EventDB) StoreEthTxAtHead(ctx context.Context, tx *types.Transaction,
 	return r0
 }
 
+// StoreInternalTxs provides a mock function with given fields: ctx, chainID, internalTxs
+func (_m *EventDB) StoreInternalTxs(ctx context.Context, chainID uint32, internalTxs ...db.InternalTx) error {
+	_va := make([]interface{}, len(internalTxs))
+	for _i := range internalTxs {
+		_va[_i] = internalTxs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, ...db.InternalTx) error); ok {
+		r0 = rf(ctx, chainID, internalTxs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StoreLastConfirmedBlock provides a mock function with given fields: ctx, chainID, blockNumber
 func (_m *EventDB) StoreLastConfirmedBlock(ctx context.Context, chainID uint32, blockNumber uint64) error {
 	ret := _m.Called(ctx, chainID, blockNumber)
@@ -0,0 +1,146 @@
+package db_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+)
+
+func (t *DBSuite) TestPruneLogs() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		contractAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+		keepTopic := common.BigToHash(big.NewInt(gofakeit.Int64()))
+
+		// Store a log per block, every other log has the topic to keep.
+		for blockNumber := uint64(1); blockNumber <= 10; blockNumber++ {
+			log := t.buildLog(contractAddress, blockNumber)
+			if blockNumber%2 == 0 {
+				log.Topics[0] = keepTopic
+			}
+			Nil(t.T(), testDB.StoreLogs(t.GetTestContext(), chainID, log))
+		}
+
+		// Prune everything below block 5.
+		pruned, err := testDB.PruneLogs(t.GetTestContext(), chainID, contractAddress, 5, nil)
+		Nil(t.T(), err)
+		Equal(t.T(), int64(4), pruned)
+
+		// Prune logs without the kept topic below block 9.
+		pruned, err = testDB.PruneLogs(t.GetTestContext(), chainID, contractAddress, 9, []common.Hash{keepTopic})
+		Nil(t.T(), err)
+		Equal(t.T(), int64(2), pruned)
+
+		logs, err := testDB.RetrieveLogsInRangeAsc(t.GetTestContext(), db.LogFilter{ChainID: chainID, ContractAddress: contractAddress.String()}, 0, 10, 1)
+		Nil(t.T(), err)
+		blockNumbers := make([]uint64, len(logs))
+		for i, log := range logs {
+			blockNumbers[i] = log.BlockNumber
+		}
+		Equal(t.T(), []uint64{6, 8, 9, 10}, blockNumbers)
+	})
+}
+
+func (t *DBSuite) TestPruneLogsAcrossBatches() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+		contractAddress := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+
+		// Store logs spread over several prune batches with gaps between them.
+		for _, blockNumber := range []uint64{10, 1500, 1501, 5000, 9000} {
+			Nil(t.T(), testDB.StoreLogs(t.GetTestContext(), chainID, t.buildLog(contractAddress, blockNumber)))
+		}
+
+		pruned, err := testDB.PruneLogs(t.GetTestContext(), chainID, contractAddress, 5001, nil)
+		Nil(t.T(), err)
+		Equal(t.T(), int64(4), pruned)
+
+		logs, err := testDB.RetrieveLogsInRangeAsc(t.GetTestContext(), db.LogFilter{ChainID: chainID, ContractAddress: contractAddress.String()}, 0, 10000, 1)
+		Nil(t.T(), err)
+		Len(t.T(), logs, 1)
+		Equal(t.T(), uint64(9000), logs[0].BlockNumber)
+	})
+}
+
+func (t *DBSuite) TestPruneReceiptsAndBlockData() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+
+		for blockNumber := uint64(1); blockNumber <= 4; blockNumber++ {
+			receipt := t.MakeRandomReceipt(common.BigToHash(big.NewInt(gofakeit.Int64())))
+			receipt.BlockNumber = new(big.Int).SetUint64(blockNumber)
+			Nil(t.T(), testDB.StoreReceipt(t.GetTestContext(), chainID, receipt))
+
+			Nil(t.T(), testDB.StoreInternalTxs(t.GetTestContext(), chainID, db.InternalTx{
+				TxHash:       receipt.TxHash,
+				BlockNumber:  blockNumber,
+				TraceAddress: []int{0},
+				Value:        big.NewInt(1),
+			}))
+		}
+
+		pruned, err := testDB.PruneReceiptsAndTxs(t.GetTestContext(), chainID, 3)
+		Nil(t.T(), err)
+		Equal(t.T(), int64(2), pruned)
+
+		receipts, err := testDB.RetrieveReceiptsWithFilter(t.GetTestContext(), db.ReceiptFilter{ChainID: chainID}, 1)
+		Nil(t.T(), err)
+		Len(t.T(), receipts, 2)
+
+		pruned, err = testDB.PruneBlockData(t.GetTestContext(), chainID, 4)
+		Nil(t.T(), err)
+		Equal(t.T(), int64(3), pruned)
+
+		internalTxs, err := testDB.RetrieveInternalTxsWithFilter(t.GetTestContext(), db.InternalTxFilter{ChainID: chainID}, 1)
+		Nil(t.T(), err)
+		Len(t.T(), internalTxs, 1)
+	})
+}
+
+func (t *DBSuite) TestStoreRetrieveConsumerCursor() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+
+		Nil(t.T(), testDB.StoreConsumerCursor(t.GetTestContext(), "explorer", chainID, 10))
+		Nil(t.T(), testDB.StoreConsumerCursor(t.GetTestContext(), "relayer", chainID, 20))
+		// Moving a cursor updates it in place.
+		Nil(t.T(), testDB.StoreConsumerCursor(t.GetTestContext(), "explorer", chainID, 15))
+
+		cursors, err := testDB.RetrieveConsumerCursors(t.GetTestContext(), chainID)
+		Nil(t.T(), err)
+		Len(t.T(), cursors, 2)
+		for _, cursor := range cursors {
+			switch cursor.Consumer {
+			case "explorer":
+				Equal(t.T(), uint64(15), cursor.BlockNumber)
+			case "relayer":
+				Equal(t.T(), uint64(20), cursor.BlockNumber)
+			default:
+				t.T().Errorf("unexpected consumer %s", cursor.Consumer)
+			}
+			WithinDuration(t.T(), time.Now(), cursor.UpdatedAt, time.Minute)
+		}
+	})
+}
+
+func (t *DBSuite) TestRetrieveLastBlockBeforeTime() {
+	t.RunOnAllDBs(func(testDB db.EventDB) {
+		chainID := gofakeit.Uint32()
+
+		lastBlock, err := testDB.RetrieveLastBlockBeforeTime(t.GetTestContext(), chainID, 1000)
+		Nil(t.T(), err)
+		Equal(t.T(), uint64(0), lastBlock)
+
+		for blockNumber := uint64(1); blockNumber <= 10; blockNumber++ {
+			Nil(t.T(), testDB.StoreBlockTime(t.GetTestContext(), chainID, blockNumber, blockNumber*100))
+		}
+
+		lastBlock, err = testDB.RetrieveLastBlockBeforeTime(t.GetTestContext(), chainID, 550)
+		Nil(t.T(), err)
+		Equal(t.T(), uint64(5), lastBlock)
+	})
+}
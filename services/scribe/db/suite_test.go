@@ -11,6 +11,7 @@
 
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/dockerutil"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/core/metrics/localmetrics"
 	"github.com/synapsecns/sanguine/core/testsuite"
@@ -21,7 +22,10 @@
 	. "github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/mysql"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/postgres"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/sqlite"
+	pg "gorm.io/driver/postgres"
+	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
@@ -30,6 +34,8 @@ type DBSuite struct {
 	dbs           []db.EventDB
 	logIndex      atomic.Int64
 	scribeMetrics metrics.Handler
+	// postgresPort is the port of the postgres container, empty if postgres tests are disabled.
+	postgresPort string
 }
 
 // NewEventDBSuite creates a new EventDBSuite.
@@ -50,6 +56,7 @@ func (t *DBSuite) SetupTest() {
 
 	t.dbs = []db.EventDB{sqliteStore}
 	t.setupMysqlDB()
+	t.setupPostgresDB()
 }
 
 func (t *DBSuite) SetupSuite() {
@@ -68,6 +75,10 @@ func (t *DBSuite) SetupSuite() {
 	var err error
 	t.scribeMetrics, err = metrics.NewByType(t.GetSuiteContext(), metadata.BuildInfo(), metricsHandler)
 	t.Require().Nil(err)
+
+	if os.Getenv(dbcommon.EnablePostgresTestVar) != "" {
+		t.postgresPort = dockerutil.StartPostgres(t.GetSuiteContext(), t.T())
+	}
 }
 
 func (t *DBSuite) setupMysqlDB() {
@@ -102,6 +113,47 @@ func (t *DBSuite) setupMysqlDB() {
 	t.dbs = append(t.dbs, mysqlStore)
 }
 
+func (t *DBSuite) setupPostgresDB() {
+	// skip if postgres test disabled
+	if t.postgresPort == "" {
+		return
+	}
+
+	// index names are global to a postgres schema, so rather than prefixing tables each test gets its own database.
+	testDB, err := gorm.Open(pg.Open(dockerutil.PostgresConnString(t.postgresPort, dockerutil.PostgresDatabase)), &gorm.Config{})
+	Nil(t.T(), err)
+
+	dbName := fmt.Sprintf("test%d_%d", t.GetTestID(), time.Now().Unix())
+	Nil(t.T(), testDB.Exec(fmt.Sprintf("CREATE DATABASE %s", dbName)).Error)
+
+	sqlDB, err := testDB.DB()
+	Nil(t.T(), err)
+	Nil(t.T(), sqlDB.Close())
+
+	postgres.MaxIdleConns = 10
+	postgres.MaxOpenConns = 10
+
+	postgresStore, err := postgres.NewPostgresStore(t.GetTestContext(), dockerutil.PostgresConnString(t.postgresPort, dbName), t.scribeMetrics, false)
+	Nil(t.T(), err)
+	t.dbs = append(t.dbs, postgresStore)
+
+	// drop the database once the test is done so the container doesn't accumulate one database per test.
+	tt := t.T()
+	tt.Cleanup(func() {
+		storeDB, err := postgresStore.DB().DB()
+		Nil(tt, err)
+		Nil(tt, storeDB.Close())
+
+		adminDB, err := gorm.Open(pg.Open(dockerutil.PostgresConnString(t.postgresPort, dockerutil.PostgresDatabase)), &gorm.Config{})
+		Nil(tt, err)
+		Nil(tt, adminDB.Exec(fmt.Sprintf("DROP DATABASE IF EXISTS %s WITH (FORCE)", dbName)).Error)
+
+		adminSQLDB, err := adminDB.DB()
+		Nil(tt, err)
+		Nil(tt, adminSQLDB.Close())
+	})
+}
+
 func (t *DBSuite) RunOnAllDBs(testFunc func(testDB db.EventDB)) {
 	t.T().Helper()
 
@@ -19,24 +19,35 @@ func NewClient(cli *http.Client, baseURL string, options ...client.HTTPRequestOp
 }
 
 type Query struct {
-	Logs                     []*model.Log         "json:\"logs\" graphql:\"logs\""
-	LogsRange                []*model.Log         "json:\"logsRange\" graphql:\"logsRange\""
-	Receipts                 []*model.Receipt     "json:\"receipts\" graphql:\"receipts\""
-	ReceiptsRange            []*model.Receipt     "json:\"receiptsRange\" graphql:\"receiptsRange\""
-	Transactions             []*model.Transaction "json:\"transactions\" graphql:\"transactions\""
-	TransactionsRange        []*model.Transaction "json:\"transactionsRange\" graphql:\"transactionsRange\""
-	BlockTime                *int                 "json:\"blockTime\" graphql:\"blockTime\""
-	LastStoredBlockNumber    *int                 "json:\"lastStoredBlockNumber\" graphql:\"lastStoredBlockNumber\""
-	FirstStoredBlockNumber   *int                 "json:\"firstStoredBlockNumber\" graphql:\"firstStoredBlockNumber\""
-	LastConfirmedBlockNumber *int                 "json:\"lastConfirmedBlockNumber\" graphql:\"lastConfirmedBlockNumber\""
-	TxSender                 *string              "json:\"txSender\" graphql:\"txSender\""
-	LastIndexed              *int                 "json:\"lastIndexed\" graphql:\"lastIndexed\""
-	LogCount                 *int                 "json:\"logCount\" graphql:\"logCount\""
-	ReceiptCount             *int                 "json:\"receiptCount\" graphql:\"receiptCount\""
-	BlockTimeCount           *int                 "json:\"blockTimeCount\" graphql:\"blockTimeCount\""
-	LogsAtHeadRange          []*model.Log         "json:\"logsAtHeadRange\" graphql:\"logsAtHeadRange\""
-	ReceiptsAtHeadRange      []*model.Receipt     "json:\"receiptsAtHeadRange\" graphql:\"receiptsAtHeadRange\""
-	TransactionsAtHeadRange  []*model.Transaction "json:\"transactionsAtHeadRange\" graphql:\"transactionsAtHeadRange\""
+	Logs                     []*model.Log                 "json:\"logs\" graphql:\"logs\""
+	LogsRange                []*model.Log                 "json:\"logsRange\" graphql:\"logsRange\""
+	Receipts                 []*model.Receipt             "json:\"receipts\" graphql:\"receipts\""
+	ReceiptsRange            []*model.Receipt             "json:\"receiptsRange\" graphql:\"receiptsRange\""
+	Transactions             []*model.Transaction         "json:\"transactions\" graphql:\"transactions\""
+	TransactionsRange        []*model.Transaction         "json:\"transactionsRange\" graphql:\"transactionsRange\""
+	BlockTime                *int                         "json:\"blockTime\" graphql:\"blockTime\""
+	LastStoredBlockNumber    *int                         "json:\"lastStoredBlockNumber\" graphql:\"lastStoredBlockNumber\""
+	FirstStoredBlockNumber   *int                         "json:\"firstStoredBlockNumber\" graphql:\"firstStoredBlockNumber\""
+	LastConfirmedBlockNumber *int                         "json:\"lastConfirmedBlockNumber\" graphql:\"lastConfirmedBlockNumber\""
+	TxSender                 *string                      "json:\"txSender\" graphql:\"txSender\""
+	LastIndexed              *int                         "json:\"lastIndexed\" graphql:\"lastIndexed\""
+	LogCount                 *int                         "json:\"logCount\" graphql:\"logCount\""
+	ReceiptCount             *int                         "json:\"receiptCount\" graphql:\"receiptCount\""
+	BlockTimeCount           *int                         "json:\"blockTimeCount\" graphql:\"blockTimeCount\""
+	LogsAtHeadRange          []*model.Log                 "json:\"logsAtHeadRange\" graphql:\"logsAtHeadRange\""
+	ReceiptsAtHeadRange      []*model.Receipt             "json:\"receiptsAtHeadRange\" graphql:\"receiptsAtHeadRange\""
+	TransactionsAtHeadRange  []*model.Transaction         "json:\"transactionsAtHeadRange\" graphql:\"transactionsAtHeadRange\""
+	BlockHeader              *model.BlockHeader           "json:\"blockHeader\" graphql:\"blockHeader\""
+	BlockHeaders             []*model.BlockHeader         "json:\"blockHeaders\" graphql:\"blockHeaders\""
+	InternalTransactions     []*model.InternalTransaction "json:\"internalTransactions\" graphql:\"internalTransactions\""
+	ConsumerCursors          []*model.ConsumerCursor      "json:\"consumerCursors\" graphql:\"consumerCursors\""
+	LogsConnection           *model.LogConnection         "json:\"logsConnection\" graphql:\"logsConnection\""
+	ReceiptsConnection       *model.ReceiptConnection     "json:\"receiptsConnection\" graphql:\"receiptsConnection\""
+	TransactionsConnection   *model.TransactionConnection "json:\"transactionsConnection\" graphql:\"transactionsConnection\""
+	LogAggregates            []*model.LogAggregate        "json:\"logAggregates\" graphql:\"logAggregates\""
+}
+type Mutation struct {
+	UpdateConsumerCursor *model.ConsumerCursor "json:\"updateConsumerCursor\" graphql:\"updateConsumerCursor\""
 }
 type GetLogs struct {
 	Response []*struct {
@@ -296,6 +307,139 @@ type GetReceiptCount struct {
 type GetBlockTimeCount struct {
 	Response *int "json:\"response\" graphql:\"response\""
 }
+type GetBlockHeader struct {
+	Response *struct {
+		ChainID     int     "json:\"chain_id\" graphql:\"chain_id\""
+		BlockNumber int     "json:\"block_number\" graphql:\"block_number\""
+		BlockHash   string  "json:\"block_hash\" graphql:\"block_hash\""
+		ParentHash  string  "json:\"parent_hash\" graphql:\"parent_hash\""
+		Miner       string  "json:\"miner\" graphql:\"miner\""
+		GasLimit    int     "json:\"gas_limit\" graphql:\"gas_limit\""
+		GasUsed     int     "json:\"gas_used\" graphql:\"gas_used\""
+		BaseFee     *string "json:\"base_fee\" graphql:\"base_fee\""
+		Timestamp   int     "json:\"timestamp\" graphql:\"timestamp\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetBlockHeaders struct {
+	Response []*struct {
+		ChainID     int     "json:\"chain_id\" graphql:\"chain_id\""
+		BlockNumber int     "json:\"block_number\" graphql:\"block_number\""
+		BlockHash   string  "json:\"block_hash\" graphql:\"block_hash\""
+		ParentHash  string  "json:\"parent_hash\" graphql:\"parent_hash\""
+		Miner       string  "json:\"miner\" graphql:\"miner\""
+		GasLimit    int     "json:\"gas_limit\" graphql:\"gas_limit\""
+		GasUsed     int     "json:\"gas_used\" graphql:\"gas_used\""
+		BaseFee     *string "json:\"base_fee\" graphql:\"base_fee\""
+		Timestamp   int     "json:\"timestamp\" graphql:\"timestamp\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetInternalTransactions struct {
+	Response []*struct {
+		ChainID      int     "json:\"chain_id\" graphql:\"chain_id\""
+		TxHash       string  "json:\"tx_hash\" graphql:\"tx_hash\""
+		BlockNumber  int     "json:\"block_number\" graphql:\"block_number\""
+		BlockHash    string  "json:\"block_hash\" graphql:\"block_hash\""
+		TraceAddress []int   "json:\"trace_address\" graphql:\"trace_address\""
+		CallType     string  "json:\"call_type\" graphql:\"call_type\""
+		From         string  "json:\"from\" graphql:\"from\""
+		To           string  "json:\"to\" graphql:\"to\""
+		Value        string  "json:\"value\" graphql:\"value\""
+		Gas          int     "json:\"gas\" graphql:\"gas\""
+		GasUsed      int     "json:\"gas_used\" graphql:\"gas_used\""
+		Input        string  "json:\"input\" graphql:\"input\""
+		Error        *string "json:\"error\" graphql:\"error\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetConsumerCursors struct {
+	Response []*struct {
+		Consumer    string "json:\"consumer\" graphql:\"consumer\""
+		ChainID     int    "json:\"chain_id\" graphql:\"chain_id\""
+		BlockNumber int    "json:\"block_number\" graphql:\"block_number\""
+		UpdatedAt   int    "json:\"updated_at\" graphql:\"updated_at\""
+	} "json:\"response\" graphql:\"response\""
+}
+type UpdateConsumerCursor struct {
+	Response *struct {
+		Consumer    string "json:\"consumer\" graphql:\"consumer\""
+		ChainID     int    "json:\"chain_id\" graphql:\"chain_id\""
+		BlockNumber int    "json:\"block_number\" graphql:\"block_number\""
+		UpdatedAt   int    "json:\"updated_at\" graphql:\"updated_at\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetLogsConnection struct {
+	Response *struct {
+		Logs []*struct {
+			ContractAddress string   "json:\"contract_address\" graphql:\"contract_address\""
+			ChainID         int      "json:\"chain_id\" graphql:\"chain_id\""
+			Topics          []string "json:\"topics\" graphql:\"topics\""
+			Data            string   "json:\"data\" graphql:\"data\""
+			BlockNumber     int      "json:\"block_number\" graphql:\"block_number\""
+			TxHash          string   "json:\"tx_hash\" graphql:\"tx_hash\""
+			TxIndex         int      "json:\"tx_index\" graphql:\"tx_index\""
+			BlockHash       string   "json:\"block_hash\" graphql:\"block_hash\""
+			Index           int      "json:\"index\" graphql:\"index\""
+			Removed         bool     "json:\"removed\" graphql:\"removed\""
+		} "json:\"logs\" graphql:\"logs\""
+		PageInfo struct {
+			EndCursor   *string "json:\"end_cursor\" graphql:\"end_cursor\""
+			HasNextPage bool    "json:\"has_next_page\" graphql:\"has_next_page\""
+		} "json:\"page_info\" graphql:\"page_info\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetReceiptsConnection struct {
+	Response *struct {
+		Receipts []*struct {
+			ChainID           int    "json:\"chain_id\" graphql:\"chain_id\""
+			Type              int    "json:\"type\" graphql:\"type\""
+			PostState         string "json:\"post_state\" graphql:\"post_state\""
+			Status            int    "json:\"status\" graphql:\"status\""
+			CumulativeGasUsed int    "json:\"cumulative_gas_used\" graphql:\"cumulative_gas_used\""
+			Bloom             string "json:\"bloom\" graphql:\"bloom\""
+			TxHash            string "json:\"tx_hash\" graphql:\"tx_hash\""
+			ContractAddress   string "json:\"contract_address\" graphql:\"contract_address\""
+			GasUsed           int    "json:\"gas_used\" graphql:\"gas_used\""
+			BlockNumber       int    "json:\"block_number\" graphql:\"block_number\""
+			TransactionIndex  int    "json:\"transaction_index\" graphql:\"transaction_index\""
+		} "json:\"receipts\" graphql:\"receipts\""
+		PageInfo struct {
+			EndCursor   *string "json:\"end_cursor\" graphql:\"end_cursor\""
+			HasNextPage bool    "json:\"has_next_page\" graphql:\"has_next_page\""
+		} "json:\"page_info\" graphql:\"page_info\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetTransactionsConnection struct {
+	Response *struct {
+		Transactions []*struct {
+			ChainID   int    "json:\"chain_id\" graphql:\"chain_id\""
+			TxHash    string "json:\"tx_hash\" graphql:\"tx_hash\""
+			Protected bool   "json:\"protected\" graphql:\"protected\""
+			Type      int    "json:\"type\" graphql:\"type\""
+			Data      string "json:\"data\" graphql:\"data\""
+			Gas       int    "json:\"gas\" graphql:\"gas\""
+			GasPrice  int    "json:\"gas_price\" graphql:\"gas_price\""
+			GasTipCap string "json:\"gas_tip_cap\" graphql:\"gas_tip_cap\""
+			GasFeeCap string "json:\"gas_fee_cap\" graphql:\"gas_fee_cap\""
+			Value     string "json:\"value\" graphql:\"value\""
+			Nonce     int    "json:\"nonce\" graphql:\"nonce\""
+			To        string "json:\"to\" graphql:\"to\""
+			Timestamp int    "json:\"timestamp\" graphql:\"timestamp\""
+			Sender    string "json:\"sender\" graphql:\"sender\""
+		} "json:\"transactions\" graphql:\"transactions\""
+		PageInfo struct {
+			EndCursor   *string "json:\"end_cursor\" graphql:\"end_cursor\""
+			HasNextPage bool    "json:\"has_next_page\" graphql:\"has_next_page\""
+		} "json:\"page_info\" graphql:\"page_info\""
+	} "json:\"response\" graphql:\"response\""
+}
+type GetLogAggregates struct {
+	Response []*struct {
+		ContractAddress string  "json:\"contract_address\" graphql:\"contract_address\""
+		Topic           *string "json:\"topic\" graphql:\"topic\""
+		Count           int     "json:\"count\" graphql:\"count\""
+		FirstBlock      int     "json:\"first_block\" graphql:\"first_block\""
+		LastBlock       int     "json:\"last_block\" graphql:\"last_block\""
+	} "json:\"response\" graphql:\"response\""
+}
 
 const GetLogsDocument = `query GetLogs ($chain_id: Int!, $page: Int!) {
 	response: logs(chain_id: $chain_id, page: $page) {
@@ -900,3 +1044,296 @@ func (c *Client) GetBlockTimeCount(ctx context.Context, chainID int, httpRequest
 
 	return &res, nil
 }
+
+const GetBlockHeaderDocument = `query GetBlockHeader ($chain_id: Int!, $block_number: Int!) {
+	response: blockHeader(chain_id: $chain_id, block_number: $block_number) {
+		chain_id
+		block_number
+		block_hash
+		parent_hash
+		miner
+		gas_limit
+		gas_used
+		base_fee
+		timestamp
+	}
+}
+`
+
+func (c *Client) GetBlockHeader(ctx context.Context, chainID int, blockNumber int, httpRequestOptions ...client.HTTPRequestOption) (*GetBlockHeader, error) {
+	vars := map[string]interface{}{
+		"chain_id":     chainID,
+		"block_number": blockNumber,
+	}
+
+	var res GetBlockHeader
+	if err := c.Client.Post(ctx, "GetBlockHeader", GetBlockHeaderDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetBlockHeadersDocument = `query GetBlockHeaders ($chain_id: Int!, $start_block: Int!, $end_block: Int!, $page: Int!) {
+	response: blockHeaders(chain_id: $chain_id, start_block: $start_block, end_block: $end_block, page: $page) {
+		chain_id
+		block_number
+		block_hash
+		parent_hash
+		miner
+		gas_limit
+		gas_used
+		base_fee
+		timestamp
+	}
+}
+`
+
+func (c *Client) GetBlockHeaders(ctx context.Context, chainID int, startBlock int, endBlock int, page int, httpRequestOptions ...client.HTTPRequestOption) (*GetBlockHeaders, error) {
+	vars := map[string]interface{}{
+		"chain_id":    chainID,
+		"start_block": startBlock,
+		"end_block":   endBlock,
+		"page":        page,
+	}
+
+	var res GetBlockHeaders
+	if err := c.Client.Post(ctx, "GetBlockHeaders", GetBlockHeadersDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetInternalTransactionsDocument = `query GetInternalTransactions ($chain_id: Int!, $tx_hash: String, $page: Int!) {
+	response: internalTransactions(chain_id: $chain_id, tx_hash: $tx_hash, page: $page) {
+		chain_id
+		tx_hash
+		block_number
+		block_hash
+		trace_address
+		call_type
+		from
+		to
+		value
+		gas
+		gas_used
+		input
+		error
+	}
+}
+`
+
+func (c *Client) GetInternalTransactions(ctx context.Context, chainID int, txHash *string, page int, httpRequestOptions ...client.HTTPRequestOption) (*GetInternalTransactions, error) {
+	vars := map[string]interface{}{
+		"chain_id": chainID,
+		"tx_hash":  txHash,
+		"page":     page,
+	}
+
+	var res GetInternalTransactions
+	if err := c.Client.Post(ctx, "GetInternalTransactions", GetInternalTransactionsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetConsumerCursorsDocument = `query GetConsumerCursors ($chain_id: Int!) {
+	response: consumerCursors(chain_id: $chain_id) {
+		consumer
+		chain_id
+		block_number
+		updated_at
+	}
+}
+`
+
+func (c *Client) GetConsumerCursors(ctx context.Context, chainID int, httpRequestOptions ...client.HTTPRequestOption) (*GetConsumerCursors, error) {
+	vars := map[string]interface{}{
+		"chain_id": chainID,
+	}
+
+	var res GetConsumerCursors
+	if err := c.Client.Post(ctx, "GetConsumerCursors", GetConsumerCursorsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateConsumerCursorDocument = `mutation UpdateConsumerCursor ($consumer: String!, $chain_id: Int!, $block_number: Int!) {
+	response: updateConsumerCursor(consumer: $consumer, chain_id: $chain_id, block_number: $block_number) {
+		consumer
+		chain_id
+		block_number
+		updated_at
+	}
+}
+`
+
+func (c *Client) UpdateConsumerCursor(ctx context.Context, consumer string, chainID int, blockNumber int, httpRequestOptions ...client.HTTPRequestOption) (*UpdateConsumerCursor, error) {
+	vars := map[string]interface{}{
+		"consumer":     consumer,
+		"chain_id":     chainID,
+		"block_number": blockNumber,
+	}
+
+	var res UpdateConsumerCursor
+	if err := c.Client.Post(ctx, "UpdateConsumerCursor", UpdateConsumerCursorDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetLogsConnectionDocument = `query GetLogsConnection ($chain_id: Int!, $contract_address: String, $start_block: Int!, $end_block: Int!, $first: Int, $after: String) {
+	response: logsConnection(chain_id: $chain_id, contract_address: $contract_address, start_block: $start_block, end_block: $end_block, first: $first, after: $after) {
+		logs {
+			contract_address
+			chain_id
+			topics
+			data
+			block_number
+			tx_hash
+			tx_index
+			block_hash
+			index
+			removed
+		}
+		page_info {
+			end_cursor
+			has_next_page
+		}
+	}
+}
+`
+
+func (c *Client) GetLogsConnection(ctx context.Context, chainID int, contractAddress *string, startBlock int, endBlock int, first *int, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetLogsConnection, error) {
+	vars := map[string]interface{}{
+		"chain_id":         chainID,
+		"contract_address": contractAddress,
+		"start_block":      startBlock,
+		"end_block":        endBlock,
+		"first":            first,
+		"after":            after,
+	}
+
+	var res GetLogsConnection
+	if err := c.Client.Post(ctx, "GetLogsConnection", GetLogsConnectionDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetReceiptsConnectionDocument = `query GetReceiptsConnection ($chain_id: Int!, $start_block: Int!, $end_block: Int!, $first: Int, $after: String) {
+	response: receiptsConnection(chain_id: $chain_id, start_block: $start_block, end_block: $end_block, first: $first, after: $after) {
+		receipts {
+			chain_id
+			type
+			post_state
+			status
+			cumulative_gas_used
+			bloom
+			tx_hash
+			contract_address
+			gas_used
+			block_number
+			transaction_index
+		}
+		page_info {
+			end_cursor
+			has_next_page
+		}
+	}
+}
+`
+
+func (c *Client) GetReceiptsConnection(ctx context.Context, chainID int, startBlock int, endBlock int, first *int, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetReceiptsConnection, error) {
+	vars := map[string]interface{}{
+		"chain_id":    chainID,
+		"start_block": startBlock,
+		"end_block":   endBlock,
+		"first":       first,
+		"after":       after,
+	}
+
+	var res GetReceiptsConnection
+	if err := c.Client.Post(ctx, "GetReceiptsConnection", GetReceiptsConnectionDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetTransactionsConnectionDocument = `query GetTransactionsConnection ($chain_id: Int!, $start_block: Int!, $end_block: Int!, $first: Int, $after: String) {
+	response: transactionsConnection(chain_id: $chain_id, start_block: $start_block, end_block: $end_block, first: $first, after: $after) {
+		transactions {
+			chain_id
+			tx_hash
+			protected
+			type
+			data
+			gas
+			gas_price
+			gas_tip_cap
+			gas_fee_cap
+			value
+			nonce
+			to
+			timestamp
+			sender
+		}
+		page_info {
+			end_cursor
+			has_next_page
+		}
+	}
+}
+`
+
+func (c *Client) GetTransactionsConnection(ctx context.Context, chainID int, startBlock int, endBlock int, first *int, after *string, httpRequestOptions ...client.HTTPRequestOption) (*GetTransactionsConnection, error) {
+	vars := map[string]interface{}{
+		"chain_id":    chainID,
+		"start_block": startBlock,
+		"end_block":   endBlock,
+		"first":       first,
+		"after":       after,
+	}
+
+	var res GetTransactionsConnection
+	if err := c.Client.Post(ctx, "GetTransactionsConnection", GetTransactionsConnectionDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetLogAggregatesDocument = `query GetLogAggregates ($chain_id: Int!, $contract_address: String, $topic: String, $start_block: Int!, $end_block: Int!) {
+	response: logAggregates(chain_id: $chain_id, contract_address: $contract_address, topic: $topic, start_block: $start_block, end_block: $end_block) {
+		contract_address
+		topic
+		count
+		first_block
+		last_block
+	}
+}
+`
+
+func (c *Client) GetLogAggregates(ctx context.Context, chainID int, contractAddress *string, topic *string, startBlock int, endBlock int, httpRequestOptions ...client.HTTPRequestOption) (*GetLogAggregates, error) {
+	vars := map[string]interface{}{
+		"chain_id":         chainID,
+		"contract_address": contractAddress,
+		"topic":            topic,
+		"start_block":      startBlock,
+		"end_block":        endBlock,
+	}
+
+	var res GetLogAggregates
+	if err := c.Client.Post(ctx, "GetLogAggregates", GetLogAggregatesDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -17,14 +17,15 @@
 	GraphiqlEndpoint string = "/graphiql"
 )
 
-// EnableGraphql enables the scribe graphql service.
-func EnableGraphql(engine *gin.Engine, eventDB db.EventDB, omniRPCURL string, metrics metrics.Handler) {
+// EnableGraphql enables the scribe graphql service. Consumers move their cursors with the consumer token.
+func EnableGraphql(engine *gin.Engine, eventDB db.EventDB, omniRPCURL string, consumerToken string, metrics metrics.Handler) {
 	server := handler.NewDefaultServer(
 		resolvers.NewExecutableSchema(
 			resolvers.Config{Resolvers: &graph.Resolver{
-				DB:         eventDB,
-				OmniRPCURL: omniRPCURL,
-				Metrics:    metrics,
+				DB:            eventDB,
+				OmniRPCURL:    omniRPCURL,
+				Metrics:       metrics,
+				ConsumerToken: consumerToken,
 			}},
 		),
 	)
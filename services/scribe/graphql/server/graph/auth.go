@@ -0,0 +1,29 @@
+package graph
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+)
+
+type authorizationKey struct{}
+
+// WithAuthorization adds the authorization header of a request to its context.
+func WithAuthorization(ctx context.Context, header string) context.Context {
+	return context.WithValue(ctx, authorizationKey{}, header)
+}
+
+// errUnauthorized is returned to consumers without the consumer token.
+var errUnauthorized = errors.New("invalid consumer token")
+
+// authorizeConsumer checks the bearer token of the request against the consumer token. Consumers are refused when no
+// consumer token is configured.
+func (r Resolver) authorizeConsumer(ctx context.Context) error {
+	header, _ := ctx.Value(authorizationKey{}).(string)
+	token := strings.TrimPrefix(header, "Bearer ")
+	if r.ConsumerToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(r.ConsumerToken)) != 1 {
+		return errUnauthorized
+	}
+	return nil
+}
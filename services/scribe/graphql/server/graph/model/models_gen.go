@@ -6,12 +6,48 @@
 	"github.com/synapsecns/sanguine/services/scribe/graphql/server/types"
 )
 
+type BlockHeader struct {
+	ChainID     int     `json:"chain_id"`
+	BlockNumber int     `json:"block_number"`
+	BlockHash   string  `json:"block_hash"`
+	ParentHash  string  `json:"parent_hash"`
+	Miner       string  `json:"miner"`
+	GasLimit    int     `json:"gas_limit"`
+	GasUsed     int     `json:"gas_used"`
+	BaseFee     *string `json:"base_fee,omitempty"`
+	Timestamp   int     `json:"timestamp"`
+}
+
 type BlockTime struct {
 	ChainID     int `json:"chain_id"`
 	BlockNumber int `json:"block_number"`
 	Timestamp   int `json:"timestamp"`
 }
 
+type ConsumerCursor struct {
+	Consumer    string `json:"consumer"`
+	ChainID     int    `json:"chain_id"`
+	BlockNumber int    `json:"block_number"`
+	UpdatedAt   int    `json:"updated_at"`
+}
+
+type InternalTransaction struct {
+	ChainID      int     `json:"chain_id"`
+	TxHash       string  `json:"tx_hash"`
+	BlockNumber  int     `json:"block_number"`
+	BlockHash    string  `json:"block_hash"`
+	TraceAddress []int   `json:"trace_address"`
+	CallType     string  `json:"call_type"`
+	From         string  `json:"from"`
+	To           string  `json:"to"`
+	Value        string  `json:"value"`
+	Gas          int     `json:"gas"`
+	GasUsed      int     `json:"gas_used"`
+	Input        string  `json:"input"`
+	Error        *string `json:"error,omitempty"`
+	Page         int     `json:"page"`
+}
+
 type Log struct {
 	ContractAddress string       `json:"contract_address"`
 	ChainID         int          `json:"chain_id"`
@@ -29,6 +65,24 @@ type Log struct {
 	JSON            types.JSON   `json:"json"`
 }
 
+type LogAggregate struct {
+	ContractAddress string  `json:"contract_address"`
+	Topic           *string `json:"topic,omitempty"`
+	Count           int     `json:"count"`
+	FirstBlock      int     `json:"first_block"`
+	LastBlock       int     `json:"last_block"`
+}
+
+type LogConnection struct {
+	Logs     []*Log    `json:"logs"`
+	PageInfo *PageInfo `json:"page_info"`
+}
+
+type PageInfo struct {
+	EndCursor   *string `json:"end_cursor,omitempty"`
+	HasNextPage bool    `json:"has_next_page"`
+}
+
 type Receipt struct {
 	ChainID           int          `json:"chain_id"`
 	Type              int          `json:"type"`
@@ -47,6 +101,11 @@ type Receipt struct {
 	JSON              types.JSON   `json:"json"`
 }
 
+type ReceiptConnection struct {
+	Receipts []*Receipt `json:"receipts"`
+	PageInfo *PageInfo  `json:"page_info"`
+}
+
 type Transaction struct {
 	ChainID   int        `json:"chain_id"`
 	TxHash    string     `json:"tx_hash"`
@@ -67,3 +126,8 @@ type Transaction struct {
 	Receipt   *Receipt   `json:"receipt"`
 	JSON      types.JSON `json:"json"`
 }
+
+type TransactionConnection struct {
+	Transactions []*Transaction `json:"transactions"`
+	PageInfo     *PageInfo      `json:"page_info"`
+}
@@ -0,0 +1,54 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.36
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/synapsecns/sanguine/services/scribe/graphql/server/graph/model"
+	resolvers "github.com/synapsecns/sanguine/services/scribe/graphql/server/graph/resolver"
+)
+
+// UpdateConsumerCursor is the resolver for the updateConsumerCursor field.
+func (r *mutationResolver) UpdateConsumerCursor(ctx context.Context, consumer string, chainID int, blockNumber int) (*model.ConsumerCursor, error) {
+	err := r.authorizeConsumer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if consumer == "" {
+		return nil, fmt.Errorf("consumer is required")
+	}
+	if chainID <= 0 || chainID > math.MaxUint32 {
+		return nil, fmt.Errorf("invalid chain id %d", chainID)
+	}
+	if blockNumber < 0 {
+		return nil, fmt.Errorf("invalid block number %d", blockNumber)
+	}
+
+	err = r.DB.StoreConsumerCursor(ctx, consumer, uint32(chainID), uint64(blockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("error storing consumer cursor: %w", err)
+	}
+
+	cursors, err := r.DB.RetrieveConsumerCursors(ctx, uint32(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving consumer cursors: %w", err)
+	}
+
+	for _, cursor := range cursors {
+		if cursor.Consumer == consumer {
+			return r.consumerCursorToModelConsumerCursor(cursor), nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find consumer cursor for %s after storing it", consumer)
+}
+
+// Mutation returns resolvers.MutationResolver implementation.
+func (r *Resolver) Mutation() resolvers.MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
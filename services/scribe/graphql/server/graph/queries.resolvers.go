@@ -269,6 +269,176 @@ func (r *queryResolver) TransactionsAtHeadRange(ctx context.Context, txHash *str
 	return r.ethTxsToModelTransactions(ctx, transactions, transactionsFilter.ChainID), nil
 }
 
+// BlockHeader is the resolver for the blockHeader field.
+func (r *queryResolver) BlockHeader(ctx context.Context, chainID int, blockNumber int) (*model.BlockHeader, error) {
+	blockHeader, err := r.DB.RetrieveBlockHeader(ctx, uint32(chainID), uint64(blockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving block header: %w", err)
+	}
+
+	return r.blockHeaderToModelBlockHeader(*blockHeader), nil
+}
+
+// BlockHeaders is the resolver for the blockHeaders field.
+func (r *queryResolver) BlockHeaders(ctx context.Context, chainID int, startBlock int, endBlock int, page int) ([]*model.BlockHeader, error) {
+	blockHeaders, err := r.DB.RetrieveBlockHeadersInRange(ctx, uint32(chainID), uint64(startBlock), uint64(endBlock), page)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving block headers: %w", err)
+	}
+
+	modelBlockHeaders := make([]*model.BlockHeader, len(blockHeaders))
+	for i := range blockHeaders {
+		modelBlockHeaders[i] = r.blockHeaderToModelBlockHeader(blockHeaders[i])
+	}
+
+	return modelBlockHeaders, nil
+}
+
+// InternalTransactions is the resolver for the internalTransactions field.
+func (r *queryResolver) InternalTransactions(ctx context.Context, chainID int, txHash *string, blockNumber *int, from *string, to *string, page int) ([]*model.InternalTransaction, error) {
+	internalTxFilter := db.BuildInternalTxFilter(txHash, blockNumber, from, to)
+	internalTxFilter.ChainID = uint32(chainID)
+	internalTxs, err := r.DB.RetrieveInternalTxsWithFilter(ctx, internalTxFilter, page)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving internal transactions: %w", err)
+	}
+
+	modelInternalTxs := make([]*model.InternalTransaction, len(internalTxs))
+	for i := range internalTxs {
+		modelInternalTxs[i] = r.internalTxToModelInternalTransaction(internalTxs[i], internalTxFilter.ChainID)
+	}
+
+	return modelInternalTxs, nil
+}
+
+// ConsumerCursors is the resolver for the consumerCursors field.
+func (r *queryResolver) ConsumerCursors(ctx context.Context, chainID int) ([]*model.ConsumerCursor, error) {
+	cursors, err := r.DB.RetrieveConsumerCursors(ctx, uint32(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving consumer cursors: %w", err)
+	}
+
+	modelCursors := make([]*model.ConsumerCursor, len(cursors))
+	for i := range cursors {
+		modelCursors[i] = r.consumerCursorToModelConsumerCursor(cursors[i])
+	}
+
+	return modelCursors, nil
+}
+
+// LogsConnection is the resolver for the logsConnection field.
+func (r *queryResolver) LogsConnection(ctx context.Context, contractAddress *string, chainID int, txHash *string, blockHash *string, topic *string, confirmed *bool, startBlock int, endBlock int, first *int, after *string) (*model.LogConnection, error) {
+	cursor, err := decodeAfterCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	logsFilter := db.BuildLogFilter(contractAddress, nil, txHash, nil, blockHash, nil, confirmed)
+	logsFilter.ChainID = uint32(chainID)
+	if topic != nil {
+		logsFilter.Topic = *topic
+	}
+	limit := connectionLimit(first)
+	logs, err := r.DB.RetrieveLogsAfterCursor(ctx, logsFilter, uint64(startBlock), uint64(endBlock), cursor, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving logs: %w", err)
+	}
+
+	pageInfo := &model.PageInfo{HasNextPage: len(logs) > limit}
+	if pageInfo.HasNextPage {
+		logs = logs[:limit]
+	}
+	if len(logs) > 0 {
+		last := logs[len(logs)-1]
+		pageInfo.EndCursor = encodeCursor(db.Cursor{BlockNumber: last.BlockNumber, TxIndex: uint64(last.TxIndex), Index: uint64(last.Index)})
+	}
+
+	return &model.LogConnection{
+		Logs:     r.logsToModelLogs(logs, logsFilter.ChainID),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+// ReceiptsConnection is the resolver for the receiptsConnection field.
+func (r *queryResolver) ReceiptsConnection(ctx context.Context, chainID int, txHash *string, contractAddress *string, blockHash *string, confirmed *bool, startBlock int, endBlock int, first *int, after *string) (*model.ReceiptConnection, error) {
+	cursor, err := decodeAfterCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptsFilter := db.BuildReceiptFilter(txHash, contractAddress, blockHash, nil, nil, confirmed)
+	receiptsFilter.ChainID = uint32(chainID)
+	limit := connectionLimit(first)
+	receipts, err := r.DB.RetrieveReceiptsAfterCursor(ctx, receiptsFilter, uint64(startBlock), uint64(endBlock), cursor, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving receipts: %w", err)
+	}
+
+	pageInfo := &model.PageInfo{HasNextPage: len(receipts) > limit}
+	if pageInfo.HasNextPage {
+		receipts = receipts[:limit]
+	}
+	if len(receipts) > 0 {
+		last := receipts[len(receipts)-1]
+		pageInfo.EndCursor = encodeCursor(db.Cursor{BlockNumber: last.BlockNumber.Uint64(), TxIndex: uint64(last.TransactionIndex)})
+	}
+
+	return &model.ReceiptConnection{
+		Receipts: r.receiptsToModelReceipts(receipts, receiptsFilter.ChainID),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+// TransactionsConnection is the resolver for the transactionsConnection field.
+func (r *queryResolver) TransactionsConnection(ctx context.Context, txHash *string, chainID int, blockHash *string, confirmed *bool, startBlock int, endBlock int, first *int, after *string) (*model.TransactionConnection, error) {
+	cursor, err := decodeAfterCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionsFilter := db.BuildEthTxFilter(txHash, nil, blockHash, confirmed)
+	transactionsFilter.ChainID = uint32(chainID)
+	limit := connectionLimit(first)
+	transactions, err := r.DB.RetrieveEthTxsAfterCursor(ctx, transactionsFilter, uint64(startBlock), uint64(endBlock), cursor, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving transactions: %w", err)
+	}
+
+	pageInfo := &model.PageInfo{HasNextPage: len(transactions) > limit}
+	if pageInfo.HasNextPage {
+		transactions = transactions[:limit]
+	}
+	if len(transactions) > 0 {
+		last := transactions[len(transactions)-1]
+		pageInfo.EndCursor = encodeCursor(db.Cursor{BlockNumber: last.BlockNumber, TxIndex: last.TransactionIndex})
+	}
+
+	return &model.TransactionConnection{
+		Transactions: r.ethTxsToModelTransactions(ctx, transactions, transactionsFilter.ChainID),
+		PageInfo:     pageInfo,
+	}, nil
+}
+
+// LogAggregates is the resolver for the logAggregates field.
+func (r *queryResolver) LogAggregates(ctx context.Context, chainID int, contractAddress *string, topic *string, startBlock int, endBlock int) ([]*model.LogAggregate, error) {
+	logsFilter := db.BuildLogFilter(contractAddress, nil, nil, nil, nil, nil, nil)
+	logsFilter.ChainID = uint32(chainID)
+	if topic != nil {
+		logsFilter.Topic = *topic
+	}
+	aggregates, err := r.DB.RetrieveLogAggregates(ctx, logsFilter, uint64(startBlock), uint64(endBlock))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving log aggregates: %w", err)
+	}
+
+	modelAggregates := make([]*model.LogAggregate, len(aggregates))
+	for i := range aggregates {
+		modelAggregates[i] = r.logAggregateToModelLogAggregate(aggregates[i])
+	}
+
+	return modelAggregates, nil
+}
+
 // Query returns resolvers.QueryResolver implementation.
 func (r *Resolver) Query() resolvers.QueryResolver { return &queryResolver{r} }
 
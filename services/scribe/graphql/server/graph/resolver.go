@@ -16,4 +16,6 @@ type Resolver struct {
 	OmniRPCURL string
 	DB         db.EventDB
 	Metrics    metrics.Handler
+	// ConsumerToken authorizes consumers to move their cursors.
+	ConsumerToken string
 }
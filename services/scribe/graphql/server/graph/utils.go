@@ -123,6 +123,107 @@ func (r Resolver) ethTxToModelTransaction(ethTx types.Transaction, chainID uint3
 	}
 }
 
+func (r Resolver) blockHeaderToModelBlockHeader(blockHeader db.BlockHeader) *model.BlockHeader {
+	var baseFee *string
+	if blockHeader.BaseFee != nil {
+		baseFeeString := blockHeader.BaseFee.String()
+		baseFee = &baseFeeString
+	}
+
+	return &model.BlockHeader{
+		ChainID:     int(blockHeader.ChainID),
+		BlockNumber: int(blockHeader.BlockNumber),
+		BlockHash:   blockHeader.BlockHash.String(),
+		ParentHash:  blockHeader.ParentHash.String(),
+		Miner:       blockHeader.Miner.String(),
+		GasLimit:    int(blockHeader.GasLimit),
+		GasUsed:     int(blockHeader.GasUsed),
+		BaseFee:     baseFee,
+		Timestamp:   int(blockHeader.Timestamp),
+	}
+}
+
+func (r Resolver) internalTxToModelInternalTransaction(internalTx db.InternalTx, chainID uint32) *model.InternalTransaction {
+	var callError *string
+	if internalTx.Error != "" {
+		callError = &internalTx.Error
+	}
+
+	return &model.InternalTransaction{
+		ChainID:      int(chainID),
+		TxHash:       internalTx.TxHash.String(),
+		BlockNumber:  int(internalTx.BlockNumber),
+		BlockHash:    internalTx.BlockHash.String(),
+		TraceAddress: internalTx.TraceAddress,
+		CallType:     internalTx.CallType,
+		From:         internalTx.From.String(),
+		To:           internalTx.To.String(),
+		Value:        internalTx.Value.String(),
+		Gas:          int(internalTx.Gas),
+		GasUsed:      int(internalTx.GasUsed),
+		Input:        common.Bytes2Hex(internalTx.Input),
+		Error:        callError,
+	}
+}
+
+func (r Resolver) consumerCursorToModelConsumerCursor(cursor db.ConsumerCursor) *model.ConsumerCursor {
+	return &model.ConsumerCursor{
+		Consumer:    cursor.Consumer,
+		ChainID:     int(cursor.ChainID),
+		BlockNumber: int(cursor.BlockNumber),
+		UpdatedAt:   int(cursor.UpdatedAt.Unix()),
+	}
+}
+
+func (r Resolver) logAggregateToModelLogAggregate(aggregate db.LogAggregate) *model.LogAggregate {
+	var topic *string
+	if aggregate.Topic != nil {
+		topicString := aggregate.Topic.String()
+		topic = &topicString
+	}
+
+	return &model.LogAggregate{
+		ContractAddress: aggregate.ContractAddress.String(),
+		Topic:           topic,
+		Count:           int(aggregate.Count),
+		FirstBlock:      int(aggregate.FirstBlock),
+		LastBlock:       int(aggregate.LastBlock),
+	}
+}
+
+// maxConnectionSize is the maximum amount of entries returned for a single connection query.
+const maxConnectionSize = 500
+
+// connectionLimit gets the amount of entries to return for a connection query.
+func connectionLimit(first *int) int {
+	if first == nil || *first < 1 {
+		return 100
+	}
+	if *first > maxConnectionSize {
+		return maxConnectionSize
+	}
+	return *first
+}
+
+// decodeAfterCursor decodes an optional after cursor of a connection query.
+func decodeAfterCursor(after *string) (*db.Cursor, error) {
+	if after == nil || *after == "" {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	cursor, err := db.DecodeCursor(*after)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode cursor: %w", err)
+	}
+	return cursor, nil
+}
+
+func encodeCursor(cursor db.Cursor) *string {
+	encoded := cursor.Encode()
+	return &encoded
+}
+
 // getBlockTime retrieves a singular blocktime.
 //
 //nolint:gocognit,cyclop
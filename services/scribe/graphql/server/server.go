@@ -4,11 +4,12 @@
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/friendsofgo/graphiql"
 	"github.com/gin-gonic/gin"
+	"github.com/synapsecns/sanguine/services/scribe/graphql/server/graph"
 )
 
 func graphqlHandler(server *handler.Server) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		server.ServeHTTP(c.Writer, c.Request)
+		server.ServeHTTP(c.Writer, c.Request.WithContext(graph.WithAuthorization(c.Request.Context(), c.GetHeader("Authorization"))))
 	}
 }
 
@@ -39,6 +39,8 @@
 	FatalScribeError
 	// ErroneousHeadBlock is returned when the head block is below the last indexed.
 	ErroneousHeadBlock
+	// PruneError is returned when data cannot be pruned from the database.
+	PruneError
 )
 
 const (
@@ -54,6 +56,8 @@
 	BackfillCompleted
 	// BeginBackfillIndexing is returned when a backfill is beginning.
 	BeginBackfillIndexing
+	// PruningData is returned when the pruner deletes data past the retention policy.
+	PruningData
 )
 
 // ErrorType is a type of error.
@@ -117,6 +121,8 @@ func ReportScribeError(err error, chainID uint32, errorType ErrorType) {
 		logger.Errorf("Could not get head block on chain %d. Error: %v", chainID, err)
 	case TestError:
 		logger.Errorf("Test error on chain %d. Error: %v", chainID, err)
+	case PruneError:
+		logger.Errorf("Could not prune data on chain %d. Error: %v", chainID, err)
 
 	default:
 
@@ -140,6 +146,8 @@ func ReportScribeState(chainID uint32, block uint64, addresses []common.Address,
 		logger.Warnf("Flushing logs at head on chain %d", chainID)
 	case CreatingSQLStore:
 		logger.Warnf("Creating SQL store")
+	case PruningData:
+		logger.Warnf("Pruning data on chain %d up to block %d for contracts %s", chainID, block, dumpAddresses(addresses))
 	default:
 		logger.Warnf("Event on chain %d on block %d while interacting with contract %s", chainID, block, dumpAddresses(addresses))
 	}
@@ -57,6 +57,30 @@ type ChainIndexer struct {
 // into the ChainIndexer struct, as well as iterating through all the contracts in the chain config & creating
 // ContractIndexers for each contract.
 func NewChainIndexer(eventDB db.EventDB, client []backend.ScribeBackend, chainConfig config.ChainConfig, handler metrics.Handler) (*ChainIndexer, error) {
+	chainConfig = withChainConfigDefaults(chainConfig)
+
+	blockHeightMeterMap := make(map[common.Address]metric.Int64Histogram)
+	for _, contract := range chainConfig.Contracts {
+		blockHeightMeter, err := handler.Metrics().NewHistogram(fmt.Sprintf("scribe_block_meter_%d_%s", chainConfig.ChainID, contract.Address), "block_histogram", "a block height meter", "blocks")
+		if err != nil {
+			return nil, fmt.Errorf("error creating otel histogram %w", err)
+		}
+		blockHeightMeterMap[common.HexToAddress(contract.Address)] = blockHeightMeter
+	}
+
+	return &ChainIndexer{
+		chainID:           chainConfig.ChainID,
+		eventDB:           eventDB,
+		client:            client,
+		blockHeightMeters: blockHeightMeterMap,
+		chainConfig:       chainConfig,
+		handler:           handler,
+		readyForLivefill:  make(chan config.ContractConfig),
+	}, nil
+}
+
+// withChainConfigDefaults sets defaults for any unset indexing parameters of a chain config.
+func withChainConfigDefaults(chainConfig config.ChainConfig) config.ChainConfig {
 	if chainConfig.GetLogsRange == 0 {
 		chainConfig.GetLogsRange = 600
 	}
@@ -80,24 +104,15 @@ func NewChainIndexer(eventDB db.EventDB, client []backend.ScribeBackend, chainCo
 		chainConfig.LivefillFlushInterval = 10800
 	}
 
-	blockHeightMeterMap := make(map[common.Address]metric.Int64Histogram)
-	for _, contract := range chainConfig.Contracts {
-		blockHeightMeter, err := handler.Metrics().NewHistogram(fmt.Sprintf("scribe_block_meter_%d_%s", chainConfig.ChainID, contract.Address), "block_histogram", "a block height meter", "blocks")
-		if err != nil {
-			return nil, fmt.Errorf("error creating otel histogram %w", err)
-		}
-		blockHeightMeterMap[common.HexToAddress(contract.Address)] = blockHeightMeter
+	if chainConfig.PruneInterval == 0 {
+		chainConfig.PruneInterval = 3600
 	}
 
-	return &ChainIndexer{
-		chainID:           chainConfig.ChainID,
-		eventDB:           eventDB,
-		client:            client,
-		blockHeightMeters: blockHeightMeterMap,
-		chainConfig:       chainConfig,
-		handler:           handler,
-		readyForLivefill:  make(chan config.ContractConfig),
-	}, nil
+	if chainConfig.ConsumerCursorTTL == 0 {
+		chainConfig.ConsumerCursorTTL = 86400
+	}
+
+	return chainConfig
 }
 
 // Index iterates over each contract indexer and calls Index concurrently on each one.
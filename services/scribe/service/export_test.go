@@ -1,6 +1,8 @@
 package service
 
 import (
+	"time"
+
 	"github.com/synapsecns/sanguine/services/scribe/config"
 )
 
@@ -8,3 +10,13 @@
 func (c *ChainIndexer) GetLivefillContracts() []config.ContractConfig {
 	return c.livefillContracts
 }
+
+// SetNow sets the clock used by the pruner for testing.
+func (p *Pruner) SetNow(now func() time.Time) {
+	p.now = now
+}
+
+// SetWindowSize sets the number of blocks verified at once for testing.
+func (v *Verifier) SetWindowSize(windowSize uint64) {
+	v.windowSize = windowSize
+}
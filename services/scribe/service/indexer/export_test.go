@@ -0,0 +1,12 @@
+package indexer
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// IndexBlockData exports indexBlockData for testing.
+func (x *Indexer) IndexBlockData(ctx context.Context, startHeight, endHeight uint64) error {
+	var indexed atomic.Uint64
+	return x.indexBlockData(ctx, startHeight, endHeight, &indexed)
+}
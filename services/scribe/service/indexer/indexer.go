@@ -9,6 +9,7 @@
 
 	"github.com/synapsecns/sanguine/services/scribe/logger"
 	"math/big"
+	"sync/atomic"
 	"time"
 
 	"github.com/lmittmann/w3"
@@ -53,6 +54,9 @@ type Indexer struct {
 	isBackfill bool
 }
 
+// blockDataBatchSize is the number of blocks whose headers are fetched in a single batch and traced concurrently.
+const blockDataBatchSize = 10
+
 // retryTolerance is the number of times to retry a failed operation before rerunning the entire Backfill function.
 const retryTolerance = 20
 
@@ -110,6 +114,9 @@ func NewIndexer(chainConfig config.ChainConfig, addresses []common.Address, even
 		StoreConcurrency:     chainConfig.StoreConcurrency,
 		ChainID:              chainConfig.ChainID,
 		ConcurrencyThreshold: chainConfig.ConcurrencyThreshold,
+		IndexBlockHeaders:    chainConfig.IndexBlockHeaders,
+		IndexTraces:          chainConfig.IndexTraces,
+		TraceMethod:          chainConfig.TraceMethod,
 	}
 
 	return &Indexer{
@@ -152,6 +159,8 @@ func (x *Indexer) RefreshRate() uint64 {
 //   - Get the receipt for each log and store it and all of its logs.
 //   - Get the transaction for each log and store it.
 //
+// 3. If enabled, store the header and the internal txs of every block in the range, whether it has logs or not.
+//
 //nolint:gocognit, cyclop
 func (x *Indexer) Index(parentCtx context.Context, startHeight uint64, endHeight uint64) (err error) {
 	ctx, span := x.handler.Tracer().Start(parentCtx, "contract.Backfill", trace.WithAttributes(
@@ -171,6 +180,19 @@ func (x *Indexer) Index(parentCtx context.Context, startHeight uint64, endHeight
 	x.indexerConfig.StartHeight = startHeight
 	x.indexerConfig.EndHeight = endHeight
 
+	// blockDataIndexed is the last block whose header and internal txs are stored, last indexed does not go past it.
+	var blockDataIndexed atomic.Uint64
+	if x.indexesBlockData() {
+		if startHeight > 0 {
+			blockDataIndexed.Store(startHeight - 1)
+		}
+		g.Go(func() error {
+			return x.indexBlockData(groupCtx, startHeight, endHeight, &blockDataIndexed)
+		})
+	} else {
+		blockDataIndexed.Store(endHeight)
+	}
+
 	// Start fetching logs
 	logFetcher := NewLogFetcher(x.client[0], big.NewInt(int64(startHeight)), big.NewInt(int64(endHeight)), &x.indexerConfig, true)
 	logsChan := *logFetcher.GetFetchedLogsChan()
@@ -232,7 +254,7 @@ func (x *Indexer) Index(parentCtx context.Context, startHeight uint64, endHeight
 					// Only update last indexed if all logs from the last block have been processed to prevent premature
 					// updates of last indexed. Prevents having to lag a block behind on downstream dependencies (agents).
 					if lastBlockSeen < log.BlockNumber {
-						err = x.saveLastIndexed(storeCtx, lastBlockSeen)
+						err = x.saveLastIndexed(storeCtx, min(lastBlockSeen, blockDataIndexed.Load()))
 						if err != nil {
 							logger.ReportIndexerError(err, x.indexerConfig, logger.StoreError)
 							return fmt.Errorf("could not store last indexed: %w", err)
@@ -390,6 +412,130 @@ func (x *Indexer) store(parentCtx context.Context, log types.Log) (err error) {
 	return nil
 }
 
+// indexesBlockData checks if the indexer stores block headers or internal txs. They are only indexed for confirmed data.
+func (x *Indexer) indexesBlockData() bool {
+	return (x.indexerConfig.IndexBlockHeaders || x.indexerConfig.IndexTraces) && !x.toHead
+}
+
+// indexBlockData stores the header and the internal txs of every block in a range, blockDataBatchSize blocks at a time.
+// indexed is set to the last block stored after each batch.
+func (x *Indexer) indexBlockData(ctx context.Context, startHeight, endHeight uint64, indexed *atomic.Uint64) error {
+	for batchStart := startHeight; batchStart <= endHeight; batchStart += blockDataBatchSize {
+		batchEnd := batchStart + blockDataBatchSize - 1
+		if batchEnd > endHeight {
+			batchEnd = endHeight
+		}
+
+		// the headers are fetched for the block hashes of the internal txs even if they are not stored.
+		headers, err := x.fetchBlockHeaders(ctx, batchStart, batchEnd)
+		if err != nil {
+			return err
+		}
+
+		g, groupCtx := errgroup.WithContext(ctx)
+		for i := range headers {
+			header := &headers[i]
+			if x.indexerConfig.IndexBlockHeaders {
+				g.Go(func() error {
+					err := x.eventDB.StoreBlockHeader(groupCtx, x.indexerConfig.ChainID, header)
+					if err != nil {
+						return fmt.Errorf("could not store block header: %w", err)
+					}
+					return nil
+				})
+			}
+			if x.indexerConfig.IndexTraces {
+				g.Go(func() error {
+					return x.storeInternalTxs(groupCtx, header)
+				})
+			}
+		}
+
+		err = g.Wait()
+		if err != nil {
+			return fmt.Errorf("could not index blocks %d to %d: %w", batchStart, batchEnd, err)
+		}
+		indexed.Store(batchEnd)
+	}
+
+	return nil
+}
+
+// fetchBlockHeaders fetches the headers of a range of blocks in a single batch.
+func (x *Indexer) fetchBlockHeaders(ctx context.Context, startHeight, endHeight uint64) ([]types.Header, error) {
+	blockNumbers := backend.MakeRange(startHeight, endHeight)
+	headers := make([]types.Header, len(blockNumbers))
+	calls := make([]w3types.Caller, len(blockNumbers))
+	for i, blockNumber := range blockNumbers {
+		calls[i] = eth.HeaderByNumber(new(big.Int).SetUint64(blockNumber)).Returns(&headers[i])
+	}
+
+	err := x.client[0].BatchWithContext(ctx, calls...)
+	if err != nil {
+		return nil, fmt.Errorf("could not get block headers %d to %d: %w", startHeight, endHeight, err)
+	}
+	return headers, nil
+}
+
+// storeInternalTxs traces every tx of a block and stores the internal calls that touch one of the indexed contracts.
+func (x *Indexer) storeInternalTxs(ctx context.Context, header *types.Header) error {
+	// blocks without txs (including the untraceable genesis block) have nothing to trace.
+	if header.TxHash == types.EmptyTxsHash {
+		return nil
+	}
+
+	blockNumber := header.Number.Uint64()
+	blockFrames, err := backend.GetBlockTxCallFrames(ctx, x.client[0], x.indexerConfig.TraceMethod, blockNumber)
+	if err != nil {
+		return fmt.Errorf("could not get call frames: %w", err)
+	}
+
+	var internalTxs []db.InternalTx
+	for txHash, frames := range blockFrames {
+		for _, frame := range frames {
+			// the top level call is already stored as the transaction itself.
+			if len(frame.TraceAddress) == 0 || !x.touchesAddresses(frame) {
+				continue
+			}
+
+			internalTxs = append(internalTxs, db.InternalTx{
+				TxHash:       txHash,
+				BlockNumber:  blockNumber,
+				BlockHash:    header.Hash(),
+				TraceAddress: frame.TraceAddress,
+				CallType:     frame.CallType,
+				From:         frame.From,
+				To:           frame.To,
+				Value:        frame.Value,
+				Gas:          frame.Gas,
+				GasUsed:      frame.GasUsed,
+				Input:        frame.Input,
+				Error:        frame.Error,
+			})
+		}
+	}
+
+	err = x.eventDB.StoreInternalTxs(ctx, x.indexerConfig.ChainID, internalTxs...)
+	if err != nil {
+		return fmt.Errorf("could not store internal txs: %w", err)
+	}
+	return nil
+}
+
+// touchesAddresses checks if a call frame is made from or to one of the indexed contracts.
+// If the indexer has no addresses (livefill at head), every frame is kept.
+func (x *Indexer) touchesAddresses(frame backend.CallFrame) bool {
+	if len(x.indexerConfig.Addresses) == 0 {
+		return true
+	}
+	for _, address := range x.indexerConfig.Addresses {
+		if frame.From == address || frame.To == address {
+			return true
+		}
+	}
+	return false
+}
+
 // prunedReceiptLogs gets all logs from a receipt and prunes null logs.
 func (x *Indexer) prunedReceiptLogs(receipt types.Receipt) (logs []types.Log, err error) {
 	for i := range receipt.Logs {
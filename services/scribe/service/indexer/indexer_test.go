@@ -2,10 +2,13 @@
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	// register the call tracer used to index traces.
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 	"github.com/ethereum/go-ethereum/params"
 	. "github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -288,6 +291,64 @@ func (x *IndexerSuite) TestContractBackfill() {
 	Equal(x.T(), txBlockNumber, lastIndexed)
 }
 
+// TestIndexBlockHeadersAndTraces tests that block headers and traces are indexed when enabled.
+func (x *IndexerSuite) TestIndexBlockHeadersAndTraces() {
+	simulatedChain := geth.NewEmbeddedBackendForChainID(x.GetSuiteContext(), x.T(), big.NewInt(142))
+	simulatedClient, err := backend.DialBackend(x.GetTestContext(), simulatedChain.RPCAddress(), x.metrics)
+	Nil(x.T(), err)
+
+	simulatedChain.FundAccount(x.GetTestContext(), x.wallet.Address(), *big.NewInt(params.Ether))
+	testContract, testRef := x.manager.GetTestContract(x.GetTestContext(), simulatedChain)
+	transactOpts := simulatedChain.GetTxContext(x.GetTestContext(), nil)
+
+	contractConfig := config.ContractConfig{
+		Address:    testContract.Address().String(),
+		StartBlock: 0,
+	}
+
+	chainConfig := config.ChainConfig{
+		ChainID:              142,
+		GetLogsBatchAmount:   1,
+		StoreConcurrency:     1,
+		GetLogsRange:         1,
+		ConcurrencyThreshold: 100,
+		Contracts:            []config.ContractConfig{contractConfig},
+		IndexBlockHeaders:    true,
+		IndexTraces:          true,
+	}
+	blockHeightMeter, err := x.metrics.Metrics().NewHistogram(fmt.Sprint("scribe_block_meter", chainConfig.ChainID), "block_histogram", "a block height meter", "blocks")
+	Nil(x.T(), err)
+	contractIndexer, err := indexer.NewIndexer(chainConfig, []common.Address{testContract.Address()},
+		x.testDB, []backend.ScribeBackend{simulatedClient}, x.metrics, blockHeightMeter, false)
+	Nil(x.T(), err)
+
+	tx, err := testRef.EmitEventA(transactOpts.TransactOpts, big.NewInt(1), big.NewInt(2), big.NewInt(3))
+	Nil(x.T(), err)
+	simulatedChain.WaitForConfirmation(x.GetTestContext(), tx)
+
+	txBlockNumber, err := testutil.GetTxBlockNumber(x.GetTestContext(), simulatedChain, tx)
+	Nil(x.T(), err)
+
+	Nil(x.T(), contractIndexer.Index(x.GetTestContext(), contractConfig.StartBlock, txBlockNumber))
+
+	header, err := simulatedChain.HeaderByNumber(x.GetTestContext(), new(big.Int).SetUint64(txBlockNumber))
+	Nil(x.T(), err)
+
+	storedHeader, err := x.testDB.RetrieveBlockHeader(x.GetTestContext(), chainConfig.ChainID, txBlockNumber)
+	Nil(x.T(), err)
+	Equal(x.T(), header.Hash(), storedHeader.BlockHash)
+	Equal(x.T(), header.GasUsed, storedHeader.GasUsed)
+	Equal(x.T(), header.BaseFee, storedHeader.BaseFee)
+
+	// The test contract makes no internal calls, so only the top level call is traced and it is not stored.
+	txHash := tx.Hash().String()
+	internalTxFilter := db.BuildInternalTxFilter(&txHash, nil, nil, nil)
+	internalTxFilter.ChainID = chainConfig.ChainID
+	internalTxs, err := x.testDB.RetrieveInternalTxsWithFilter(x.GetTestContext(), internalTxFilter, 1)
+	Nil(x.T(), err)
+	Empty(x.T(), internalTxs)
+}
+
 // TestContractBackfill tests using a contractBackfiller for recording receipts and logs in a database.
 func (x *IndexerSuite) TestContractBackfillFromPreIndexed() {
 	// Get simulated blockchain, deploy the test contract, and set up test variables.
@@ -615,3 +676,57 @@ func (x *IndexerSuite) TestLargeVolumeIndexer() {
 	logs, err := testutil.GetLogsUntilNoneLeft(x.GetTestContext(), x.testDB, db.LogFilter{})
 	Equal(x.T(), int(testChainHandler.EventsEmitted[contractAddress]), len(logs))
 }
+
+// TestIndexBlockData tests that every tx of the blocks of a range is traced once per block, whether it emitted logs or not.
+func (x *IndexerSuite) TestIndexBlockData() {
+	router := common.HexToAddress("0xaa")
+	blockHeightMeter, err := x.metrics.Metrics().NewHistogram("scribe_block_meter_traces", "block_histogram", "a block height meter", "blocks")
+	Nil(x.T(), err)
+
+	retrieveInternalTxs := func(chainID uint32, txHash common.Hash) []db.InternalTx {
+		txHashStr := txHash.String()
+		internalTxFilter := db.BuildInternalTxFilter(&txHashStr, nil, nil, nil)
+		internalTxFilter.ChainID = chainID
+		internalTxs, err := x.testDB.RetrieveInternalTxsWithFilter(x.GetTestContext(), internalTxFilter, 1)
+		Nil(x.T(), err)
+		return internalTxs
+	}
+
+	// trace_block traces the block once for all its txes.
+	traceBackend := testutil.NewTraceBackend(x.T(), nil, map[uint64]json.RawMessage{testutil.TraceBlockNumber: testutil.TraceBlockFixture})
+	blockChainConfig := config.ChainConfig{ChainID: 143, IndexBlockHeaders: true, IndexTraces: true, TraceMethod: backend.TraceBlock}
+	blockIndexer, err := indexer.NewIndexer(blockChainConfig, []common.Address{router}, x.testDB, []backend.ScribeBackend{traceBackend}, x.metrics, blockHeightMeter, false)
+	Nil(x.T(), err)
+
+	Nil(x.T(), blockIndexer.IndexBlockData(x.GetTestContext(), testutil.TraceBlockNumber, testutil.TraceBlockNumber))
+	Equal(x.T(), int64(1), traceBackend.BlockTraceCount())
+
+	header, err := x.testDB.RetrieveBlockHeader(x.GetTestContext(), blockChainConfig.ChainID, testutil.TraceBlockNumber)
+	Nil(x.T(), err)
+	Equal(x.T(), uint64(testutil.TraceBlockNumber), header.BlockNumber)
+
+	internalTxsA := retrieveInternalTxs(blockChainConfig.ChainID, testutil.TraceBlockTxA)
+	Len(x.T(), internalTxsA, 2)
+	Equal(x.T(), header.BlockHash, internalTxsA[0].BlockHash)
+	internalTxsB := retrieveInternalTxs(blockChainConfig.ChainID, testutil.TraceBlockTxB)
+	Len(x.T(), internalTxsB, 2)
+	callTypes := make(map[string]db.InternalTx)
+	for _, internalTx := range internalTxsB {
+		callTypes[internalTx.CallType] = internalTx
+	}
+	Equal(x.T(), "Reverted", callTypes["staticcall"].Error)
+	Equal(x.T(), router, callTypes["suicide"].To)
+	Equal(x.T(), big.NewInt(1e16), callTypes["suicide"].Value)
+
+	// debug_traceTransaction chains trace each block of the range once with debug_traceBlockByNumber.
+	txHash := common.HexToHash("0xabc")
+	traceBackend = testutil.NewTraceBackend(x.T(), map[common.Hash]json.RawMessage{txHash: testutil.CallTraceFixture}, nil)
+	txChainConfig := config.ChainConfig{ChainID: 144, IndexTraces: true, TraceMethod: backend.DebugTraceTransaction}
+	txIndexer, err := indexer.NewIndexer(txChainConfig, []common.Address{router}, x.testDB, []backend.ScribeBackend{traceBackend}, x.metrics, blockHeightMeter, false)
+	Nil(x.T(), err)
+
+	Nil(x.T(), txIndexer.IndexBlockData(x.GetTestContext(), 1, 12))
+	Equal(x.T(), int64(12), traceBackend.BlockTraceCount())
+	Zero(x.T(), traceBackend.TxTraceCount())
+	Len(x.T(), retrieveInternalTxs(txChainConfig.ChainID, txHash), 3)
+}
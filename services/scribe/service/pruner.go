@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Pruner enforces the retention policies of a chain.
+type Pruner struct {
+	// eventDB is the database to prune.
+	eventDB db.EventDB
+	// chainConfig is the config of the chain to prune.
+	chainConfig config.ChainConfig
+	// handler is the metrics handler for the scribe.
+	handler metrics.Handler
+	// now is used to get the current time, overridable for testing.
+	now func() time.Time
+}
+
+// PruneResult is the outcome of a single prune run.
+type PruneResult struct {
+	// SafeBlock is the highest block that could be pruned, bounded by the last confirmed block and live consumer cursors.
+	SafeBlock uint64
+	// LogsPruned is the number of logs deleted.
+	LogsPruned int64
+	// ReceiptsAndTxsPruned is the number of receipts and txs deleted.
+	ReceiptsAndTxsPruned int64
+	// BlockDataPruned is the number of block headers and internal txs deleted.
+	BlockDataPruned int64
+}
+
+// NewPruner creates a new pruner for a chain.
+func NewPruner(eventDB db.EventDB, chainConfig config.ChainConfig, handler metrics.Handler) *Pruner {
+	return &Pruner{
+		eventDB:     eventDB,
+		chainConfig: withChainConfigDefaults(chainConfig),
+		handler:     handler,
+		now:         time.Now,
+	}
+}
+
+// Start runs the pruner every prune interval until the context is canceled.
+// Errors are reported and retried on the next interval rather than returned, so pruning never stops indexing.
+func (p *Pruner) Start(ctx context.Context) error {
+	interval := time.Duration(p.chainConfig.PruneInterval) * time.Second
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context canceled: %w", ctx.Err())
+		case <-time.After(interval):
+			if _, err := p.Prune(ctx); err != nil {
+				logger.ReportScribeError(err, p.chainConfig.ChainID, logger.PruneError)
+			}
+		}
+	}
+}
+
+// Prune deletes all data that is outside the retention policies of the chain and its contracts.
+// Only data below the last confirmed block and below every live consumer cursor is ever deleted.
+//
+//nolint:cyclop
+func (p *Pruner) Prune(parentCtx context.Context) (result PruneResult, err error) {
+	ctx, span := p.handler.Tracer().Start(parentCtx, "pruner.Prune", trace.WithAttributes(
+		attribute.Int("chain", int(p.chainConfig.ChainID)),
+	))
+
+	defer func() {
+		span.SetAttributes(
+			attribute.Int64("safe_block", int64(result.SafeBlock)),
+			attribute.Int64("logs_pruned", result.LogsPruned),
+			attribute.Int64("receipts_and_txs_pruned", result.ReceiptsAndTxsPruned),
+			attribute.Int64("block_data_pruned", result.BlockDataPruned),
+		)
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	result.SafeBlock, err = p.safeBlock(ctx)
+	if err != nil {
+		return result, err
+	}
+	if result.SafeBlock == 0 {
+		return result, nil
+	}
+
+	// chainCutoff is the block below which receipts, txs, and block data can be pruned: it may not pass
+	// the cutoff of any contract, since every stored tx belongs to a log of one of them.
+	chainCutoff := result.SafeBlock
+	var addresses []common.Address
+	for _, contract := range p.chainConfig.Contracts {
+		retention := contract.Retention
+		if retention == nil {
+			retention = p.chainConfig.Retention
+		}
+		if retention == nil {
+			chainCutoff = 0
+			continue
+		}
+
+		address := common.HexToAddress(contract.Address)
+		addresses = append(addresses, address)
+
+		cutoff, err := p.cutoff(ctx, *retention, result.SafeBlock)
+		if err != nil {
+			return result, err
+		}
+		chainCutoff = min(chainCutoff, cutoff)
+
+		if cutoff > 0 {
+			pruned, err := p.eventDB.PruneLogs(ctx, p.chainConfig.ChainID, address, cutoff, nil)
+			if err != nil {
+				return result, fmt.Errorf("could not prune logs for %s: %w", address.String(), err)
+			}
+			result.LogsPruned += pruned
+		}
+
+		if len(retention.KeepTopics) > 0 {
+			pruned, err := p.eventDB.PruneLogs(ctx, p.chainConfig.ChainID, address, result.SafeBlock, retention.KeepTopicHashes())
+			if err != nil {
+				return result, fmt.Errorf("could not prune logs by topic for %s: %w", address.String(), err)
+			}
+			result.LogsPruned += pruned
+		}
+	}
+
+	logger.ReportScribeState(p.chainConfig.ChainID, chainCutoff, addresses, logger.PruningData)
+
+	receiptCutoff := chainCutoff
+	if p.chainConfig.Retention != nil && p.chainConfig.Retention.PruneReceiptsAndTxs {
+		receiptCutoff = result.SafeBlock
+	}
+	if receiptCutoff > 0 {
+		result.ReceiptsAndTxsPruned, err = p.eventDB.PruneReceiptsAndTxs(ctx, p.chainConfig.ChainID, receiptCutoff)
+		if err != nil {
+			return result, fmt.Errorf("could not prune receipts and txs: %w", err)
+		}
+	}
+
+	if chainCutoff > 0 {
+		result.BlockDataPruned, err = p.eventDB.PruneBlockData(ctx, p.chainConfig.ChainID, chainCutoff)
+		if err != nil {
+			return result, fmt.Errorf("could not prune block data: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// safeBlock gets the highest block that can be pruned. Blocks at or above it are never pruned.
+func (p *Pruner) safeBlock(ctx context.Context) (uint64, error) {
+	safeBlock, err := p.lastConfirmedBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	cursors, err := p.eventDB.RetrieveConsumerCursors(ctx, p.chainConfig.ChainID)
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve consumer cursors: %w", err)
+	}
+
+	liveSince := p.now().Add(-time.Duration(p.chainConfig.ConsumerCursorTTL) * time.Second)
+	for _, cursor := range cursors {
+		if cursor.UpdatedAt.Before(liveSince) {
+			continue
+		}
+		safeBlock = min(safeBlock, cursor.BlockNumber)
+	}
+
+	return safeBlock, nil
+}
+
+// lastConfirmedBlock gets the lowest block the contracts of the chain have been indexed to.
+// Contracts are only indexed up to the head minus the chain's confirmations (anything past that is stored at head),
+// so every block below it is confirmed for every contract. A contract that has not been indexed yet holds it at 0.
+func (p *Pruner) lastConfirmedBlock(ctx context.Context) (uint64, error) {
+	if len(p.chainConfig.Contracts) == 0 {
+		return 0, nil
+	}
+
+	addresses := make([]common.Address, len(p.chainConfig.Contracts))
+	for i, contract := range p.chainConfig.Contracts {
+		addresses[i] = common.HexToAddress(contract.Address)
+	}
+
+	lastIndexed, err := p.eventDB.RetrieveLastIndexedMultiple(ctx, addresses, p.chainConfig.ChainID)
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve last indexed blocks: %w", err)
+	}
+
+	lastConfirmed := lastIndexed[addresses[0]]
+	for _, address := range addresses[1:] {
+		lastConfirmed = min(lastConfirmed, lastIndexed[address])
+	}
+	return lastConfirmed, nil
+}
+
+// cutoff gets the block below which a retention policy allows data to be pruned, 0 if nothing can be pruned.
+func (p *Pruner) cutoff(ctx context.Context, retention config.RetentionConfig, safeBlock uint64) (uint64, error) {
+	if retention.KeepBlocks == 0 && retention.KeepDays == 0 {
+		return 0, nil
+	}
+
+	var blockCutoff, dayCutoff uint64
+	if retention.KeepBlocks > 0 && safeBlock > retention.KeepBlocks {
+		blockCutoff = safeBlock - retention.KeepBlocks
+	}
+
+	if retention.KeepDays > 0 {
+		before := p.now().Add(-time.Duration(retention.KeepDays) * 24 * time.Hour)
+		lastBlock, err := p.eventDB.RetrieveLastBlockBeforeTime(ctx, p.chainConfig.ChainID, uint64(before.Unix()))
+		if err != nil {
+			return 0, fmt.Errorf("could not retrieve last block before %s: %w", before.String(), err)
+		}
+		// the last block before the cutoff time is itself out of retention.
+		if lastBlock > 0 {
+			dayCutoff = lastBlock + 1
+		}
+	}
+
+	// data is kept as long as either rule keeps it.
+	var cutoff uint64
+	switch {
+	case retention.KeepBlocks == 0:
+		cutoff = dayCutoff
+	case retention.KeepDays == 0:
+		cutoff = blockCutoff
+	default:
+		cutoff = min(blockCutoff, dayCutoff)
+	}
+
+	return min(cutoff, safeBlock), nil
+}
@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/service"
+	scribeTypes "github.com/synapsecns/sanguine/services/scribe/types"
+)
+
+// storeBlockOfData stores a log, receipt, and block time for a contract at a block.
+func (s *ScribeSuite) storeBlockOfData(chainID uint32, contract common.Address, blockNumber uint64, topic common.Hash, timestamp uint64) {
+	txHash := common.BigToHash(big.NewInt(gofakeit.Int64()))
+	log := types.Log{
+		Address:     contract,
+		Topics:      []common.Hash{topic},
+		BlockNumber: blockNumber,
+		TxHash:      txHash,
+		BlockHash:   common.BigToHash(new(big.Int).SetUint64(blockNumber)),
+		Index:       uint(blockNumber),
+	}
+	Nil(s.T(), s.testDB.StoreLogs(s.GetTestContext(), chainID, log))
+	Nil(s.T(), s.testDB.StoreReceipt(s.GetTestContext(), chainID, types.Receipt{
+		TxHash:      txHash,
+		BlockHash:   log.BlockHash,
+		BlockNumber: new(big.Int).SetUint64(blockNumber),
+		Logs:        []*types.Log{&log},
+	}))
+	Nil(s.T(), s.testDB.StoreBlockTime(s.GetTestContext(), chainID, blockNumber, timestamp))
+}
+
+func (s *ScribeSuite) retrieveLogBlocks(chainID uint32, contract common.Address) []uint64 {
+	logs, err := s.testDB.RetrieveLogsInRangeAsc(s.GetTestContext(), db.LogFilter{ChainID: chainID, ContractAddress: contract.String()}, 0, 100, 1)
+	Nil(s.T(), err)
+	blocks := make([]uint64, len(logs))
+	for i, log := range logs {
+		blocks[i] = log.BlockNumber
+	}
+	return blocks
+}
+
+// TestPruner tests that the pruner enforces chain and contract retention without passing confirmed blocks or live consumers.
+func (s *ScribeSuite) TestPruner() {
+	chainID := gofakeit.Uint32()
+	contractA := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	contractB := common.BigToAddress(big.NewInt(gofakeit.Int64()))
+	keepTopic := common.BigToHash(big.NewInt(gofakeit.Int64()))
+	otherTopic := common.BigToHash(big.NewInt(gofakeit.Int64()))
+	now := time.Now()
+
+	// One block every 6 hours for 20 blocks, the last one half an hour from now.
+	for blockNumber := uint64(1); blockNumber <= 20; blockNumber++ {
+		timestamp := uint64(now.Add(-time.Duration(20-blockNumber)*6*time.Hour + 30*time.Minute).Unix())
+		topic := otherTopic
+		if blockNumber%2 == 0 {
+			topic = keepTopic
+		}
+		s.storeBlockOfData(chainID, contractA, blockNumber, topic, timestamp)
+		s.storeBlockOfData(chainID, contractB, blockNumber, topic, timestamp)
+	}
+
+	chainConfig := config.ChainConfig{
+		ChainID:   chainID,
+		Retention: &config.RetentionConfig{KeepBlocks: 5},
+		Contracts: []config.ContractConfig{
+			{Address: contractA.String()},
+			{
+				Address:   contractB.String(),
+				Retention: &config.RetentionConfig{KeepDays: 2, KeepTopics: []string{keepTopic.String()}},
+			},
+		},
+	}
+	pruner := service.NewPruner(s.testDB, chainConfig, s.nullMetrics)
+
+	// Nothing is pruned until the contracts have been indexed.
+	result, err := pruner.Prune(s.GetTestContext())
+	Nil(s.T(), err)
+	Equal(s.T(), service.PruneResult{}, result)
+
+	// The contracts are confirmed up to the lowest block they have been indexed to.
+	Nil(s.T(), s.testDB.StoreLastIndexed(s.GetTestContext(), contractA, chainID, 19, scribeTypes.IndexingConfirmed))
+	Nil(s.T(), s.testDB.StoreLastIndexed(s.GetTestContext(), contractB, chainID, 18, scribeTypes.IndexingConfirmed))
+	Nil(s.T(), s.testDB.StoreConsumerCursor(s.GetTestContext(), "explorer", chainID, 16))
+
+	// The live consumer at block 16 bounds pruning.
+	result, err = pruner.Prune(s.GetTestContext())
+	Nil(s.T(), err)
+	Equal(s.T(), service.PruneResult{
+		SafeBlock: 16,
+		// contract A keeps 5 blocks (1-10 pruned), contract B keeps 2 days (1-11 pruned) and the kept topic (13, 15 pruned).
+		LogsPruned: 23,
+		// receipts can only be pruned up to the lowest contract cutoff (block 11).
+		ReceiptsAndTxsPruned: 20,
+	}, result)
+	Equal(s.T(), []uint64{11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, s.retrieveLogBlocks(chainID, contractA))
+	Equal(s.T(), []uint64{12, 14, 16, 17, 18, 19, 20}, s.retrieveLogBlocks(chainID, contractB))
+
+	// A consumer that is further behind holds back pruning while it is live.
+	Nil(s.T(), s.testDB.StoreConsumerCursor(s.GetTestContext(), "analytics", chainID, 2))
+	result, err = pruner.Prune(s.GetTestContext())
+	Nil(s.T(), err)
+	Equal(s.T(), service.PruneResult{SafeBlock: 2}, result)
+
+	// Once every cursor is stale, the last confirmed block bounds pruning.
+	pruner.SetNow(func() time.Time { return now.Add(25 * time.Hour) })
+	result, err = pruner.Prune(s.GetTestContext())
+	Nil(s.T(), err)
+	Equal(s.T(), uint64(18), result.SafeBlock)
+	Equal(s.T(), []uint64{13, 14, 15, 16, 17, 18, 19, 20}, s.retrieveLogBlocks(chainID, contractA))
+	Equal(s.T(), []uint64{18, 19, 20}, s.retrieveLogBlocks(chainID, contractB))
+}
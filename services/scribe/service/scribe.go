@@ -70,6 +70,14 @@ func (s Scribe) Start(ctx context.Context) error {
 		chainConfig := s.config.Chains[i]
 		chainID := chainConfig.ChainID
 
+		// Run the pruner for chains with a retention policy.
+		if chainConfig.HasRetention() {
+			pruner := NewPruner(s.eventDB, chainConfig, s.handler)
+			g.Go(func() error {
+				return pruner.Start(groupCtx)
+			})
+		}
+
 		// Run chain indexer for each chain
 		g.Go(func() error {
 			// Each chain gets its own context so it can retry on its own if there is a fatal error.
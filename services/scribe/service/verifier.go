@@ -0,0 +1,387 @@
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/scribe/backend"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/db"
+	"github.com/synapsecns/sanguine/services/scribe/service/indexer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Verifier compares the logs stored for a contract against the logs on chain and repairs ranges that differ.
+type Verifier struct {
+	// eventDB is the database to verify.
+	eventDB db.EventDB
+	// client contains the clients used for fetching logs and re-indexing.
+	client []backend.ScribeBackend
+	// chainConfig is the config for the chain being verified.
+	chainConfig config.ChainConfig
+	// handler is the metrics handler for the scribe.
+	handler metrics.Handler
+	// windowSize is the number of blocks verified at once.
+	windowSize uint64
+}
+
+// verifyWindowSize is the number of blocks whose logs are held in memory at once by a verification.
+const verifyWindowSize = 10000
+
+// BlockDiscrepancy is a block in which the logs stored for a contract do not match the chain.
+type BlockDiscrepancy struct {
+	// BlockNumber is the block number.
+	BlockNumber uint64
+	// ChainLogCount is the number of logs emitted by the contract on chain.
+	ChainLogCount int
+	// DBLogCount is the number of logs stored for the contract.
+	DBLogCount int
+	// ChainHash is the hash of the logs on chain.
+	ChainHash common.Hash
+	// DBHash is the hash of the logs stored.
+	DBHash common.Hash
+	// StaleBlockHashes are block hashes of stored logs that are not canonical, i.e. missed reorgs.
+	StaleBlockHashes []common.Hash
+}
+
+// BlockRange is an inclusive range of blocks.
+type BlockRange struct {
+	// StartBlock is the first block in the range.
+	StartBlock uint64
+	// EndBlock is the last block in the range.
+	EndBlock uint64
+}
+
+// VerificationReport is the result of verifying a contract over a block range.
+type VerificationReport struct {
+	// ChainID is the chain id of the contract.
+	ChainID uint32
+	// ContractAddress is the verified contract.
+	ContractAddress common.Address
+	// StartBlock is the first block verified.
+	StartBlock uint64
+	// EndBlock is the last block verified.
+	EndBlock uint64
+	// ChainLogCount is the number of logs found on chain.
+	ChainLogCount int
+	// DBLogCount is the number of logs found in the database.
+	DBLogCount int
+	// Discrepancies are the blocks that do not match, in ascending order.
+	Discrepancies []BlockDiscrepancy
+}
+
+// IsValid returns true if the stored logs match the chain over the verified range.
+func (v VerificationReport) IsValid() bool {
+	return len(v.Discrepancies) == 0
+}
+
+// BrokenRanges merges consecutive discrepant blocks into ranges.
+func (v VerificationReport) BrokenRanges() (ranges []BlockRange) {
+	for _, discrepancy := range v.Discrepancies {
+		if len(ranges) > 0 && ranges[len(ranges)-1].EndBlock+1 == discrepancy.BlockNumber {
+			ranges[len(ranges)-1].EndBlock = discrepancy.BlockNumber
+			continue
+		}
+		ranges = append(ranges, BlockRange{StartBlock: discrepancy.BlockNumber, EndBlock: discrepancy.BlockNumber})
+	}
+	return ranges
+}
+
+// NewVerifier creates a new verifier for a chain.
+func NewVerifier(eventDB db.EventDB, client []backend.ScribeBackend, chainConfig config.ChainConfig, handler metrics.Handler) (*Verifier, error) {
+	if len(client) == 0 {
+		return nil, fmt.Errorf("no clients passed for chain %d", chainConfig.ChainID)
+	}
+
+	return &Verifier{
+		eventDB:     eventDB,
+		client:      client,
+		chainConfig: withChainConfigDefaults(chainConfig),
+		handler:     handler,
+		windowSize:  verifyWindowSize,
+	}, nil
+}
+
+// WindowReporter is called with the report of each window of a verification, once the window is verified.
+type WindowReporter func(ctx context.Context, report *VerificationReport) error
+
+// Verify re-fetches the logs of a contract in a range and compares the count and hash of each block against the database.
+// The range is verified in windows of blocks so only the logs of a window are held in memory. The report of each window
+// is passed to onWindow, if set, as soon as the window is verified, and the returned report covers the whole range.
+func (v *Verifier) Verify(parentCtx context.Context, contractAddress common.Address, startBlock, endBlock uint64, onWindow WindowReporter) (_ *VerificationReport, err error) {
+	ctx, span := v.handler.Tracer().Start(parentCtx, "Verify", trace.WithAttributes(
+		attribute.Int("chain", int(v.chainConfig.ChainID)),
+		attribute.String("address", contractAddress.String()),
+		attribute.Int("start", int(startBlock)),
+		attribute.Int("end", int(endBlock)),
+	))
+
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	if startBlock > endBlock {
+		return nil, fmt.Errorf("start block %d is after end block %d", startBlock, endBlock)
+	}
+
+	report := &VerificationReport{
+		ChainID:         v.chainConfig.ChainID,
+		ContractAddress: contractAddress,
+		StartBlock:      startBlock,
+		EndBlock:        endBlock,
+	}
+
+	for windowStart := startBlock; windowStart <= endBlock; windowStart += v.windowSize {
+		windowEnd := windowStart + v.windowSize - 1
+		if windowEnd > endBlock || windowEnd < windowStart {
+			windowEnd = endBlock
+		}
+
+		windowReport, err := v.verifyWindow(ctx, contractAddress, windowStart, windowEnd)
+		if err != nil {
+			return nil, err
+		}
+
+		report.ChainLogCount += windowReport.ChainLogCount
+		report.DBLogCount += windowReport.DBLogCount
+		report.Discrepancies = append(report.Discrepancies, windowReport.Discrepancies...)
+
+		if onWindow != nil {
+			err = onWindow(ctx, windowReport)
+			if err != nil {
+				return nil, fmt.Errorf("could not report blocks %d to %d: %w", windowStart, windowEnd, err)
+			}
+		}
+
+		// the window can not be advanced past the last block without overflowing.
+		if windowEnd == endBlock {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// verifyWindow verifies the logs of a contract in a window of blocks.
+func (v *Verifier) verifyWindow(ctx context.Context, contractAddress common.Address, startBlock, endBlock uint64) (*VerificationReport, error) {
+	chainLogs, err := v.fetchChainLogs(ctx, contractAddress, startBlock, endBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch logs of blocks %d to %d from chain: %w", startBlock, endBlock, err)
+	}
+
+	dbLogs, err := v.fetchDBLogs(ctx, contractAddress, startBlock, endBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch logs of blocks %d to %d from db: %w", startBlock, endBlock, err)
+	}
+
+	report := &VerificationReport{
+		ChainID:         v.chainConfig.ChainID,
+		ContractAddress: contractAddress,
+		StartBlock:      startBlock,
+		EndBlock:        endBlock,
+		ChainLogCount:   len(chainLogs),
+		DBLogCount:      len(dbLogs),
+	}
+
+	chainBlocks := groupLogsByBlock(chainLogs)
+	dbBlocks := groupLogsByBlock(dbLogs)
+
+	blockNumbers := make(map[uint64]bool)
+	for blockNumber := range chainBlocks {
+		blockNumbers[blockNumber] = true
+	}
+	for blockNumber := range dbBlocks {
+		blockNumbers[blockNumber] = true
+	}
+
+	for blockNumber := range blockNumbers {
+		chainHash := hashLogs(chainBlocks[blockNumber])
+		dbHash := hashLogs(dbBlocks[blockNumber])
+		if chainHash == dbHash {
+			continue
+		}
+
+		report.Discrepancies = append(report.Discrepancies, BlockDiscrepancy{
+			BlockNumber:      blockNumber,
+			ChainLogCount:    len(chainBlocks[blockNumber]),
+			DBLogCount:       len(dbBlocks[blockNumber]),
+			ChainHash:        chainHash,
+			DBHash:           dbHash,
+			StaleBlockHashes: staleBlockHashes(chainBlocks[blockNumber], dbBlocks[blockNumber]),
+		})
+	}
+
+	sort.Slice(report.Discrepancies, func(i, j int) bool {
+		return report.Discrepancies[i].BlockNumber < report.Discrepancies[j].BlockNumber
+	})
+
+	return report, nil
+}
+
+// Repair deletes data stored for stale block hashes in a report and re-indexes each broken range.
+// Last indexed is not updated by the repair.
+func (v *Verifier) Repair(parentCtx context.Context, report *VerificationReport) (err error) {
+	ctx, span := v.handler.Tracer().Start(parentCtx, "Repair", trace.WithAttributes(
+		attribute.Int("chain", int(report.ChainID)),
+		attribute.String("address", report.ContractAddress.String()),
+		attribute.Int("discrepancies", len(report.Discrepancies)),
+	))
+
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	for _, discrepancy := range report.Discrepancies {
+		for _, blockHash := range discrepancy.StaleBlockHashes {
+			if err = v.eventDB.DeleteLogsForBlockHash(ctx, blockHash, report.ChainID); err != nil {
+				return fmt.Errorf("could not delete stale logs for block %d: %w", discrepancy.BlockNumber, err)
+			}
+			if err = v.eventDB.DeleteReceiptsForBlockHash(ctx, report.ChainID, blockHash); err != nil {
+				return fmt.Errorf("could not delete stale receipts for block %d: %w", discrepancy.BlockNumber, err)
+			}
+			if err = v.eventDB.DeleteEthTxsForBlockHash(ctx, blockHash, report.ChainID); err != nil {
+				return fmt.Errorf("could not delete stale txs for block %d: %w", discrepancy.BlockNumber, err)
+			}
+		}
+	}
+
+	contractIndexer, err := v.newIndexer(report.ContractAddress)
+	if err != nil {
+		return err
+	}
+	contractIndexer.SetToBackfill()
+
+	for _, brokenRange := range report.BrokenRanges() {
+		err = contractIndexer.Index(ctx, brokenRange.StartBlock, brokenRange.EndBlock)
+		if err != nil {
+			return fmt.Errorf("could not re-index blocks %d to %d: %w", brokenRange.StartBlock, brokenRange.EndBlock, err)
+		}
+	}
+
+	return nil
+}
+
+func (v *Verifier) newIndexer(contractAddress common.Address) (*indexer.Indexer, error) {
+	blockHeightMeter, err := v.handler.Metrics().NewHistogram(fmt.Sprintf("scribe_verify_block_meter_%d_%s", v.chainConfig.ChainID, contractAddress), "block_histogram", "a block height meter", "blocks")
+	if err != nil {
+		return nil, fmt.Errorf("error creating otel histogram %w", err)
+	}
+
+	contractIndexer, err := indexer.NewIndexer(v.chainConfig, []common.Address{contractAddress}, v.eventDB, v.client, v.handler, blockHeightMeter, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not create contract indexer: %w", err)
+	}
+
+	return contractIndexer, nil
+}
+
+// fetchChainLogs gets all logs for a contract in a window from the chain.
+func (v *Verifier) fetchChainLogs(ctx context.Context, contractAddress common.Address, startBlock, endBlock uint64) ([]types.Log, error) {
+	contractIndexer, err := v.newIndexer(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	indexerConfig := contractIndexer.GetIndexerConfig()
+	logFetcher := indexer.NewLogFetcher(v.client[0], new(big.Int).SetUint64(startBlock), new(big.Int).SetUint64(endBlock), &indexerConfig, true)
+
+	var logs []types.Log
+	for {
+		chunks := logFetcher.GetChunkArr()
+		if len(chunks) == 0 {
+			return logs, nil
+		}
+
+		fetchedLogs, err := logFetcher.FetchLogs(ctx, chunks)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch logs: %w", err)
+		}
+		logs = append(logs, fetchedLogs...)
+	}
+}
+
+// fetchDBLogs gets all logs for a contract in a window from the database.
+func (v *Verifier) fetchDBLogs(ctx context.Context, contractAddress common.Address, startBlock, endBlock uint64) ([]types.Log, error) {
+	logFilter := db.LogFilter{
+		ContractAddress: contractAddress.String(),
+		ChainID:         v.chainConfig.ChainID,
+	}
+
+	var logs []types.Log
+	for page := 1; ; page++ {
+		pageLogs, err := v.eventDB.RetrieveLogsInRangeAsc(ctx, logFilter, startBlock, endBlock, page)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve logs on page %d: %w", page, err)
+		}
+		if len(pageLogs) == 0 {
+			return logs, nil
+		}
+		for _, log := range pageLogs {
+			logs = append(logs, *log)
+		}
+	}
+}
+
+// groupLogsByBlock groups logs by block number, with each block's logs sorted by index.
+func groupLogsByBlock(logs []types.Log) map[uint64][]types.Log {
+	blocks := make(map[uint64][]types.Log)
+	for _, log := range logs {
+		blocks[log.BlockNumber] = append(blocks[log.BlockNumber], log)
+	}
+
+	for _, blockLogs := range blocks {
+		sort.Slice(blockLogs, func(i, j int) bool {
+			return blockLogs[i].Index < blockLogs[j].Index
+		})
+	}
+	return blocks
+}
+
+// hashLogs hashes the consensus fields of a block's logs. Logs must be sorted by index.
+func hashLogs(logs []types.Log) common.Hash {
+	if len(logs) == 0 {
+		return common.Hash{}
+	}
+
+	hasher := crypto.NewKeccakState()
+	for _, log := range logs {
+		_, _ = hasher.Write(log.BlockHash.Bytes())
+		_, _ = hasher.Write(log.TxHash.Bytes())
+		_, _ = hasher.Write(binary.BigEndian.AppendUint64(nil, uint64(log.Index)))
+		_, _ = hasher.Write(log.Address.Bytes())
+		for _, topic := range log.Topics {
+			_, _ = hasher.Write(topic.Bytes())
+		}
+		_, _ = hasher.Write(crypto.Keccak256(log.Data))
+	}
+
+	var hash common.Hash
+	_, _ = hasher.Read(hash[:])
+	return hash
+}
+
+// staleBlockHashes gets the block hashes of stored logs that don't appear on chain for the same block.
+func staleBlockHashes(chainLogs, dbLogs []types.Log) (stale []common.Hash) {
+	canonical := make(map[common.Hash]bool)
+	for _, log := range chainLogs {
+		canonical[log.BlockHash] = true
+	}
+
+	seen := make(map[common.Hash]bool)
+	for _, log := range dbLogs {
+		if canonical[log.BlockHash] || seen[log.BlockHash] {
+			continue
+		}
+		seen[log.BlockHash] = true
+		stale = append(stale, log.BlockHash)
+	}
+	return stale
+}
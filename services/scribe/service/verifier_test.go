@@ -0,0 +1,111 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/backends/geth"
+	"github.com/synapsecns/sanguine/services/scribe/backend"
+	"github.com/synapsecns/sanguine/services/scribe/config"
+	"github.com/synapsecns/sanguine/services/scribe/service"
+	"github.com/synapsecns/sanguine/services/scribe/service/indexer"
+	"github.com/synapsecns/sanguine/services/scribe/testutil"
+)
+
+// TestVerifyAndRepair tests that the verifier finds missing logs and re-indexes the broken range.
+func (s *ScribeSuite) TestVerifyAndRepair() {
+	simulatedChain := geth.NewEmbeddedBackendForChainID(s.GetSuiteContext(), s.T(), big.NewInt(142))
+	simulatedClient, err := backend.DialBackend(s.GetTestContext(), simulatedChain.RPCAddress(), s.nullMetrics)
+	Nil(s.T(), err)
+
+	simulatedChain.FundAccount(s.GetTestContext(), s.wallet.Address(), *big.NewInt(params.Ether))
+	testContract, testRef := s.manager.GetTestContract(s.GetTestContext(), simulatedChain)
+	transactOpts := simulatedChain.GetTxContext(s.GetTestContext(), nil)
+
+	contractConfig := config.ContractConfig{
+		Address:    testContract.Address().String(),
+		StartBlock: 0,
+	}
+
+	simulatedChainArr := []backend.ScribeBackend{simulatedClient, simulatedClient}
+	chainConfig := config.ChainConfig{
+		ChainID:              142,
+		GetLogsBatchAmount:   1,
+		StoreConcurrency:     1,
+		GetLogsRange:         1,
+		ConcurrencyThreshold: 100,
+		Contracts:            []config.ContractConfig{contractConfig},
+	}
+
+	tx, err := testRef.EmitEventA(transactOpts.TransactOpts, big.NewInt(1), big.NewInt(2), big.NewInt(3))
+	Nil(s.T(), err)
+	simulatedChain.WaitForConfirmation(s.GetTestContext(), tx)
+
+	tx, err = testRef.EmitEventAandB(transactOpts.TransactOpts, big.NewInt(4), big.NewInt(5), big.NewInt(6))
+	Nil(s.T(), err)
+	simulatedChain.WaitForConfirmation(s.GetTestContext(), tx)
+
+	receipt, err := simulatedChain.TransactionReceipt(s.GetTestContext(), tx.Hash())
+	Nil(s.T(), err)
+
+	endBlock, err := testutil.GetTxBlockNumber(s.GetTestContext(), simulatedChain, tx)
+	Nil(s.T(), err)
+
+	blockHeightMeter, err := s.nullMetrics.Metrics().NewHistogram(fmt.Sprint("scribe_block_meter", chainConfig.ChainID), "block_histogram", "a block height meter", "blocks")
+	Nil(s.T(), err)
+
+	contractIndexer, err := indexer.NewIndexer(chainConfig, []common.Address{testContract.Address()}, s.testDB, simulatedChainArr, s.nullMetrics, blockHeightMeter, false)
+	Nil(s.T(), err)
+	Nil(s.T(), contractIndexer.Index(s.GetTestContext(), 0, endBlock))
+
+	verifier, err := service.NewVerifier(s.testDB, simulatedChainArr, chainConfig, s.nullMetrics)
+	Nil(s.T(), err)
+
+	report, err := verifier.Verify(s.GetTestContext(), testContract.Address(), 0, endBlock, nil)
+	Nil(s.T(), err)
+	True(s.T(), report.IsValid())
+	Equal(s.T(), 3, report.ChainLogCount)
+	Equal(s.T(), 3, report.DBLogCount)
+
+	// Drop the logs of the last block to create a gap.
+	Nil(s.T(), s.testDB.DeleteLogsForBlockHash(s.GetTestContext(), receipt.BlockHash, chainConfig.ChainID))
+
+	report, err = verifier.Verify(s.GetTestContext(), testContract.Address(), 0, endBlock, nil)
+	Nil(s.T(), err)
+	False(s.T(), report.IsValid())
+	Equal(s.T(), 1, report.DBLogCount)
+	Len(s.T(), report.Discrepancies, 1)
+	Equal(s.T(), endBlock, report.Discrepancies[0].BlockNumber)
+	Equal(s.T(), 2, report.Discrepancies[0].ChainLogCount)
+	Equal(s.T(), 0, report.Discrepancies[0].DBLogCount)
+	Empty(s.T(), report.Discrepancies[0].StaleBlockHashes)
+	Equal(s.T(), []service.BlockRange{{StartBlock: endBlock, EndBlock: endBlock}}, report.BrokenRanges())
+
+	// Verify in windows of 2 blocks, the last window holds the gap.
+	verifier.SetWindowSize(2)
+	var windows []service.BlockRange
+	report, err = verifier.Verify(s.GetTestContext(), testContract.Address(), 0, endBlock, func(_ context.Context, windowReport *service.VerificationReport) error {
+		windows = append(windows, service.BlockRange{StartBlock: windowReport.StartBlock, EndBlock: windowReport.EndBlock})
+		if windowReport.EndBlock == endBlock {
+			Len(s.T(), windowReport.Discrepancies, 1)
+		}
+		return nil
+	})
+	Nil(s.T(), err)
+	Equal(s.T(), (endBlock+2)/2, uint64(len(windows)))
+	Equal(s.T(), endBlock, windows[len(windows)-1].EndBlock)
+	Equal(s.T(), 3, report.ChainLogCount)
+	Equal(s.T(), 1, report.DBLogCount)
+	Len(s.T(), report.Discrepancies, 1)
+
+	Nil(s.T(), verifier.Repair(s.GetTestContext(), report))
+
+	report, err = verifier.Verify(s.GetTestContext(), testContract.Address(), 0, endBlock, nil)
+	Nil(s.T(), err)
+	True(s.T(), report.IsValid())
+	Equal(s.T(), 3, report.DBLogCount)
+}
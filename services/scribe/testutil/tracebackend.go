@@ -0,0 +1,211 @@
+package testutil
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lmittmann/w3/w3types"
+	"github.com/synapsecns/sanguine/services/scribe/backend"
+)
+
+// CallTraceFixture is a geth callTracer trace of a tx with nested and reverted internal calls.
+//
+//go:embed testdata/call_trace.json
+var CallTraceFixture json.RawMessage
+
+// TraceBlockFixture is a trace_block trace of block TraceBlockNumber, containing TraceBlockTxA, TraceBlockTxB and a block reward.
+//
+//go:embed testdata/trace_block.json
+var TraceBlockFixture json.RawMessage
+
+// TraceBlockNumber is the block number of TraceBlockFixture.
+const TraceBlockNumber = 16
+
+var (
+	// TraceBlockTxA is the tx of TraceBlockFixture with a call and a create.
+	TraceBlockTxA = common.HexToHash("0x01")
+	// TraceBlockTxB is the tx of TraceBlockFixture with a reverted static call.
+	TraceBlockTxB = common.HexToHash("0x02")
+)
+
+// TraceBackend is a scribe backend serving fixed traces over an in process rpc server.
+// It counts the trace calls it serves, so tests can check txes and blocks are not traced repeatedly.
+type TraceBackend struct {
+	client          *rpc.Client
+	callTraces      map[common.Hash]json.RawMessage
+	blockTraces     map[uint64]json.RawMessage
+	txTraceCount    atomic.Int64
+	blockTraceCount atomic.Int64
+}
+
+// NewTraceBackend creates a backend serving the call traces of txes for debug_traceTransaction,
+// and the traces of blocks for trace_block. Every block contains the txes with a call trace: debug_traceBlockByNumber
+// serves their call traces and eth_getBlockByNumber an empty header with their hashes.
+func NewTraceBackend(tb testing.TB, callTraces map[common.Hash]json.RawMessage, blockTraces map[uint64]json.RawMessage) *TraceBackend {
+	tb.Helper()
+
+	traceBackend := &TraceBackend{
+		callTraces:  callTraces,
+		blockTraces: blockTraces,
+	}
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("debug", &debugService{traceBackend}); err != nil {
+		tb.Fatal(err)
+	}
+	if err := server.RegisterName("trace", &traceService{traceBackend}); err != nil {
+		tb.Fatal(err)
+	}
+	if err := server.RegisterName("eth", &ethService{traceBackend}); err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(server.Stop)
+
+	traceBackend.client = rpc.DialInProc(server)
+	tb.Cleanup(traceBackend.client.Close)
+
+	return traceBackend
+}
+
+// TxTraceCount is the number of debug_traceTransaction calls served.
+func (t *TraceBackend) TxTraceCount() int64 {
+	return t.txTraceCount.Load()
+}
+
+// BlockTraceCount is the number of trace_block and debug_traceBlockByNumber calls served.
+func (t *TraceBackend) BlockTraceCount() int64 {
+	return t.blockTraceCount.Load()
+}
+
+// ChainID is not supported by the trace backend.
+func (t *TraceBackend) ChainID(_ context.Context) (*big.Int, error) {
+	return nil, errors.New("not supported by the trace backend")
+}
+
+// BlockNumber is not supported by the trace backend.
+func (t *TraceBackend) BlockNumber(_ context.Context) (uint64, error) {
+	return 0, errors.New("not supported by the trace backend")
+}
+
+// HeaderByNumber is not supported by the trace backend.
+func (t *TraceBackend) HeaderByNumber(_ context.Context, _ *big.Int) (*types.Header, error) {
+	return nil, errors.New("not supported by the trace backend")
+}
+
+// BatchWithContext batches the calls to the in process rpc server.
+func (t *TraceBackend) BatchWithContext(ctx context.Context, calls ...w3types.Caller) error {
+	elems := make([]rpc.BatchElem, len(calls))
+	for i, call := range calls {
+		elem, err := call.CreateRequest()
+		if err != nil {
+			return fmt.Errorf("could not create request: %w", err)
+		}
+		elems[i] = elem
+	}
+
+	if err := t.client.BatchCallContext(ctx, elems); err != nil {
+		return fmt.Errorf("could not batch calls: %w", err)
+	}
+
+	for i, call := range calls {
+		if err := call.HandleResponse(elems[i]); err != nil {
+			return fmt.Errorf("could not handle response: %w", err)
+		}
+	}
+	return nil
+}
+
+var _ backend.ScribeBackend = &TraceBackend{}
+
+// debugService serves debug_traceTransaction.
+type debugService struct {
+	backend *TraceBackend
+}
+
+// TraceTransaction returns the call trace of a tx, the trace config is ignored.
+func (d *debugService) TraceTransaction(txHash common.Hash, _ map[string]interface{}) (json.RawMessage, error) {
+	d.backend.txTraceCount.Add(1)
+	callTrace, ok := d.backend.callTraces[txHash]
+	if !ok {
+		return nil, fmt.Errorf("transaction %s not found", txHash)
+	}
+	return callTrace, nil
+}
+
+// debugTxTrace is a tx trace of debug_traceBlockByNumber.
+type debugTxTrace struct {
+	Result json.RawMessage `json:"result"`
+}
+
+// TraceBlockByNumber returns the call traces of every tx in the order of blockTxs, the block number and trace config
+// are ignored.
+func (d *debugService) TraceBlockByNumber(_ hexutil.Uint64, _ map[string]interface{}) ([]debugTxTrace, error) {
+	d.backend.blockTraceCount.Add(1)
+	txHashes := d.backend.blockTxs()
+	txTraces := make([]debugTxTrace, len(txHashes))
+	for i, txHash := range txHashes {
+		txTraces[i] = debugTxTrace{Result: d.backend.callTraces[txHash]}
+	}
+	return txTraces, nil
+}
+
+// blockTxs are the txes of every block, the txes with a call trace ordered by tx hash.
+func (t *TraceBackend) blockTxs() []common.Hash {
+	txHashes := make([]common.Hash, 0, len(t.callTraces))
+	for txHash := range t.callTraces {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Slice(txHashes, func(i, j int) bool {
+		return txHashes[i].Big().Cmp(txHashes[j].Big()) < 0
+	})
+	return txHashes
+}
+
+// traceService serves trace_block.
+type traceService struct {
+	backend *TraceBackend
+}
+
+// Block returns the traces of a block.
+func (t *traceService) Block(blockNumber hexutil.Uint64) (json.RawMessage, error) {
+	t.backend.blockTraceCount.Add(1)
+	blockTrace, ok := t.backend.blockTraces[uint64(blockNumber)]
+	if !ok {
+		return nil, fmt.Errorf("block %d not found", blockNumber)
+	}
+	return blockTrace, nil
+}
+
+// ethService serves eth_getBlockByNumber.
+type ethService struct {
+	backend *TraceBackend
+}
+
+// GetBlockByNumber returns an empty header with the block number and the hashes of the block txes.
+func (e *ethService) GetBlockByNumber(blockNumber hexutil.Uint64, _ bool) (map[string]interface{}, error) {
+	header, err := json.Marshal(&types.Header{
+		Number:     new(big.Int).SetUint64(uint64(blockNumber)),
+		Difficulty: new(big.Int),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal header: %w", err)
+	}
+
+	var block map[string]interface{}
+	if err := json.Unmarshal(header, &block); err != nil {
+		return nil, fmt.Errorf("could not unmarshal header: %w", err)
+	}
+	block["transactions"] = e.backend.blockTxs()
+	return block, nil
+}
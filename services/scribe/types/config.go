@@ -13,4 +13,7 @@ type IndexerConfig struct {
 	EndHeight            uint64
 	ConcurrencyThreshold uint64
 	Topics               [][]common.Hash
+	IndexBlockHeaders    bool
+	IndexTraces          bool
+	TraceMethod          string
 }
@@ -3,6 +3,10 @@
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/synapsecns/sanguine/core/commandline"
 	"github.com/synapsecns/sanguine/core/dbcommon"
@@ -22,39 +26,168 @@
 	TakesFile: true,
 }
 
+var dryRunFlag = &cli.BoolFlag{
+	Name:  "dry-run",
+	Usage: "compute and print the rebates without submitting them",
+}
+
 // runCommand runs the cctp relayer.
 var runCommand = &cli.Command{
 	Name:        "run",
 	Description: "run the API Server",
-	Flags:       []cli.Flag{configFlag, &commandline.LogLevel},
+	Flags:       []cli.Flag{configFlag, dryRunFlag, &commandline.LogLevel},
 	Action: func(c *cli.Context) (err error) {
 		commandline.SetLogLevel(c)
-		cfg, err := stipconfig.LoadConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+		stipRelayer, err := newRelayer(c)
 		if err != nil {
-			return fmt.Errorf("could not read config file: %w", err)
+			return err
 		}
 
-		metricsProvider := metrics.Get()
+		err = stipRelayer.Run(c.Context)
+		if err != nil {
+			return fmt.Errorf("could not run STIP relayer: %w", err)
+		}
+		return nil
+	},
+}
 
-		dbType, err := dbcommon.DBTypeFromString(cfg.Database.Type)
+var fromFlag = &cli.StringFlag{
+	Name:     "from",
+	Usage:    "start of the report, inclusive, as YYYY-MM-DD or RFC3339",
+	Required: true,
+}
+
+var toFlag = &cli.StringFlag{
+	Name:  "to",
+	Usage: "end of the report, exclusive, as YYYY-MM-DD or RFC3339. Defaults to now",
+}
+
+var outFlag = &cli.StringFlag{
+	Name:  "out",
+	Usage: "directory the csv files are written to",
+	Value: ".",
+}
+
+// reportCommand writes the rebate reconciliation report of a date range.
+var reportCommand = &cli.Command{
+	Name:        "report",
+	Description: "compute the rebates of a date range, compare them to the rebates sent and write them as csv",
+	Flags:       []cli.Flag{configFlag, fromFlag, toFlag, outFlag, &commandline.LogLevel},
+	Action: func(c *cli.Context) (err error) {
+		commandline.SetLogLevel(c)
+		from, err := parseReportTime(c.String(fromFlag.Name))
 		if err != nil {
-			return fmt.Errorf("could not get db type: %w", err)
+			return fmt.Errorf("could not parse from: %w", err)
 		}
-		store, err := sql.Connect(c.Context, dbType, cfg.Database.DSN, metricsProvider)
+		to := time.Now()
+		if c.IsSet(toFlag.Name) {
+			to, err = parseReportTime(c.String(toFlag.Name))
+			if err != nil {
+				return fmt.Errorf("could not parse to: %w", err)
+			}
+		}
+
+		stipRelayer, err := newRelayer(c)
 		if err != nil {
-			return fmt.Errorf("could not connect to database: %w", err)
+			return err
 		}
 
-		omnirpcClient := omniClient.NewOmnirpcClient(cfg.OmniRPCURL, metricsProvider, omniClient.WithCaptureReqRes())
-		stipRelayer, err := relayer.NewSTIPRelayer(c.Context, cfg, metricsProvider, omnirpcClient, store)
+		report, err := stipRelayer.BuildReport(c.Context, from, to)
 		if err != nil {
-			return fmt.Errorf("could not create STIP relayer instance: %w", err)
+			return fmt.Errorf("could not build report: %w", err)
 		}
 
-		err = stipRelayer.Run(c.Context)
+		err = writeReport(core.ExpandOrReturnPath(c.String(outFlag.Name)), report)
 		if err != nil {
-			return fmt.Errorf("could not run STIP relayer: %w", err)
+			return err
 		}
+		fmt.Printf("reported %d transactions with %d mismatches\n", len(report.Rows), len(report.Mismatches()))
 		return nil
 	},
 }
+
+// newRelayer creates the relayer of the config flag.
+func newRelayer(c *cli.Context) (*relayer.STIPRelayer, error) {
+	cfg, err := stipconfig.LoadConfig(core.ExpandOrReturnPath(c.String(configFlag.Name)))
+	if err != nil {
+		return nil, fmt.Errorf("could not read config file: %w", err)
+	}
+	if c.Bool(dryRunFlag.Name) {
+		cfg.DryRun = true
+	}
+
+	metricsProvider := metrics.Get()
+
+	dbType, err := dbcommon.DBTypeFromString(cfg.Database.Type)
+	if err != nil {
+		return nil, fmt.Errorf("could not get db type: %w", err)
+	}
+	store, err := sql.Connect(c.Context, dbType, cfg.Database.DSN, metricsProvider)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to database: %w", err)
+	}
+
+	omnirpcClient := omniClient.NewOmnirpcClient(cfg.OmniRPCURL, metricsProvider, omniClient.WithCaptureReqRes())
+	stipRelayer, err := relayer.NewSTIPRelayer(c.Context, cfg, metricsProvider, omnirpcClient, store)
+	if err != nil {
+		return nil, fmt.Errorf("could not create STIP relayer instance: %w", err)
+	}
+	return stipRelayer, nil
+}
+
+// parseReportTime parses a date or a RFC3339 time.
+func parseReportTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.DateOnly, value)
+	if err == nil {
+		return t, nil
+	}
+	t, err = time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %s: %w", value, err)
+	}
+	return t, nil
+}
+
+// writeReport writes the rebates, address totals, module totals and mismatches of a report to a directory.
+func writeReport(dir string, report *relayer.Report) error {
+	err := os.MkdirAll(dir, 0750)
+	if err != nil {
+		return fmt.Errorf("could not create report directory: %w", err)
+	}
+
+	files := map[string]func(w io.Writer) error{
+		"rebates.csv": func(w io.Writer) error {
+			return relayer.WriteRowsCSV(w, report.Rows)
+		},
+		"addresses.csv": func(w io.Writer) error {
+			return relayer.WriteTotalsCSV(w, "address", report.AddressTotals())
+		},
+		"modules.csv": func(w io.Writer) error {
+			return relayer.WriteTotalsCSV(w, "module", report.ModuleTotals())
+		},
+		"mismatches.csv": func(w io.Writer) error {
+			return relayer.WriteRowsCSV(w, report.Mismatches())
+		},
+	}
+	for name, write := range files {
+		err = writeReportFile(filepath.Join(dir, name), write)
+		if err != nil {
+			return fmt.Errorf("could not write %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func writeReportFile(path string, write func(w io.Writer) error) (err error) {
+	file, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("could not create file: %w", err)
+	}
+	defer func() {
+		if closeErr := file.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("could not close file: %w", closeErr)
+		}
+	}()
+
+	return write(file)
+}
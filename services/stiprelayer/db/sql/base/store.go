@@ -2,38 +2,70 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 // Write some queries here
 
-// GetSTIPTransactionsNotRebated gets transactions that have not yet been rebated.
+// GetSTIPTransactionsNotRebated gets transactions that have not yet been rebated, ordered by block time like the
+// transactions of the report.
 func (s *Store) GetSTIPTransactionsNotRebated(ctx context.Context) ([]*db.STIPTransactions, error) {
 	var stipTransactions []*db.STIPTransactions
 
-	result := s.db.WithContext(ctx).Where("rebated = ?", false).Where("do_not_process = ?", false).Find(&stipTransactions)
+	result := s.db.WithContext(ctx).
+		Where("rebated = ?", false).
+		Where("do_not_process = ?", false).
+		Order("block_time ASC").
+		Order("hash ASC").
+		Find(&stipTransactions)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	return stipTransactions, nil
 }
 
-// GetTotalArbRebated gets the total amount of arb rebated for a given address.
-func (s *Store) GetTotalArbRebated(ctx context.Context, address string) (*big.Int, error) {
+// GetSTIPTransactionsInRange gets the transactions with a block time in [from, to), ordered by block time.
+func (s *Store) GetSTIPTransactionsInRange(ctx context.Context, from, to time.Time) ([]*db.STIPTransactions, error) {
 	var stipTransactions []*db.STIPTransactions
 
-	// Fetch all transactions that have been rebated for the given address
 	result := s.db.WithContext(ctx).
-		Where("rebated = ?", true).
-		Where("address = ?", address).
+		Where("block_time >= ?", from).
+		Where("block_time < ?", to).
+		Order("block_time ASC").
+		Order("hash ASC").
 		Find(&stipTransactions)
 	if result.Error != nil {
 		return nil, result.Error
 	}
+	return stipTransactions, nil
+}
+
+// GetTotalRebated gets the total amount of reward tokens rebated to an address by a campaign.
+func (s *Store) GetTotalRebated(ctx context.Context, campaign, address string) (*big.Int, error) {
+	return s.sumRebated(ctx, s.db.WithContext(ctx).Where("campaign = ?", campaign).Where("address = ?", address))
+}
+
+// GetCampaignSpend gets the total amount of reward tokens rebated by a campaign.
+func (s *Store) GetCampaignSpend(ctx context.Context, campaign string) (*big.Int, error) {
+	return s.sumRebated(ctx, s.db.WithContext(ctx).Where("campaign = ?", campaign))
+}
+
+// sumRebated sums the amount rebated of the rebated transactions matching a query.
+func (s *Store) sumRebated(_ context.Context, query *gorm.DB) (*big.Int, error) {
+	var stipTransactions []*db.STIPTransactions
+
+	// Fetch all transactions that have been rebated
+	result := query.Where("rebated = ?", true).Find(&stipTransactions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
 
 	// Compute the sum of arb rebated across all transactions
 	totalRebated := big.NewInt(0)
@@ -89,3 +121,29 @@ func (s *Store) InsertNewStipTransactions(ctx context.Context, stipTransactions
 
 	return nil
 }
+
+// GetLogScanCursor gets the last scanned block of a chain.
+func (s *Store) GetLogScanCursor(ctx context.Context, chainID int) (uint64, bool, error) {
+	var cursor db.LogScanCursor
+
+	result := s.db.WithContext(ctx).Where("chain_id = ?", chainID).First(&cursor)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return 0, false, nil
+	}
+	if result.Error != nil {
+		return 0, false, result.Error
+	}
+	return cursor.LastBlock, true, nil
+}
+
+// UpsertLogScanCursor sets the last scanned block of a chain.
+func (s *Store) UpsertLogScanCursor(ctx context.Context, chainID int, lastBlock uint64) error {
+	result := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "chain_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_block"}),
+	}).Create(&db.LogScanCursor{ChainID: chainID, LastBlock: lastBlock})
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
@@ -26,12 +26,23 @@ type STIPTransactions struct {
 	Nonce            uint64    `gorm:"column:nonce"`
 	DoNotProcess     bool      `gorm:"column:do_not_process"`
 	ArbAmountRebated string    `gorm:"column:arb_amount_rebated"`
+	Campaign         string    `gorm:"column:campaign;index;default:stip"`
+}
+
+// LogScanCursor is the last block of a chain scanned by the logs transaction source.
+type LogScanCursor struct {
+	ChainID   int    `gorm:"column:chain_id;primaryKey;autoIncrement:false"`
+	LastBlock uint64 `gorm:"column:last_block"`
 }
 
 // STIPDBReader is the interface for reading from the database.
 type STIPDBReader interface {
 	GetSTIPTransactionsNotRebated(ctx context.Context) ([]*STIPTransactions, error)
-	GetTotalArbRebated(ctx context.Context, address string) (*big.Int, error)
+	GetTotalRebated(ctx context.Context, campaign, address string) (*big.Int, error)
+	GetCampaignSpend(ctx context.Context, campaign string) (*big.Int, error)
+	GetSTIPTransactionsInRange(ctx context.Context, from, to time.Time) ([]*STIPTransactions, error)
+	// GetLogScanCursor gets the last scanned block of a chain, found is false when the chain was never scanned.
+	GetLogScanCursor(ctx context.Context, chainID int) (lastBlock uint64, found bool, err error)
 }
 
 // STIPDBWriter is the interface for writing to the database.
@@ -39,6 +50,7 @@ type STIPDBWriter interface {
 	UpdateSTIPTransactionRebated(ctx context.Context, hash string, nonce uint64, arbAmountRebated string) error
 	InsertNewStipTransactions(ctx context.Context, stipTransactions []STIPTransactions) error
 	UpdateSTIPTransactionDoNotProcess(ctx context.Context, hash string) error
+	UpsertLogScanCursor(ctx context.Context, chainID int, lastBlock uint64) error
 }
 
 // STIPDB is the interface for the database service.
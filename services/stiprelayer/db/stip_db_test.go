@@ -43,3 +43,72 @@ func (d *DBSuite) TestGetSTIPTransactionsNotRebated() {
 func (d *DBSuite) TestUpdateSTIPTransactionRebated() {}
 
 func (d *DBSuite) TestInsertNewStipTransactions() {}
+
+func (d *DBSuite) TestCampaignSpend() {
+	d.RunOnAllDBs(func(testDB db.STIPDB) {
+		transactions := []db.STIPTransactions{
+			{Hash: "0x1", Address: "0xa", Campaign: "stip", BlockTime: time.Now()},
+			{Hash: "0x2", Address: "0xa", Campaign: "op", BlockTime: time.Now()},
+			{Hash: "0x3", Address: "0xb", Campaign: "op", BlockTime: time.Now()},
+			{Hash: "0x4", Address: "0xb", Campaign: "op", BlockTime: time.Now()},
+		}
+		err := testDB.InsertNewStipTransactions(d.GetTestContext(), transactions)
+		d.Require().NoError(err)
+
+		d.Require().NoError(testDB.UpdateSTIPTransactionRebated(d.GetTestContext(), "0x1", 1, "100"))
+		d.Require().NoError(testDB.UpdateSTIPTransactionRebated(d.GetTestContext(), "0x2", 2, "20"))
+		d.Require().NoError(testDB.UpdateSTIPTransactionRebated(d.GetTestContext(), "0x3", 3, "3"))
+
+		spend, err := testDB.GetCampaignSpend(d.GetTestContext(), "op")
+		d.Require().NoError(err)
+		d.Equal("23", spend.String())
+
+		spend, err = testDB.GetCampaignSpend(d.GetTestContext(), "stip")
+		d.Require().NoError(err)
+		d.Equal("100", spend.String())
+
+		rebated, err := testDB.GetTotalRebated(d.GetTestContext(), "op", "0xa")
+		d.Require().NoError(err)
+		d.Equal("20", rebated.String())
+
+		rebated, err = testDB.GetTotalRebated(d.GetTestContext(), "stip", "0xb")
+		d.Require().NoError(err)
+		d.Equal("0", rebated.String())
+	})
+}
+
+func (d *DBSuite) TestLogScanCursor() {
+	d.RunOnAllDBs(func(testDB db.STIPDB) {
+		_, found, err := testDB.GetLogScanCursor(d.GetTestContext(), 42161)
+		d.Require().NoError(err)
+		d.False(found)
+
+		d.Require().NoError(testDB.UpsertLogScanCursor(d.GetTestContext(), 42161, 10))
+		d.Require().NoError(testDB.UpsertLogScanCursor(d.GetTestContext(), 42161, 20))
+
+		lastBlock, found, err := testDB.GetLogScanCursor(d.GetTestContext(), 42161)
+		d.Require().NoError(err)
+		d.True(found)
+		d.Equal(uint64(20), lastBlock)
+	})
+}
+
+func (d *DBSuite) TestGetSTIPTransactionsNotRebatedOrder() {
+	d.RunOnAllDBs(func(testDB db.STIPDB) {
+		blockTime := time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)
+		transactions := []db.STIPTransactions{
+			{Hash: "0xorder3", Address: "0xa", BlockTime: blockTime.Add(time.Hour)},
+			{Hash: "0xorder2", Address: "0xa", BlockTime: blockTime},
+			{Hash: "0xorder1", Address: "0xa", BlockTime: blockTime},
+		}
+		d.Require().NoError(testDB.InsertNewStipTransactions(d.GetTestContext(), transactions))
+
+		notRebated, err := testDB.GetSTIPTransactionsNotRebated(d.GetTestContext())
+		d.Require().NoError(err)
+		var hashes []string
+		for _, transaction := range notRebated {
+			hashes = append(hashes, transaction.Hash)
+		}
+		d.Equal([]string{"0xorder1", "0xorder2", "0xorder3"}, hashes)
+	})
+}
@@ -0,0 +1,79 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+)
+
+// ErrCampaignExhausted is returned when the budget of a campaign is spent.
+var ErrCampaignExhausted = errors.New("campaign budget is exhausted")
+
+// matchCampaign returns the campaign a row is rebated by: the first campaign active at the time of the row that has a
+// rebate for it, or else the first campaign active at that time.
+func (s *STIPRelayer) matchCampaign(row Row) (stipconfig.CampaignConfig, bool) {
+	var active []stipconfig.CampaignConfig
+	for _, campaign := range s.cfg.GetCampaigns() {
+		if campaign.IsActive(row.BlockTime.Time) {
+			active = append(active, campaign)
+		}
+	}
+
+	for _, campaign := range active {
+		if campaign.IsEligible(directionChainIDs[row.Direction], row.Module, row.Token) {
+			return campaign, true
+		}
+	}
+	if len(active) > 0 {
+		return active[0], true
+	}
+	return stipconfig.CampaignConfig{}, false
+}
+
+// getRemainingBudget returns the amount a campaign can still rebate, and whether the campaign has a budget at all.
+func (s *STIPRelayer) getRemainingBudget(ctx context.Context, ledger rebateLedger, campaign stipconfig.CampaignConfig) (_ *big.Int, hasBudget bool, err error) {
+	if campaign.Budget <= 0 {
+		return nil, false, nil
+	}
+
+	spend, err := ledger.GetCampaignSpend(ctx, campaign.Name)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not get campaign spend: %w", err)
+	}
+	budget := toRewardUnits(campaign, new(big.Float).SetFloat64(campaign.Budget))
+	return budget.Sub(budget, spend), true, nil
+}
+
+// isCampaignExhausted returns whether a campaign has spent its budget.
+func (s *STIPRelayer) isCampaignExhausted(ctx context.Context, ledger rebateLedger, campaign stipconfig.CampaignConfig) (bool, error) {
+	remainingBudget, hasBudget, err := s.getRemainingBudget(ctx, ledger, campaign)
+	if err != nil {
+		return false, err
+	}
+	return hasBudget && remainingBudget.Sign() <= 0, nil
+}
+
+// getRewardPrice returns the usd price of the reward token of a campaign.
+func (s *STIPRelayer) getRewardPrice(ctx context.Context, campaign stipconfig.CampaignConfig, transaction *db.STIPTransactions) (float64, error) {
+	if campaign.RewardCoingeckoID == "" {
+		return transaction.ArbPrice, nil
+	}
+
+	price, err := s.prices.GetPrice(ctx, campaign.RewardCoingeckoID)
+	if err != nil {
+		return 0, fmt.Errorf("could not get price of %s: %w", campaign.RewardCoingeckoID, err)
+	}
+	return price, nil
+}
+
+// toRewardUnits converts an amount of reward tokens to the smallest unit of the reward token, truncating the fractional
+// part.
+func toRewardUnits(campaign stipconfig.CampaignConfig, amount *big.Float) *big.Int {
+	decimalMultiplier := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(campaign.GetRewardDecimals())), nil))
+	units, _ := new(big.Float).Mul(amount, decimalMultiplier).Int(nil)
+	return units
+}
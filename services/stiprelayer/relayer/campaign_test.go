@@ -0,0 +1,120 @@
+package relayer_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"github.com/synapsecns/sanguine/services/stiprelayer/relayer"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+)
+
+func (c *STIPRelayerSuite) campaignConfig() stipconfig.Config {
+	cfg := c.cfg
+	cfg.Campaigns = []stipconfig.CampaignConfig{
+		{
+			Name:          "ended",
+			RewardToken:   c.arbERC20Address.Hex(),
+			RewardChainID: c.cfg.ArbChainID,
+			StartDate:     time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:       time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			FeesAndRebates: stipconfig.FeesAndRebates{
+				42161: {"SynapseRFQ": {"USDC": {RebateBps: 100}}},
+			},
+			CapPerAddress: 10,
+		},
+		{
+			Name:          "budgeted",
+			RewardToken:   c.arbERC20Address.Hex(),
+			RewardChainID: c.cfg.ArbChainID,
+			StartDate:     time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			FeesAndRebates: stipconfig.FeesAndRebates{
+				42161: {"SynapseRFQ": {"USDC": {RebateBps: 100}}},
+			},
+			CapPerAddress: 10,
+			Budget:        1.5,
+		},
+	}
+	return cfg
+}
+
+func (c *STIPRelayerSuite) TestStoreResultsInCampaigns() {
+	stipRelayer, err := relayer.NewSTIPRelayer(c.GetTestContext(), c.campaignConfig(), c.handler, c.omniRPCClient, c.database)
+	c.Require().NoError(err)
+
+	rows := []relayer.Row{
+		{Hash: "0xcampaign1", BlockTime: relayer.CustomTime{Time: time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC)}},
+		{Hash: "0xcampaign2", BlockTime: relayer.CustomTime{Time: time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)}},
+		{Hash: "0xcampaign3", BlockTime: relayer.CustomTime{Time: time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)}},
+	}
+	err = stipRelayer.StoreResultsInDatabase(c.GetTestContext(), rows, "exec")
+	c.Require().NoError(err)
+
+	transactions, err := c.database.GetSTIPTransactionsNotRebated(c.GetTestContext())
+	c.Require().NoError(err)
+	campaigns := make(map[string]string)
+	for _, transaction := range transactions {
+		campaigns[transaction.Hash] = transaction.Campaign
+	}
+	c.NotContains(campaigns, "0xcampaign1")
+	c.Equal("ended", campaigns["0xcampaign2"])
+	c.Equal("budgeted", campaigns["0xcampaign3"])
+}
+
+func (c *STIPRelayerSuite) TestCampaignBudget() {
+	stipRelayer, err := relayer.NewSTIPRelayer(c.GetTestContext(), c.campaignConfig(), c.handler, c.omniRPCClient, c.database)
+	c.Require().NoError(err)
+
+	// each transaction is rebated 1 token: 100 usd at 100 bps with ARB at 1 usd.
+	hashes := []string{"0xbudget1", "0xbudget2", "0xbudget3"}
+	transactions := make([]db.STIPTransactions, len(hashes))
+	for i, hash := range hashes {
+		transactions[i] = db.STIPTransactions{
+			Hash:      hash,
+			Address:   "0x119bde4540d7703c2f12d37aba39a24cc49d74e8",
+			AmountUSD: 100,
+			ArbPrice:  1,
+			BlockTime: time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+			Direction: "ARB",
+			Module:    "SynapseRFQ",
+			Token:     "USDC",
+			Campaign:  "budgeted",
+		}
+	}
+	c.Require().NoError(c.database.InsertNewStipTransactions(c.GetTestContext(), transactions))
+
+	c.Require().NoError(stipRelayer.SubmitAndRebateTransaction(c.GetTestContext(), &transactions[0]))
+	c.Require().NoError(stipRelayer.SubmitAndRebateTransaction(c.GetTestContext(), &transactions[1]))
+
+	spend, err := c.database.GetCampaignSpend(c.GetTestContext(), "budgeted")
+	c.Require().NoError(err)
+	c.Equal("1500000000000000000", spend.String())
+
+	err = stipRelayer.SubmitAndRebateTransaction(c.GetTestContext(), &transactions[2])
+	c.True(errors.Is(err, relayer.ErrCampaignExhausted))
+
+	// transactions of an exhausted campaign are kept for later.
+	notRebated, err := c.database.GetSTIPTransactionsNotRebated(c.GetTestContext())
+	c.Require().NoError(err)
+	var found bool
+	for _, transaction := range notRebated {
+		found = found || transaction.Hash == "0xbudget3"
+	}
+	c.True(found)
+}
+
+func (c *STIPRelayerSuite) TestValidateCampaigns() {
+	c.Require().NoError(c.campaignConfig().ValidateCampaigns())
+
+	// a campaign without a cap per address could never rebate.
+	cfg := c.campaignConfig()
+	cfg.Campaigns[0].CapPerAddress = 0
+	c.Error(cfg.ValidateCampaigns())
+
+	// a reward token other than ARB can not be priced without a coingecko id.
+	cfg = c.campaignConfig()
+	cfg.Campaigns[0].RewardToken = "0x0000000000000000000000000000000000000001"
+	c.Error(cfg.ValidateCampaigns())
+	cfg.Campaigns[0].RewardCoingeckoID = "token"
+	c.Require().NoError(cfg.ValidateCampaigns())
+}
@@ -8,8 +8,11 @@
 	"io"
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/cenkalti/backoff"
 	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -28,8 +31,60 @@ type stipQueryParams struct {
 	LastHours int `json:"last_hours"`
 }
 
+// DuneSource is a TransactionSource reading the results of a Dune query.
+type DuneSource struct {
+	cfg     stipconfig.Config
+	handler metrics.Handler
+}
+
+// NewDuneSource creates a new DuneSource.
+func NewDuneSource(cfg stipconfig.Config, handler metrics.Handler) *DuneSource {
+	return &DuneSource{
+		cfg:     cfg,
+		handler: handler,
+	}
+}
+
+// FetchTransactions executes the Dune query and waits for its results.
+func (s *DuneSource) FetchTransactions(ctx context.Context) (string, []Row, error) {
+	executionID, err := s.ExecuteDuneQuery(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to execute Dune query: %w", err)
+	}
+
+	// TODO: remove if exponentialBackoff.InitialInterval waits 30 seconds?
+	// time.Sleep(30 * time.Second) // Consider replacing this with a more robust solution
+	var getResultsJSONResult QueryResult
+	operation := func() error {
+		jsonResult, err := s.GetExecutionResults(ctx, executionID)
+		if err != nil {
+			return fmt.Errorf("failed to get execution results: %w", err)
+		}
+
+		if jsonResult.State != "QUERY_STATE_COMPLETED" {
+			// query state is not completed, so return an error to retry
+			return fmt.Errorf("query state is not completed")
+		}
+		getResultsJSONResult = *jsonResult
+		return nil
+	}
+
+	// Create a new exponential backoff policy
+	expBackOff := backoff.NewExponentialBackOff()
+	expBackOff.InitialInterval = 30 * time.Second
+	expBackOff.MaxElapsedTime = 300 * time.Second
+
+	// Retry the operation with the backoff policy
+	err = backoff.Retry(operation, expBackOff)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get execution results after retries: %w", err)
+	}
+
+	return getResultsJSONResult.ExecutionID, getResultsJSONResult.Result.Rows, nil
+}
+
 // ExecuteDuneQuery executes a predefined query on the Dune API and returns the http response.
-func (s *STIPRelayer) ExecuteDuneQuery(parentCtx context.Context) (executionID string, err error) {
+func (s *DuneSource) ExecuteDuneQuery(parentCtx context.Context) (executionID string, err error) {
 	ctx, span := s.handler.Tracer().Start(parentCtx, "ExecuteDuneQuery")
 	defer func() {
 		metrics.EndSpanWithErr(span, err)
@@ -47,7 +102,7 @@ func (s *STIPRelayer) ExecuteDuneQuery(parentCtx context.Context) (executionID s
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal request body: %w", err)
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("https://api.dune.com/api/v1/query/%d/execute", s.cfg.StipQueryID), bytes.NewBuffer(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/query/%d/execute", s.cfg.GetDuneAPIURL(), s.cfg.StipQueryID), bytes.NewBuffer(reqBody))
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
 	}
@@ -90,7 +145,7 @@ func (s *STIPRelayer) ExecuteDuneQuery(parentCtx context.Context) (executionID s
 }
 
 // GetExecutionResults fetches the results of a Dune query execution using the provided execution ID.
-func (s *STIPRelayer) GetExecutionResults(parentCtx context.Context, executionID string) (_ *QueryResult, err error) {
+func (s *DuneSource) GetExecutionResults(parentCtx context.Context, executionID string) (_ *QueryResult, err error) {
 	ctx, span := s.handler.Tracer().Start(parentCtx, "ExecuteDuneQuery", trace.WithAttributes(attribute.String("executionID", executionID)))
 	defer func() {
 		metrics.EndSpanWithErr(span, err)
@@ -98,7 +153,7 @@ func (s *STIPRelayer) GetExecutionResults(parentCtx context.Context, executionID
 
 	client := &http.Client{}
 	s.handler.ConfigureHTTPClient(client)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://api.dune.com/api/v1/execution/%s/results", executionID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/execution/%s/results", s.cfg.GetDuneAPIURL(), executionID), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -0,0 +1,247 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// explorerBridgeTransactionsQuery fetches the completed bridge transactions to the given chains since a start time.
+const explorerBridgeTransactionsQuery = `query GetBridgeTransactions($chainIDTo: [Int], $startTime: Int, $page: Int) {
+  response: bridgeTransactions(chainIDTo: $chainIDTo, startTime: $startTime, pending: false, page: $page) {
+    fromInfo {
+      txnHash
+      eventType
+    }
+    toInfo {
+      chainID
+      address
+      txnHash
+      formattedValue
+      USDValue
+      tokenSymbol
+      time
+    }
+    pending
+  }
+}`
+
+// explorerMaxPages is the maximum number of pages read per fetch.
+const explorerMaxPages = 100
+
+// explorer event types of the origin events of the rfq and cctp modules, see the explorer's bridge.EventType.
+const (
+	explorerCircleRequestSentEvent = 10
+	explorerBridgeRequestedEvent   = 12
+)
+
+type explorerRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type explorerResponse struct {
+	Data struct {
+		Response []explorerBridgeTransaction `json:"response"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type explorerBridgeTransaction struct {
+	FromInfo *explorerPartialInfo `json:"fromInfo"`
+	ToInfo   *explorerPartialInfo `json:"toInfo"`
+	Pending  *bool                `json:"pending"`
+}
+
+type explorerPartialInfo struct {
+	ChainID        *int     `json:"chainID"`
+	Address        *string  `json:"address"`
+	TxnHash        *string  `json:"txnHash"`
+	FormattedValue *float64 `json:"formattedValue"`
+	USDValue       *float64 `json:"USDValue"`
+	TokenSymbol    *string  `json:"tokenSymbol"`
+	Time           *int     `json:"time"`
+	EventType      *int     `json:"eventType"`
+}
+
+// ExplorerSource is a TransactionSource reading the bridge transactions of the explorer graphql API.
+type ExplorerSource struct {
+	cfg     stipconfig.Config
+	handler metrics.Handler
+	prices  PriceFetcher
+}
+
+// NewExplorerSource creates a new ExplorerSource.
+func NewExplorerSource(cfg stipconfig.Config, handler metrics.Handler, prices PriceFetcher) *ExplorerSource {
+	return &ExplorerSource{
+		cfg:     cfg,
+		handler: handler,
+		prices:  prices,
+	}
+}
+
+// FetchTransactions fetches the bridge transactions to the chains of the campaigns within the lookback hours.
+func (e *ExplorerSource) FetchTransactions(parentCtx context.Context) (executionID string, rows []Row, err error) {
+	ctx, span := e.handler.Tracer().Start(parentCtx, "FetchExplorerTransactions")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	now := time.Now()
+	executionID = fmt.Sprintf("%s-%d", stipconfig.ExplorerSource, now.Unix())
+	span.SetAttributes(attribute.String("execution_id", executionID))
+
+	arbPrice, err := e.prices.GetPrice(ctx, e.cfg.GetArbCoingeckoID())
+	if err != nil {
+		return "", nil, fmt.Errorf("could not get arb price: %w", err)
+	}
+
+	chainIDs := e.cfg.GetCampaignChainIDs()
+	startTime := now.Add(-time.Duration(e.cfg.GetDuneLookbackHours()) * time.Hour).Unix()
+
+	for page := 1; page <= explorerMaxPages; page++ {
+		transactions, err := e.getBridgeTransactions(ctx, chainIDs, startTime, page)
+		if err != nil {
+			return "", nil, fmt.Errorf("could not get bridge transactions: %w", err)
+		}
+		if len(transactions) == 0 {
+			break
+		}
+		// the last page is not empty, so the transactions of the following pages are not read by this fetch.
+		if page == explorerMaxPages {
+			span.SetAttributes(attribute.Bool("truncated", true))
+			fmt.Printf("explorer transactions truncated at %d pages, lower the lookback hours to read every transaction\n", explorerMaxPages)
+		}
+
+		for _, transaction := range transactions {
+			row, ok := toExplorerRow(transaction, arbPrice)
+			if ok {
+				rows = append(rows, row)
+			}
+		}
+	}
+	span.SetAttributes(attribute.Int("number_of_rows", len(rows)))
+
+	return executionID, rows, nil
+}
+
+// getBridgeTransactions gets a page of bridge transactions from the explorer.
+func (e *ExplorerSource) getBridgeTransactions(parentCtx context.Context, chainIDs []int, startTime int64, page int) (_ []explorerBridgeTransaction, err error) {
+	ctx, span := e.handler.Tracer().Start(parentCtx, "getBridgeTransactions", trace.WithAttributes(attribute.Int("page", page)))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	reqBody, err := json.Marshal(explorerRequest{
+		Query: explorerBridgeTransactionsQuery,
+		Variables: map[string]interface{}{
+			"chainIDTo": chainIDs,
+			"startTime": startTime,
+			"page":      page,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	client := &http.Client{}
+	e.handler.ConfigureHTTPClient(client)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.cfg.ExplorerURL, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query explorer: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("failed to close response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected status code 200, got %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result explorerResponse
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+	if len(result.Errors) > 0 {
+		return nil, fmt.Errorf("explorer returned an error: %s", result.Errors[0].Message)
+	}
+
+	return result.Data.Response, nil
+}
+
+// toExplorerRow converts a completed explorer bridge transaction to a row. Transactions are identified by their
+// destination transaction hash.
+func toExplorerRow(transaction explorerBridgeTransaction, arbPrice float64) (Row, bool) {
+	to := transaction.ToInfo
+	if to == nil || transaction.Pending != nil && *transaction.Pending {
+		return Row{}, false
+	}
+	if to.ChainID == nil || to.Address == nil || to.TxnHash == nil || to.FormattedValue == nil || to.USDValue == nil ||
+		to.TokenSymbol == nil || to.Time == nil {
+		return Row{}, false
+	}
+
+	direction, ok := chainDirection(*to.ChainID)
+	if !ok {
+		return Row{}, false
+	}
+
+	var tokenPrice float64
+	if *to.FormattedValue != 0 {
+		tokenPrice = *to.USDValue / *to.FormattedValue
+	}
+
+	return Row{
+		Address:    *to.Address,
+		Amount:     *to.FormattedValue,
+		AmountUsd:  *to.USDValue,
+		ArbPrice:   arbPrice,
+		BlockTime:  CustomTime{Time: time.Unix(int64(*to.Time), 0).UTC()},
+		Direction:  direction,
+		Hash:       *to.TxnHash,
+		Module:     explorerModule(transaction.FromInfo),
+		Token:      *to.TokenSymbol,
+		TokenPrice: tokenPrice,
+	}, true
+}
+
+// explorerModule returns the module of a transaction from its origin event type.
+func explorerModule(from *explorerPartialInfo) string {
+	if from == nil || from.EventType == nil {
+		return synapseBridgeModule
+	}
+
+	switch *from.EventType {
+	case explorerCircleRequestSentEvent:
+		return synapseCCTPModule
+	case explorerBridgeRequestedEvent:
+		return synapseRFQModule
+	default:
+		return synapseBridgeModule
+	}
+}
@@ -0,0 +1,18 @@
+package relayer
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DecodeLog decodes a log of the given contracts for testing.
+func DecodeLog(synapseBridgeAddress, synapseCCTPAddress, fastBridgeAddress string, log types.Log) (module string, recipient, token common.Address, amount *big.Int, ok bool, err error) {
+	decoder, err := newLogDecoder(synapseBridgeAddress, synapseCCTPAddress, fastBridgeAddress)
+	if err != nil {
+		return "", common.Address{}, common.Address{}, nil, false, err
+	}
+	transfer, ok, err := decoder.decode(log)
+	return transfer.module, transfer.recipient, transfer.token, transfer.amount, ok, err
+}
@@ -0,0 +1,89 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// rebateLedger tracks the amounts rebated by each campaign.
+type rebateLedger interface {
+	// GetTotalRebated gets the total amount rebated to an address by a campaign.
+	GetTotalRebated(ctx context.Context, campaign, address string) (*big.Int, error)
+	// GetCampaignSpend gets the total amount rebated by a campaign.
+	GetCampaignSpend(ctx context.Context, campaign string) (*big.Int, error)
+}
+
+// memoryLedger tracks rebates that are not stored on top of an optional base ledger.
+type memoryLedger struct {
+	base rebateLedger
+	// mux protects the fields below.
+	mux     sync.Mutex
+	spend   map[string]*big.Int
+	rebated map[string]map[string]*big.Int
+}
+
+// newMemoryLedger creates a memory ledger on top of a base ledger, which can be nil to start from no rebates.
+func newMemoryLedger(base rebateLedger) *memoryLedger {
+	return &memoryLedger{
+		base:    base,
+		spend:   make(map[string]*big.Int),
+		rebated: make(map[string]map[string]*big.Int),
+	}
+}
+
+// add tracks a rebate.
+func (m *memoryLedger) add(campaign, address string, amount *big.Int) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if m.spend[campaign] == nil {
+		m.spend[campaign] = new(big.Int)
+	}
+	m.spend[campaign].Add(m.spend[campaign], amount)
+
+	if m.rebated[campaign] == nil {
+		m.rebated[campaign] = make(map[string]*big.Int)
+	}
+	if m.rebated[campaign][address] == nil {
+		m.rebated[campaign][address] = new(big.Int)
+	}
+	m.rebated[campaign][address].Add(m.rebated[campaign][address], amount)
+}
+
+func (m *memoryLedger) GetTotalRebated(ctx context.Context, campaign, address string) (*big.Int, error) {
+	total := new(big.Int)
+	if m.base != nil {
+		baseTotal, err := m.base.GetTotalRebated(ctx, campaign, address)
+		if err != nil {
+			return nil, fmt.Errorf("could not get total rebated: %w", err)
+		}
+		total.Set(baseTotal)
+	}
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	if rebated := m.rebated[campaign][address]; rebated != nil {
+		total.Add(total, rebated)
+	}
+	return total, nil
+}
+
+func (m *memoryLedger) GetCampaignSpend(ctx context.Context, campaign string) (*big.Int, error) {
+	total := new(big.Int)
+	if m.base != nil {
+		baseSpend, err := m.base.GetCampaignSpend(ctx, campaign)
+		if err != nil {
+			return nil, fmt.Errorf("could not get campaign spend: %w", err)
+		}
+		total.Set(baseSpend)
+	}
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	if spend := m.spend[campaign]; spend != nil {
+		total.Add(total, spend)
+	}
+	return total, nil
+}
@@ -0,0 +1,161 @@
+package relayer
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
+)
+
+// synapseBridgeEventsABI holds the destination events of the SynapseBridge. Only the leading non indexed fields are
+// decoded, so the events of the bridge versions with a non indexed kappa are decoded as well.
+const synapseBridgeEventsABI = `[
+{"anonymous":false,"inputs":[{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"token","type":"address"},{"indexed":false,"name":"amount","type":"uint256"},{"indexed":false,"name":"fee","type":"uint256"},{"indexed":true,"name":"kappa","type":"bytes32"}],"name":"TokenMint","type":"event"},
+{"anonymous":false,"inputs":[{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"token","type":"address"},{"indexed":false,"name":"amount","type":"uint256"},{"indexed":false,"name":"fee","type":"uint256"},{"indexed":false,"name":"tokenIndexFrom","type":"uint8"},{"indexed":false,"name":"tokenIndexTo","type":"uint8"},{"indexed":false,"name":"minDy","type":"uint256"},{"indexed":false,"name":"deadline","type":"uint256"},{"indexed":false,"name":"swapSuccess","type":"bool"},{"indexed":true,"name":"kappa","type":"bytes32"}],"name":"TokenMintAndSwap","type":"event"},
+{"anonymous":false,"inputs":[{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"token","type":"address"},{"indexed":false,"name":"amount","type":"uint256"},{"indexed":false,"name":"fee","type":"uint256"},{"indexed":true,"name":"kappa","type":"bytes32"}],"name":"TokenWithdraw","type":"event"},
+{"anonymous":false,"inputs":[{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"token","type":"address"},{"indexed":false,"name":"amount","type":"uint256"},{"indexed":false,"name":"fee","type":"uint256"},{"indexed":false,"name":"swapTokenIndex","type":"uint8"},{"indexed":false,"name":"swapMinAmount","type":"uint256"},{"indexed":false,"name":"swapDeadline","type":"uint256"},{"indexed":false,"name":"swapSuccess","type":"bool"},{"indexed":true,"name":"kappa","type":"bytes32"}],"name":"TokenWithdrawAndRemove","type":"event"}
+]`
+
+// synapseCCTPEventsABI holds the destination event of the SynapseCCTP.
+const synapseCCTPEventsABI = `[
+{"anonymous":false,"inputs":[{"indexed":false,"name":"originDomain","type":"uint32"},{"indexed":true,"name":"recipient","type":"address"},{"indexed":false,"name":"mintToken","type":"address"},{"indexed":false,"name":"fee","type":"uint256"},{"indexed":false,"name":"token","type":"address"},{"indexed":false,"name":"amount","type":"uint256"},{"indexed":false,"name":"requestID","type":"bytes32"}],"name":"CircleRequestFulfilled","type":"event"}
+]`
+
+var (
+	synapseBridgeABI abi.ABI
+	synapseCCTPABI   abi.ABI
+)
+
+// static checks to make sure the abis are valid.
+func init() {
+	var err error
+
+	synapseBridgeABI, err = abi.JSON(strings.NewReader(synapseBridgeEventsABI))
+	if err != nil {
+		panic(err)
+	}
+
+	synapseCCTPABI, err = abi.JSON(strings.NewReader(synapseCCTPEventsABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// logTransfer is a transfer to a recipient decoded from a destination log.
+type logTransfer struct {
+	module    string
+	recipient common.Address
+	token     common.Address
+	amount    *big.Int
+	raw       types.Log
+}
+
+// logDecoder decodes the destination logs of the contracts scanned on a chain.
+type logDecoder struct {
+	synapseBridge common.Address
+	synapseCCTP   common.Address
+	fastBridge    common.Address
+	fastBridgeABI *fastbridge.FastBridgeFilterer
+	// addresses are the scanned contracts.
+	addresses []common.Address
+	// topics are the destination events of the scanned contracts.
+	topics []common.Hash
+}
+
+// newLogDecoder creates a decoder of the contracts configured on a chain.
+func newLogDecoder(synapseBridgeAddress, synapseCCTPAddress, fastBridgeAddress string) (*logDecoder, error) {
+	decoder := &logDecoder{}
+	if synapseBridgeAddress != "" {
+		decoder.synapseBridge = common.HexToAddress(synapseBridgeAddress)
+		decoder.addresses = append(decoder.addresses, decoder.synapseBridge)
+		for _, event := range synapseBridgeABI.Events {
+			decoder.topics = append(decoder.topics, event.ID)
+		}
+	}
+	if synapseCCTPAddress != "" {
+		decoder.synapseCCTP = common.HexToAddress(synapseCCTPAddress)
+		decoder.addresses = append(decoder.addresses, decoder.synapseCCTP)
+		for _, event := range synapseCCTPABI.Events {
+			decoder.topics = append(decoder.topics, event.ID)
+		}
+	}
+	if fastBridgeAddress != "" {
+		var err error
+		decoder.fastBridge = common.HexToAddress(fastBridgeAddress)
+		decoder.fastBridgeABI, err = fastbridge.NewFastBridgeFilterer(decoder.fastBridge, nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not get fast bridge filterer: %w", err)
+		}
+		decoder.addresses = append(decoder.addresses, decoder.fastBridge)
+		decoder.topics = append(decoder.topics, fastbridge.BridgeRelayedTopic)
+	}
+
+	if len(decoder.addresses) == 0 {
+		return nil, fmt.Errorf("no contract to scan")
+	}
+	return decoder, nil
+}
+
+// decode decodes a log to a transfer, ok is false when the log is not a destination log of a scanned contract.
+func (d *logDecoder) decode(log types.Log) (_ logTransfer, ok bool, err error) {
+	if len(log.Topics) == 0 {
+		return logTransfer{}, false, nil
+	}
+
+	switch {
+	case d.synapseBridge != (common.Address{}) && log.Address == d.synapseBridge:
+		// the recipient is the first indexed field of every bridge event.
+		return decodeABILog(synapseBridgeABI, synapseBridgeModule, log, "to", "token", "amount")
+	case d.synapseCCTP != (common.Address{}) && log.Address == d.synapseCCTP:
+		return decodeABILog(synapseCCTPABI, synapseCCTPModule, log, "recipient", "token", "amount")
+	case d.fastBridgeABI != nil && log.Address == d.fastBridge && log.Topics[0] == fastbridge.BridgeRelayedTopic:
+		relayed, err := d.fastBridgeABI.ParseBridgeRelayed(log)
+		if err != nil {
+			return logTransfer{}, false, fmt.Errorf("could not parse bridge relayed log: %w", err)
+		}
+		return logTransfer{
+			module:    synapseRFQModule,
+			recipient: relayed.To,
+			token:     relayed.DestToken,
+			amount:    relayed.DestAmount,
+			raw:       log,
+		}, true, nil
+	}
+	return logTransfer{}, false, nil
+}
+
+// decodeABILog decodes a log of an event with an indexed recipient as its only indexed address, and token and amount
+// data fields.
+func decodeABILog(parsedABI abi.ABI, module string, log types.Log, recipientField, tokenField, amountField string) (logTransfer, bool, error) {
+	event, err := parsedABI.EventByID(log.Topics[0])
+	if err != nil {
+		//nolint: nilerr
+		return logTransfer{}, false, nil
+	}
+	if len(log.Topics) < 2 {
+		return logTransfer{}, false, fmt.Errorf("%s log has no %s topic", event.Name, recipientField)
+	}
+
+	values := make(map[string]interface{})
+	err = parsedABI.UnpackIntoMap(values, event.Name, log.Data)
+	if err != nil {
+		return logTransfer{}, false, fmt.Errorf("could not unpack %s log: %w", event.Name, err)
+	}
+	token, tokenOK := values[tokenField].(common.Address)
+	amount, amountOK := values[amountField].(*big.Int)
+	if !tokenOK || !amountOK {
+		return logTransfer{}, false, fmt.Errorf("could not decode the %s and %s of %s log", tokenField, amountField, event.Name)
+	}
+
+	return logTransfer{
+		module:    module,
+		recipient: common.BytesToAddress(log.Topics[1].Bytes()),
+		token:     token,
+		amount:    amount,
+		raw:       log,
+	}, true, nil
+}
@@ -0,0 +1,279 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	omniClient "github.com/synapsecns/sanguine/services/omnirpc/client"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LogSource is a TransactionSource scanning the destination logs of the SynapseBridge, SynapseCCTP and FastBridge
+// contracts of the destination chains. Transactions are identified by their destination transaction hash.
+type LogSource struct {
+	cfg           stipconfig.Config
+	handler       metrics.Handler
+	omnirpcClient omniClient.RPCClient
+	prices        PriceFetcher
+	db            db.STIPDB
+	// mux protects lastBlocks and scannedBlocks.
+	mux sync.Mutex
+	// lastBlocks holds the last committed block of each chain, loaded from and stored in the database.
+	lastBlocks map[int]uint64
+	// scannedBlocks holds the last block of each chain scanned by the last fetch, committed once its rows are stored.
+	scannedBlocks map[int]uint64
+}
+
+var _ CommittingSource = &LogSource{}
+
+// NewLogSource creates a new LogSource.
+func NewLogSource(cfg stipconfig.Config, handler metrics.Handler, omniRPCClient omniClient.RPCClient, prices PriceFetcher, store db.STIPDB) *LogSource {
+	return &LogSource{
+		cfg:           cfg,
+		handler:       handler,
+		omnirpcClient: omniRPCClient,
+		prices:        prices,
+		db:            store,
+		lastBlocks:    make(map[int]uint64),
+		scannedBlocks: make(map[int]uint64),
+	}
+}
+
+// FetchTransactions scans the blocks of each chain produced since the last committed fetch.
+func (l *LogSource) FetchTransactions(parentCtx context.Context) (executionID string, rows []Row, err error) {
+	ctx, span := l.handler.Tracer().Start(parentCtx, "FetchLogTransactions")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	executionID = fmt.Sprintf("%s-%d", stipconfig.LogsSource, time.Now().Unix())
+	span.SetAttributes(attribute.String("execution_id", executionID))
+
+	chainIDs := make([]int, 0, len(l.cfg.LogScan.Chains))
+	for chainID := range l.cfg.LogScan.Chains {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Ints(chainIDs)
+
+	dailyPrices := make(map[dailyPriceKey]float64)
+	for _, chainID := range chainIDs {
+		chainRows, err := l.scanChain(ctx, chainID, dailyPrices)
+		if err != nil {
+			return "", nil, fmt.Errorf("could not scan chain %d: %w", chainID, err)
+		}
+		rows = append(rows, chainRows...)
+	}
+	span.SetAttributes(attribute.Int("number_of_rows", len(rows)))
+
+	return executionID, rows, nil
+}
+
+// scanChain scans the logs of a chain from its last committed block to the latest one. The scanned block is only
+// recorded once every batch is read, so a failed scan is retried by the next fetch.
+func (l *LogSource) scanChain(parentCtx context.Context, chainID int, dailyPrices map[dailyPriceKey]float64) (rows []Row, err error) {
+	ctx, span := l.handler.Tracer().Start(parentCtx, "scanChain", trace.WithAttributes(attribute.Int("chain_id", chainID)))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	direction, ok := chainDirection(chainID)
+	if !ok {
+		return nil, fmt.Errorf("no direction found for chain %d", chainID)
+	}
+	chainCfg := l.cfg.LogScan.Chains[chainID]
+
+	decoder, err := newLogDecoder(chainCfg.SynapseBridgeAddress, chainCfg.SynapseCCTPAddress, chainCfg.FastBridgeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not get log decoder: %w", err)
+	}
+
+	chainClient, err := l.omnirpcClient.GetChainClient(ctx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get client: %w", err)
+	}
+
+	latestBlock, err := chainClient.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest block: %w", err)
+	}
+
+	lastBlock, scanned, err := l.getLastBlock(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	startBlock := lastBlock + 1
+	if !scanned {
+		startBlock = 0
+		if latestBlock > l.cfg.LogScan.GetLookbackBlocks() {
+			startBlock = latestBlock - l.cfg.LogScan.GetLookbackBlocks()
+		}
+	}
+	span.SetAttributes(attribute.Int64("start_block", int64(startBlock)), attribute.Int64("end_block", int64(latestBlock)))
+
+	blockTimes := make(map[uint64]time.Time)
+	for from := startBlock; from <= latestBlock; from += l.cfg.LogScan.GetBlockBatchSize() {
+		to := from + l.cfg.LogScan.GetBlockBatchSize() - 1
+		if to > latestBlock {
+			to = latestBlock
+		}
+
+		logs, err := chainClient.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: decoder.addresses,
+			Topics:    [][]common.Hash{decoder.topics},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not filter logs of blocks %d to %d: %w", from, to, err)
+		}
+
+		for _, log := range logs {
+			transfer, ok, err := decoder.decode(log)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			row, ok, err := l.toLogRow(ctx, chainClient, chainCfg, transfer, direction, dailyPrices, blockTimes)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				rows = append(rows, row)
+			}
+		}
+	}
+
+	l.mux.Lock()
+	l.scannedBlocks[chainID] = latestBlock
+	l.mux.Unlock()
+
+	return rows, nil
+}
+
+// getLastBlock gets the last committed block of a chain from memory, or else from the database.
+func (l *LogSource) getLastBlock(ctx context.Context, chainID int) (uint64, bool, error) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if lastBlock, ok := l.lastBlocks[chainID]; ok {
+		return lastBlock, true, nil
+	}
+
+	lastBlock, found, err := l.db.GetLogScanCursor(ctx, chainID)
+	if err != nil {
+		return 0, false, fmt.Errorf("could not get log scan cursor: %w", err)
+	}
+	if found {
+		l.lastBlocks[chainID] = lastBlock
+	}
+	return lastBlock, found, nil
+}
+
+// Commit stores the blocks scanned by the last fetch as the last scanned blocks, so they are not scanned again.
+func (l *LogSource) Commit(ctx context.Context) error {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	for chainID, lastBlock := range l.scannedBlocks {
+		err := l.db.UpsertLogScanCursor(ctx, chainID, lastBlock)
+		if err != nil {
+			return fmt.Errorf("could not store log scan cursor of chain %d: %w", chainID, err)
+		}
+		l.lastBlocks[chainID] = lastBlock
+		delete(l.scannedBlocks, chainID)
+	}
+	return nil
+}
+
+// toLogRow converts a transfer to a row. Transfers of tokens that are not configured are skipped.
+// The token and ARB are priced on the day of the transfer's block, so rows fetched later keep the price they had.
+func (l *LogSource) toLogRow(ctx context.Context, chainClient client.EVM, chainCfg stipconfig.LogScanChainConfig,
+	transfer logTransfer, direction string,
+	dailyPrices map[dailyPriceKey]float64, blockTimes map[uint64]time.Time) (Row, bool, error) {
+	token, ok := lookupToken(chainCfg.Tokens, transfer.token)
+	if !ok {
+		return Row{}, false, nil
+	}
+
+	blockTime, ok := blockTimes[transfer.raw.BlockNumber]
+	if !ok {
+		header, err := chainClient.HeaderByNumber(ctx, new(big.Int).SetUint64(transfer.raw.BlockNumber))
+		if err != nil {
+			return Row{}, false, fmt.Errorf("could not get header of block %d: %w", transfer.raw.BlockNumber, err)
+		}
+		blockTime = time.Unix(int64(header.Time), 0).UTC()
+		blockTimes[transfer.raw.BlockNumber] = blockTime
+	}
+
+	tokenPrice, err := l.dailyPrice(ctx, token.CoingeckoID, blockTime, dailyPrices)
+	if err != nil {
+		return Row{}, false, fmt.Errorf("could not get price of %s: %w", token.Symbol, err)
+	}
+	arbPrice, err := l.dailyPrice(ctx, l.cfg.GetArbCoingeckoID(), blockTime, dailyPrices)
+	if err != nil {
+		return Row{}, false, fmt.Errorf("could not get arb price: %w", err)
+	}
+
+	decimalMultiplier := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(token.Decimals)), nil))
+	amount, _ := new(big.Float).Quo(new(big.Float).SetInt(transfer.amount), decimalMultiplier).Float64()
+
+	return Row{
+		Address:    transfer.recipient.Hex(),
+		Amount:     amount,
+		AmountUsd:  amount * tokenPrice,
+		ArbPrice:   arbPrice,
+		BlockTime:  CustomTime{Time: blockTime},
+		Direction:  direction,
+		Hash:       transfer.raw.TxHash.Hex(),
+		Module:     transfer.module,
+		Token:      token.Symbol,
+		TokenPrice: tokenPrice,
+	}, true, nil
+}
+
+// dailyPriceKey identifies the price of a token on a utc day.
+type dailyPriceKey struct {
+	coingeckoID string
+	day         string
+}
+
+// dailyPrice gets the historical price of a token on the day of the given time, caching it for the fetch.
+func (l *LogSource) dailyPrice(ctx context.Context, coingeckoID string, at time.Time, dailyPrices map[dailyPriceKey]float64) (float64, error) {
+	key := dailyPriceKey{coingeckoID: coingeckoID, day: at.UTC().Format(time.DateOnly)}
+	if price, ok := dailyPrices[key]; ok {
+		return price, nil
+	}
+
+	price, err := l.prices.GetHistoricalPrice(ctx, coingeckoID, at)
+	if err != nil {
+		return 0, fmt.Errorf("could not get historical price: %w", err)
+	}
+	dailyPrices[key] = price
+	return price, nil
+}
+
+// lookupToken finds the config of a token, ignoring the case of the configured addresses.
+func lookupToken(tokens map[string]stipconfig.TokenConfig, address common.Address) (stipconfig.TokenConfig, bool) {
+	for tokenAddress, token := range tokens {
+		if strings.EqualFold(tokenAddress, address.Hex()) {
+			return token, true
+		}
+	}
+	return stipconfig.TokenConfig{}, false
+}
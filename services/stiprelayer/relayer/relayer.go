@@ -3,15 +3,14 @@
 import (
 	"context"
 	"fmt"
-	"github.com/synapsecns/sanguine/contrib/screener-api/client"
 	"math/big"
 	"strings"
 	"time"
 
-	"github.com/cenkalti/backoff"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/contrib/screener-api/client"
 	"github.com/synapsecns/sanguine/core/metrics"
 	signerConfig "github.com/synapsecns/sanguine/ethergo/signer/config"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer"
@@ -41,6 +40,10 @@ type STIPRelayer struct {
 	submittter    submitter.TransactionSubmitter
 	signer        signer.Signer
 	apiServer     *stipapi.Server
+	// source fetches the transactions eligible for rebates.
+	source TransactionSource
+	// prices prices the reward tokens that are not ARB.
+	prices PriceFetcher
 	// screener is used to screen addresses using the screener-api client stub.
 	screener client.ScreenerClient
 }
@@ -52,6 +55,11 @@ func NewSTIPRelayer(ctx context.Context,
 	omniRPCClient omniClient.RPCClient,
 	store db.STIPDB,
 ) (*STIPRelayer, error) {
+	err := cfg.ValidateCampaigns()
+	if err != nil {
+		return nil, fmt.Errorf("invalid campaigns: %w", err)
+	}
+
 	sg, err := signerConfig.SignerFromConfig(ctx, cfg.Signer)
 	if err != nil {
 		return nil, fmt.Errorf("could not get signer: %w", err)
@@ -66,12 +74,17 @@ func NewSTIPRelayer(ctx context.Context,
 	screener, _ := client.NewNoOpClient()
 
 	if cfg.ScreenerAPIUrl != "" {
-		screener, err = client.NewClient(handler, cfg.ScreenerAPIUrl)
+		screener, err = client.NewClient(handler, cfg.ScreenerAPIUrl, client.WithCaller("stiprelayer"))
 		if err != nil {
 			return nil, fmt.Errorf("could not create screener client: %w", err)
 		}
 	}
 
+	source, err := NewTransactionSource(cfg, handler, omniRPCClient, store)
+	if err != nil {
+		return nil, fmt.Errorf("could not create transaction source: %w", err)
+	}
+
 	return &STIPRelayer{
 		cfg:           cfg,
 		db:            store,
@@ -81,6 +94,8 @@ func NewSTIPRelayer(ctx context.Context,
 		signer:        sg,
 		apiServer:     apiServer,
 		screener:      screener,
+		source:        source,
+		prices:        NewCoingeckoPriceFetcher(cfg.GetCoingeckoAPIURL(), handler),
 	}, nil
 }
 
@@ -221,7 +236,7 @@ func (s *STIPRelayer) RequestAndStoreResults(ctx context.Context) error {
 	}
 }
 
-// ProcessExecutionResults encapsulates the logic for requesting and storing execution results.
+// ProcessExecutionResults fetches the transactions of the transaction source and stores them.
 func (s *STIPRelayer) ProcessExecutionResults(parentCtx context.Context) (err error) {
 	fmt.Println("Starting execution logic")
 
@@ -230,66 +245,53 @@ func (s *STIPRelayer) ProcessExecutionResults(parentCtx context.Context) (err er
 		metrics.EndSpanWithErr(span, err)
 	}()
 
-	executionID, err := s.ExecuteDuneQuery(ctx)
+	executionID, rows, err := s.source.FetchTransactions(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to execute Dune query: %w", err)
+		return fmt.Errorf("failed to fetch transactions: %w", err)
 	}
 	span.SetAttributes(attribute.String("execution_id", executionID))
 
-	// TODO: remove if exponentialBackoff.InitialInterval waits 30 seconds?
-	// time.Sleep(30 * time.Second) // Consider replacing this with a more robust solution
-	var getResultsJSONResult QueryResult
-	operation := func() error {
-		jsonResult, err := s.GetExecutionResults(ctx, executionID)
-		if err != nil {
-			return fmt.Errorf("failed to get execution results: %w", err)
-		}
-
-		if jsonResult.State != "QUERY_STATE_COMPLETED" {
-			// query state is not completed, so return an error to retry
-			return fmt.Errorf("query state is not completed")
-		}
-		getResultsJSONResult = *jsonResult
-		return nil
-	}
-
-	// Create a new exponential backoff policy
-	expBackOff := backoff.NewExponentialBackOff()
-	expBackOff.InitialInterval = 30 * time.Second
-	expBackOff.MaxElapsedTime = 300 * time.Second
-
-	// Retry the operation with the backoff policy
-	err = backoff.Retry(operation, expBackOff)
-	if err != nil {
-		return fmt.Errorf("failed to get execution results after retries: %w", err)
-	}
-
-	var rowsAfterStartDate []Row
+	var rowsInCampaigns []Row
 	var firstResultTime time.Time
-	for _, row := range getResultsJSONResult.Result.Rows {
-		// TODO: Will this panic if StartDate not set?
-		if row.BlockTime.After(s.cfg.StartDate) {
-			rowsAfterStartDate = append(rowsAfterStartDate, row)
+	for _, row := range rows {
+		if _, ok := s.matchCampaign(row); ok {
+			rowsInCampaigns = append(rowsInCampaigns, row)
 		}
 		if firstResultTime.IsZero() || row.BlockTime.Before(firstResultTime) {
 			firstResultTime = row.BlockTime.Time
 		}
 	}
 	span.SetAttributes(
-		attribute.Int("number_of_rows", len(rowsAfterStartDate)),
+		attribute.Int("number_of_rows", len(rowsInCampaigns)),
 		attribute.String("first_result_time", firstResultTime.String()),
 	)
-	fmt.Println("Number of rows after start date:", len(rowsAfterStartDate))
+	fmt.Println("Number of rows in campaigns:", len(rowsInCampaigns))
 
 	// Convert each Row to a STIPTransactions and store them in the database
-	return s.StoreResultsInDatabase(ctx, rowsAfterStartDate, getResultsJSONResult.ExecutionID)
+	err = s.StoreResultsInDatabase(ctx, rowsInCampaigns, executionID)
+	if err != nil {
+		return err
+	}
+
+	if source, ok := s.source.(CommittingSource); ok {
+		err = source.Commit(ctx)
+		if err != nil {
+			return fmt.Errorf("could not commit transactions: %w", err)
+		}
+	}
+	return nil
 }
 
-// StoreResultsInDatabase handles the storage of results in the database.
+// StoreResultsInDatabase handles the storage of results in the database. Each transaction is assigned to its campaign,
+// rows outside of every campaign are skipped.
 func (s *STIPRelayer) StoreResultsInDatabase(ctx context.Context, rows []Row, executionID string) error {
-	stipTransactions := make([]db.STIPTransactions, len(rows))
-	for i, row := range rows {
-		stipTransactions[i] = db.STIPTransactions{
+	stipTransactions := make([]db.STIPTransactions, 0, len(rows))
+	for _, row := range rows {
+		campaign, ok := s.matchCampaign(row)
+		if !ok {
+			continue
+		}
+		stipTransactions = append(stipTransactions, db.STIPTransactions{
 			Address:     row.Address,
 			Amount:      row.Amount,
 			AmountUSD:   row.AmountUsd,
@@ -302,7 +304,8 @@ func (s *STIPRelayer) StoreResultsInDatabase(ctx context.Context, rows []Row, ex
 			Token:       row.Token,
 			TokenPrice:  row.TokenPrice,
 			Rebated:     false,
-		}
+			Campaign:    campaign.Name,
+		})
 	}
 
 	if len(stipTransactions) > 0 {
@@ -356,6 +359,12 @@ func (s *STIPRelayer) RelayAndRebateTransactions(ctx context.Context) error {
 	}
 	fmt.Println("Found", len(stipTransactionsNotRebated), "STIP transactions that have not been rebated.")
 
+	// A dry run does not store its rebates, so they are tracked in memory for the caps of the following transactions.
+	var ledger rebateLedger = s.db
+	if s.cfg.DryRun {
+		ledger = newMemoryLedger(s.db)
+	}
+
 	// Relay and rebate transactions with rate limiting
 	for _, transaction := range stipTransactionsNotRebated {
 		// Wait for the limiter to allow another event
@@ -366,7 +375,7 @@ func (s *STIPRelayer) RelayAndRebateTransactions(ctx context.Context) error {
 		}
 
 		// Submit and rebate the transaction
-		if err := s.SubmitAndRebateTransaction(ctx, transaction); err != nil {
+		if err := s.rebateTransaction(ctx, ledger, transaction); err != nil {
 			// Log the error and continue processing the rest of the transactions
 			fmt.Printf("Error relaying and rebating transaction: %v", err)
 			// Optionally, you can return the error to stop processing further transactions
@@ -380,37 +389,96 @@ func (s *STIPRelayer) RelayAndRebateTransactions(ctx context.Context) error {
 const stipRuleset = "stip"
 
 // SubmitAndRebateTransaction handles the relaying and rebating of a single transaction.
-// nolint: cyclop
 func (s *STIPRelayer) SubmitAndRebateTransaction(ctx context.Context, transaction *db.STIPTransactions) error {
+	return s.rebateTransaction(ctx, s.db, transaction)
+}
+
+// rebateDecision is the outcome of evaluating a transaction for a rebate.
+type rebateDecision struct {
+	campaign stipconfig.CampaignConfig
+	amount   *big.Int
+	// doNotProcess is set along with an error when the transaction will never be rebated.
+	doNotProcess bool
+}
+
+// evaluateRebate screens the address of a transaction and computes its rebate against the amounts already rebated
+// according to a ledger.
+func (s *STIPRelayer) evaluateRebate(ctx context.Context, ledger rebateLedger, transaction *db.STIPTransactions) (rebateDecision, error) {
 	// Check if the address is blocked
 	blocked, err := s.screener.ScreenAddress(ctx, stipRuleset, transaction.Address)
 	if err != nil {
-		return fmt.Errorf("could not screen address: %w", err)
+		return rebateDecision{}, fmt.Errorf("could not screen address: %w", err)
 	}
-
 	if blocked {
-		err = s.db.UpdateSTIPTransactionDoNotProcess(ctx, transaction.Hash)
-		if err != nil {
-			return fmt.Errorf("could not update STIP transaction as do not process: %w", err)
-		}
-		return fmt.Errorf("address is blocked: %s", transaction.Address)
+		return rebateDecision{doNotProcess: true}, fmt.Errorf("address is blocked: %s", transaction.Address)
+	}
+
+	return s.computeRebate(ctx, ledger, transaction, s.getRewardPrice)
+}
+
+// rewardPriceFunc returns the usd price of the reward token of a campaign to rebate a transaction with.
+type rewardPriceFunc func(ctx context.Context, campaign stipconfig.CampaignConfig, transaction *db.STIPTransactions) (float64, error)
+
+// computeRebate computes the rebate of a transaction against the amounts already rebated according to a ledger,
+// pricing the reward token with rewardPrice. The address of the transaction is not screened.
+func (s *STIPRelayer) computeRebate(ctx context.Context, ledger rebateLedger, transaction *db.STIPTransactions, rewardPrice rewardPriceFunc) (rebateDecision, error) {
+	campaign, ok := s.cfg.GetCampaign(transaction.Campaign)
+	if !ok {
+		return rebateDecision{doNotProcess: true}, fmt.Errorf("campaign %s not found", transaction.Campaign)
+	}
+
+	// Transactions of an exhausted campaign are kept, so they are rebated if its budget is raised.
+	exhausted, err := s.isCampaignExhausted(ctx, ledger, campaign)
+	if err != nil {
+		return rebateDecision{}, fmt.Errorf("could not check campaign budget: %w", err)
+	}
+	if exhausted {
+		return rebateDecision{}, fmt.Errorf("%w: %s", ErrCampaignExhausted, campaign.Name)
+	}
+
+	price, err := rewardPrice(ctx, campaign, transaction)
+	if err != nil {
+		return rebateDecision{}, fmt.Errorf("could not get reward price: %w", err)
 	}
 
 	// Calculate the transfer amount based on transaction details
-	// This function encapsulates the logic for determining the transfer amount
-	// You can define it elsewhere and call it here
-	transferAmount, err := s.CalculateTransferAmount(ctx, transaction)
+	transferAmount, err := s.calculateTransferAmount(ctx, ledger, campaign, transaction, price)
 	if err != nil {
-		err := s.db.UpdateSTIPTransactionDoNotProcess(ctx, transaction.Hash)
-		if err != nil {
-			return fmt.Errorf("could not update STIP transaction as do not process: %w", err)
+		return rebateDecision{doNotProcess: true}, fmt.Errorf("could not calculate transfer amount: %w", err)
+	}
+
+	return rebateDecision{campaign: campaign, amount: transferAmount}, nil
+}
+
+// rebateTransaction evaluates and submits the rebate of a transaction. In dry run mode the rebate is only printed and
+// added to the ledger, which must then be a memory ledger.
+// nolint: cyclop
+func (s *STIPRelayer) rebateTransaction(ctx context.Context, ledger rebateLedger, transaction *db.STIPTransactions) error {
+	decision, err := s.evaluateRebate(ctx, ledger, transaction)
+	if err != nil {
+		if decision.doNotProcess && !s.cfg.DryRun {
+			updateErr := s.db.UpdateSTIPTransactionDoNotProcess(ctx, transaction.Hash)
+			if updateErr != nil {
+				return fmt.Errorf("could not update STIP transaction as do not process: %w", updateErr)
+			}
 		}
-		return fmt.Errorf("could not calculate transfer amount: %w", err)
+		return err
+	}
+	campaign := decision.campaign
+	transferAmount := decision.amount
+
+	if s.cfg.DryRun {
+		fmt.Printf("dry run: would rebate %s of %s on chain %d to %s for %s (campaign %s)\n",
+			transferAmount.String(), campaign.RewardToken, campaign.RewardChainID, transaction.Address, transaction.Hash, campaign.Name)
+		if preview, ok := ledger.(*memoryLedger); ok {
+			preview.add(campaign.Name, transaction.Address, transferAmount)
+		}
+		return nil
 	}
 
 	// Setup for submitting the transaction
-	chainID := s.cfg.ArbChainID
-	arbAddress := s.cfg.ArbAddress
+	chainID := campaign.RewardChainID
+	rewardToken := campaign.RewardToken
 	backendClient, err := s.omnirpcClient.GetClient(ctx, big.NewInt(int64(chainID)))
 	if err != nil {
 		return fmt.Errorf("could not get client: %w", err)
@@ -418,7 +486,7 @@ func (s *STIPRelayer) SubmitAndRebateTransaction(ctx context.Context, transactio
 
 	// Submit the transaction
 	nonceSubmitted, err := s.submittter.SubmitTransaction(ctx, big.NewInt(int64(chainID)), func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
-		erc20, err := ierc20.NewIERC20(common.HexToAddress(arbAddress), backendClient)
+		erc20, err := ierc20.NewIERC20(common.HexToAddress(rewardToken), backendClient)
 		if err != nil {
 			return nil, fmt.Errorf("could not get erc20: %w", err)
 		}
@@ -445,19 +513,21 @@ func (s *STIPRelayer) SubmitAndRebateTransaction(ctx context.Context, transactio
 	return nil
 }
 
-// CalculateTransferAmount determines the amount to transfer based on the transaction.
-func (s *STIPRelayer) CalculateTransferAmount(ctx context.Context, transaction *db.STIPTransactions) (*big.Int, error) {
-	var toChainID int
-	switch transaction.Direction {
-	case "ARB":
-		toChainID = 42161
-	case "ETH":
-		toChainID = 1
-	case "AVAX":
-		toChainID = 43114
+// CalculateTransferAmount determines the amount of reward tokens of a campaign to transfer based on the transaction.
+func (s *STIPRelayer) CalculateTransferAmount(ctx context.Context, campaign stipconfig.CampaignConfig, transaction *db.STIPTransactions) (*big.Int, error) {
+	rewardPrice, err := s.getRewardPrice(ctx, campaign, transaction)
+	if err != nil {
+		return nil, fmt.Errorf("could not get reward price: %w", err)
 	}
+	return s.calculateTransferAmount(ctx, s.db, campaign, transaction, rewardPrice)
+}
+
+// calculateTransferAmount determines the amount to transfer at the given reward price, capped by the amounts already
+// rebated according to a ledger.
+func (s *STIPRelayer) calculateTransferAmount(ctx context.Context, ledger rebateLedger, campaign stipconfig.CampaignConfig, transaction *db.STIPTransactions, rewardPrice float64) (*big.Int, error) {
+	toChainID := directionChainIDs[transaction.Direction]
 
-	moduleConfig, ok := s.cfg.FeesAndRebates[toChainID][transaction.Module]
+	moduleConfig, ok := campaign.FeesAndRebates[toChainID][transaction.Module]
 	if !ok {
 		return nil, fmt.Errorf("module configuration not found for module %s", transaction.Module)
 	}
@@ -476,36 +546,26 @@ func (s *STIPRelayer) CalculateTransferAmount(ctx context.Context, transaction *
 	rebateRate := new(big.Float).Quo(rebateBPS, big.NewFloat(10000))
 	rebateUSD := new(big.Float).Mul(amountUSD, rebateRate)
 
-	// Convert arbPrice to big.Float
-	arbPrice := new(big.Float).SetFloat64(transaction.ArbPrice)
-
-	// Calculate the amount of ARB to transfer (rebateUSD / arbPrice)
-	transferAmountFloat := new(big.Float).Quo(rebateUSD, arbPrice)
-
-	// Convert the transfer amount to big.Int (assuming we want to truncate to the integer value)
-	// Multiply by 10^18 to get the value in wei (like params.Ether does)
-	transferAmountFloatWei := new(big.Float).Mul(transferAmountFloat, big.NewFloat(1e18))
-	transferAmount, _ := transferAmountFloatWei.Int(nil) // Truncate fractional part
-	// Check if transferAmount is greater than configured max ARB (MaxAmount * 10^18 wei)
-	limit := big.NewInt(s.cfg.ARBMaxTransfer)
-	limit = limit.Mul(limit, big.NewInt(1e18)) // Convert to wei
-	if transferAmount.Cmp(limit) > 0 {
-		return nil, fmt.Errorf("transfer amount exceeds the limit of %d ARB", s.cfg.ARBMaxTransfer)
-	}
-	// Check if transferAmount is lower than configured min ARB (MinAmount * 10^18 wei)
-	minAmountFloat := new(big.Float).SetFloat64(s.cfg.ARBMinTransfer)
-	minAmountFloatWei := new(big.Float).Mul(minAmountFloat, big.NewFloat(1e18))
-	minAmount, _ := minAmountFloatWei.Int(nil) // Truncate fractional part
+	// Calculate the amount of reward tokens to transfer (rebateUSD / rewardPrice)
+	transferAmountFloat := new(big.Float).Quo(rebateUSD, new(big.Float).SetFloat64(rewardPrice))
+
+	// Convert the transfer amount to big.Int in the smallest unit of the reward token, truncating the fractional part
+	transferAmount := toRewardUnits(campaign, transferAmountFloat)
+	// Check if transferAmount is greater than the configured max transfer
+	if campaign.MaxTransfer > 0 {
+		limit := toRewardUnits(campaign, new(big.Float).SetFloat64(campaign.MaxTransfer))
+		if transferAmount.Cmp(limit) > 0 {
+			return nil, fmt.Errorf("transfer amount exceeds the limit of %f", campaign.MaxTransfer)
+		}
+	}
+	// Check if transferAmount is lower than the configured min transfer
+	minAmount := toRewardUnits(campaign, new(big.Float).SetFloat64(campaign.MinTransfer))
 	if transferAmount.Cmp(minAmount) < 0 {
-		return nil, fmt.Errorf("transfer amount is lower than the minimum of %f ARB", s.cfg.ARBMinTransfer)
+		return nil, fmt.Errorf("transfer amount is lower than the minimum of %f", campaign.MinTransfer)
 	}
-	// If you need to round to the nearest integer instead of truncating, use the following:
-	// transferAmount := new(big.Int)
-	// transferAmountFloat.Int(transferAmount) // Round to the nearest integer
 
 	// Finally, apply the rebate cap
-	var err error
-	transferAmount, err = s.applyRebateCap(ctx, transaction, transferAmount)
+	transferAmount, err := s.applyRebateCap(ctx, ledger, campaign, transaction, transferAmount)
 	if err != nil {
 		return nil, fmt.Errorf("could not apply rebate cap: %w", err)
 	}
@@ -513,18 +573,27 @@ func (s *STIPRelayer) CalculateTransferAmount(ctx context.Context, transaction *
 	return transferAmount, nil
 }
 
-func (s *STIPRelayer) applyRebateCap(ctx context.Context, transaction *db.STIPTransactions, amount *big.Int) (*big.Int, error) {
-	totalArbRebated, err := s.db.GetTotalArbRebated(ctx, transaction.Address)
+// applyRebateCap limits a rebate to the remaining cap of its address and the remaining budget of its campaign.
+func (s *STIPRelayer) applyRebateCap(ctx context.Context, ledger rebateLedger, campaign stipconfig.CampaignConfig, transaction *db.STIPTransactions, amount *big.Int) (*big.Int, error) {
+	totalRebated, err := ledger.GetTotalRebated(ctx, campaign.Name, transaction.Address)
 	if err != nil {
-		return nil, fmt.Errorf("could not get total ARB rebated: %w", err)
+		return nil, fmt.Errorf("could not get total rebated: %w", err)
 	}
-	rebateCap := new(big.Int).Mul(big.NewInt(s.cfg.GetArbCapPerAddress()), big.NewInt(1e18)) // Convert to wei
-	remainingAmount := new(big.Int).Sub(rebateCap, totalArbRebated)
+	rebateCap := toRewardUnits(campaign, new(big.Float).SetFloat64(campaign.CapPerAddress))
+	remainingAmount := new(big.Int).Sub(rebateCap, totalRebated)
 
 	if remainingAmount.Cmp(big.NewInt(0)) <= 0 {
-		return nil, fmt.Errorf("address has reached the ARB rebate cap: %s", rebateCap.String())
+		return nil, fmt.Errorf("address has reached the rebate cap: %s", rebateCap.String())
 	} else if amount.Cmp(remainingAmount) >= 0 {
-		return remainingAmount, nil
+		amount = remainingAmount
+	}
+
+	remainingBudget, hasBudget, err := s.getRemainingBudget(ctx, ledger, campaign)
+	if err != nil {
+		return nil, err
+	}
+	if hasBudget && amount.Cmp(remainingBudget) > 0 {
+		return remainingBudget, nil
 	}
 	return amount, nil
 }
@@ -0,0 +1,306 @@
+package relayer
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	submitterDB "github.com/synapsecns/sanguine/ethergo/submitter/db"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ReportRow is the reconciliation of the rebate of a transaction.
+type ReportRow struct {
+	Hash      string
+	Campaign  string
+	Address   string
+	Module    string
+	Token     string
+	BlockTime time.Time
+	// Expected is the rebate computed for the transaction, zero when it is not eligible.
+	Expected *big.Int
+	// Reason is why the transaction is not eligible.
+	Reason string
+	// Rebated is whether a rebate was submitted for the transaction.
+	Rebated bool
+	// DoNotProcess is whether the transaction was excluded from rebates.
+	DoNotProcess bool
+	// Sent is the rebate submitted for the transaction.
+	Sent *big.Int
+	// Status is the submitter status of the rebate, empty when no rebate was submitted.
+	Status string
+	// PriceEstimated is whether Expected was computed with the current price of the reward token, since only the ARB
+	// price of a transaction is stored. Expected and Sent of such rows are not compared.
+	PriceEstimated bool
+}
+
+// reportStatusMissing is the status of rebates the submitter has no transaction for.
+const reportStatusMissing = "missing"
+
+// Mismatch returns why the expected and sent rebates of a transaction disagree, or an empty string if they agree.
+// Transactions that are still waiting for their rebate are not mismatches. The report does not screen addresses, so
+// transactions excluded for a blocked address are reported as eligible transactions that were not processed.
+func (r ReportRow) Mismatch() string {
+	switch {
+	case r.Rebated && !r.PriceEstimated && r.Sent.Cmp(r.Expected) != 0:
+		return "amount mismatch"
+	case r.Rebated && r.Status == submitterDB.FailedSubmit.String():
+		return "rebate failed"
+	case r.Rebated && r.Status == reportStatusMissing:
+		return "rebate not found"
+	case r.DoNotProcess && r.Expected.Sign() > 0:
+		return "eligible transaction not processed"
+	default:
+		return ""
+	}
+}
+
+// ReportTotal is the total of the rebates of a group of transactions.
+type ReportTotal struct {
+	Campaign     string
+	Key          string
+	Transactions int
+	Expected     *big.Int
+	Sent         *big.Int
+}
+
+// Report is the reconciliation of the rebates of the transactions in a date range.
+type Report struct {
+	From time.Time
+	To   time.Time
+	Rows []ReportRow
+}
+
+// BuildReport computes the rebates of the transactions in [from, to) and compares them to the rebates actually sent.
+// Rebates are computed in block time order from the start of each campaign, so the caps and budgets account for the
+// transactions before the range. Like the relayer's ledger, the caps and budgets count the rebates actually sent for
+// rebated transactions and nothing for excluded ones. Addresses are not screened. Rewards priced through coingecko use
+// the current price, fetched once per campaign.
+func (s *STIPRelayer) BuildReport(parentCtx context.Context, from, to time.Time) (_ *Report, err error) {
+	ctx, span := s.handler.Tracer().Start(parentCtx, "BuildReport")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	start := from
+	for _, campaign := range s.cfg.GetCampaigns() {
+		if campaign.StartDate.Before(start) {
+			start = campaign.StartDate
+		}
+	}
+
+	transactions, err := s.db.GetSTIPTransactionsInRange(ctx, start, to)
+	if err != nil {
+		return nil, fmt.Errorf("could not get transactions: %w", err)
+	}
+
+	ledger := newMemoryLedger(nil)
+	rewardPrice := s.reportRewardPrice()
+	report := &Report{From: from, To: to}
+	for _, transaction := range transactions {
+		expected := new(big.Int)
+		var reason string
+		decision, err := s.computeRebate(ctx, ledger, transaction, rewardPrice)
+		priceEstimated := err == nil && decision.campaign.RewardCoingeckoID != ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			expected = decision.amount
+		}
+
+		switch {
+		case transaction.Rebated:
+			sent, err := sentRebate(transaction)
+			if err != nil {
+				return nil, err
+			}
+			ledger.add(transaction.Campaign, transaction.Address, sent)
+		case !transaction.DoNotProcess && expected.Sign() > 0:
+			ledger.add(decision.campaign.Name, transaction.Address, expected)
+		}
+
+		if transaction.BlockTime.Before(from) {
+			continue
+		}
+
+		row, err := s.toReportRow(ctx, transaction, expected, reason)
+		if err != nil {
+			return nil, err
+		}
+		row.PriceEstimated = priceEstimated
+		report.Rows = append(report.Rows, row)
+	}
+	span.SetAttributes(attribute.Int("number_of_rows", len(report.Rows)))
+
+	return report, nil
+}
+
+// reportRewardPrice returns a rewardPriceFunc pricing the rewards of campaigns without a coingecko id with the ARB price
+// of their transactions, and the other ones with the current price of their reward token, fetched once per campaign.
+func (s *STIPRelayer) reportRewardPrice() rewardPriceFunc {
+	prices := make(map[string]float64)
+	return func(ctx context.Context, campaign stipconfig.CampaignConfig, transaction *db.STIPTransactions) (float64, error) {
+		if campaign.RewardCoingeckoID == "" {
+			return transaction.ArbPrice, nil
+		}
+		if price, ok := prices[campaign.Name]; ok {
+			return price, nil
+		}
+
+		price, err := s.getRewardPrice(ctx, campaign, transaction)
+		if err != nil {
+			return 0, err
+		}
+		prices[campaign.Name] = price
+		return price, nil
+	}
+}
+
+// toReportRow converts a transaction and its expected rebate to a report row.
+func (s *STIPRelayer) toReportRow(ctx context.Context, transaction *db.STIPTransactions, expected *big.Int, reason string) (ReportRow, error) {
+	row := ReportRow{
+		Hash:         transaction.Hash,
+		Campaign:     transaction.Campaign,
+		Address:      transaction.Address,
+		Module:       transaction.Module,
+		Token:        transaction.Token,
+		BlockTime:    transaction.BlockTime,
+		Expected:     expected,
+		Reason:       reason,
+		Rebated:      transaction.Rebated,
+		DoNotProcess: transaction.DoNotProcess,
+		Sent:         new(big.Int),
+	}
+	if !transaction.Rebated {
+		return row, nil
+	}
+
+	sent, err := sentRebate(transaction)
+	if err != nil {
+		return ReportRow{}, err
+	}
+	row.Sent = sent
+
+	campaign, ok := s.cfg.GetCampaign(transaction.Campaign)
+	if !ok {
+		row.Status = "unknown campaign"
+		return row, nil
+	}
+	status, err := s.db.SubmitterDB().GetNonceStatus(ctx, s.signer.Address(), new(big.Int).SetUint64(campaign.RewardChainID), transaction.Nonce)
+	if errors.Is(err, submitterDB.ErrNonceNotExist) {
+		row.Status = reportStatusMissing
+		return row, nil
+	}
+	if err != nil {
+		return ReportRow{}, fmt.Errorf("could not get status of the rebate of %s: %w", transaction.Hash, err)
+	}
+	row.Status = status.String()
+
+	return row, nil
+}
+
+// sentRebate returns the rebate submitted for a rebated transaction.
+func sentRebate(transaction *db.STIPTransactions) (*big.Int, error) {
+	sent, ok := new(big.Int).SetString(transaction.ArbAmountRebated, 10)
+	if !ok {
+		return nil, fmt.Errorf("failed to convert arb amount rebated of %s to number", transaction.Hash)
+	}
+	return sent, nil
+}
+
+// Mismatches returns the rows whose expected and sent rebates disagree.
+func (r *Report) Mismatches() []ReportRow {
+	var mismatches []ReportRow
+	for _, row := range r.Rows {
+		if row.Mismatch() != "" {
+			mismatches = append(mismatches, row)
+		}
+	}
+	return mismatches
+}
+
+// AddressTotals returns the totals of each address of each campaign.
+func (r *Report) AddressTotals() []ReportTotal {
+	return r.totals(func(row ReportRow) string {
+		return row.Address
+	})
+}
+
+// ModuleTotals returns the totals of each module of each campaign.
+func (r *Report) ModuleTotals() []ReportTotal {
+	return r.totals(func(row ReportRow) string {
+		return row.Module
+	})
+}
+
+// totals groups the rows by campaign and key, sorted by campaign then key.
+func (r *Report) totals(key func(row ReportRow) string) []ReportTotal {
+	byKey := make(map[[2]string]*ReportTotal)
+	for _, row := range r.Rows {
+		id := [2]string{row.Campaign, key(row)}
+		total, ok := byKey[id]
+		if !ok {
+			total = &ReportTotal{Campaign: id[0], Key: id[1], Expected: new(big.Int), Sent: new(big.Int)}
+			byKey[id] = total
+		}
+		total.Transactions++
+		total.Expected.Add(total.Expected, row.Expected)
+		total.Sent.Add(total.Sent, row.Sent)
+	}
+
+	totals := make([]ReportTotal, 0, len(byKey))
+	for _, total := range byKey {
+		totals = append(totals, *total)
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].Campaign != totals[j].Campaign {
+			return totals[i].Campaign < totals[j].Campaign
+		}
+		return totals[i].Key < totals[j].Key
+	})
+	return totals
+}
+
+// WriteTotalsCSV writes totals as csv, with the key column named after what the totals are grouped by.
+func WriteTotalsCSV(w io.Writer, keyName string, totals []ReportTotal) error {
+	writer := csv.NewWriter(w)
+	records := [][]string{{"campaign", keyName, "transactions", "expected", "sent"}}
+	for _, total := range totals {
+		records = append(records, []string{
+			total.Campaign, total.Key, strconv.Itoa(total.Transactions), total.Expected.String(), total.Sent.String(),
+		})
+	}
+
+	err := writer.WriteAll(records)
+	if err != nil {
+		return fmt.Errorf("could not write csv: %w", err)
+	}
+	return nil
+}
+
+// WriteRowsCSV writes report rows as csv.
+func WriteRowsCSV(w io.Writer, rows []ReportRow) error {
+	writer := csv.NewWriter(w)
+	records := [][]string{{"hash", "campaign", "address", "module", "token", "block_time", "expected", "sent", "status", "reason", "price_estimated", "mismatch"}}
+	for _, row := range rows {
+		records = append(records, []string{
+			row.Hash, row.Campaign, row.Address, row.Module, row.Token, row.BlockTime.UTC().Format(time.RFC3339),
+			row.Expected.String(), row.Sent.String(), row.Status, row.Reason, strconv.FormatBool(row.PriceEstimated), row.Mismatch(),
+		})
+	}
+
+	err := writer.WriteAll(records)
+	if err != nil {
+		return fmt.Errorf("could not write csv: %w", err)
+	}
+	return nil
+}
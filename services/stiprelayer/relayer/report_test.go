@@ -0,0 +1,173 @@
+package relayer_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"github.com/synapsecns/sanguine/services/stiprelayer/relayer"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+)
+
+const reportAddress = "0x219bde4540d7703c2f12d37aba39a24cc49d74e8"
+
+func (c *STIPRelayerSuite) reportConfig() stipconfig.Config {
+	cfg := c.cfg
+	cfg.Campaigns = []stipconfig.CampaignConfig{{
+		Name:          "report",
+		RewardToken:   c.arbERC20Address.Hex(),
+		RewardChainID: c.cfg.ArbChainID,
+		StartDate:     time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC),
+		FeesAndRebates: stipconfig.FeesAndRebates{
+			42161: {"SynapseRFQ": {"USDC": {RebateBps: 100}}},
+		},
+		CapPerAddress: 1.5,
+	}}
+	return cfg
+}
+
+func (c *STIPRelayerSuite) insertReportTransactions(address string, day int, hashes ...string) []db.STIPTransactions {
+	// each transaction is rebated 1 token: 100 usd at 100 bps with ARB at 1 usd.
+	transactions := make([]db.STIPTransactions, len(hashes))
+	for i, hash := range hashes {
+		transactions[i] = db.STIPTransactions{
+			Hash:      hash,
+			Address:   address,
+			AmountUSD: 100,
+			ArbPrice:  1,
+			BlockTime: time.Date(2025, time.May, day, i, 0, 0, 0, time.UTC),
+			Direction: "ARB",
+			Module:    "SynapseRFQ",
+			Token:     "USDC",
+			Campaign:  "report",
+		}
+	}
+	c.Require().NoError(c.database.InsertNewStipTransactions(c.GetTestContext(), transactions))
+	return transactions
+}
+
+func (c *STIPRelayerSuite) TestDryRun() {
+	cfg := c.reportConfig()
+	cfg.DryRun = true
+	stipRelayer, err := relayer.NewSTIPRelayer(c.GetTestContext(), cfg, c.handler, c.omniRPCClient, c.database)
+	c.Require().NoError(err)
+
+	transactions := c.insertReportTransactions(reportAddress, 10, "0xdryrun1")
+	c.Require().NoError(stipRelayer.SubmitAndRebateTransaction(c.GetTestContext(), &transactions[0]))
+
+	spend, err := c.database.GetCampaignSpend(c.GetTestContext(), "report")
+	c.Require().NoError(err)
+	c.Equal("0", spend.String())
+}
+
+func (c *STIPRelayerSuite) TestReport() {
+	stipRelayer, err := relayer.NewSTIPRelayer(c.GetTestContext(), c.reportConfig(), c.handler, c.omniRPCClient, c.database)
+	c.Require().NoError(err)
+
+	transactions := c.insertReportTransactions(reportAddress, 2, "0xreport1", "0xreport2", "0xreport3", "0xreport4")
+	// the first rebate is sent by the relayer, the second is sent with a wrong amount.
+	c.Require().NoError(stipRelayer.SubmitAndRebateTransaction(c.GetTestContext(), &transactions[0]))
+	c.Require().NoError(c.database.UpdateSTIPTransactionRebated(c.GetTestContext(), "0xreport2", 100, "700000000000000000"))
+	// the third is excluded, and like the fourth it is over the address cap.
+	c.Require().NoError(c.database.UpdateSTIPTransactionDoNotProcess(c.GetTestContext(), "0xreport3"))
+
+	report, err := stipRelayer.BuildReport(c.GetTestContext(), time.Date(2025, time.May, 2, 0, 0, 0, 0, time.UTC), time.Date(2025, time.May, 3, 0, 0, 0, 0, time.UTC))
+	c.Require().NoError(err)
+
+	rows := make(map[string]relayer.ReportRow)
+	for _, row := range report.Rows {
+		rows[row.Hash] = row
+	}
+	c.Equal("1000000000000000000", rows["0xreport1"].Expected.String())
+	c.Equal("1000000000000000000", rows["0xreport1"].Sent.String())
+	c.Empty(rows["0xreport1"].Mismatch())
+	c.Equal("500000000000000000", rows["0xreport2"].Expected.String())
+	c.Equal("amount mismatch", rows["0xreport2"].Mismatch())
+	c.Equal("missing", rows["0xreport2"].Status)
+	c.Equal("Stored", rows["0xreport1"].Status)
+	c.Equal("0", rows["0xreport3"].Expected.String())
+	c.Contains(rows["0xreport3"].Reason, "rebate cap")
+	c.Empty(rows["0xreport3"].Mismatch())
+	c.Equal("0", rows["0xreport4"].Expected.String())
+
+	var mismatches []string
+	for _, row := range report.Mismatches() {
+		mismatches = append(mismatches, row.Hash)
+	}
+	c.Equal([]string{"0xreport2"}, mismatches)
+
+	var buf bytes.Buffer
+	c.Require().NoError(relayer.WriteTotalsCSV(&buf, "module", report.ModuleTotals()))
+	records, err := csv.NewReader(&buf).ReadAll()
+	c.Require().NoError(err)
+	c.Contains(records, []string{"report", "SynapseRFQ", "4", "1500000000000000000", "1700000000000000000"})
+}
+
+func (c *STIPRelayerSuite) TestReportEstimatedPrice() {
+	var priceCalls, screenCalls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/simple/price") {
+			priceCalls.Inc()
+			_, _ = w.Write([]byte(`{"reward-token": {"usd": 2}}`))
+			return
+		}
+		screenCalls.Inc()
+		_, _ = w.Write([]byte(`{"risk": false}`))
+	}))
+	defer server.Close()
+
+	cfg := c.reportConfig()
+	cfg.CoingeckoAPIURL = server.URL
+	cfg.ScreenerAPIUrl = server.URL
+	cfg.Campaigns[0].RewardCoingeckoID = "reward-token"
+	stipRelayer, err := relayer.NewSTIPRelayer(c.GetTestContext(), cfg, c.handler, c.omniRPCClient, c.database)
+	c.Require().NoError(err)
+
+	// each transaction is rebated 0.5 tokens at 2 usd, the first one was sent at another price.
+	c.insertReportTransactions("0x319bde4540d7703c2f12d37aba39a24cc49d74e8", 12, "0xestimated1", "0xestimated2", "0xestimated3", "0xestimated4")
+	c.Require().NoError(c.database.UpdateSTIPTransactionRebated(c.GetTestContext(), "0xestimated1", 101, "400000000000000000"))
+
+	report, err := stipRelayer.BuildReport(c.GetTestContext(), time.Date(2025, time.May, 12, 0, 0, 0, 0, time.UTC), time.Date(2025, time.May, 13, 0, 0, 0, 0, time.UTC))
+	c.Require().NoError(err)
+	c.Equal(int64(1), priceCalls.Load())
+	c.Zero(screenCalls.Load())
+
+	rows := make(map[string]relayer.ReportRow)
+	for _, row := range report.Rows {
+		rows[row.Hash] = row
+	}
+	c.True(rows["0xestimated1"].PriceEstimated)
+	c.Equal("500000000000000000", rows["0xestimated1"].Expected.String())
+	// the submitter has no rebate at the stored nonce, but the amounts are not compared.
+	c.Equal("rebate not found", rows["0xestimated1"].Mismatch())
+	// the cap accounts for the rebate that was actually sent.
+	c.Equal("500000000000000000", rows["0xestimated3"].Expected.String())
+	c.Equal("100000000000000000", rows["0xestimated4"].Expected.String())
+}
+
+func (c *STIPRelayerSuite) TestReportSkipsExcludedTransactions() {
+	stipRelayer, err := relayer.NewSTIPRelayer(c.GetTestContext(), c.reportConfig(), c.handler, c.omniRPCClient, c.database)
+	c.Require().NoError(err)
+
+	// the first transaction is excluded, so it does not count towards the 1.5 tokens cap.
+	c.insertReportTransactions("0x419bde4540d7703c2f12d37aba39a24cc49d74e8", 14, "0xexcluded1", "0xexcluded2", "0xexcluded3")
+	c.Require().NoError(c.database.UpdateSTIPTransactionDoNotProcess(c.GetTestContext(), "0xexcluded1"))
+
+	report, err := stipRelayer.BuildReport(c.GetTestContext(), time.Date(2025, time.May, 14, 0, 0, 0, 0, time.UTC), time.Date(2025, time.May, 15, 0, 0, 0, 0, time.UTC))
+	c.Require().NoError(err)
+
+	rows := make(map[string]relayer.ReportRow)
+	for _, row := range report.Rows {
+		rows[row.Hash] = row
+	}
+	c.Equal("1000000000000000000", rows["0xexcluded1"].Expected.String())
+	c.Equal("eligible transaction not processed", rows["0xexcluded1"].Mismatch())
+	c.Equal("1000000000000000000", rows["0xexcluded2"].Expected.String())
+	c.Equal("500000000000000000", rows["0xexcluded3"].Expected.String())
+}
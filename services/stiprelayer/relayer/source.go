@@ -0,0 +1,213 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	omniClient "github.com/synapsecns/sanguine/services/omnirpc/client"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TransactionSource fetches the bridge transactions eligible for rebates.
+type TransactionSource interface {
+	// FetchTransactions fetches the latest transactions of the source. The returned execution id identifies the fetch
+	// and is stored alongside the transactions.
+	FetchTransactions(ctx context.Context) (executionID string, rows []Row, err error)
+}
+
+// CommittingSource is a TransactionSource that only advances past the transactions of a fetch once they are committed,
+// so the transactions of a fetch that could not be stored are fetched again.
+type CommittingSource interface {
+	TransactionSource
+	// Commit marks the transactions of the last fetch as stored.
+	Commit(ctx context.Context) error
+}
+
+// NewTransactionSource creates the transaction source selected in the config.
+func NewTransactionSource(cfg stipconfig.Config, handler metrics.Handler, omniRPCClient omniClient.RPCClient, store db.STIPDB) (TransactionSource, error) {
+	sourceType, err := cfg.GetTransactionSource()
+	if err != nil {
+		return nil, fmt.Errorf("could not get transaction source: %w", err)
+	}
+
+	prices := NewCoingeckoPriceFetcher(cfg.GetCoingeckoAPIURL(), handler)
+	switch sourceType {
+	case stipconfig.ExplorerSource:
+		if cfg.ExplorerURL == "" {
+			return nil, fmt.Errorf("explorer url is required by the explorer transaction source")
+		}
+		return NewExplorerSource(cfg, handler, prices), nil
+	case stipconfig.LogsSource:
+		if len(cfg.LogScan.Chains) == 0 {
+			return nil, fmt.Errorf("at least one chain is required by the logs transaction source")
+		}
+		return NewLogSource(cfg, handler, omniRPCClient, prices, store), nil
+	default:
+		return NewDuneSource(cfg, handler), nil
+	}
+}
+
+// modules of the fees and rebates.
+const (
+	synapseBridgeModule = "SynapseBridge"
+	synapseCCTPModule   = "SynapseCCTP"
+	synapseRFQModule    = "SynapseRFQ"
+)
+
+// directionChainIDs holds the destination chain id of each direction of a transaction.
+var directionChainIDs = map[string]int{
+	"ARB":  42161,
+	"ETH":  1,
+	"AVAX": 43114,
+}
+
+// chainDirection returns the direction of a transaction to a destination chain id.
+func chainDirection(chainID int) (string, bool) {
+	for direction, directionChainID := range directionChainIDs {
+		if directionChainID == chainID {
+			return direction, true
+		}
+	}
+	return "", false
+}
+
+// PriceFetcher fetches the usd price of a token.
+type PriceFetcher interface {
+	// GetPrice gets the usd price of the token with the given coingecko id.
+	GetPrice(ctx context.Context, coingeckoID string) (float64, error)
+	// GetHistoricalPrice gets the usd price of the token with the given coingecko id on the utc day of the given time.
+	GetHistoricalPrice(ctx context.Context, coingeckoID string, at time.Time) (float64, error)
+}
+
+type coingeckoPriceFetcher struct {
+	url     string
+	handler metrics.Handler
+}
+
+// NewCoingeckoPriceFetcher creates a price fetcher backed by the coingecko API.
+func NewCoingeckoPriceFetcher(apiURL string, handler metrics.Handler) PriceFetcher {
+	return &coingeckoPriceFetcher{
+		url:     apiURL,
+		handler: handler,
+	}
+}
+
+func (c *coingeckoPriceFetcher) GetPrice(parentCtx context.Context, coingeckoID string) (price float64, err error) {
+	ctx, span := c.handler.Tracer().Start(parentCtx, "GetPrice", trace.WithAttributes(attribute.String("coingecko_id", coingeckoID)))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	client := &http.Client{}
+	c.handler.ConfigureHTTPClient(client)
+	query := url.Values{}
+	query.Set("ids", coingeckoID)
+	query.Set("vs_currencies", "usd")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/simple/price?%s", c.url, query.Encode()), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get price: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("failed to close response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("expected status code 200, got %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var prices map[string]map[string]float64
+	err = json.Unmarshal(body, &prices)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	price, ok := prices[coingeckoID]["usd"]
+	if !ok {
+		return 0, fmt.Errorf("no usd price found for %s", coingeckoID)
+	}
+	span.SetAttributes(attribute.Float64("price", price))
+
+	return price, nil
+}
+
+// historyDateFormat is the date format of the coingecko history endpoint.
+const historyDateFormat = "02-01-2006"
+
+func (c *coingeckoPriceFetcher) GetHistoricalPrice(parentCtx context.Context, coingeckoID string, at time.Time) (price float64, err error) {
+	date := at.UTC().Format(historyDateFormat)
+	ctx, span := c.handler.Tracer().Start(parentCtx, "GetHistoricalPrice", trace.WithAttributes(
+		attribute.String("coingecko_id", coingeckoID),
+		attribute.String("date", date),
+	))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	client := &http.Client{}
+	c.handler.ConfigureHTTPClient(client)
+	query := url.Values{}
+	query.Set("date", date)
+	query.Set("localization", "false")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/coins/%s/history?%s", c.url, url.PathEscape(coingeckoID), query.Encode()), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get historical price: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("failed to close response body: %v", err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("expected status code 200, got %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var history struct {
+		MarketData struct {
+			CurrentPrice map[string]float64 `json:"current_price"`
+		} `json:"market_data"`
+	}
+	err = json.Unmarshal(body, &history)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	price, ok := history.MarketData.CurrentPrice["usd"]
+	if !ok {
+		return 0, fmt.Errorf("no usd price found for %s on %s", coingeckoID, date)
+	}
+	span.SetAttributes(attribute.Float64("price", price))
+
+	return price, nil
+}
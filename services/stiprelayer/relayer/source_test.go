@@ -0,0 +1,250 @@
+package relayer_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/services/rfq/contracts/testcontracts/fastbridgemock"
+	"github.com/synapsecns/sanguine/services/stiprelayer/relayer"
+	"github.com/synapsecns/sanguine/services/stiprelayer/stipconfig"
+)
+
+type staticPrices map[string]float64
+
+func (s staticPrices) GetPrice(_ context.Context, coingeckoID string) (float64, error) {
+	price, ok := s[coingeckoID]
+	if !ok {
+		return 0, fmt.Errorf("no price for %s", coingeckoID)
+	}
+	return price, nil
+}
+
+func (s staticPrices) GetHistoricalPrice(ctx context.Context, coingeckoID string, _ time.Time) (float64, error) {
+	return s.GetPrice(ctx, coingeckoID)
+}
+
+// historicalPrices only serves historical prices, failing on spot prices.
+type historicalPrices struct {
+	staticPrices
+}
+
+func (h historicalPrices) GetPrice(_ context.Context, coingeckoID string) (float64, error) {
+	return 0, fmt.Errorf("no spot price for %s", coingeckoID)
+}
+
+func TestCoingeckoHistoricalPrice(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/coins/arbitrum/history" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"id": "arbitrum", "market_data": {"current_price": {"eur": 1.5, "usd": 1.75}}}`))
+	}))
+	defer server.Close()
+
+	prices := relayer.NewCoingeckoPriceFetcher(server.URL, metrics.NewNullHandler())
+	price, err := prices.GetHistoricalPrice(context.Background(), "arbitrum", time.Date(2024, 2, 1, 23, 0, 0, 0, time.UTC))
+	Nil(t, err)
+	InDelta(t, 1.75, price, 1e-9)
+	Equal(t, "01-02-2024", query.Get("date"))
+
+	_, err = prices.GetHistoricalPrice(context.Background(), "unknown", time.Now())
+	NotNil(t, err)
+}
+
+func TestDuneSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/query/1234/execute":
+			_, _ = w.Write([]byte(`{"execution_id": "exec-1"}`))
+		case "/execution/exec-1/results":
+			_, _ = w.Write([]byte(`{"execution_id": "exec-1", "state": "QUERY_STATE_COMPLETED", "result": {"rows": [
+				{"address": "0xabc", "amount": 10, "amount_usd": 10, "arb_price": 2, "block_time": "2024-02-01 10:00:00.000 UTC",
+				"direction": "ARB", "hash": "0x1", "module": "SynapseRFQ", "token": "USDC", "token_price": 1}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	source := relayer.NewDuneSource(stipconfig.Config{DuneAPIURL: server.URL, StipQueryID: 1234}, metrics.NewNullHandler())
+	executionID, rows, err := source.FetchTransactions(context.Background())
+	Nil(t, err)
+	Equal(t, "exec-1", executionID)
+	Len(t, rows, 1)
+	Equal(t, "0x1", rows[0].Hash)
+	Equal(t, "SynapseRFQ", rows[0].Module)
+}
+
+func TestExplorerSource(t *testing.T) {
+	var variables []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		variables = append(variables, req.Variables)
+
+		if req.Variables["page"] != float64(1) {
+			_, _ = w.Write([]byte(`{"data": {"response": []}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"response": [
+			{"fromInfo": {"txnHash": "0xo1", "eventType": 12}, "toInfo": {"chainID": 42161, "address": "0xabc", "txnHash": "0xd1",
+			"formattedValue": 100, "USDValue": 200, "tokenSymbol": "WETH", "time": 1706781600}, "pending": false},
+			{"fromInfo": {"txnHash": "0xo2", "eventType": 0}, "toInfo": {"chainID": 10, "address": "0xabc", "txnHash": "0xd2",
+			"formattedValue": 1, "USDValue": 1, "tokenSymbol": "nUSD", "time": 1706781600}, "pending": false},
+			{"fromInfo": {"txnHash": "0xo3", "eventType": 10}, "toInfo": null, "pending": true}
+		]}}`))
+	}))
+	defer server.Close()
+
+	cfg := stipconfig.Config{
+		ExplorerURL:    server.URL,
+		FeesAndRebates: stipconfig.FeesAndRebates{42161: {}},
+	}
+	source := relayer.NewExplorerSource(cfg, metrics.NewNullHandler(), staticPrices{"arbitrum": 2})
+	_, rows, err := source.FetchTransactions(context.Background())
+	Nil(t, err)
+	Len(t, variables, 2)
+	Equal(t, []interface{}{float64(42161)}, variables[0]["chainIDTo"])
+
+	Len(t, rows, 1)
+	Equal(t, "0xd1", rows[0].Hash)
+	Equal(t, "0xabc", rows[0].Address)
+	Equal(t, "ARB", rows[0].Direction)
+	Equal(t, "SynapseRFQ", rows[0].Module)
+	Equal(t, "WETH", rows[0].Token)
+	InDelta(t, 2, rows[0].TokenPrice, 1e-9)
+	InDelta(t, 2, rows[0].ArbPrice, 1e-9)
+	Equal(t, int64(1706781600), rows[0].BlockTime.Unix())
+}
+
+func (c *STIPRelayerSuite) TestLogSource() {
+	auth := c.arbitrumSimulatedBackend.GetTxContext(c.GetTestContext(), nil)
+	bridgeAddress, tx, bridge, err := fastbridgemock.DeployFastBridgeMock(auth.TransactOpts, c.arbitrumSimulatedBackend, auth.From)
+	c.Require().NoError(err)
+	c.arbitrumSimulatedBackend.WaitForConfirmation(c.GetTestContext(), tx)
+
+	recipient := common.HexToAddress("0x119bde4540d7703c2f12d37aba39a24cc49d74e8")
+	unknownToken := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	for _, token := range []common.Address{c.arbERC20Address, unknownToken} {
+		auth = c.arbitrumSimulatedBackend.GetTxContext(c.GetTestContext(), nil)
+		tx, err = bridge.MockBridgeRelayer(auth.TransactOpts, [32]byte{1}, auth.From, recipient, 1, token, token,
+			big.NewInt(5e18), big.NewInt(4e18), big.NewInt(0))
+		c.Require().NoError(err)
+		c.arbitrumSimulatedBackend.WaitForConfirmation(c.GetTestContext(), tx)
+	}
+
+	cfg := c.cfg
+	cfg.LogScan = stipconfig.LogScanConfig{
+		Chains: map[int]stipconfig.LogScanChainConfig{
+			42161: {
+				FastBridgeAddress: bridgeAddress.Hex(),
+				Tokens: map[string]stipconfig.TokenConfig{
+					c.arbERC20Address.Hex(): {Symbol: "WETH", Decimals: 18, CoingeckoID: "weth"},
+				},
+			},
+		},
+		BlockBatchSize: 2,
+	}
+	prices := historicalPrices{staticPrices{"arbitrum": 2, "weth": 3}}
+	source := relayer.NewLogSource(cfg, c.handler, c.omniRPCClient, prices, c.database)
+
+	_, rows, err := source.FetchTransactions(c.GetTestContext())
+	c.Require().NoError(err)
+	c.Require().Len(rows, 1)
+	c.Equal(recipient.Hex(), rows[0].Address)
+	c.Equal("ARB", rows[0].Direction)
+	c.Equal("SynapseRFQ", rows[0].Module)
+	c.Equal("WETH", rows[0].Token)
+	c.InDelta(4, rows[0].Amount, 1e-9)
+	c.InDelta(12, rows[0].AmountUsd, 1e-9)
+	c.InDelta(2, rows[0].ArbPrice, 1e-9)
+	c.False(rows[0].BlockTime.IsZero())
+
+	// blocks are scanned again until the fetch is committed.
+	_, rows, err = source.FetchTransactions(c.GetTestContext())
+	c.Require().NoError(err)
+	c.Len(rows, 1)
+	c.Require().NoError(source.Commit(c.GetTestContext()))
+
+	// committed blocks are not scanned again, even by a new source.
+	_, rows, err = source.FetchTransactions(c.GetTestContext())
+	c.Require().NoError(err)
+	c.Empty(rows)
+
+	restarted := relayer.NewLogSource(cfg, c.handler, c.omniRPCClient, prices, c.database)
+	_, rows, err = restarted.FetchTransactions(c.GetTestContext())
+	c.Require().NoError(err)
+	c.Empty(rows)
+}
+
+// packWords packs values as abi words.
+func packWords(values ...common.Hash) (data []byte) {
+	for _, value := range values {
+		data = append(data, value.Bytes()...)
+	}
+	return data
+}
+
+func TestDecodeLog(t *testing.T) {
+	bridge := common.HexToAddress("0xb1")
+	cctp := common.HexToAddress("0xc1")
+	recipient := common.HexToAddress("0xabc")
+	token := common.HexToAddress("0x70")
+	amount := common.BigToHash(big.NewInt(5e6))
+
+	mint := types.Log{
+		Address: bridge,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("TokenMint(address,address,uint256,uint256,bytes32)")),
+			common.BytesToHash(recipient.Bytes()),
+			{1},
+		},
+		Data: packWords(common.BytesToHash(token.Bytes()), amount, common.BigToHash(big.NewInt(1))),
+	}
+	module, decodedRecipient, decodedToken, decodedAmount, ok, err := relayer.DecodeLog(bridge.Hex(), cctp.Hex(), "", mint)
+	Nil(t, err)
+	True(t, ok)
+	Equal(t, "SynapseBridge", module)
+	Equal(t, recipient, decodedRecipient)
+	Equal(t, token, decodedToken)
+	Equal(t, big.NewInt(5e6), decodedAmount)
+
+	fulfilled := types.Log{
+		Address: cctp,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("CircleRequestFulfilled(uint32,address,address,uint256,address,uint256,bytes32)")),
+			common.BytesToHash(recipient.Bytes()),
+		},
+		Data: packWords(common.BigToHash(big.NewInt(3)), common.HexToHash("0x99"), common.BigToHash(big.NewInt(1)),
+			common.BytesToHash(token.Bytes()), amount, common.Hash{2}),
+	}
+	module, decodedRecipient, decodedToken, decodedAmount, ok, err = relayer.DecodeLog(bridge.Hex(), cctp.Hex(), "", fulfilled)
+	Nil(t, err)
+	True(t, ok)
+	Equal(t, "SynapseCCTP", module)
+	Equal(t, recipient, decodedRecipient)
+	Equal(t, token, decodedToken)
+	Equal(t, big.NewInt(5e6), decodedAmount)
+
+	// logs of contracts that are not scanned are skipped.
+	_, _, _, _, ok, err = relayer.DecodeLog(bridge.Hex(), "", "", fulfilled)
+	Nil(t, err)
+	False(t, ok)
+}
@@ -5,6 +5,7 @@
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/ipfs/go-log"
@@ -59,15 +60,50 @@ func (h *Handler) GetHealth(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"status": "ok"})
 }
 
-// GetFeeAndRebateInfo returns the current STIP Relayer's rebate configuration.
+// GetFeeAndRebateInfo returns the current STIP Relayer's rebate configuration, that of its first campaign.
 func (h *Handler) GetFeeAndRebateInfo(c *gin.Context) {
-	feesAndRebates := ConvertFeesAndRebatesToJSON(h.cfg.FeesAndRebates)
+	feesAndRebates := ConvertFeesAndRebatesToJSON(h.cfg.GetCampaigns()[0].FeesAndRebates)
 	c.JSON(http.StatusOK, feesAndRebates)
 }
 
+// CampaignInfo is the rebate configuration of a campaign.
+type CampaignInfo struct {
+	Name           string              `json:"name"`
+	RewardToken    string              `json:"reward_token"`
+	RewardChainID  uint64              `json:"reward_chain_id"`
+	StartDate      time.Time           `json:"start_date"`
+	EndDate        *time.Time          `json:"end_date,omitempty"`
+	CapPerAddress  float64             `json:"cap_per_address"`
+	Budget         float64             `json:"budget,omitempty"`
+	FeesAndRebates map[int]interface{} `json:"fees_and_rebates"`
+}
+
+// GetCampaigns returns the rebate configuration of every campaign.
+func (h *Handler) GetCampaigns(c *gin.Context) {
+	campaigns := h.cfg.GetCampaigns()
+	infos := make([]CampaignInfo, len(campaigns))
+	for i, campaign := range campaigns {
+		infos[i] = CampaignInfo{
+			Name:           campaign.Name,
+			RewardToken:    campaign.RewardToken,
+			RewardChainID:  campaign.RewardChainID,
+			StartDate:      campaign.StartDate,
+			CapPerAddress:  campaign.CapPerAddress,
+			Budget:         campaign.Budget,
+			FeesAndRebates: ConvertFeesAndRebatesToJSON(campaign.FeesAndRebates),
+		}
+		if !campaign.EndDate.IsZero() {
+			endDate := campaign.EndDate
+			infos[i].EndDate = &endDate
+		}
+	}
+	c.JSON(http.StatusOK, infos)
+}
+
 const (
 	getHealthRoute      = "/health"
 	getFeeAndRebateInfo = "/fee-rebate-bps"
+	getCampaignsRoute   = "/campaigns"
 )
 
 // Run runs the rest api server.
@@ -79,6 +115,7 @@ func (r *Server) Run(ctx context.Context) error {
 	// Assign GET routes
 	engine.GET(getHealthRoute, h.GetHealth)
 	engine.GET(getFeeAndRebateInfo, h.GetFeeAndRebateInfo)
+	engine.GET(getCampaignsRoute, h.GetCampaigns)
 
 	r.engine = engine
 
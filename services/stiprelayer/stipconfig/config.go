@@ -5,6 +5,8 @@
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/jftuga/ellipsis"
@@ -58,6 +60,256 @@ type Config struct {
 	StipQueryID       int                    `yaml:"stip_query_id"`
 	// ScreenerAPIUrl is the TRM API url.
 	ScreenerAPIUrl string `yaml:"screener_api_url"`
+	// TransactionSource is the source of the bridge transactions eligible for rebates, one of dune, explorer or logs.
+	TransactionSource string `yaml:"transaction_source"`
+	// DuneAPIURL is the url of the Dune API.
+	DuneAPIURL string `yaml:"dune_api_url"`
+	// ExplorerURL is the url of the explorer graphql API.
+	ExplorerURL string `yaml:"explorer_url"`
+	// CoingeckoAPIURL is the url of the coingecko API, used to price transactions that are not priced by their source.
+	CoingeckoAPIURL string `yaml:"coingecko_api_url"`
+	// ArbCoingeckoID is the coingecko id of ARB.
+	ArbCoingeckoID string `yaml:"arb_coingecko_id"`
+	// LogScan is the config of the logs transaction source.
+	LogScan LogScanConfig `yaml:"log_scan"`
+	// DryRun computes and prints the rebates without submitting or storing them.
+	DryRun bool `yaml:"dry_run"`
+	// Campaigns are the rebate campaigns. When empty, the top level ARB fields define a single campaign named stip.
+	Campaigns []CampaignConfig `yaml:"campaigns"`
+}
+
+// CampaignConfig is the config of a rebate campaign.
+type CampaignConfig struct {
+	// Name is the unique name of the campaign.
+	Name string `yaml:"name"`
+	// RewardToken is the address of the token rebated by the campaign.
+	RewardToken string `yaml:"reward_token"`
+	// RewardChainID is the chain id the rebates are sent on.
+	RewardChainID uint64 `yaml:"reward_chain_id"`
+	// RewardDecimals is the number of decimals of the reward token, 18 by default.
+	RewardDecimals uint8 `yaml:"reward_decimals"`
+	// RewardCoingeckoID is the coingecko id used to price the reward token. It is required when the reward token is
+	// not ARB, for ARB the price of the transactions is used when it is empty.
+	RewardCoingeckoID string `yaml:"reward_coingecko_id"`
+	// StartDate is the start of the eligibility window.
+	StartDate time.Time `yaml:"start_date"`
+	// EndDate is the end of the eligibility window. The window is open ended when it is not set.
+	EndDate time.Time `yaml:"end_date"`
+	// FeesAndRebates holds the fees and rebates of the campaign.
+	FeesAndRebates FeesAndRebates `yaml:"fees_and_rebates"`
+	// MaxTransfer is the maximum amount of a single rebate, in reward tokens. Rebates are not limited when it is not set.
+	MaxTransfer float64 `yaml:"max_transfer"`
+	// MinTransfer is the minimum amount of a single rebate, in reward tokens.
+	MinTransfer float64 `yaml:"min_transfer"`
+	// CapPerAddress is the maximum amount rebated to an address, in reward tokens. It is required.
+	CapPerAddress float64 `yaml:"cap_per_address"`
+	// Budget is the total amount the campaign can rebate, in reward tokens. The campaign has no budget when it is not
+	// set.
+	Budget float64 `yaml:"budget"`
+}
+
+// DefaultCampaignName is the name of the campaign defined by the top level ARB fields.
+const DefaultCampaignName = "stip"
+
+// GetCampaigns returns the configured campaigns, or the campaign of the top level ARB fields when none is configured.
+func (c Config) GetCampaigns() []CampaignConfig {
+	if len(c.Campaigns) > 0 {
+		return c.Campaigns
+	}
+
+	return []CampaignConfig{{
+		Name:           DefaultCampaignName,
+		RewardToken:    c.ArbAddress,
+		RewardChainID:  c.ArbChainID,
+		StartDate:      c.StartDate,
+		FeesAndRebates: c.FeesAndRebates,
+		MaxTransfer:    float64(c.ARBMaxTransfer),
+		MinTransfer:    c.ARBMinTransfer,
+		CapPerAddress:  float64(c.GetArbCapPerAddress()),
+	}}
+}
+
+// ValidateCampaigns checks the campaigns have unique names, a priced reward token and a cap per address.
+func (c Config) ValidateCampaigns() error {
+	names := make(map[string]bool)
+	for _, campaign := range c.GetCampaigns() {
+		if campaign.Name == "" {
+			return fmt.Errorf("campaign name is required")
+		}
+		if names[campaign.Name] {
+			return fmt.Errorf("campaign %s is defined more than once", campaign.Name)
+		}
+		names[campaign.Name] = true
+
+		if campaign.RewardToken == "" || campaign.RewardChainID == 0 {
+			return fmt.Errorf("campaign %s requires a reward token and chain id", campaign.Name)
+		}
+		if campaign.RewardCoingeckoID == "" && !strings.EqualFold(campaign.RewardToken, c.ArbAddress) {
+			return fmt.Errorf("campaign %s requires a reward coingecko id to price its reward token", campaign.Name)
+		}
+		if campaign.CapPerAddress <= 0 {
+			return fmt.Errorf("campaign %s requires a positive cap per address", campaign.Name)
+		}
+		if !campaign.EndDate.IsZero() && !campaign.EndDate.After(campaign.StartDate) {
+			return fmt.Errorf("campaign %s ends before it starts", campaign.Name)
+		}
+	}
+	return nil
+}
+
+// GetCampaign returns the campaign with the given name.
+func (c Config) GetCampaign(name string) (CampaignConfig, bool) {
+	for _, campaign := range c.GetCampaigns() {
+		if campaign.Name == name {
+			return campaign, true
+		}
+	}
+	return CampaignConfig{}, false
+}
+
+const defaultRewardDecimals = 18
+
+// GetRewardDecimals returns the configured reward decimals.
+func (c CampaignConfig) GetRewardDecimals() uint8 {
+	if c.RewardDecimals == 0 {
+		return defaultRewardDecimals
+	}
+	return c.RewardDecimals
+}
+
+// IsActive returns whether a time is within the eligibility window of the campaign.
+func (c CampaignConfig) IsActive(t time.Time) bool {
+	if !t.After(c.StartDate) {
+		return false
+	}
+	return c.EndDate.IsZero() || t.Before(c.EndDate)
+}
+
+// IsEligible returns whether the campaign has a rebate for a module and token to a chain.
+func (c CampaignConfig) IsEligible(toChainID int, module, token string) bool {
+	_, ok := c.FeesAndRebates[toChainID][module][token]
+	return ok
+}
+
+// GetCampaignChainIDs returns the destination chain ids of the fees and rebates of every campaign.
+func (c Config) GetCampaignChainIDs() []int {
+	seen := make(map[int]bool)
+	var chainIDs []int
+	for _, campaign := range c.GetCampaigns() {
+		for chainID := range campaign.FeesAndRebates {
+			if !seen[chainID] {
+				seen[chainID] = true
+				chainIDs = append(chainIDs, chainID)
+			}
+		}
+	}
+	sort.Ints(chainIDs)
+	return chainIDs
+}
+
+const (
+	// DuneSource reads the transactions from a Dune query.
+	DuneSource = "dune"
+	// ExplorerSource reads the transactions from the explorer graphql API.
+	ExplorerSource = "explorer"
+	// LogsSource reads the transactions from the logs of the FastBridge contracts.
+	LogsSource = "logs"
+)
+
+// GetTransactionSource returns the configured transaction source, dune by default.
+func (c Config) GetTransactionSource() (string, error) {
+	switch c.TransactionSource {
+	case "":
+		return DuneSource, nil
+	case DuneSource, ExplorerSource, LogsSource:
+		return c.TransactionSource, nil
+	default:
+		return "", fmt.Errorf("unknown transaction source %s", c.TransactionSource)
+	}
+}
+
+const defaultDuneAPIURL = "https://api.dune.com/api/v1"
+
+// GetDuneAPIURL returns the configured dune api url.
+func (c Config) GetDuneAPIURL() string {
+	if c.DuneAPIURL == "" {
+		return defaultDuneAPIURL
+	}
+	return c.DuneAPIURL
+}
+
+const defaultCoingeckoAPIURL = "https://api.coingecko.com/api/v3"
+
+// GetCoingeckoAPIURL returns the configured coingecko api url.
+func (c Config) GetCoingeckoAPIURL() string {
+	if c.CoingeckoAPIURL == "" {
+		return defaultCoingeckoAPIURL
+	}
+	return c.CoingeckoAPIURL
+}
+
+const defaultArbCoingeckoID = "arbitrum"
+
+// GetArbCoingeckoID returns the configured coingecko id of ARB.
+func (c Config) GetArbCoingeckoID() string {
+	if c.ArbCoingeckoID == "" {
+		return defaultArbCoingeckoID
+	}
+	return c.ArbCoingeckoID
+}
+
+// LogScanConfig is the config of the logs transaction source. The destination logs of the SynapseBridge (mints and
+// withdrawals), SynapseCCTP (fulfilled requests) and FastBridge (relays) contracts are scanned.
+type LogScanConfig struct {
+	// Chains holds the contracts scanned on each destination chain id.
+	Chains map[int]LogScanChainConfig `yaml:"chains"`
+	// LookbackBlocks is how many blocks before the latest one are scanned on the first fetch.
+	LookbackBlocks uint64 `yaml:"lookback_blocks"`
+	// BlockBatchSize is the number of blocks requested per log filter.
+	BlockBatchSize uint64 `yaml:"block_batch_size"`
+}
+
+// LogScanChainConfig is the config of a chain scanned by the logs transaction source.
+type LogScanChainConfig struct {
+	// SynapseBridgeAddress is the address of the SynapseBridge contract, it is not scanned when empty.
+	SynapseBridgeAddress string `yaml:"synapse_bridge_address"`
+	// SynapseCCTPAddress is the address of the SynapseCCTP contract, it is not scanned when empty.
+	SynapseCCTPAddress string `yaml:"synapse_cctp_address"`
+	// FastBridgeAddress is the address of the FastBridge contract, it is not scanned when empty.
+	FastBridgeAddress string `yaml:"fast_bridge_address"`
+	// Tokens holds the tokens eligible for rebates, by address. SynapseBridge mints are matched by the minted token
+	// (e.g. nUSD), configure it with the symbol of the token it is swapped to.
+	Tokens map[string]TokenConfig `yaml:"tokens"`
+}
+
+// TokenConfig describes a token eligible for rebates.
+type TokenConfig struct {
+	// Symbol is the symbol of the token, matching the tokens of the fees and rebates.
+	Symbol string `yaml:"symbol"`
+	// Decimals is the number of decimals of the token.
+	Decimals uint8 `yaml:"decimals"`
+	// CoingeckoID is the coingecko id used to price the token.
+	CoingeckoID string `yaml:"coingecko_id"`
+}
+
+const defaultLookbackBlocks = 10000
+
+// GetLookbackBlocks returns the configured lookback blocks.
+func (c LogScanConfig) GetLookbackBlocks() uint64 {
+	if c.LookbackBlocks == 0 {
+		return defaultLookbackBlocks
+	}
+	return c.LookbackBlocks
+}
+
+const defaultBlockBatchSize = 2000
+
+// GetBlockBatchSize returns the configured block batch size.
+func (c LogScanConfig) GetBlockBatchSize() uint64 {
+	if c.BlockBatchSize == 0 {
+		return defaultBlockBatchSize
+	}
+	return c.BlockBatchSize
 }
 
 const defaultArbCapPerAddress = 2000